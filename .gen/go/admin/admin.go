@@ -269,12 +269,12 @@ func (v *AddSearchAttributeRequest) IsSetSearchAttribute() bool {
 	return v != nil && v.SearchAttribute != nil
 }
 
-type DescribeWorkflowExecutionRequest struct {
+type DeleteWorkflowExecutionRequest struct {
 	Domain    *string                   `json:"domain,omitempty"`
 	Execution *shared.WorkflowExecution `json:"execution,omitempty"`
 }
 
-// ToWire translates a DescribeWorkflowExecutionRequest struct into a Thrift-level intermediate
+// ToWire translates a DeleteWorkflowExecutionRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -289,7 +289,7 @@ type DescribeWorkflowExecutionRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DescribeWorkflowExecutionRequest) ToWire() (wire.Value, error) {
+func (v *DeleteWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
 		fields [2]wire.Field
 		i      int = 0
@@ -323,11 +323,11 @@ func _WorkflowExecution_Read(w wire.Value) (*shared.WorkflowExecution, error) {
 	return &v, err
 }
 
-// FromWire deserializes a DescribeWorkflowExecutionRequest struct from its Thrift-level
+// FromWire deserializes a DeleteWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DescribeWorkflowExecutionRequest struct
+// An error is returned if we were unable to build a DeleteWorkflowExecutionRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -335,12 +335,12 @@ func _WorkflowExecution_Read(w wire.Value) (*shared.WorkflowExecution, error) {
 //     return nil, err
 //   }
 //
-//   var v DescribeWorkflowExecutionRequest
+//   var v DeleteWorkflowExecutionRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
+func (v *DeleteWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -369,9 +369,9 @@ func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DescribeWorkflowExecutionRequest
+// String returns a readable string representation of a DeleteWorkflowExecutionRequest
 // struct.
-func (v *DescribeWorkflowExecutionRequest) String() string {
+func (v *DeleteWorkflowExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -387,7 +387,7 @@ func (v *DescribeWorkflowExecutionRequest) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("DescribeWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("DeleteWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
 func _String_EqualsPtr(lhs, rhs *string) bool {
@@ -400,11 +400,11 @@ func _String_EqualsPtr(lhs, rhs *string) bool {
 	return lhs == nil && rhs == nil
 }
 
-// Equals returns true if all the fields of this DescribeWorkflowExecutionRequest match the
-// provided DescribeWorkflowExecutionRequest.
+// Equals returns true if all the fields of this DeleteWorkflowExecutionRequest match the
+// provided DeleteWorkflowExecutionRequest.
 //
 // This function performs a deep comparison.
-func (v *DescribeWorkflowExecutionRequest) Equals(rhs *DescribeWorkflowExecutionRequest) bool {
+func (v *DeleteWorkflowExecutionRequest) Equals(rhs *DeleteWorkflowExecutionRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -421,8 +421,8 @@ func (v *DescribeWorkflowExecutionRequest) Equals(rhs *DescribeWorkflowExecution
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of DescribeWorkflowExecutionRequest.
-func (v *DescribeWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of DeleteWorkflowExecutionRequest.
+func (v *DeleteWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -437,7 +437,7 @@ func (v *DescribeWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEn
 
 // GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *DescribeWorkflowExecutionRequest) GetDomain() (o string) {
+func (v *DeleteWorkflowExecutionRequest) GetDomain() (o string) {
 	if v != nil && v.Domain != nil {
 		return *v.Domain
 	}
@@ -446,13 +446,13 @@ func (v *DescribeWorkflowExecutionRequest) GetDomain() (o string) {
 }
 
 // IsSetDomain returns true if Domain is not nil.
-func (v *DescribeWorkflowExecutionRequest) IsSetDomain() bool {
+func (v *DeleteWorkflowExecutionRequest) IsSetDomain() bool {
 	return v != nil && v.Domain != nil
 }
 
 // GetExecution returns the value of Execution if it is set or its
 // zero value if it is unset.
-func (v *DescribeWorkflowExecutionRequest) GetExecution() (o *shared.WorkflowExecution) {
+func (v *DeleteWorkflowExecutionRequest) GetExecution() (o *shared.WorkflowExecution) {
 	if v != nil && v.Execution != nil {
 		return v.Execution
 	}
@@ -461,18 +461,74 @@ func (v *DescribeWorkflowExecutionRequest) GetExecution() (o *shared.WorkflowExe
 }
 
 // IsSetExecution returns true if Execution is not nil.
-func (v *DescribeWorkflowExecutionRequest) IsSetExecution() bool {
+func (v *DeleteWorkflowExecutionRequest) IsSetExecution() bool {
 	return v != nil && v.Execution != nil
 }
 
-type DescribeWorkflowExecutionResponse struct {
-	ShardId                *string `json:"shardId,omitempty"`
-	HistoryAddr            *string `json:"historyAddr,omitempty"`
-	MutableStateInCache    *string `json:"mutableStateInCache,omitempty"`
-	MutableStateInDatabase *string `json:"mutableStateInDatabase,omitempty"`
+type DescribeClusterResponse struct {
+	Rings         []*RingInfo         `json:"rings,omitempty"`
+	HistoryShards []*HistoryShardInfo `json:"historyShards,omitempty"`
 }
 
-// ToWire translates a DescribeWorkflowExecutionResponse struct into a Thrift-level intermediate
+type _List_RingInfo_ValueList []*RingInfo
+
+func (v _List_RingInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_RingInfo_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_RingInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_RingInfo_ValueList) Close() {}
+
+type _List_HistoryShardInfo_ValueList []*HistoryShardInfo
+
+func (v _List_HistoryShardInfo_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_HistoryShardInfo_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_HistoryShardInfo_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_HistoryShardInfo_ValueList) Close() {}
+
+// ToWire translates a DescribeClusterResponse struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -487,55 +543,87 @@ type DescribeWorkflowExecutionResponse struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *DescribeWorkflowExecutionResponse) ToWire() (wire.Value, error) {
+func (v *DescribeClusterResponse) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.ShardId != nil {
-		w, err = wire.NewValueString(*(v.ShardId)), error(nil)
+	if v.Rings != nil {
+		w, err = wire.NewValueList(_List_RingInfo_ValueList(v.Rings)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 10, Value: w}
 		i++
 	}
-	if v.HistoryAddr != nil {
-		w, err = wire.NewValueString(*(v.HistoryAddr)), error(nil)
+	if v.HistoryShards != nil {
+		w, err = wire.NewValueList(_List_HistoryShardInfo_ValueList(v.HistoryShards)), error(nil)
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.MutableStateInCache != nil {
-		w, err = wire.NewValueString(*(v.MutableStateInCache)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _RingInfo_Read(w wire.Value) (*RingInfo, error) {
+	var v RingInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_RingInfo_Read(l wire.ValueList) ([]*RingInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
 	}
-	if v.MutableStateInDatabase != nil {
-		w, err = wire.NewValueString(*(v.MutableStateInDatabase)), error(nil)
+
+	o := make([]*RingInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _RingInfo_Read(x)
 		if err != nil {
-			return w, err
+			return err
 		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _HistoryShardInfo_Read(w wire.Value) (*HistoryShardInfo, error) {
+	var v HistoryShardInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_HistoryShardInfo_Read(l wire.ValueList) ([]*HistoryShardInfo, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
 	}
 
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+	o := make([]*HistoryShardInfo, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _HistoryShardInfo_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
 }
 
-// FromWire deserializes a DescribeWorkflowExecutionResponse struct from its Thrift-level
+// FromWire deserializes a DescribeClusterResponse struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a DescribeWorkflowExecutionResponse struct
+// An error is returned if we were unable to build a DescribeClusterResponse struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -543,51 +631,27 @@ func (v *DescribeWorkflowExecutionResponse) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v DescribeWorkflowExecutionResponse
+//   var v DescribeClusterResponse
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *DescribeWorkflowExecutionResponse) FromWire(w wire.Value) error {
+func (v *DescribeClusterResponse) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 10:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.ShardId = &x
+			if field.Value.Type() == wire.TList {
+				v.Rings, err = _List_RingInfo_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
 
 			}
 		case 20:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.HistoryAddr = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.MutableStateInCache = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
-				v.MutableStateInDatabase = &x
+			if field.Value.Type() == wire.TList {
+				v.HistoryShards, err = _List_HistoryShardInfo_Read(field.Value.GetList())
 				if err != nil {
 					return err
 				}
@@ -599,152 +663,150 @@ func (v *DescribeWorkflowExecutionResponse) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a DescribeWorkflowExecutionResponse
+// String returns a readable string representation of a DescribeClusterResponse
 // struct.
-func (v *DescribeWorkflowExecutionResponse) String() string {
+func (v *DescribeClusterResponse) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [2]string
 	i := 0
-	if v.ShardId != nil {
-		fields[i] = fmt.Sprintf("ShardId: %v", *(v.ShardId))
+	if v.Rings != nil {
+		fields[i] = fmt.Sprintf("Rings: %v", v.Rings)
 		i++
 	}
-	if v.HistoryAddr != nil {
-		fields[i] = fmt.Sprintf("HistoryAddr: %v", *(v.HistoryAddr))
+	if v.HistoryShards != nil {
+		fields[i] = fmt.Sprintf("HistoryShards: %v", v.HistoryShards)
 		i++
 	}
-	if v.MutableStateInCache != nil {
-		fields[i] = fmt.Sprintf("MutableStateInCache: %v", *(v.MutableStateInCache))
-		i++
+
+	return fmt.Sprintf("DescribeClusterResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_RingInfo_Equals(lhs, rhs []*RingInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
 	}
-	if v.MutableStateInDatabase != nil {
-		fields[i] = fmt.Sprintf("MutableStateInDatabase: %v", *(v.MutableStateInDatabase))
-		i++
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
 	}
 
-	return fmt.Sprintf("DescribeWorkflowExecutionResponse{%v}", strings.Join(fields[:i], ", "))
+	return true
 }
 
-// Equals returns true if all the fields of this DescribeWorkflowExecutionResponse match the
-// provided DescribeWorkflowExecutionResponse.
+func _List_HistoryShardInfo_Equals(lhs, rhs []*HistoryShardInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this DescribeClusterResponse match the
+// provided DescribeClusterResponse.
 //
 // This function performs a deep comparison.
-func (v *DescribeWorkflowExecutionResponse) Equals(rhs *DescribeWorkflowExecutionResponse) bool {
+func (v *DescribeClusterResponse) Equals(rhs *DescribeClusterResponse) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !_String_EqualsPtr(v.ShardId, rhs.ShardId) {
-		return false
-	}
-	if !_String_EqualsPtr(v.HistoryAddr, rhs.HistoryAddr) {
-		return false
-	}
-	if !_String_EqualsPtr(v.MutableStateInCache, rhs.MutableStateInCache) {
+	if !((v.Rings == nil && rhs.Rings == nil) || (v.Rings != nil && rhs.Rings != nil && _List_RingInfo_Equals(v.Rings, rhs.Rings))) {
 		return false
 	}
-	if !_String_EqualsPtr(v.MutableStateInDatabase, rhs.MutableStateInDatabase) {
+	if !((v.HistoryShards == nil && rhs.HistoryShards == nil) || (v.HistoryShards != nil && rhs.HistoryShards != nil && _List_HistoryShardInfo_Equals(v.HistoryShards, rhs.HistoryShards))) {
 		return false
 	}
 
 	return true
 }
 
+type _List_RingInfo_Zapper []*RingInfo
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_RingInfo_Zapper.
+func (l _List_RingInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _List_HistoryShardInfo_Zapper []*HistoryShardInfo
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_HistoryShardInfo_Zapper.
+func (l _List_HistoryShardInfo_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of DescribeWorkflowExecutionResponse.
-func (v *DescribeWorkflowExecutionResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of DescribeClusterResponse.
+func (v *DescribeClusterResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.ShardId != nil {
-		enc.AddString("shardId", *v.ShardId)
-	}
-	if v.HistoryAddr != nil {
-		enc.AddString("historyAddr", *v.HistoryAddr)
-	}
-	if v.MutableStateInCache != nil {
-		enc.AddString("mutableStateInCache", *v.MutableStateInCache)
+	if v.Rings != nil {
+		err = multierr.Append(err, enc.AddArray("rings", (_List_RingInfo_Zapper)(v.Rings)))
 	}
-	if v.MutableStateInDatabase != nil {
-		enc.AddString("mutableStateInDatabase", *v.MutableStateInDatabase)
+	if v.HistoryShards != nil {
+		err = multierr.Append(err, enc.AddArray("historyShards", (_List_HistoryShardInfo_Zapper)(v.HistoryShards)))
 	}
 	return err
 }
 
-// GetShardId returns the value of ShardId if it is set or its
+// GetRings returns the value of Rings if it is set or its
 // zero value if it is unset.
-func (v *DescribeWorkflowExecutionResponse) GetShardId() (o string) {
-	if v != nil && v.ShardId != nil {
-		return *v.ShardId
+func (v *DescribeClusterResponse) GetRings() (o []*RingInfo) {
+	if v != nil && v.Rings != nil {
+		return v.Rings
 	}
 
 	return
 }
 
-// IsSetShardId returns true if ShardId is not nil.
-func (v *DescribeWorkflowExecutionResponse) IsSetShardId() bool {
-	return v != nil && v.ShardId != nil
+// IsSetRings returns true if Rings is not nil.
+func (v *DescribeClusterResponse) IsSetRings() bool {
+	return v != nil && v.Rings != nil
 }
 
-// GetHistoryAddr returns the value of HistoryAddr if it is set or its
+// GetHistoryShards returns the value of HistoryShards if it is set or its
 // zero value if it is unset.
-func (v *DescribeWorkflowExecutionResponse) GetHistoryAddr() (o string) {
-	if v != nil && v.HistoryAddr != nil {
-		return *v.HistoryAddr
+func (v *DescribeClusterResponse) GetHistoryShards() (o []*HistoryShardInfo) {
+	if v != nil && v.HistoryShards != nil {
+		return v.HistoryShards
 	}
 
 	return
 }
 
-// IsSetHistoryAddr returns true if HistoryAddr is not nil.
-func (v *DescribeWorkflowExecutionResponse) IsSetHistoryAddr() bool {
-	return v != nil && v.HistoryAddr != nil
+// IsSetHistoryShards returns true if HistoryShards is not nil.
+func (v *DescribeClusterResponse) IsSetHistoryShards() bool {
+	return v != nil && v.HistoryShards != nil
 }
 
-// GetMutableStateInCache returns the value of MutableStateInCache if it is set or its
-// zero value if it is unset.
-func (v *DescribeWorkflowExecutionResponse) GetMutableStateInCache() (o string) {
-	if v != nil && v.MutableStateInCache != nil {
-		return *v.MutableStateInCache
-	}
-
-	return
-}
-
-// IsSetMutableStateInCache returns true if MutableStateInCache is not nil.
-func (v *DescribeWorkflowExecutionResponse) IsSetMutableStateInCache() bool {
-	return v != nil && v.MutableStateInCache != nil
-}
-
-// GetMutableStateInDatabase returns the value of MutableStateInDatabase if it is set or its
-// zero value if it is unset.
-func (v *DescribeWorkflowExecutionResponse) GetMutableStateInDatabase() (o string) {
-	if v != nil && v.MutableStateInDatabase != nil {
-		return *v.MutableStateInDatabase
-	}
-
-	return
-}
-
-// IsSetMutableStateInDatabase returns true if MutableStateInDatabase is not nil.
-func (v *DescribeWorkflowExecutionResponse) IsSetMutableStateInDatabase() bool {
-	return v != nil && v.MutableStateInDatabase != nil
-}
-
-type GetWorkflowExecutionRawHistoryRequest struct {
-	Domain          *string                   `json:"domain,omitempty"`
-	Execution       *shared.WorkflowExecution `json:"execution,omitempty"`
-	FirstEventId    *int64                    `json:"firstEventId,omitempty"`
-	NextEventId     *int64                    `json:"nextEventId,omitempty"`
-	MaximumPageSize *int32                    `json:"maximumPageSize,omitempty"`
-	NextPageToken   []byte                    `json:"nextPageToken,omitempty"`
+type DescribeWorkflowExecutionRequest struct {
+	Domain    *string                   `json:"domain,omitempty"`
+	Execution *shared.WorkflowExecution `json:"execution,omitempty"`
 }
 
-// ToWire translates a GetWorkflowExecutionRawHistoryRequest struct into a Thrift-level intermediate
+// ToWire translates a DescribeWorkflowExecutionRequest struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -759,9 +821,9 @@ type GetWorkflowExecutionRawHistoryRequest struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *GetWorkflowExecutionRawHistoryRequest) ToWire() (wire.Value, error) {
+func (v *DescribeWorkflowExecutionRequest) ToWire() (wire.Value, error) {
 	var (
-		fields [6]wire.Field
+		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
@@ -783,47 +845,15 @@ func (v *GetWorkflowExecutionRawHistoryRequest) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 20, Value: w}
 		i++
 	}
-	if v.FirstEventId != nil {
-		w, err = wire.NewValueI64(*(v.FirstEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 30, Value: w}
-		i++
-	}
-	if v.NextEventId != nil {
-		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 40, Value: w}
-		i++
-	}
-	if v.MaximumPageSize != nil {
-		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 50, Value: w}
-		i++
-	}
-	if v.NextPageToken != nil {
-		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 60, Value: w}
-		i++
-	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-// FromWire deserializes a GetWorkflowExecutionRawHistoryRequest struct from its Thrift-level
+// FromWire deserializes a DescribeWorkflowExecutionRequest struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a GetWorkflowExecutionRawHistoryRequest struct
+// An error is returned if we were unable to build a DescribeWorkflowExecutionRequest struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -831,12 +861,12 @@ func (v *GetWorkflowExecutionRawHistoryRequest) ToWire() (wire.Value, error) {
 //     return nil, err
 //   }
 //
-//   var v GetWorkflowExecutionRawHistoryRequest
+//   var v DescribeWorkflowExecutionRequest
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *GetWorkflowExecutionRawHistoryRequest) FromWire(w wire.Value) error {
+func (v *DescribeWorkflowExecutionRequest) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
@@ -858,44 +888,6 @@ func (v *GetWorkflowExecutionRawHistoryRequest) FromWire(w wire.Value) error {
 					return err
 				}
 
-			}
-		case 30:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.FirstEventId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 40:
-			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
-				v.NextEventId = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 50:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.MaximumPageSize = &x
-				if err != nil {
-					return err
-				}
-
-			}
-		case 60:
-			if field.Value.Type() == wire.TBinary {
-				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
-
 			}
 		}
 	}
@@ -903,14 +895,14 @@ func (v *GetWorkflowExecutionRawHistoryRequest) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a GetWorkflowExecutionRawHistoryRequest
+// String returns a readable string representation of a DescribeWorkflowExecutionRequest
 // struct.
-func (v *GetWorkflowExecutionRawHistoryRequest) String() string {
+func (v *DescribeWorkflowExecutionRequest) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [6]string
+	var fields [2]string
 	i := 0
 	if v.Domain != nil {
 		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
@@ -920,51 +912,15 @@ func (v *GetWorkflowExecutionRawHistoryRequest) String() string {
 		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
 		i++
 	}
-	if v.FirstEventId != nil {
-		fields[i] = fmt.Sprintf("FirstEventId: %v", *(v.FirstEventId))
-		i++
-	}
-	if v.NextEventId != nil {
-		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
-		i++
-	}
-	if v.MaximumPageSize != nil {
-		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
-		i++
-	}
-	if v.NextPageToken != nil {
-		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
-		i++
-	}
-
-	return fmt.Sprintf("GetWorkflowExecutionRawHistoryRequest{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _I64_EqualsPtr(lhs, rhs *int64) bool {
-	if lhs != nil && rhs != nil {
-
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
-}
-
-func _I32_EqualsPtr(lhs, rhs *int32) bool {
-	if lhs != nil && rhs != nil {
 
-		x := *lhs
-		y := *rhs
-		return (x == y)
-	}
-	return lhs == nil && rhs == nil
+	return fmt.Sprintf("DescribeWorkflowExecutionRequest{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this GetWorkflowExecutionRawHistoryRequest match the
-// provided GetWorkflowExecutionRawHistoryRequest.
+// Equals returns true if all the fields of this DescribeWorkflowExecutionRequest match the
+// provided DescribeWorkflowExecutionRequest.
 //
 // This function performs a deep comparison.
-func (v *GetWorkflowExecutionRawHistoryRequest) Equals(rhs *GetWorkflowExecutionRawHistoryRequest) bool {
+func (v *DescribeWorkflowExecutionRequest) Equals(rhs *DescribeWorkflowExecutionRequest) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -976,25 +932,13 @@ func (v *GetWorkflowExecutionRawHistoryRequest) Equals(rhs *GetWorkflowExecution
 	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
 		return false
 	}
-	if !_I64_EqualsPtr(v.FirstEventId, rhs.FirstEventId) {
-		return false
-	}
-	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
-		return false
-	}
-	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
-		return false
-	}
-	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
-		return false
-	}
 
 	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of GetWorkflowExecutionRawHistoryRequest.
-func (v *GetWorkflowExecutionRawHistoryRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of DescribeWorkflowExecutionRequest.
+func (v *DescribeWorkflowExecutionRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -1004,24 +948,12 @@ func (v *GetWorkflowExecutionRawHistoryRequest) MarshalLogObject(enc zapcore.Obj
 	if v.Execution != nil {
 		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
 	}
-	if v.FirstEventId != nil {
-		enc.AddInt64("firstEventId", *v.FirstEventId)
-	}
-	if v.NextEventId != nil {
-		enc.AddInt64("nextEventId", *v.NextEventId)
-	}
-	if v.MaximumPageSize != nil {
-		enc.AddInt32("maximumPageSize", *v.MaximumPageSize)
-	}
-	if v.NextPageToken != nil {
-		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
-	}
 	return err
 }
 
 // GetDomain returns the value of Domain if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryRequest) GetDomain() (o string) {
+func (v *DescribeWorkflowExecutionRequest) GetDomain() (o string) {
 	if v != nil && v.Domain != nil {
 		return *v.Domain
 	}
@@ -1030,13 +962,13 @@ func (v *GetWorkflowExecutionRawHistoryRequest) GetDomain() (o string) {
 }
 
 // IsSetDomain returns true if Domain is not nil.
-func (v *GetWorkflowExecutionRawHistoryRequest) IsSetDomain() bool {
+func (v *DescribeWorkflowExecutionRequest) IsSetDomain() bool {
 	return v != nil && v.Domain != nil
 }
 
 // GetExecution returns the value of Execution if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryRequest) GetExecution() (o *shared.WorkflowExecution) {
+func (v *DescribeWorkflowExecutionRequest) GetExecution() (o *shared.WorkflowExecution) {
 	if v != nil && v.Execution != nil {
 		return v.Execution
 	}
@@ -1045,145 +977,5428 @@ func (v *GetWorkflowExecutionRawHistoryRequest) GetExecution() (o *shared.Workfl
 }
 
 // IsSetExecution returns true if Execution is not nil.
-func (v *GetWorkflowExecutionRawHistoryRequest) IsSetExecution() bool {
+func (v *DescribeWorkflowExecutionRequest) IsSetExecution() bool {
 	return v != nil && v.Execution != nil
 }
 
-// GetFirstEventId returns the value of FirstEventId if it is set or its
-// zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryRequest) GetFirstEventId() (o int64) {
-	if v != nil && v.FirstEventId != nil {
-		return *v.FirstEventId
-	}
-
-	return
-}
-
-// IsSetFirstEventId returns true if FirstEventId is not nil.
-func (v *GetWorkflowExecutionRawHistoryRequest) IsSetFirstEventId() bool {
-	return v != nil && v.FirstEventId != nil
+type DescribeWorkflowExecutionResponse struct {
+	ShardId                *string `json:"shardId,omitempty"`
+	HistoryAddr            *string `json:"historyAddr,omitempty"`
+	MutableStateInCache    *string `json:"mutableStateInCache,omitempty"`
+	MutableStateInDatabase *string `json:"mutableStateInDatabase,omitempty"`
 }
 
-// GetNextEventId returns the value of NextEventId if it is set or its
-// zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryRequest) GetNextEventId() (o int64) {
-	if v != nil && v.NextEventId != nil {
-		return *v.NextEventId
-	}
+// ToWire translates a DescribeWorkflowExecutionResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *DescribeWorkflowExecutionResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ShardId != nil {
+		w, err = wire.NewValueString(*(v.ShardId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.HistoryAddr != nil {
+		w, err = wire.NewValueString(*(v.HistoryAddr)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.MutableStateInCache != nil {
+		w, err = wire.NewValueString(*(v.MutableStateInCache)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.MutableStateInDatabase != nil {
+		w, err = wire.NewValueString(*(v.MutableStateInDatabase)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a DescribeWorkflowExecutionResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a DescribeWorkflowExecutionResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v DescribeWorkflowExecutionResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *DescribeWorkflowExecutionResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.ShardId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.HistoryAddr = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.MutableStateInCache = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.MutableStateInDatabase = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a DescribeWorkflowExecutionResponse
+// struct.
+func (v *DescribeWorkflowExecutionResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.ShardId != nil {
+		fields[i] = fmt.Sprintf("ShardId: %v", *(v.ShardId))
+		i++
+	}
+	if v.HistoryAddr != nil {
+		fields[i] = fmt.Sprintf("HistoryAddr: %v", *(v.HistoryAddr))
+		i++
+	}
+	if v.MutableStateInCache != nil {
+		fields[i] = fmt.Sprintf("MutableStateInCache: %v", *(v.MutableStateInCache))
+		i++
+	}
+	if v.MutableStateInDatabase != nil {
+		fields[i] = fmt.Sprintf("MutableStateInDatabase: %v", *(v.MutableStateInDatabase))
+		i++
+	}
+
+	return fmt.Sprintf("DescribeWorkflowExecutionResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this DescribeWorkflowExecutionResponse match the
+// provided DescribeWorkflowExecutionResponse.
+//
+// This function performs a deep comparison.
+func (v *DescribeWorkflowExecutionResponse) Equals(rhs *DescribeWorkflowExecutionResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.ShardId, rhs.ShardId) {
+		return false
+	}
+	if !_String_EqualsPtr(v.HistoryAddr, rhs.HistoryAddr) {
+		return false
+	}
+	if !_String_EqualsPtr(v.MutableStateInCache, rhs.MutableStateInCache) {
+		return false
+	}
+	if !_String_EqualsPtr(v.MutableStateInDatabase, rhs.MutableStateInDatabase) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DescribeWorkflowExecutionResponse.
+func (v *DescribeWorkflowExecutionResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ShardId != nil {
+		enc.AddString("shardId", *v.ShardId)
+	}
+	if v.HistoryAddr != nil {
+		enc.AddString("historyAddr", *v.HistoryAddr)
+	}
+	if v.MutableStateInCache != nil {
+		enc.AddString("mutableStateInCache", *v.MutableStateInCache)
+	}
+	if v.MutableStateInDatabase != nil {
+		enc.AddString("mutableStateInDatabase", *v.MutableStateInDatabase)
+	}
+	return err
+}
+
+// GetShardId returns the value of ShardId if it is set or its
+// zero value if it is unset.
+func (v *DescribeWorkflowExecutionResponse) GetShardId() (o string) {
+	if v != nil && v.ShardId != nil {
+		return *v.ShardId
+	}
+
+	return
+}
+
+// IsSetShardId returns true if ShardId is not nil.
+func (v *DescribeWorkflowExecutionResponse) IsSetShardId() bool {
+	return v != nil && v.ShardId != nil
+}
+
+// GetHistoryAddr returns the value of HistoryAddr if it is set or its
+// zero value if it is unset.
+func (v *DescribeWorkflowExecutionResponse) GetHistoryAddr() (o string) {
+	if v != nil && v.HistoryAddr != nil {
+		return *v.HistoryAddr
+	}
+
+	return
+}
+
+// IsSetHistoryAddr returns true if HistoryAddr is not nil.
+func (v *DescribeWorkflowExecutionResponse) IsSetHistoryAddr() bool {
+	return v != nil && v.HistoryAddr != nil
+}
+
+// GetMutableStateInCache returns the value of MutableStateInCache if it is set or its
+// zero value if it is unset.
+func (v *DescribeWorkflowExecutionResponse) GetMutableStateInCache() (o string) {
+	if v != nil && v.MutableStateInCache != nil {
+		return *v.MutableStateInCache
+	}
+
+	return
+}
+
+// IsSetMutableStateInCache returns true if MutableStateInCache is not nil.
+func (v *DescribeWorkflowExecutionResponse) IsSetMutableStateInCache() bool {
+	return v != nil && v.MutableStateInCache != nil
+}
+
+// GetMutableStateInDatabase returns the value of MutableStateInDatabase if it is set or its
+// zero value if it is unset.
+func (v *DescribeWorkflowExecutionResponse) GetMutableStateInDatabase() (o string) {
+	if v != nil && v.MutableStateInDatabase != nil {
+		return *v.MutableStateInDatabase
+	}
+
+	return
+}
+
+// IsSetMutableStateInDatabase returns true if MutableStateInDatabase is not nil.
+func (v *DescribeWorkflowExecutionResponse) IsSetMutableStateInDatabase() bool {
+	return v != nil && v.MutableStateInDatabase != nil
+}
+
+type GetWorkflowExecutionRawHistoryRequest struct {
+	Domain          *string                   `json:"domain,omitempty"`
+	Execution       *shared.WorkflowExecution `json:"execution,omitempty"`
+	FirstEventId    *int64                    `json:"firstEventId,omitempty"`
+	NextEventId     *int64                    `json:"nextEventId,omitempty"`
+	MaximumPageSize *int32                    `json:"maximumPageSize,omitempty"`
+	NextPageToken   []byte                    `json:"nextPageToken,omitempty"`
+}
+
+// ToWire translates a GetWorkflowExecutionRawHistoryRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetWorkflowExecutionRawHistoryRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.FirstEventId != nil {
+		w, err = wire.NewValueI64(*(v.FirstEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.NextEventId != nil {
+		w, err = wire.NewValueI64(*(v.NextEventId)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		w, err = wire.NewValueI32(*(v.MaximumPageSize)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 50, Value: w}
+		i++
+	}
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 60, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a GetWorkflowExecutionRawHistoryRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetWorkflowExecutionRawHistoryRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetWorkflowExecutionRawHistoryRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetWorkflowExecutionRawHistoryRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.FirstEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI64 {
+				var x int64
+				x, err = field.Value.GetI64(), error(nil)
+				v.NextEventId = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 50:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.MaximumPageSize = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 60:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetWorkflowExecutionRawHistoryRequest
+// struct.
+func (v *GetWorkflowExecutionRawHistoryRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+	if v.FirstEventId != nil {
+		fields[i] = fmt.Sprintf("FirstEventId: %v", *(v.FirstEventId))
+		i++
+	}
+	if v.NextEventId != nil {
+		fields[i] = fmt.Sprintf("NextEventId: %v", *(v.NextEventId))
+		i++
+	}
+	if v.MaximumPageSize != nil {
+		fields[i] = fmt.Sprintf("MaximumPageSize: %v", *(v.MaximumPageSize))
+		i++
+	}
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+
+	return fmt.Sprintf("GetWorkflowExecutionRawHistoryRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this GetWorkflowExecutionRawHistoryRequest match the
+// provided GetWorkflowExecutionRawHistoryRequest.
+//
+// This function performs a deep comparison.
+func (v *GetWorkflowExecutionRawHistoryRequest) Equals(rhs *GetWorkflowExecutionRawHistoryRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.FirstEventId, rhs.FirstEventId) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.NextEventId, rhs.NextEventId) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.MaximumPageSize, rhs.MaximumPageSize) {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GetWorkflowExecutionRawHistoryRequest.
+func (v *GetWorkflowExecutionRawHistoryRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.Execution != nil {
+		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	}
+	if v.FirstEventId != nil {
+		enc.AddInt64("firstEventId", *v.FirstEventId)
+	}
+	if v.NextEventId != nil {
+		enc.AddInt64("nextEventId", *v.NextEventId)
+	}
+	if v.MaximumPageSize != nil {
+		enc.AddInt32("maximumPageSize", *v.MaximumPageSize)
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *GetWorkflowExecutionRawHistoryRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetExecution returns the value of Execution if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryRequest) GetExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+
+	return
+}
+
+// IsSetExecution returns true if Execution is not nil.
+func (v *GetWorkflowExecutionRawHistoryRequest) IsSetExecution() bool {
+	return v != nil && v.Execution != nil
+}
+
+// GetFirstEventId returns the value of FirstEventId if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryRequest) GetFirstEventId() (o int64) {
+	if v != nil && v.FirstEventId != nil {
+		return *v.FirstEventId
+	}
+
+	return
+}
+
+// IsSetFirstEventId returns true if FirstEventId is not nil.
+func (v *GetWorkflowExecutionRawHistoryRequest) IsSetFirstEventId() bool {
+	return v != nil && v.FirstEventId != nil
+}
+
+// GetNextEventId returns the value of NextEventId if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryRequest) GetNextEventId() (o int64) {
+	if v != nil && v.NextEventId != nil {
+		return *v.NextEventId
+	}
+
+	return
+}
+
+// IsSetNextEventId returns true if NextEventId is not nil.
+func (v *GetWorkflowExecutionRawHistoryRequest) IsSetNextEventId() bool {
+	return v != nil && v.NextEventId != nil
+}
+
+// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryRequest) GetMaximumPageSize() (o int32) {
+	if v != nil && v.MaximumPageSize != nil {
+		return *v.MaximumPageSize
+	}
+
+	return
+}
+
+// IsSetMaximumPageSize returns true if MaximumPageSize is not nil.
+func (v *GetWorkflowExecutionRawHistoryRequest) IsSetMaximumPageSize() bool {
+	return v != nil && v.MaximumPageSize != nil
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryRequest) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *GetWorkflowExecutionRawHistoryRequest) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
+}
+
+type GetWorkflowExecutionRawHistoryResponse struct {
+	NextPageToken     []byte                             `json:"nextPageToken,omitempty"`
+	HistoryBatches    []*shared.DataBlob                 `json:"historyBatches,omitempty"`
+	ReplicationInfo   map[string]*shared.ReplicationInfo `json:"replicationInfo,omitempty"`
+	EventStoreVersion *int32                             `json:"eventStoreVersion,omitempty"`
+}
+
+type _List_DataBlob_ValueList []*shared.DataBlob
+
+func (v _List_DataBlob_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_DataBlob_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_DataBlob_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_DataBlob_ValueList) Close() {}
+
+type _Map_String_ReplicationInfo_MapItemList map[string]*shared.ReplicationInfo
+
+func (m _Map_String_ReplicationInfo_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := wire.NewValueString(k), error(nil)
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(wire.MapItem{Key: kw, Value: vw})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_ReplicationInfo_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_ReplicationInfo_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_ReplicationInfo_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_String_ReplicationInfo_MapItemList) Close() {}
+
+// ToWire translates a GetWorkflowExecutionRawHistoryResponse struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *GetWorkflowExecutionRawHistoryResponse) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.NextPageToken != nil {
+		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.HistoryBatches != nil {
+		w, err = wire.NewValueList(_List_DataBlob_ValueList(v.HistoryBatches)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.ReplicationInfo != nil {
+		w, err = wire.NewValueMap(_Map_String_ReplicationInfo_MapItemList(v.ReplicationInfo)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.EventStoreVersion != nil {
+		w, err = wire.NewValueI32(*(v.EventStoreVersion)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DataBlob_Read(w wire.Value) (*shared.DataBlob, error) {
+	var v shared.DataBlob
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_DataBlob_Read(l wire.ValueList) ([]*shared.DataBlob, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*shared.DataBlob, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _DataBlob_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _ReplicationInfo_Read(w wire.Value) (*shared.ReplicationInfo, error) {
+	var v shared.ReplicationInfo
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_String_ReplicationInfo_Read(m wire.MapItemList) (map[string]*shared.ReplicationInfo, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]*shared.ReplicationInfo, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := x.Key.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+
+		v, err := _ReplicationInfo_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a GetWorkflowExecutionRawHistoryResponse struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a GetWorkflowExecutionRawHistoryResponse struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v GetWorkflowExecutionRawHistoryResponse
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *GetWorkflowExecutionRawHistoryResponse) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TList {
+				v.HistoryBatches, err = _List_DataBlob_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TMap {
+				v.ReplicationInfo, err = _Map_String_ReplicationInfo_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.EventStoreVersion = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a GetWorkflowExecutionRawHistoryResponse
+// struct.
+func (v *GetWorkflowExecutionRawHistoryResponse) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.NextPageToken != nil {
+		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+		i++
+	}
+	if v.HistoryBatches != nil {
+		fields[i] = fmt.Sprintf("HistoryBatches: %v", v.HistoryBatches)
+		i++
+	}
+	if v.ReplicationInfo != nil {
+		fields[i] = fmt.Sprintf("ReplicationInfo: %v", v.ReplicationInfo)
+		i++
+	}
+	if v.EventStoreVersion != nil {
+		fields[i] = fmt.Sprintf("EventStoreVersion: %v", *(v.EventStoreVersion))
+		i++
+	}
+
+	return fmt.Sprintf("GetWorkflowExecutionRawHistoryResponse{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_DataBlob_Equals(lhs, rhs []*shared.DataBlob) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_ReplicationInfo_Equals(lhs, rhs map[string]*shared.ReplicationInfo) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this GetWorkflowExecutionRawHistoryResponse match the
+// provided GetWorkflowExecutionRawHistoryResponse.
+//
+// This function performs a deep comparison.
+func (v *GetWorkflowExecutionRawHistoryResponse) Equals(rhs *GetWorkflowExecutionRawHistoryResponse) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+		return false
+	}
+	if !((v.HistoryBatches == nil && rhs.HistoryBatches == nil) || (v.HistoryBatches != nil && rhs.HistoryBatches != nil && _List_DataBlob_Equals(v.HistoryBatches, rhs.HistoryBatches))) {
+		return false
+	}
+	if !((v.ReplicationInfo == nil && rhs.ReplicationInfo == nil) || (v.ReplicationInfo != nil && rhs.ReplicationInfo != nil && _Map_String_ReplicationInfo_Equals(v.ReplicationInfo, rhs.ReplicationInfo))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.EventStoreVersion, rhs.EventStoreVersion) {
+		return false
+	}
+
+	return true
+}
+
+type _List_DataBlob_Zapper []*shared.DataBlob
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_DataBlob_Zapper.
+func (l _List_DataBlob_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		err = multierr.Append(err, enc.AppendObject(v))
+	}
+	return err
+}
+
+type _Map_String_ReplicationInfo_Zapper map[string]*shared.ReplicationInfo
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_ReplicationInfo_Zapper.
+func (m _Map_String_ReplicationInfo_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	for k, v := range m {
+		err = multierr.Append(err, enc.AddObject((string)(k), v))
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GetWorkflowExecutionRawHistoryResponse.
+func (v *GetWorkflowExecutionRawHistoryResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.NextPageToken != nil {
+		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	}
+	if v.HistoryBatches != nil {
+		err = multierr.Append(err, enc.AddArray("historyBatches", (_List_DataBlob_Zapper)(v.HistoryBatches)))
+	}
+	if v.ReplicationInfo != nil {
+		err = multierr.Append(err, enc.AddObject("replicationInfo", (_Map_String_ReplicationInfo_Zapper)(v.ReplicationInfo)))
+	}
+	if v.EventStoreVersion != nil {
+		enc.AddInt32("eventStoreVersion", *v.EventStoreVersion)
+	}
+	return err
+}
+
+// GetNextPageToken returns the value of NextPageToken if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryResponse) GetNextPageToken() (o []byte) {
+	if v != nil && v.NextPageToken != nil {
+		return v.NextPageToken
+	}
+
+	return
+}
+
+// IsSetNextPageToken returns true if NextPageToken is not nil.
+func (v *GetWorkflowExecutionRawHistoryResponse) IsSetNextPageToken() bool {
+	return v != nil && v.NextPageToken != nil
+}
+
+// GetHistoryBatches returns the value of HistoryBatches if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryResponse) GetHistoryBatches() (o []*shared.DataBlob) {
+	if v != nil && v.HistoryBatches != nil {
+		return v.HistoryBatches
+	}
+
+	return
+}
+
+// IsSetHistoryBatches returns true if HistoryBatches is not nil.
+func (v *GetWorkflowExecutionRawHistoryResponse) IsSetHistoryBatches() bool {
+	return v != nil && v.HistoryBatches != nil
+}
+
+// GetReplicationInfo returns the value of ReplicationInfo if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryResponse) GetReplicationInfo() (o map[string]*shared.ReplicationInfo) {
+	if v != nil && v.ReplicationInfo != nil {
+		return v.ReplicationInfo
+	}
+
+	return
+}
+
+// IsSetReplicationInfo returns true if ReplicationInfo is not nil.
+func (v *GetWorkflowExecutionRawHistoryResponse) IsSetReplicationInfo() bool {
+	return v != nil && v.ReplicationInfo != nil
+}
+
+// GetEventStoreVersion returns the value of EventStoreVersion if it is set or its
+// zero value if it is unset.
+func (v *GetWorkflowExecutionRawHistoryResponse) GetEventStoreVersion() (o int32) {
+	if v != nil && v.EventStoreVersion != nil {
+		return *v.EventStoreVersion
+	}
+
+	return
+}
+
+// IsSetEventStoreVersion returns true if EventStoreVersion is not nil.
+func (v *GetWorkflowExecutionRawHistoryResponse) IsSetEventStoreVersion() bool {
+	return v != nil && v.EventStoreVersion != nil
+}
+
+type HistoryShardInfo struct {
+	ShardID     *int32  `json:"shardID,omitempty"`
+	RingOwner   *string `json:"ringOwner,omitempty"`
+	HostAddress *string `json:"hostAddress,omitempty"`
+	Status      *string `json:"status,omitempty"`
+}
+
+// ToWire translates a HistoryShardInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *HistoryShardInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.ShardID != nil {
+		w, err = wire.NewValueI32(*(v.ShardID)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.RingOwner != nil {
+		w, err = wire.NewValueString(*(v.RingOwner)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+	if v.HostAddress != nil {
+		w, err = wire.NewValueString(*(v.HostAddress)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 30, Value: w}
+		i++
+	}
+	if v.Status != nil {
+		w, err = wire.NewValueString(*(v.Status)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 40, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a HistoryShardInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a HistoryShardInfo struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v HistoryShardInfo
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *HistoryShardInfo) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TI32 {
+				var x int32
+				x, err = field.Value.GetI32(), error(nil)
+				v.ShardID = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.RingOwner = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 30:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.HostAddress = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 40:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Status = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a HistoryShardInfo
+// struct.
+func (v *HistoryShardInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.ShardID != nil {
+		fields[i] = fmt.Sprintf("ShardID: %v", *(v.ShardID))
+		i++
+	}
+	if v.RingOwner != nil {
+		fields[i] = fmt.Sprintf("RingOwner: %v", *(v.RingOwner))
+		i++
+	}
+	if v.HostAddress != nil {
+		fields[i] = fmt.Sprintf("HostAddress: %v", *(v.HostAddress))
+		i++
+	}
+	if v.Status != nil {
+		fields[i] = fmt.Sprintf("Status: %v", *(v.Status))
+		i++
+	}
+
+	return fmt.Sprintf("HistoryShardInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this HistoryShardInfo match the
+// provided HistoryShardInfo.
+//
+// This function performs a deep comparison.
+func (v *HistoryShardInfo) Equals(rhs *HistoryShardInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_I32_EqualsPtr(v.ShardID, rhs.ShardID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.RingOwner, rhs.RingOwner) {
+		return false
+	}
+	if !_String_EqualsPtr(v.HostAddress, rhs.HostAddress) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Status, rhs.Status) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of HistoryShardInfo.
+func (v *HistoryShardInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.ShardID != nil {
+		enc.AddInt32("shardID", *v.ShardID)
+	}
+	if v.RingOwner != nil {
+		enc.AddString("ringOwner", *v.RingOwner)
+	}
+	if v.HostAddress != nil {
+		enc.AddString("hostAddress", *v.HostAddress)
+	}
+	if v.Status != nil {
+		enc.AddString("status", *v.Status)
+	}
+	return err
+}
+
+// GetShardID returns the value of ShardID if it is set or its
+// zero value if it is unset.
+func (v *HistoryShardInfo) GetShardID() (o int32) {
+	if v != nil && v.ShardID != nil {
+		return *v.ShardID
+	}
+
+	return
+}
+
+// IsSetShardID returns true if ShardID is not nil.
+func (v *HistoryShardInfo) IsSetShardID() bool {
+	return v != nil && v.ShardID != nil
+}
+
+// GetRingOwner returns the value of RingOwner if it is set or its
+// zero value if it is unset.
+func (v *HistoryShardInfo) GetRingOwner() (o string) {
+	if v != nil && v.RingOwner != nil {
+		return *v.RingOwner
+	}
+
+	return
+}
+
+// IsSetRingOwner returns true if RingOwner is not nil.
+func (v *HistoryShardInfo) IsSetRingOwner() bool {
+	return v != nil && v.RingOwner != nil
+}
+
+// GetHostAddress returns the value of HostAddress if it is set or its
+// zero value if it is unset.
+func (v *HistoryShardInfo) GetHostAddress() (o string) {
+	if v != nil && v.HostAddress != nil {
+		return *v.HostAddress
+	}
+
+	return
+}
+
+// IsSetHostAddress returns true if HostAddress is not nil.
+func (v *HistoryShardInfo) IsSetHostAddress() bool {
+	return v != nil && v.HostAddress != nil
+}
+
+// GetStatus returns the value of Status if it is set or its
+// zero value if it is unset.
+func (v *HistoryShardInfo) GetStatus() (o string) {
+	if v != nil && v.Status != nil {
+		return *v.Status
+	}
+
+	return
+}
+
+// IsSetStatus returns true if Status is not nil.
+func (v *HistoryShardInfo) IsSetStatus() bool {
+	return v != nil && v.Status != nil
+}
+
+type RefreshWorkflowTasksRequest struct {
+	Domain    *string                   `json:"domain,omitempty"`
+	Execution *shared.WorkflowExecution `json:"execution,omitempty"`
+}
+
+// ToWire translates a RefreshWorkflowTasksRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RefreshWorkflowTasksRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Domain != nil {
+		w, err = wire.NewValueString(*(v.Domain)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Execution != nil {
+		w, err = v.Execution.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a RefreshWorkflowTasksRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RefreshWorkflowTasksRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RefreshWorkflowTasksRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RefreshWorkflowTasksRequest) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Domain = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TStruct {
+				v.Execution, err = _WorkflowExecution_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RefreshWorkflowTasksRequest
+// struct.
+func (v *RefreshWorkflowTasksRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Domain != nil {
+		fields[i] = fmt.Sprintf("Domain: %v", *(v.Domain))
+		i++
+	}
+	if v.Execution != nil {
+		fields[i] = fmt.Sprintf("Execution: %v", v.Execution)
+		i++
+	}
+
+	return fmt.Sprintf("RefreshWorkflowTasksRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this RefreshWorkflowTasksRequest match the
+// provided RefreshWorkflowTasksRequest.
+//
+// This function performs a deep comparison.
+func (v *RefreshWorkflowTasksRequest) Equals(rhs *RefreshWorkflowTasksRequest) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Domain, rhs.Domain) {
+		return false
+	}
+	if !((v.Execution == nil && rhs.Execution == nil) || (v.Execution != nil && rhs.Execution != nil && v.Execution.Equals(rhs.Execution))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of RefreshWorkflowTasksRequest.
+func (v *RefreshWorkflowTasksRequest) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Domain != nil {
+		enc.AddString("domain", *v.Domain)
+	}
+	if v.Execution != nil {
+		err = multierr.Append(err, enc.AddObject("execution", v.Execution))
+	}
+	return err
+}
+
+// GetDomain returns the value of Domain if it is set or its
+// zero value if it is unset.
+func (v *RefreshWorkflowTasksRequest) GetDomain() (o string) {
+	if v != nil && v.Domain != nil {
+		return *v.Domain
+	}
+
+	return
+}
+
+// IsSetDomain returns true if Domain is not nil.
+func (v *RefreshWorkflowTasksRequest) IsSetDomain() bool {
+	return v != nil && v.Domain != nil
+}
+
+// GetExecution returns the value of Execution if it is set or its
+// zero value if it is unset.
+func (v *RefreshWorkflowTasksRequest) GetExecution() (o *shared.WorkflowExecution) {
+	if v != nil && v.Execution != nil {
+		return v.Execution
+	}
+
+	return
+}
+
+// IsSetExecution returns true if Execution is not nil.
+func (v *RefreshWorkflowTasksRequest) IsSetExecution() bool {
+	return v != nil && v.Execution != nil
+}
+
+type RingInfo struct {
+	Role    *string  `json:"role,omitempty"`
+	Members []string `json:"members,omitempty"`
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := wire.NewValueString(x), error(nil)
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+// ToWire translates a RingInfo struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RingInfo) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Role != nil {
+		w, err = wire.NewValueString(*(v.Role)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Members != nil {
+		w, err = wire.NewValueList(_List_String_ValueList(v.Members)), error(nil)
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 20, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := x.GetString(), error(nil)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a RingInfo struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RingInfo struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RingInfo
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RingInfo) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				var x string
+				x, err = field.Value.GetString(), error(nil)
+				v.Role = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 20:
+			if field.Value.Type() == wire.TList {
+				v.Members, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RingInfo
+// struct.
+func (v *RingInfo) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Role != nil {
+		fields[i] = fmt.Sprintf("Role: %v", *(v.Role))
+		i++
+	}
+	if v.Members != nil {
+		fields[i] = fmt.Sprintf("Members: %v", v.Members)
+		i++
+	}
+
+	return fmt.Sprintf("RingInfo{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this RingInfo match the
+// provided RingInfo.
+//
+// This function performs a deep comparison.
+func (v *RingInfo) Equals(rhs *RingInfo) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !_String_EqualsPtr(v.Role, rhs.Role) {
+		return false
+	}
+	if !((v.Members == nil && rhs.Members == nil) || (v.Members != nil && rhs.Members != nil && _List_String_Equals(v.Members, rhs.Members))) {
+		return false
+	}
+
+	return true
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return err
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of RingInfo.
+func (v *RingInfo) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Role != nil {
+		enc.AddString("role", *v.Role)
+	}
+	if v.Members != nil {
+		err = multierr.Append(err, enc.AddArray("members", (_List_String_Zapper)(v.Members)))
+	}
+	return err
+}
+
+// GetRole returns the value of Role if it is set or its
+// zero value if it is unset.
+func (v *RingInfo) GetRole() (o string) {
+	if v != nil && v.Role != nil {
+		return *v.Role
+	}
+
+	return
+}
+
+// IsSetRole returns true if Role is not nil.
+func (v *RingInfo) IsSetRole() bool {
+	return v != nil && v.Role != nil
+}
+
+// GetMembers returns the value of Members if it is set or its
+// zero value if it is unset.
+func (v *RingInfo) GetMembers() (o []string) {
+	if v != nil && v.Members != nil {
+		return v.Members
+	}
+
+	return
+}
+
+// IsSetMembers returns true if Members is not nil.
+func (v *RingInfo) IsSetMembers() bool {
+	return v != nil && v.Members != nil
+}
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:     "admin",
+	Package:  "github.com/uber/cadence/.gen/go/admin",
+	FilePath: "admin.thrift",
+	SHA1:     "a1e5f30a92c0ef6b7432a086eb8cca6b898f0718",
+	Includes: []*thriftreflect.ThriftModule{
+		shared.ThriftModule,
+	},
+	Raw: rawIDL,
+}
+
+const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.admin\n\ninclude \"shared.thrift\"\n\n/**\n* AdminService provides advanced APIs for debugging and analysis with admin privillege\n**/\nservice AdminService {\n  /**\n  * DescribeWorkflowExecution returns information about the internal states of workflow execution.\n  **/\n  DescribeWorkflowExecutionResponse DescribeWorkflowExecution(1: DescribeWorkflowExecutionRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.AccessDeniedError       accessDeniedError,\n    )\n\n  /**\n  * DescribeHistoryHost returns information about the internal states of a history host\n  **/\n  shared.DescribeHistoryHostResponse DescribeHistoryHost(1: shared.DescribeHistoryHostRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  /**\n  * DescribeShardQueues returns the ack levels, read levels and outstanding task counts of the queue processors of a shard\n  **/\n  shared.DescribeShardQueuesResponse DescribeShardQueues(1: shared.DescribeShardQueuesRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  /**\n  * ListShardTasks returns the transfer, timer or replication tasks of a shard\n  **/\n  shared.ListShardTasksResponse ListShardTasks(1: shared.ListShardTasksRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  /**\n  * RemoveShardTask removes a transfer, timer or replication task of a shard and reloads the shard\n  **/\n  void RemoveShardTask(1: shared.RemoveShardTaskRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  /**\n  * CloseShard unloads a history shard from its current host, so that it is acquired by the host the membership ring assigns it to\n  **/\n  void CloseShard(1: shared.CloseShardRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  /**\n  * DescribeCluster returns the members of each service ring, and the host and status of each history shard\n  **/\n  DescribeClusterResponse DescribeCluster()\n    throws (\n      1: shared.InternalServiceError  internalServiceError,\n      2: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  /**\n  * RefreshWorkflowTasks regenerates the transfer and timer tasks implied by the mutable state of a workflow execution\n  **/\n  void RefreshWorkflowTasks(1: RefreshWorkflowTasksRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.AccessDeniedError       accessDeniedError,\n    )\n\n  /**\n  * DeleteWorkflowExecution permanently removes the mutable state, history and visibility records of a workflow execution.\n  * It fails with 'BadRequestError' if the workflow execution is still running, it has to be terminated first.\n  **/\n  void DeleteWorkflowExecution(1: DeleteWorkflowExecutionRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.AccessDeniedError       accessDeniedError,\n    )\n\n  /**\n  * Returns the raw history of specified workflow execution.  It fails with 'EntityNotExistError' if speficied workflow\n  * execution in unknown to the service.\n  **/\n  GetWorkflowExecutionRawHistoryResponse GetWorkflowExecutionRawHistory(1: GetWorkflowExecutionRawHistoryRequest getRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * AddSearchAttribute whitelist search attribute in request.\n  **/\n  void AddSearchAttribute(1: AddSearchAttributeRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string                       domain\n  20: optional shared.WorkflowExecution     execution\n}\n\nstruct DescribeWorkflowExecutionResponse {\n  10: optional string shardId\n  20: optional string historyAddr\n  40: optional string mutableStateInCache\n  50: optional string mutableStateInDatabase\n}\n\nstruct RefreshWorkflowTasksRequest {\n  10: optional string                       domain\n  20: optional shared.WorkflowExecution     execution\n}\n\nstruct DeleteWorkflowExecutionRequest {\n  10: optional string                       domain\n  20: optional shared.WorkflowExecution     execution\n}\n\nstruct GetWorkflowExecutionRawHistoryRequest {\n  10: optional string domain\n  20: optional shared.WorkflowExecution execution\n  30: optional i64 (js.type = \"Long\") firstEventId\n  40: optional i64 (js.type = \"Long\") nextEventId\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n}\n\nstruct GetWorkflowExecutionRawHistoryResponse {\n  10: optional binary nextPageToken\n  20: optional list<shared.DataBlob> historyBatches\n  30: optional map<string, shared.ReplicationInfo> replicationInfo\n  40: optional i32 eventStoreVersion\n}\n\nstruct AddSearchAttributeRequest {\n  10: optional map<string, shared.IndexedValueType> searchAttribute\n}\n\nstruct RingInfo {\n  10: optional string role\n  20: optional list<string> members\n}\n\n// ringOwner is the host the membership ring assigns the shard to,\n// hostAddress is the host which currently has the shard loaded, if any\nstruct HistoryShardInfo {\n  10: optional i32 shardID\n  20: optional string ringOwner\n  30: optional string hostAddress\n  40: optional string status\n}\n\nstruct DescribeClusterResponse {\n  10: optional list<RingInfo> rings\n  20: optional list<HistoryShardInfo> historyShards\n}\n"
+
+// AdminService_AddSearchAttribute_Args represents the arguments for the AdminService.AddSearchAttribute function.
+//
+// The arguments for AddSearchAttribute are sent and received over the wire as this struct.
+type AdminService_AddSearchAttribute_Args struct {
+	Request *AddSearchAttributeRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_AddSearchAttribute_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_AddSearchAttribute_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _AddSearchAttributeRequest_Read(w wire.Value) (*AddSearchAttributeRequest, error) {
+	var v AddSearchAttributeRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_AddSearchAttribute_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_AddSearchAttribute_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_AddSearchAttribute_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_AddSearchAttribute_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _AddSearchAttributeRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_AddSearchAttribute_Args
+// struct.
+func (v *AdminService_AddSearchAttribute_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_AddSearchAttribute_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_AddSearchAttribute_Args match the
+// provided AdminService_AddSearchAttribute_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_AddSearchAttribute_Args) Equals(rhs *AdminService_AddSearchAttribute_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_AddSearchAttribute_Args.
+func (v *AdminService_AddSearchAttribute_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_AddSearchAttribute_Args) GetRequest() (o *AddSearchAttributeRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_AddSearchAttribute_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "AddSearchAttribute" for this struct.
+func (v *AdminService_AddSearchAttribute_Args) MethodName() string {
+	return "AddSearchAttribute"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_AddSearchAttribute_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_AddSearchAttribute_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.AddSearchAttribute
+// function.
+var AdminService_AddSearchAttribute_Helper = struct {
+	// Args accepts the parameters of AddSearchAttribute in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *AddSearchAttributeRequest,
+	) *AdminService_AddSearchAttribute_Args
+
+	// IsException returns true if the given error can be thrown
+	// by AddSearchAttribute.
+	//
+	// An error can be thrown by AddSearchAttribute only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for AddSearchAttribute
+	// given the error returned by it. The provided error may
+	// be nil if AddSearchAttribute did not fail.
+	//
+	// This allows mapping errors returned by AddSearchAttribute into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// AddSearchAttribute
+	//
+	//   err := AddSearchAttribute(args)
+	//   result, err := AdminService_AddSearchAttribute_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from AddSearchAttribute: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*AdminService_AddSearchAttribute_Result, error)
+
+	// UnwrapResponse takes the result struct for AddSearchAttribute
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if AddSearchAttribute threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := AdminService_AddSearchAttribute_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_AddSearchAttribute_Result) error
+}{}
+
+func init() {
+	AdminService_AddSearchAttribute_Helper.Args = func(
+		request *AddSearchAttributeRequest,
+	) *AdminService_AddSearchAttribute_Args {
+		return &AdminService_AddSearchAttribute_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_AddSearchAttribute_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.ServiceBusyError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_AddSearchAttribute_Helper.WrapResponse = func(err error) (*AdminService_AddSearchAttribute_Result, error) {
+		if err == nil {
+			return &AdminService_AddSearchAttribute_Result{}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.BadRequestError")
+			}
+			return &AdminService_AddSearchAttribute_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.InternalServiceError")
+			}
+			return &AdminService_AddSearchAttribute_Result{InternalServiceError: e}, nil
+		case *shared.ServiceBusyError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.ServiceBusyError")
+			}
+			return &AdminService_AddSearchAttribute_Result{ServiceBusyError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_AddSearchAttribute_Helper.UnwrapResponse = func(result *AdminService_AddSearchAttribute_Result) (err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.ServiceBusyError != nil {
+			err = result.ServiceBusyError
+			return
+		}
+		return
+	}
+
+}
+
+// AdminService_AddSearchAttribute_Result represents the result of a AdminService.AddSearchAttribute function call.
+//
+// The result of a AddSearchAttribute execution is sent and received over the wire as this struct.
+type AdminService_AddSearchAttribute_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+}
+
+// ToWire translates a AdminService_AddSearchAttribute_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_AddSearchAttribute_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		w, err = v.ServiceBusyError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_AddSearchAttribute_Result should have at most one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _BadRequestError_Read(w wire.Value) (*shared.BadRequestError, error) {
+	var v shared.BadRequestError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _InternalServiceError_Read(w wire.Value) (*shared.InternalServiceError, error) {
+	var v shared.InternalServiceError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _ServiceBusyError_Read(w wire.Value) (*shared.ServiceBusyError, error) {
+	var v shared.ServiceBusyError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_AddSearchAttribute_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_AddSearchAttribute_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_AddSearchAttribute_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_AddSearchAttribute_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.ServiceBusyError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("AdminService_AddSearchAttribute_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_AddSearchAttribute_Result
+// struct.
+func (v *AdminService_AddSearchAttribute_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.ServiceBusyError != nil {
+		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_AddSearchAttribute_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_AddSearchAttribute_Result match the
+// provided AdminService_AddSearchAttribute_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_AddSearchAttribute_Result) Equals(rhs *AdminService_AddSearchAttribute_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_AddSearchAttribute_Result.
+func (v *AdminService_AddSearchAttribute_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.ServiceBusyError != nil {
+		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	}
+	return err
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_AddSearchAttribute_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_AddSearchAttribute_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_AddSearchAttribute_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_AddSearchAttribute_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_AddSearchAttribute_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+	if v != nil && v.ServiceBusyError != nil {
+		return v.ServiceBusyError
+	}
+
+	return
+}
+
+// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
+func (v *AdminService_AddSearchAttribute_Result) IsSetServiceBusyError() bool {
+	return v != nil && v.ServiceBusyError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "AddSearchAttribute" for this struct.
+func (v *AdminService_AddSearchAttribute_Result) MethodName() string {
+	return "AddSearchAttribute"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_AddSearchAttribute_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_CloseShard_Args represents the arguments for the AdminService.CloseShard function.
+//
+// The arguments for CloseShard are sent and received over the wire as this struct.
+type AdminService_CloseShard_Args struct {
+	Request *shared.CloseShardRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_CloseShard_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_CloseShard_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _CloseShardRequest_Read(w wire.Value) (*shared.CloseShardRequest, error) {
+	var v shared.CloseShardRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_CloseShard_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_CloseShard_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_CloseShard_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_CloseShard_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _CloseShardRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_CloseShard_Args
+// struct.
+func (v *AdminService_CloseShard_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_CloseShard_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_CloseShard_Args match the
+// provided AdminService_CloseShard_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_CloseShard_Args) Equals(rhs *AdminService_CloseShard_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_CloseShard_Args.
+func (v *AdminService_CloseShard_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_CloseShard_Args) GetRequest() (o *shared.CloseShardRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_CloseShard_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "CloseShard" for this struct.
+func (v *AdminService_CloseShard_Args) MethodName() string {
+	return "CloseShard"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_CloseShard_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_CloseShard_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.CloseShard
+// function.
+var AdminService_CloseShard_Helper = struct {
+	// Args accepts the parameters of CloseShard in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *shared.CloseShardRequest,
+	) *AdminService_CloseShard_Args
+
+	// IsException returns true if the given error can be thrown
+	// by CloseShard.
+	//
+	// An error can be thrown by CloseShard only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for CloseShard
+	// given the error returned by it. The provided error may
+	// be nil if CloseShard did not fail.
+	//
+	// This allows mapping errors returned by CloseShard into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// CloseShard
+	//
+	//   err := CloseShard(args)
+	//   result, err := AdminService_CloseShard_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from CloseShard: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*AdminService_CloseShard_Result, error)
+
+	// UnwrapResponse takes the result struct for CloseShard
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if CloseShard threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := AdminService_CloseShard_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_CloseShard_Result) error
+}{}
+
+func init() {
+	AdminService_CloseShard_Helper.Args = func(
+		request *shared.CloseShardRequest,
+	) *AdminService_CloseShard_Args {
+		return &AdminService_CloseShard_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_CloseShard_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_CloseShard_Helper.WrapResponse = func(err error) (*AdminService_CloseShard_Result, error) {
+		if err == nil {
+			return &AdminService_CloseShard_Result{}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_CloseShard_Result.BadRequestError")
+			}
+			return &AdminService_CloseShard_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_CloseShard_Result.InternalServiceError")
+			}
+			return &AdminService_CloseShard_Result{InternalServiceError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_CloseShard_Result.AccessDeniedError")
+			}
+			return &AdminService_CloseShard_Result{AccessDeniedError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_CloseShard_Helper.UnwrapResponse = func(result *AdminService_CloseShard_Result) (err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+		return
+	}
+
+}
+
+// AdminService_CloseShard_Result represents the result of a AdminService.CloseShard function call.
+//
+// The result of a CloseShard execution is sent and received over the wire as this struct.
+type AdminService_CloseShard_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
+}
+
+// ToWire translates a AdminService_CloseShard_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_CloseShard_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_CloseShard_Result should have at most one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _AccessDeniedError_Read(w wire.Value) (*shared.AccessDeniedError, error) {
+	var v shared.AccessDeniedError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_CloseShard_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_CloseShard_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_CloseShard_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_CloseShard_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("AdminService_CloseShard_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_CloseShard_Result
+// struct.
+func (v *AdminService_CloseShard_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_CloseShard_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_CloseShard_Result match the
+// provided AdminService_CloseShard_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_CloseShard_Result) Equals(rhs *AdminService_CloseShard_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_CloseShard_Result.
+func (v *AdminService_CloseShard_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
+	return err
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_CloseShard_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_CloseShard_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_CloseShard_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_CloseShard_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_CloseShard_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_CloseShard_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "CloseShard" for this struct.
+func (v *AdminService_CloseShard_Result) MethodName() string {
+	return "CloseShard"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_CloseShard_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_DeleteWorkflowExecution_Args represents the arguments for the AdminService.DeleteWorkflowExecution function.
+//
+// The arguments for DeleteWorkflowExecution are sent and received over the wire as this struct.
+type AdminService_DeleteWorkflowExecution_Args struct {
+	Request *DeleteWorkflowExecutionRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_DeleteWorkflowExecution_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DeleteWorkflowExecution_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DeleteWorkflowExecutionRequest_Read(w wire.Value) (*DeleteWorkflowExecutionRequest, error) {
+	var v DeleteWorkflowExecutionRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DeleteWorkflowExecution_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DeleteWorkflowExecution_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DeleteWorkflowExecution_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DeleteWorkflowExecution_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _DeleteWorkflowExecutionRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DeleteWorkflowExecution_Args
+// struct.
+func (v *AdminService_DeleteWorkflowExecution_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DeleteWorkflowExecution_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DeleteWorkflowExecution_Args match the
+// provided AdminService_DeleteWorkflowExecution_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DeleteWorkflowExecution_Args) Equals(rhs *AdminService_DeleteWorkflowExecution_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DeleteWorkflowExecution_Args.
+func (v *AdminService_DeleteWorkflowExecution_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DeleteWorkflowExecution_Args) GetRequest() (o *DeleteWorkflowExecutionRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_DeleteWorkflowExecution_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "DeleteWorkflowExecution" for this struct.
+func (v *AdminService_DeleteWorkflowExecution_Args) MethodName() string {
+	return "DeleteWorkflowExecution"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_DeleteWorkflowExecution_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_DeleteWorkflowExecution_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.DeleteWorkflowExecution
+// function.
+var AdminService_DeleteWorkflowExecution_Helper = struct {
+	// Args accepts the parameters of DeleteWorkflowExecution in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *DeleteWorkflowExecutionRequest,
+	) *AdminService_DeleteWorkflowExecution_Args
+
+	// IsException returns true if the given error can be thrown
+	// by DeleteWorkflowExecution.
+	//
+	// An error can be thrown by DeleteWorkflowExecution only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for DeleteWorkflowExecution
+	// given the error returned by it. The provided error may
+	// be nil if DeleteWorkflowExecution did not fail.
+	//
+	// This allows mapping errors returned by DeleteWorkflowExecution into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// DeleteWorkflowExecution
+	//
+	//   err := DeleteWorkflowExecution(args)
+	//   result, err := AdminService_DeleteWorkflowExecution_Helper.WrapResponse(err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from DeleteWorkflowExecution: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(error) (*AdminService_DeleteWorkflowExecution_Result, error)
+
+	// UnwrapResponse takes the result struct for DeleteWorkflowExecution
+	// and returns the erorr returned by it (if any).
+	//
+	// The error is non-nil only if DeleteWorkflowExecution threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   err := AdminService_DeleteWorkflowExecution_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_DeleteWorkflowExecution_Result) error
+}{}
+
+func init() {
+	AdminService_DeleteWorkflowExecution_Helper.Args = func(
+		request *DeleteWorkflowExecutionRequest,
+	) *AdminService_DeleteWorkflowExecution_Args {
+		return &AdminService_DeleteWorkflowExecution_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_DeleteWorkflowExecution_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_DeleteWorkflowExecution_Helper.WrapResponse = func(err error) (*AdminService_DeleteWorkflowExecution_Result, error) {
+		if err == nil {
+			return &AdminService_DeleteWorkflowExecution_Result{}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DeleteWorkflowExecution_Result.BadRequestError")
+			}
+			return &AdminService_DeleteWorkflowExecution_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DeleteWorkflowExecution_Result.InternalServiceError")
+			}
+			return &AdminService_DeleteWorkflowExecution_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DeleteWorkflowExecution_Result.EntityNotExistError")
+			}
+			return &AdminService_DeleteWorkflowExecution_Result{EntityNotExistError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DeleteWorkflowExecution_Result.AccessDeniedError")
+			}
+			return &AdminService_DeleteWorkflowExecution_Result{AccessDeniedError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_DeleteWorkflowExecution_Helper.UnwrapResponse = func(result *AdminService_DeleteWorkflowExecution_Result) (err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+		return
+	}
+
+}
+
+// AdminService_DeleteWorkflowExecution_Result represents the result of a AdminService.DeleteWorkflowExecution function call.
+//
+// The result of a DeleteWorkflowExecution execution is sent and received over the wire as this struct.
+type AdminService_DeleteWorkflowExecution_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
+}
+
+// ToWire translates a AdminService_DeleteWorkflowExecution_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DeleteWorkflowExecution_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_DeleteWorkflowExecution_Result should have at most one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, error) {
+	var v shared.EntityNotExistsError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DeleteWorkflowExecution_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DeleteWorkflowExecution_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DeleteWorkflowExecution_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DeleteWorkflowExecution_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count > 1 {
+		return fmt.Errorf("AdminService_DeleteWorkflowExecution_Result should have at most one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DeleteWorkflowExecution_Result
+// struct.
+func (v *AdminService_DeleteWorkflowExecution_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DeleteWorkflowExecution_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DeleteWorkflowExecution_Result match the
+// provided AdminService_DeleteWorkflowExecution_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DeleteWorkflowExecution_Result) Equals(rhs *AdminService_DeleteWorkflowExecution_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DeleteWorkflowExecution_Result.
+func (v *AdminService_DeleteWorkflowExecution_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
+	return err
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DeleteWorkflowExecution_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_DeleteWorkflowExecution_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DeleteWorkflowExecution_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_DeleteWorkflowExecution_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DeleteWorkflowExecution_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_DeleteWorkflowExecution_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DeleteWorkflowExecution_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_DeleteWorkflowExecution_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "DeleteWorkflowExecution" for this struct.
+func (v *AdminService_DeleteWorkflowExecution_Result) MethodName() string {
+	return "DeleteWorkflowExecution"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_DeleteWorkflowExecution_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_DescribeCluster_Args represents the arguments for the AdminService.DescribeCluster function.
+//
+// The arguments for DescribeCluster are sent and received over the wire as this struct.
+type AdminService_DescribeCluster_Args struct {
+}
+
+// ToWire translates a AdminService_DescribeCluster_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeCluster_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [0]wire.Field
+		i      int = 0
+	)
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a AdminService_DescribeCluster_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeCluster_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeCluster_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeCluster_Args) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeCluster_Args
+// struct.
+func (v *AdminService_DescribeCluster_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [0]string
+	i := 0
+
+	return fmt.Sprintf("AdminService_DescribeCluster_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeCluster_Args match the
+// provided AdminService_DescribeCluster_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeCluster_Args) Equals(rhs *AdminService_DescribeCluster_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeCluster_Args.
+func (v *AdminService_DescribeCluster_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	return err
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "DescribeCluster" for this struct.
+func (v *AdminService_DescribeCluster_Args) MethodName() string {
+	return "DescribeCluster"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_DescribeCluster_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_DescribeCluster_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.DescribeCluster
+// function.
+var AdminService_DescribeCluster_Helper = struct {
+	// Args accepts the parameters of DescribeCluster in-order and returns
+	// the arguments struct for the function.
+	Args func() *AdminService_DescribeCluster_Args
+
+	// IsException returns true if the given error can be thrown
+	// by DescribeCluster.
+	//
+	// An error can be thrown by DescribeCluster only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for DescribeCluster
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// DescribeCluster into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by DescribeCluster
+	//
+	//   value, err := DescribeCluster(args)
+	//   result, err := AdminService_DescribeCluster_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from DescribeCluster: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*DescribeClusterResponse, error) (*AdminService_DescribeCluster_Result, error)
+
+	// UnwrapResponse takes the result struct for DescribeCluster
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if DescribeCluster threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_DescribeCluster_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_DescribeCluster_Result) (*DescribeClusterResponse, error)
+}{}
+
+func init() {
+	AdminService_DescribeCluster_Helper.Args = func() *AdminService_DescribeCluster_Args {
+		return &AdminService_DescribeCluster_Args{}
+	}
+
+	AdminService_DescribeCluster_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.InternalServiceError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_DescribeCluster_Helper.WrapResponse = func(success *DescribeClusterResponse, err error) (*AdminService_DescribeCluster_Result, error) {
+		if err == nil {
+			return &AdminService_DescribeCluster_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeCluster_Result.InternalServiceError")
+			}
+			return &AdminService_DescribeCluster_Result{InternalServiceError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeCluster_Result.AccessDeniedError")
+			}
+			return &AdminService_DescribeCluster_Result{AccessDeniedError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_DescribeCluster_Helper.UnwrapResponse = func(result *AdminService_DescribeCluster_Result) (success *DescribeClusterResponse, err error) {
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_DescribeCluster_Result represents the result of a AdminService.DescribeCluster function call.
+//
+// The result of a DescribeCluster execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_DescribeCluster_Result struct {
+	// Value returned by DescribeCluster after a successful execution.
+	Success              *DescribeClusterResponse     `json:"success,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeCluster_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeCluster_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_DescribeCluster_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeClusterResponse_Read(w wire.Value) (*DescribeClusterResponse, error) {
+	var v DescribeClusterResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeCluster_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeCluster_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeCluster_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeCluster_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _DescribeClusterResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_DescribeCluster_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeCluster_Result
+// struct.
+func (v *AdminService_DescribeCluster_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeCluster_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeCluster_Result match the
+// provided AdminService_DescribeCluster_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeCluster_Result) Equals(rhs *AdminService_DescribeCluster_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeCluster_Result.
+func (v *AdminService_DescribeCluster_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeCluster_Result) GetSuccess() (o *DescribeClusterResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_DescribeCluster_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeCluster_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_DescribeCluster_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeCluster_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_DescribeCluster_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "DescribeCluster" for this struct.
+func (v *AdminService_DescribeCluster_Result) MethodName() string {
+	return "DescribeCluster"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_DescribeCluster_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_DescribeHistoryHost_Args represents the arguments for the AdminService.DescribeHistoryHost function.
+//
+// The arguments for DescribeHistoryHost are sent and received over the wire as this struct.
+type AdminService_DescribeHistoryHost_Args struct {
+	Request *shared.DescribeHistoryHostRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeHistoryHost_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeHistoryHost_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeHistoryHostRequest_Read(w wire.Value) (*shared.DescribeHistoryHostRequest, error) {
+	var v shared.DescribeHistoryHostRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeHistoryHost_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeHistoryHost_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeHistoryHost_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeHistoryHost_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _DescribeHistoryHostRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeHistoryHost_Args
+// struct.
+func (v *AdminService_DescribeHistoryHost_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeHistoryHost_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeHistoryHost_Args match the
+// provided AdminService_DescribeHistoryHost_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeHistoryHost_Args) Equals(rhs *AdminService_DescribeHistoryHost_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeHistoryHost_Args.
+func (v *AdminService_DescribeHistoryHost_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeHistoryHost_Args) GetRequest() (o *shared.DescribeHistoryHostRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_DescribeHistoryHost_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "DescribeHistoryHost" for this struct.
+func (v *AdminService_DescribeHistoryHost_Args) MethodName() string {
+	return "DescribeHistoryHost"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_DescribeHistoryHost_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_DescribeHistoryHost_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.DescribeHistoryHost
+// function.
+var AdminService_DescribeHistoryHost_Helper = struct {
+	// Args accepts the parameters of DescribeHistoryHost in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *shared.DescribeHistoryHostRequest,
+	) *AdminService_DescribeHistoryHost_Args
+
+	// IsException returns true if the given error can be thrown
+	// by DescribeHistoryHost.
+	//
+	// An error can be thrown by DescribeHistoryHost only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for DescribeHistoryHost
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// DescribeHistoryHost into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by DescribeHistoryHost
+	//
+	//   value, err := DescribeHistoryHost(args)
+	//   result, err := AdminService_DescribeHistoryHost_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from DescribeHistoryHost: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*shared.DescribeHistoryHostResponse, error) (*AdminService_DescribeHistoryHost_Result, error)
+
+	// UnwrapResponse takes the result struct for DescribeHistoryHost
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if DescribeHistoryHost threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_DescribeHistoryHost_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_DescribeHistoryHost_Result) (*shared.DescribeHistoryHostResponse, error)
+}{}
+
+func init() {
+	AdminService_DescribeHistoryHost_Helper.Args = func(
+		request *shared.DescribeHistoryHostRequest,
+	) *AdminService_DescribeHistoryHost_Args {
+		return &AdminService_DescribeHistoryHost_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_DescribeHistoryHost_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_DescribeHistoryHost_Helper.WrapResponse = func(success *shared.DescribeHistoryHostResponse, err error) (*AdminService_DescribeHistoryHost_Result, error) {
+		if err == nil {
+			return &AdminService_DescribeHistoryHost_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.BadRequestError")
+			}
+			return &AdminService_DescribeHistoryHost_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.InternalServiceError")
+			}
+			return &AdminService_DescribeHistoryHost_Result{InternalServiceError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.AccessDeniedError")
+			}
+			return &AdminService_DescribeHistoryHost_Result{AccessDeniedError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_DescribeHistoryHost_Helper.UnwrapResponse = func(result *AdminService_DescribeHistoryHost_Result) (success *shared.DescribeHistoryHostResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_DescribeHistoryHost_Result represents the result of a AdminService.DescribeHistoryHost function call.
+//
+// The result of a DescribeHistoryHost execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_DescribeHistoryHost_Result struct {
+	// Value returned by DescribeHistoryHost after a successful execution.
+	Success              *shared.DescribeHistoryHostResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError             `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError           `json:"accessDeniedError,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeHistoryHost_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeHistoryHost_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_DescribeHistoryHost_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeHistoryHostResponse_Read(w wire.Value) (*shared.DescribeHistoryHostResponse, error) {
+	var v shared.DescribeHistoryHostResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeHistoryHost_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeHistoryHost_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeHistoryHost_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeHistoryHost_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _DescribeHistoryHostResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_DescribeHistoryHost_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeHistoryHost_Result
+// struct.
+func (v *AdminService_DescribeHistoryHost_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeHistoryHost_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeHistoryHost_Result match the
+// provided AdminService_DescribeHistoryHost_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeHistoryHost_Result) Equals(rhs *AdminService_DescribeHistoryHost_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeHistoryHost_Result.
+func (v *AdminService_DescribeHistoryHost_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeHistoryHost_Result) GetSuccess() (o *shared.DescribeHistoryHostResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_DescribeHistoryHost_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeHistoryHost_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_DescribeHistoryHost_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeHistoryHost_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_DescribeHistoryHost_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeHistoryHost_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_DescribeHistoryHost_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "DescribeHistoryHost" for this struct.
+func (v *AdminService_DescribeHistoryHost_Result) MethodName() string {
+	return "DescribeHistoryHost"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_DescribeHistoryHost_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_DescribeShardQueues_Args represents the arguments for the AdminService.DescribeShardQueues function.
+//
+// The arguments for DescribeShardQueues are sent and received over the wire as this struct.
+type AdminService_DescribeShardQueues_Args struct {
+	Request *shared.DescribeShardQueuesRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeShardQueues_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeShardQueues_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeShardQueuesRequest_Read(w wire.Value) (*shared.DescribeShardQueuesRequest, error) {
+	var v shared.DescribeShardQueuesRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeShardQueues_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeShardQueues_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeShardQueues_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeShardQueues_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _DescribeShardQueuesRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeShardQueues_Args
+// struct.
+func (v *AdminService_DescribeShardQueues_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeShardQueues_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeShardQueues_Args match the
+// provided AdminService_DescribeShardQueues_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeShardQueues_Args) Equals(rhs *AdminService_DescribeShardQueues_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeShardQueues_Args.
+func (v *AdminService_DescribeShardQueues_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeShardQueues_Args) GetRequest() (o *shared.DescribeShardQueuesRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_DescribeShardQueues_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "DescribeShardQueues" for this struct.
+func (v *AdminService_DescribeShardQueues_Args) MethodName() string {
+	return "DescribeShardQueues"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_DescribeShardQueues_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
+}
+
+// AdminService_DescribeShardQueues_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.DescribeShardQueues
+// function.
+var AdminService_DescribeShardQueues_Helper = struct {
+	// Args accepts the parameters of DescribeShardQueues in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *shared.DescribeShardQueuesRequest,
+	) *AdminService_DescribeShardQueues_Args
+
+	// IsException returns true if the given error can be thrown
+	// by DescribeShardQueues.
+	//
+	// An error can be thrown by DescribeShardQueues only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
+
+	// WrapResponse returns the result struct for DescribeShardQueues
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// DescribeShardQueues into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by DescribeShardQueues
+	//
+	//   value, err := DescribeShardQueues(args)
+	//   result, err := AdminService_DescribeShardQueues_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from DescribeShardQueues: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*shared.DescribeShardQueuesResponse, error) (*AdminService_DescribeShardQueues_Result, error)
+
+	// UnwrapResponse takes the result struct for DescribeShardQueues
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if DescribeShardQueues threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_DescribeShardQueues_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_DescribeShardQueues_Result) (*shared.DescribeShardQueuesResponse, error)
+}{}
+
+func init() {
+	AdminService_DescribeShardQueues_Helper.Args = func(
+		request *shared.DescribeShardQueuesRequest,
+	) *AdminService_DescribeShardQueues_Args {
+		return &AdminService_DescribeShardQueues_Args{
+			Request: request,
+		}
+	}
+
+	AdminService_DescribeShardQueues_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		default:
+			return false
+		}
+	}
+
+	AdminService_DescribeShardQueues_Helper.WrapResponse = func(success *shared.DescribeShardQueuesResponse, err error) (*AdminService_DescribeShardQueues_Result, error) {
+		if err == nil {
+			return &AdminService_DescribeShardQueues_Result{Success: success}, nil
+		}
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeShardQueues_Result.BadRequestError")
+			}
+			return &AdminService_DescribeShardQueues_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeShardQueues_Result.InternalServiceError")
+			}
+			return &AdminService_DescribeShardQueues_Result{InternalServiceError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeShardQueues_Result.AccessDeniedError")
+			}
+			return &AdminService_DescribeShardQueues_Result{AccessDeniedError: e}, nil
+		}
+
+		return nil, err
+	}
+	AdminService_DescribeShardQueues_Helper.UnwrapResponse = func(result *AdminService_DescribeShardQueues_Result) (success *shared.DescribeShardQueuesResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
+		return
+	}
+
+}
+
+// AdminService_DescribeShardQueues_Result represents the result of a AdminService.DescribeShardQueues function call.
+//
+// The result of a DescribeShardQueues execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_DescribeShardQueues_Result struct {
+	// Value returned by DescribeShardQueues after a successful execution.
+	Success              *shared.DescribeShardQueuesResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError             `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError           `json:"accessDeniedError,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeShardQueues_Result struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeShardQueues_Result) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_DescribeShardQueues_Result should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeShardQueuesResponse_Read(w wire.Value) (*shared.DescribeShardQueuesResponse, error) {
+	var v shared.DescribeShardQueuesResponse
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeShardQueues_Result struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeShardQueues_Result struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeShardQueues_Result
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeShardQueues_Result) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _DescribeShardQueuesResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_DescribeShardQueues_Result should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeShardQueues_Result
+// struct.
+func (v *AdminService_DescribeShardQueues_Result) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
+		i++
+	}
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
+		i++
+	}
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeShardQueues_Result{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeShardQueues_Result match the
+// provided AdminService_DescribeShardQueues_Result.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeShardQueues_Result) Equals(rhs *AdminService_DescribeShardQueues_Result) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
+		return false
+	}
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
+		return false
+	}
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
+	}
+
+	return true
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeShardQueues_Result.
+func (v *AdminService_DescribeShardQueues_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
+	}
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
+	}
+	return err
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeShardQueues_Result) GetSuccess() (o *shared.DescribeShardQueuesResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
 
 	return
 }
 
-// IsSetNextEventId returns true if NextEventId is not nil.
-func (v *GetWorkflowExecutionRawHistoryRequest) IsSetNextEventId() bool {
-	return v != nil && v.NextEventId != nil
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_DescribeShardQueues_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
 }
 
-// GetMaximumPageSize returns the value of MaximumPageSize if it is set or its
+// GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryRequest) GetMaximumPageSize() (o int32) {
-	if v != nil && v.MaximumPageSize != nil {
-		return *v.MaximumPageSize
+func (v *AdminService_DescribeShardQueues_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
+	}
+
+	return
+}
+
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_DescribeShardQueues_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
+}
+
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeShardQueues_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
+	}
+
+	return
+}
+
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_DescribeShardQueues_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
+}
+
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeShardQueues_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
+	}
+
+	return
+}
+
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_DescribeShardQueues_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
+}
+
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "DescribeShardQueues" for this struct.
+func (v *AdminService_DescribeShardQueues_Result) MethodName() string {
+	return "DescribeShardQueues"
+}
+
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_DescribeShardQueues_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
+
+// AdminService_DescribeWorkflowExecution_Args represents the arguments for the AdminService.DescribeWorkflowExecution function.
+//
+// The arguments for DescribeWorkflowExecution are sent and received over the wire as this struct.
+type AdminService_DescribeWorkflowExecution_Args struct {
+	Request *DescribeWorkflowExecutionRequest `json:"request,omitempty"`
+}
+
+// ToWire translates a AdminService_DescribeWorkflowExecution_Args struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AdminService_DescribeWorkflowExecution_Args) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _DescribeWorkflowExecutionRequest_Read(w wire.Value) (*DescribeWorkflowExecutionRequest, error) {
+	var v DescribeWorkflowExecutionRequest
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a AdminService_DescribeWorkflowExecution_Args struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AdminService_DescribeWorkflowExecution_Args struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AdminService_DescribeWorkflowExecution_Args
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AdminService_DescribeWorkflowExecution_Args) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Request, err = _DescribeWorkflowExecutionRequest_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AdminService_DescribeWorkflowExecution_Args
+// struct.
+func (v *AdminService_DescribeWorkflowExecution_Args) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+		i++
+	}
+
+	return fmt.Sprintf("AdminService_DescribeWorkflowExecution_Args{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AdminService_DescribeWorkflowExecution_Args match the
+// provided AdminService_DescribeWorkflowExecution_Args.
+//
+// This function performs a deep comparison.
+func (v *AdminService_DescribeWorkflowExecution_Args) Equals(rhs *AdminService_DescribeWorkflowExecution_Args) bool {
+	if v == nil {
+		return rhs == nil
+	} else if rhs == nil {
+		return false
+	}
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+		return false
 	}
 
-	return
+	return true
 }
 
-// IsSetMaximumPageSize returns true if MaximumPageSize is not nil.
-func (v *GetWorkflowExecutionRawHistoryRequest) IsSetMaximumPageSize() bool {
-	return v != nil && v.MaximumPageSize != nil
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AdminService_DescribeWorkflowExecution_Args.
+func (v *AdminService_DescribeWorkflowExecution_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+	if v == nil {
+		return nil
+	}
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	}
+	return err
 }
 
-// GetNextPageToken returns the value of NextPageToken if it is set or its
+// GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryRequest) GetNextPageToken() (o []byte) {
-	if v != nil && v.NextPageToken != nil {
-		return v.NextPageToken
+func (v *AdminService_DescribeWorkflowExecution_Args) GetRequest() (o *DescribeWorkflowExecutionRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
 	}
 
 	return
 }
 
-// IsSetNextPageToken returns true if NextPageToken is not nil.
-func (v *GetWorkflowExecutionRawHistoryRequest) IsSetNextPageToken() bool {
-	return v != nil && v.NextPageToken != nil
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
 }
 
-type GetWorkflowExecutionRawHistoryResponse struct {
-	NextPageToken     []byte                             `json:"nextPageToken,omitempty"`
-	HistoryBatches    []*shared.DataBlob                 `json:"historyBatches,omitempty"`
-	ReplicationInfo   map[string]*shared.ReplicationInfo `json:"replicationInfo,omitempty"`
-	EventStoreVersion *int32                             `json:"eventStoreVersion,omitempty"`
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the arguments.
+//
+// This will always be "DescribeWorkflowExecution" for this struct.
+func (v *AdminService_DescribeWorkflowExecution_Args) MethodName() string {
+	return "DescribeWorkflowExecution"
 }
 
-type _List_DataBlob_ValueList []*shared.DataBlob
-
-func (v _List_DataBlob_ValueList) ForEach(f func(wire.Value) error) error {
-	for i, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", i)
-		}
-		w, err := x.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Call for this struct.
+func (v *AdminService_DescribeWorkflowExecution_Args) EnvelopeType() wire.EnvelopeType {
+	return wire.Call
 }
 
-func (v _List_DataBlob_ValueList) Size() int {
-	return len(v)
-}
+// AdminService_DescribeWorkflowExecution_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.DescribeWorkflowExecution
+// function.
+var AdminService_DescribeWorkflowExecution_Helper = struct {
+	// Args accepts the parameters of DescribeWorkflowExecution in-order and returns
+	// the arguments struct for the function.
+	Args func(
+		request *DescribeWorkflowExecutionRequest,
+	) *AdminService_DescribeWorkflowExecution_Args
 
-func (_List_DataBlob_ValueList) ValueType() wire.Type {
-	return wire.TStruct
-}
+	// IsException returns true if the given error can be thrown
+	// by DescribeWorkflowExecution.
+	//
+	// An error can be thrown by DescribeWorkflowExecution only if the
+	// corresponding exception type was mentioned in the 'throws'
+	// section for it in the Thrift file.
+	IsException func(error) bool
 
-func (_List_DataBlob_ValueList) Close() {}
+	// WrapResponse returns the result struct for DescribeWorkflowExecution
+	// given its return value and error.
+	//
+	// This allows mapping values and errors returned by
+	// DescribeWorkflowExecution into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by DescribeWorkflowExecution
+	//
+	//   value, err := DescribeWorkflowExecution(args)
+	//   result, err := AdminService_DescribeWorkflowExecution_Helper.WrapResponse(value, err)
+	//   if err != nil {
+	//     return fmt.Errorf("unexpected error from DescribeWorkflowExecution: %v", err)
+	//   }
+	//   serialize(result)
+	WrapResponse func(*DescribeWorkflowExecutionResponse, error) (*AdminService_DescribeWorkflowExecution_Result, error)
 
-type _Map_String_ReplicationInfo_MapItemList map[string]*shared.ReplicationInfo
+	// UnwrapResponse takes the result struct for DescribeWorkflowExecution
+	// and returns the value or error returned by it.
+	//
+	// The error is non-nil only if DescribeWorkflowExecution threw an
+	// exception.
+	//
+	//   result := deserialize(bytes)
+	//   value, err := AdminService_DescribeWorkflowExecution_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_DescribeWorkflowExecution_Result) (*DescribeWorkflowExecutionResponse, error)
+}{}
 
-func (m _Map_String_ReplicationInfo_MapItemList) ForEach(f func(wire.MapItem) error) error {
-	for k, v := range m {
-		if v == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", k)
+func init() {
+	AdminService_DescribeWorkflowExecution_Helper.Args = func(
+		request *DescribeWorkflowExecutionRequest,
+	) *AdminService_DescribeWorkflowExecution_Args {
+		return &AdminService_DescribeWorkflowExecution_Args{
+			Request: request,
 		}
-		kw, err := wire.NewValueString(k), error(nil)
-		if err != nil {
-			return err
+	}
+
+	AdminService_DescribeWorkflowExecution_Helper.IsException = func(err error) bool {
+		switch err.(type) {
+		case *shared.BadRequestError:
+			return true
+		case *shared.InternalServiceError:
+			return true
+		case *shared.EntityNotExistsError:
+			return true
+		case *shared.AccessDeniedError:
+			return true
+		default:
+			return false
 		}
+	}
 
-		vw, err := v.ToWire()
-		if err != nil {
-			return err
+	AdminService_DescribeWorkflowExecution_Helper.WrapResponse = func(success *DescribeWorkflowExecutionResponse, err error) (*AdminService_DescribeWorkflowExecution_Result, error) {
+		if err == nil {
+			return &AdminService_DescribeWorkflowExecution_Result{Success: success}, nil
 		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
-			return err
+
+		switch e := err.(type) {
+		case *shared.BadRequestError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.BadRequestError")
+			}
+			return &AdminService_DescribeWorkflowExecution_Result{BadRequestError: e}, nil
+		case *shared.InternalServiceError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.InternalServiceError")
+			}
+			return &AdminService_DescribeWorkflowExecution_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.EntityNotExistError")
+			}
+			return &AdminService_DescribeWorkflowExecution_Result{EntityNotExistError: e}, nil
+		case *shared.AccessDeniedError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.AccessDeniedError")
+			}
+			return &AdminService_DescribeWorkflowExecution_Result{AccessDeniedError: e}, nil
 		}
+
+		return nil, err
 	}
-	return nil
-}
+	AdminService_DescribeWorkflowExecution_Helper.UnwrapResponse = func(result *AdminService_DescribeWorkflowExecution_Result) (success *DescribeWorkflowExecutionResponse, err error) {
+		if result.BadRequestError != nil {
+			err = result.BadRequestError
+			return
+		}
+		if result.InternalServiceError != nil {
+			err = result.InternalServiceError
+			return
+		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
+			return
+		}
 
-func (m _Map_String_ReplicationInfo_MapItemList) Size() int {
-	return len(m)
-}
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
 
-func (_Map_String_ReplicationInfo_MapItemList) KeyType() wire.Type {
-	return wire.TBinary
-}
+		err = errors.New("expected a non-void result")
+		return
+	}
 
-func (_Map_String_ReplicationInfo_MapItemList) ValueType() wire.Type {
-	return wire.TStruct
 }
 
-func (_Map_String_ReplicationInfo_MapItemList) Close() {}
+// AdminService_DescribeWorkflowExecution_Result represents the result of a AdminService.DescribeWorkflowExecution function call.
+//
+// The result of a DescribeWorkflowExecution execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_DescribeWorkflowExecution_Result struct {
+	// Value returned by DescribeWorkflowExecution after a successful execution.
+	Success              *DescribeWorkflowExecutionResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError            `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError       `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError       `json:"entityNotExistError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError          `json:"accessDeniedError,omitempty"`
+}
 
-// ToWire translates a GetWorkflowExecutionRawHistoryResponse struct into a Thrift-level intermediate
+// ToWire translates a AdminService_DescribeWorkflowExecution_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1198,113 +6413,73 @@ func (_Map_String_ReplicationInfo_MapItemList) Close() {}
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *GetWorkflowExecutionRawHistoryResponse) ToWire() (wire.Value, error) {
+func (v *AdminService_DescribeWorkflowExecution_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [4]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.NextPageToken != nil {
-		w, err = wire.NewValueBinary(v.NextPageToken), error(nil)
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 10, Value: w}
+		fields[i] = wire.Field{ID: 0, Value: w}
 		i++
 	}
-	if v.HistoryBatches != nil {
-		w, err = wire.NewValueList(_List_DataBlob_ValueList(v.HistoryBatches)), error(nil)
+	if v.BadRequestError != nil {
+		w, err = v.BadRequestError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 20, Value: w}
+		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
-	if v.ReplicationInfo != nil {
-		w, err = wire.NewValueMap(_Map_String_ReplicationInfo_MapItemList(v.ReplicationInfo)), error(nil)
+	if v.InternalServiceError != nil {
+		w, err = v.InternalServiceError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 30, Value: w}
+		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
-	if v.EventStoreVersion != nil {
-		w, err = wire.NewValueI32(*(v.EventStoreVersion)), error(nil)
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 40, Value: w}
+		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
 
-	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
-}
-
-func _DataBlob_Read(w wire.Value) (*shared.DataBlob, error) {
-	var v shared.DataBlob
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _List_DataBlob_Read(l wire.ValueList) ([]*shared.DataBlob, error) {
-	if l.ValueType() != wire.TStruct {
-		return nil, nil
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_DescribeWorkflowExecution_Result should have exactly one field: got %v fields", i)
 	}
 
-	o := make([]*shared.DataBlob, 0, l.Size())
-	err := l.ForEach(func(x wire.Value) error {
-		i, err := _DataBlob_Read(x)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
-		return nil
-	})
-	l.Close()
-	return o, err
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _ReplicationInfo_Read(w wire.Value) (*shared.ReplicationInfo, error) {
-	var v shared.ReplicationInfo
+func _DescribeWorkflowExecutionResponse_Read(w wire.Value) (*DescribeWorkflowExecutionResponse, error) {
+	var v DescribeWorkflowExecutionResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-func _Map_String_ReplicationInfo_Read(m wire.MapItemList) (map[string]*shared.ReplicationInfo, error) {
-	if m.KeyType() != wire.TBinary {
-		return nil, nil
-	}
-
-	if m.ValueType() != wire.TStruct {
-		return nil, nil
-	}
-
-	o := make(map[string]*shared.ReplicationInfo, m.Size())
-	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
-
-		v, err := _ReplicationInfo_Read(x.Value)
-		if err != nil {
-			return err
-		}
-
-		o[k] = v
-		return nil
-	})
-	m.Close()
-	return o, err
-}
-
-// FromWire deserializes a GetWorkflowExecutionRawHistoryResponse struct from its Thrift-level
+// FromWire deserializes a AdminService_DescribeWorkflowExecution_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a GetWorkflowExecutionRawHistoryResponse struct
+// An error is returned if we were unable to build a AdminService_DescribeWorkflowExecution_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1312,45 +6487,51 @@ func _Map_String_ReplicationInfo_Read(m wire.MapItemList) (map[string]*shared.Re
 //     return nil, err
 //   }
 //
-//   var v GetWorkflowExecutionRawHistoryResponse
+//   var v AdminService_DescribeWorkflowExecution_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *GetWorkflowExecutionRawHistoryResponse) FromWire(w wire.Value) error {
+func (v *AdminService_DescribeWorkflowExecution_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 10:
-			if field.Value.Type() == wire.TBinary {
-				v.NextPageToken, err = field.Value.GetBinary(), error(nil)
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _DescribeWorkflowExecutionResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 20:
-			if field.Value.Type() == wire.TList {
-				v.HistoryBatches, err = _List_DataBlob_Read(field.Value.GetList())
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.BadRequestError, err = _BadRequestError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 30:
-			if field.Value.Type() == wire.TMap {
-				v.ReplicationInfo, err = _Map_String_ReplicationInfo_Read(field.Value.GetMap())
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.InternalServiceError, err = _InternalServiceError_Read(field.Value)
 				if err != nil {
 					return err
 				}
 
 			}
-		case 40:
-			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
-				v.EventStoreVersion = &x
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -1359,221 +6540,213 @@ func (v *GetWorkflowExecutionRawHistoryResponse) FromWire(w wire.Value) error {
 		}
 	}
 
+	count := 0
+	if v.Success != nil {
+		count++
+	}
+	if v.BadRequestError != nil {
+		count++
+	}
+	if v.InternalServiceError != nil {
+		count++
+	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
+	if v.AccessDeniedError != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("AdminService_DescribeWorkflowExecution_Result should have exactly one field: got %v fields", count)
+	}
+
 	return nil
 }
 
-// String returns a readable string representation of a GetWorkflowExecutionRawHistoryResponse
+// String returns a readable string representation of a AdminService_DescribeWorkflowExecution_Result
 // struct.
-func (v *GetWorkflowExecutionRawHistoryResponse) String() string {
+func (v *AdminService_DescribeWorkflowExecution_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [4]string
+	var fields [5]string
 	i := 0
-	if v.NextPageToken != nil {
-		fields[i] = fmt.Sprintf("NextPageToken: %v", v.NextPageToken)
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
 		i++
 	}
-	if v.HistoryBatches != nil {
-		fields[i] = fmt.Sprintf("HistoryBatches: %v", v.HistoryBatches)
+	if v.BadRequestError != nil {
+		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
 	}
-	if v.ReplicationInfo != nil {
-		fields[i] = fmt.Sprintf("ReplicationInfo: %v", v.ReplicationInfo)
+	if v.InternalServiceError != nil {
+		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.EventStoreVersion != nil {
-		fields[i] = fmt.Sprintf("EventStoreVersion: %v", *(v.EventStoreVersion))
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
 		i++
 	}
-
-	return fmt.Sprintf("GetWorkflowExecutionRawHistoryResponse{%v}", strings.Join(fields[:i], ", "))
-}
-
-func _List_DataBlob_Equals(lhs, rhs []*shared.DataBlob) bool {
-	if len(lhs) != len(rhs) {
-		return false
-	}
-
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
-	}
-
-	return true
-}
-
-func _Map_String_ReplicationInfo_Equals(lhs, rhs map[string]*shared.ReplicationInfo) bool {
-	if len(lhs) != len(rhs) {
-		return false
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
+		i++
 	}
 
-	for lk, lv := range lhs {
-		rv, ok := rhs[lk]
-		if !ok {
-			return false
-		}
-		if !lv.Equals(rv) {
-			return false
-		}
-	}
-	return true
+	return fmt.Sprintf("AdminService_DescribeWorkflowExecution_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this GetWorkflowExecutionRawHistoryResponse match the
-// provided GetWorkflowExecutionRawHistoryResponse.
+// Equals returns true if all the fields of this AdminService_DescribeWorkflowExecution_Result match the
+// provided AdminService_DescribeWorkflowExecution_Result.
 //
 // This function performs a deep comparison.
-func (v *GetWorkflowExecutionRawHistoryResponse) Equals(rhs *GetWorkflowExecutionRawHistoryResponse) bool {
+func (v *AdminService_DescribeWorkflowExecution_Result) Equals(rhs *AdminService_DescribeWorkflowExecution_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.NextPageToken == nil && rhs.NextPageToken == nil) || (v.NextPageToken != nil && rhs.NextPageToken != nil && bytes.Equal(v.NextPageToken, rhs.NextPageToken))) {
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
 		return false
 	}
-	if !((v.HistoryBatches == nil && rhs.HistoryBatches == nil) || (v.HistoryBatches != nil && rhs.HistoryBatches != nil && _List_DataBlob_Equals(v.HistoryBatches, rhs.HistoryBatches))) {
+	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
-	if !((v.ReplicationInfo == nil && rhs.ReplicationInfo == nil) || (v.ReplicationInfo != nil && rhs.ReplicationInfo != nil && _Map_String_ReplicationInfo_Equals(v.ReplicationInfo, rhs.ReplicationInfo))) {
+	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !_I32_EqualsPtr(v.EventStoreVersion, rhs.EventStoreVersion) {
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
 		return false
 	}
-
-	return true
-}
-
-type _List_DataBlob_Zapper []*shared.DataBlob
-
-// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
-// fast logging of _List_DataBlob_Zapper.
-func (l _List_DataBlob_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) (err error) {
-	for _, v := range l {
-		err = multierr.Append(err, enc.AppendObject(v))
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
+		return false
 	}
-	return err
-}
-
-type _Map_String_ReplicationInfo_Zapper map[string]*shared.ReplicationInfo
 
-// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of _Map_String_ReplicationInfo_Zapper.
-func (m _Map_String_ReplicationInfo_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
-	for k, v := range m {
-		err = multierr.Append(err, enc.AddObject((string)(k), v))
-	}
-	return err
+	return true
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of GetWorkflowExecutionRawHistoryResponse.
-func (v *GetWorkflowExecutionRawHistoryResponse) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_DescribeWorkflowExecution_Result.
+func (v *AdminService_DescribeWorkflowExecution_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.NextPageToken != nil {
-		enc.AddString("nextPageToken", base64.StdEncoding.EncodeToString(v.NextPageToken))
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
 	}
-	if v.HistoryBatches != nil {
-		err = multierr.Append(err, enc.AddArray("historyBatches", (_List_DataBlob_Zapper)(v.HistoryBatches)))
+	if v.BadRequestError != nil {
+		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
-	if v.ReplicationInfo != nil {
-		err = multierr.Append(err, enc.AddObject("replicationInfo", (_Map_String_ReplicationInfo_Zapper)(v.ReplicationInfo)))
+	if v.InternalServiceError != nil {
+		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.EventStoreVersion != nil {
-		enc.AddInt32("eventStoreVersion", *v.EventStoreVersion)
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
 	}
 	return err
 }
 
-// GetNextPageToken returns the value of NextPageToken if it is set or its
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_DescribeWorkflowExecution_Result) GetSuccess() (o *DescribeWorkflowExecutionResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryResponse) GetNextPageToken() (o []byte) {
-	if v != nil && v.NextPageToken != nil {
-		return v.NextPageToken
+func (v *AdminService_DescribeWorkflowExecution_Result) GetBadRequestError() (o *shared.BadRequestError) {
+	if v != nil && v.BadRequestError != nil {
+		return v.BadRequestError
 	}
 
 	return
 }
 
-// IsSetNextPageToken returns true if NextPageToken is not nil.
-func (v *GetWorkflowExecutionRawHistoryResponse) IsSetNextPageToken() bool {
-	return v != nil && v.NextPageToken != nil
+// IsSetBadRequestError returns true if BadRequestError is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Result) IsSetBadRequestError() bool {
+	return v != nil && v.BadRequestError != nil
 }
 
-// GetHistoryBatches returns the value of HistoryBatches if it is set or its
+// GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryResponse) GetHistoryBatches() (o []*shared.DataBlob) {
-	if v != nil && v.HistoryBatches != nil {
-		return v.HistoryBatches
+func (v *AdminService_DescribeWorkflowExecution_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+	if v != nil && v.InternalServiceError != nil {
+		return v.InternalServiceError
 	}
 
 	return
 }
 
-// IsSetHistoryBatches returns true if HistoryBatches is not nil.
-func (v *GetWorkflowExecutionRawHistoryResponse) IsSetHistoryBatches() bool {
-	return v != nil && v.HistoryBatches != nil
+// IsSetInternalServiceError returns true if InternalServiceError is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Result) IsSetInternalServiceError() bool {
+	return v != nil && v.InternalServiceError != nil
 }
 
-// GetReplicationInfo returns the value of ReplicationInfo if it is set or its
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryResponse) GetReplicationInfo() (o map[string]*shared.ReplicationInfo) {
-	if v != nil && v.ReplicationInfo != nil {
-		return v.ReplicationInfo
+func (v *AdminService_DescribeWorkflowExecution_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
 	}
 
 	return
 }
 
-// IsSetReplicationInfo returns true if ReplicationInfo is not nil.
-func (v *GetWorkflowExecutionRawHistoryResponse) IsSetReplicationInfo() bool {
-	return v != nil && v.ReplicationInfo != nil
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
 }
 
-// GetEventStoreVersion returns the value of EventStoreVersion if it is set or its
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
 // zero value if it is unset.
-func (v *GetWorkflowExecutionRawHistoryResponse) GetEventStoreVersion() (o int32) {
-	if v != nil && v.EventStoreVersion != nil {
-		return *v.EventStoreVersion
+func (v *AdminService_DescribeWorkflowExecution_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
 	}
 
 	return
 }
 
-// IsSetEventStoreVersion returns true if EventStoreVersion is not nil.
-func (v *GetWorkflowExecutionRawHistoryResponse) IsSetEventStoreVersion() bool {
-	return v != nil && v.EventStoreVersion != nil
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_DescribeWorkflowExecution_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
 }
 
-// ThriftModule represents the IDL file used to generate this package.
-var ThriftModule = &thriftreflect.ThriftModule{
-	Name:     "admin",
-	Package:  "github.com/uber/cadence/.gen/go/admin",
-	FilePath: "admin.thrift",
-	SHA1:     "e0204db2c0f09897b1f0efaf590a7f5e1b961d1a",
-	Includes: []*thriftreflect.ThriftModule{
-		shared.ThriftModule,
-	},
-	Raw: rawIDL,
+// MethodName returns the name of the Thrift function as specified in
+// the IDL, for which this struct represent the result.
+//
+// This will always be "DescribeWorkflowExecution" for this struct.
+func (v *AdminService_DescribeWorkflowExecution_Result) MethodName() string {
+	return "DescribeWorkflowExecution"
 }
 
-const rawIDL = "// Copyright (c) 2017 Uber Technologies, Inc.\n//\n// Permission is hereby granted, free of charge, to any person obtaining a copy\n// of this software and associated documentation files (the \"Software\"), to deal\n// in the Software without restriction, including without limitation the rights\n// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell\n// copies of the Software, and to permit persons to whom the Software is\n// furnished to do so, subject to the following conditions:\n//\n// The above copyright notice and this permission notice shall be included in\n// all copies or substantial portions of the Software.\n//\n// THE SOFTWARE IS PROVIDED \"AS IS\", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR\n// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,\n// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE\n// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER\n// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,\n// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN\n// THE SOFTWARE.\n\nnamespace java com.uber.cadence.admin\n\ninclude \"shared.thrift\"\n\n/**\n* AdminService provides advanced APIs for debugging and analysis with admin privillege\n**/\nservice AdminService {\n  /**\n  * DescribeWorkflowExecution returns information about the internal states of workflow execution.\n  **/\n  DescribeWorkflowExecutionResponse DescribeWorkflowExecution(1: DescribeWorkflowExecutionRequest request)\n    throws (\n      1: shared.BadRequestError         badRequestError,\n      2: shared.InternalServiceError    internalServiceError,\n      3: shared.EntityNotExistsError    entityNotExistError,\n      4: shared.AccessDeniedError       accessDeniedError,\n    )\n\n  /**\n  * DescribeHistoryHost returns information about the internal states of a history host\n  **/\n  shared.DescribeHistoryHostResponse DescribeHistoryHost(1: shared.DescribeHistoryHostRequest request)\n    throws (\n      1: shared.BadRequestError       badRequestError,\n      2: shared.InternalServiceError  internalServiceError,\n      3: shared.AccessDeniedError     accessDeniedError,\n    )\n\n  /**\n  * Returns the raw history of specified workflow execution.  It fails with 'EntityNotExistError' if speficied workflow\n  * execution in unknown to the service.\n  **/\n  GetWorkflowExecutionRawHistoryResponse GetWorkflowExecutionRawHistory(1: GetWorkflowExecutionRawHistoryRequest getRequest)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.EntityNotExistsError entityNotExistError,\n      4: shared.ServiceBusyError serviceBusyError,\n    )\n\n  /**\n  * AddSearchAttribute whitelist search attribute in request.\n  **/\n  void AddSearchAttribute(1: AddSearchAttributeRequest request)\n    throws (\n      1: shared.BadRequestError badRequestError,\n      2: shared.InternalServiceError internalServiceError,\n      3: shared.ServiceBusyError serviceBusyError,\n    )\n}\n\nstruct DescribeWorkflowExecutionRequest {\n  10: optional string                       domain\n  20: optional shared.WorkflowExecution     execution\n}\n\nstruct DescribeWorkflowExecutionResponse {\n  10: optional string shardId\n  20: optional string historyAddr\n  40: optional string mutableStateInCache\n  50: optional string mutableStateInDatabase\n}\n\nstruct GetWorkflowExecutionRawHistoryRequest {\n  10: optional string domain\n  20: optional shared.WorkflowExecution execution\n  30: optional i64 (js.type = \"Long\") firstEventId\n  40: optional i64 (js.type = \"Long\") nextEventId\n  50: optional i32 maximumPageSize\n  60: optional binary nextPageToken\n}\n\nstruct GetWorkflowExecutionRawHistoryResponse {\n  10: optional binary nextPageToken\n  20: optional list<shared.DataBlob> historyBatches\n  30: optional map<string, shared.ReplicationInfo> replicationInfo\n  40: optional i32 eventStoreVersion\n}\n\nstruct AddSearchAttributeRequest {\n  10: optional map<string, shared.IndexedValueType> searchAttribute\n}\n"
+// EnvelopeType returns the kind of value inside this struct.
+//
+// This will always be Reply for this struct.
+func (v *AdminService_DescribeWorkflowExecution_Result) EnvelopeType() wire.EnvelopeType {
+	return wire.Reply
+}
 
-// AdminService_AddSearchAttribute_Args represents the arguments for the AdminService.AddSearchAttribute function.
+// AdminService_GetWorkflowExecutionRawHistory_Args represents the arguments for the AdminService.GetWorkflowExecutionRawHistory function.
 //
-// The arguments for AddSearchAttribute are sent and received over the wire as this struct.
-type AdminService_AddSearchAttribute_Args struct {
-	Request *AddSearchAttributeRequest `json:"request,omitempty"`
+// The arguments for GetWorkflowExecutionRawHistory are sent and received over the wire as this struct.
+type AdminService_GetWorkflowExecutionRawHistory_Args struct {
+	GetRequest *GetWorkflowExecutionRawHistoryRequest `json:"getRequest,omitempty"`
 }
 
-// ToWire translates a AdminService_AddSearchAttribute_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_GetWorkflowExecutionRawHistory_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1588,7 +6761,7 @@ type AdminService_AddSearchAttribute_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_AddSearchAttribute_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -1596,8 +6769,8 @@ func (v *AdminService_AddSearchAttribute_Args) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	if v.Request != nil {
-		w, err = v.Request.ToWire()
+	if v.GetRequest != nil {
+		w, err = v.GetRequest.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -1608,17 +6781,17 @@ func (v *AdminService_AddSearchAttribute_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _AddSearchAttributeRequest_Read(w wire.Value) (*AddSearchAttributeRequest, error) {
-	var v AddSearchAttributeRequest
+func _GetWorkflowExecutionRawHistoryRequest_Read(w wire.Value) (*GetWorkflowExecutionRawHistoryRequest, error) {
+	var v GetWorkflowExecutionRawHistoryRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_AddSearchAttribute_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_GetWorkflowExecutionRawHistory_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_AddSearchAttribute_Args struct
+// An error is returned if we were unable to build a AdminService_GetWorkflowExecutionRawHistory_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1626,19 +6799,19 @@ func _AddSearchAttributeRequest_Read(w wire.Value) (*AddSearchAttributeRequest,
 //     return nil, err
 //   }
 //
-//   var v AdminService_AddSearchAttribute_Args
+//   var v AdminService_GetWorkflowExecutionRawHistory_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_AddSearchAttribute_Args) FromWire(w wire.Value) error {
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _AddSearchAttributeRequest_Read(field.Value)
+				v.GetRequest, err = _GetWorkflowExecutionRawHistoryRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -1650,34 +6823,34 @@ func (v *AdminService_AddSearchAttribute_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_AddSearchAttribute_Args
+// String returns a readable string representation of a AdminService_GetWorkflowExecutionRawHistory_Args
 // struct.
-func (v *AdminService_AddSearchAttribute_Args) String() string {
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.Request != nil {
-		fields[i] = fmt.Sprintf("Request: %v", v.Request)
+	if v.GetRequest != nil {
+		fields[i] = fmt.Sprintf("GetRequest: %v", v.GetRequest)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_AddSearchAttribute_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_GetWorkflowExecutionRawHistory_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_AddSearchAttribute_Args match the
-// provided AdminService_AddSearchAttribute_Args.
+// Equals returns true if all the fields of this AdminService_GetWorkflowExecutionRawHistory_Args match the
+// provided AdminService_GetWorkflowExecutionRawHistory_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_AddSearchAttribute_Args) Equals(rhs *AdminService_AddSearchAttribute_Args) bool {
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) Equals(rhs *AdminService_GetWorkflowExecutionRawHistory_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
+	if !((v.GetRequest == nil && rhs.GetRequest == nil) || (v.GetRequest != nil && rhs.GetRequest != nil && v.GetRequest.Equals(rhs.GetRequest))) {
 		return false
 	}
 
@@ -1685,108 +6858,109 @@ func (v *AdminService_AddSearchAttribute_Args) Equals(rhs *AdminService_AddSearc
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_AddSearchAttribute_Args.
-func (v *AdminService_AddSearchAttribute_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_GetWorkflowExecutionRawHistory_Args.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Request != nil {
-		err = multierr.Append(err, enc.AddObject("request", v.Request))
+	if v.GetRequest != nil {
+		err = multierr.Append(err, enc.AddObject("getRequest", v.GetRequest))
 	}
 	return err
 }
 
-// GetRequest returns the value of Request if it is set or its
+// GetGetRequest returns the value of GetRequest if it is set or its
 // zero value if it is unset.
-func (v *AdminService_AddSearchAttribute_Args) GetRequest() (o *AddSearchAttributeRequest) {
-	if v != nil && v.Request != nil {
-		return v.Request
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) GetGetRequest() (o *GetWorkflowExecutionRawHistoryRequest) {
+	if v != nil && v.GetRequest != nil {
+		return v.GetRequest
 	}
 
 	return
 }
 
-// IsSetRequest returns true if Request is not nil.
-func (v *AdminService_AddSearchAttribute_Args) IsSetRequest() bool {
-	return v != nil && v.Request != nil
+// IsSetGetRequest returns true if GetRequest is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) IsSetGetRequest() bool {
+	return v != nil && v.GetRequest != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "AddSearchAttribute" for this struct.
-func (v *AdminService_AddSearchAttribute_Args) MethodName() string {
-	return "AddSearchAttribute"
+// This will always be "GetWorkflowExecutionRawHistory" for this struct.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) MethodName() string {
+	return "GetWorkflowExecutionRawHistory"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_AddSearchAttribute_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_GetWorkflowExecutionRawHistory_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_AddSearchAttribute_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.AddSearchAttribute
+// AdminService_GetWorkflowExecutionRawHistory_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.GetWorkflowExecutionRawHistory
 // function.
-var AdminService_AddSearchAttribute_Helper = struct {
-	// Args accepts the parameters of AddSearchAttribute in-order and returns
+var AdminService_GetWorkflowExecutionRawHistory_Helper = struct {
+	// Args accepts the parameters of GetWorkflowExecutionRawHistory in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *AddSearchAttributeRequest,
-	) *AdminService_AddSearchAttribute_Args
+		getRequest *GetWorkflowExecutionRawHistoryRequest,
+	) *AdminService_GetWorkflowExecutionRawHistory_Args
 
 	// IsException returns true if the given error can be thrown
-	// by AddSearchAttribute.
+	// by GetWorkflowExecutionRawHistory.
 	//
-	// An error can be thrown by AddSearchAttribute only if the
+	// An error can be thrown by GetWorkflowExecutionRawHistory only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for AddSearchAttribute
-	// given the error returned by it. The provided error may
-	// be nil if AddSearchAttribute did not fail.
+	// WrapResponse returns the result struct for GetWorkflowExecutionRawHistory
+	// given its return value and error.
 	//
-	// This allows mapping errors returned by AddSearchAttribute into a
-	// serializable result struct. WrapResponse returns a
-	// non-nil error if the provided error cannot be thrown by
-	// AddSearchAttribute
+	// This allows mapping values and errors returned by
+	// GetWorkflowExecutionRawHistory into a serializable result struct.
+	// WrapResponse returns a non-nil error if the provided
+	// error cannot be thrown by GetWorkflowExecutionRawHistory
 	//
-	//   err := AddSearchAttribute(args)
-	//   result, err := AdminService_AddSearchAttribute_Helper.WrapResponse(err)
+	//   value, err := GetWorkflowExecutionRawHistory(args)
+	//   result, err := AdminService_GetWorkflowExecutionRawHistory_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from AddSearchAttribute: %v", err)
+	//     return fmt.Errorf("unexpected error from GetWorkflowExecutionRawHistory: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(error) (*AdminService_AddSearchAttribute_Result, error)
+	WrapResponse func(*GetWorkflowExecutionRawHistoryResponse, error) (*AdminService_GetWorkflowExecutionRawHistory_Result, error)
 
-	// UnwrapResponse takes the result struct for AddSearchAttribute
-	// and returns the erorr returned by it (if any).
+	// UnwrapResponse takes the result struct for GetWorkflowExecutionRawHistory
+	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if AddSearchAttribute threw an
+	// The error is non-nil only if GetWorkflowExecutionRawHistory threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   err := AdminService_AddSearchAttribute_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_AddSearchAttribute_Result) error
+	//   value, err := AdminService_GetWorkflowExecutionRawHistory_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_GetWorkflowExecutionRawHistory_Result) (*GetWorkflowExecutionRawHistoryResponse, error)
 }{}
 
 func init() {
-	AdminService_AddSearchAttribute_Helper.Args = func(
-		request *AddSearchAttributeRequest,
-	) *AdminService_AddSearchAttribute_Args {
-		return &AdminService_AddSearchAttribute_Args{
-			Request: request,
+	AdminService_GetWorkflowExecutionRawHistory_Helper.Args = func(
+		getRequest *GetWorkflowExecutionRawHistoryRequest,
+	) *AdminService_GetWorkflowExecutionRawHistory_Args {
+		return &AdminService_GetWorkflowExecutionRawHistory_Args{
+			GetRequest: getRequest,
 		}
 	}
 
-	AdminService_AddSearchAttribute_Helper.IsException = func(err error) bool {
+	AdminService_GetWorkflowExecutionRawHistory_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
+		case *shared.EntityNotExistsError:
+			return true
 		case *shared.ServiceBusyError:
 			return true
 		default:
@@ -1794,32 +6968,37 @@ func init() {
 		}
 	}
 
-	AdminService_AddSearchAttribute_Helper.WrapResponse = func(err error) (*AdminService_AddSearchAttribute_Result, error) {
+	AdminService_GetWorkflowExecutionRawHistory_Helper.WrapResponse = func(success *GetWorkflowExecutionRawHistoryResponse, err error) (*AdminService_GetWorkflowExecutionRawHistory_Result, error) {
 		if err == nil {
-			return &AdminService_AddSearchAttribute_Result{}, nil
+			return &AdminService_GetWorkflowExecutionRawHistory_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.BadRequestError")
 			}
-			return &AdminService_AddSearchAttribute_Result{BadRequestError: e}, nil
+			return &AdminService_GetWorkflowExecutionRawHistory_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.InternalServiceError")
 			}
-			return &AdminService_AddSearchAttribute_Result{InternalServiceError: e}, nil
+			return &AdminService_GetWorkflowExecutionRawHistory_Result{InternalServiceError: e}, nil
+		case *shared.EntityNotExistsError:
+			if e == nil {
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.EntityNotExistError")
+			}
+			return &AdminService_GetWorkflowExecutionRawHistory_Result{EntityNotExistError: e}, nil
 		case *shared.ServiceBusyError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_AddSearchAttribute_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.ServiceBusyError")
 			}
-			return &AdminService_AddSearchAttribute_Result{ServiceBusyError: e}, nil
+			return &AdminService_GetWorkflowExecutionRawHistory_Result{ServiceBusyError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_AddSearchAttribute_Helper.UnwrapResponse = func(result *AdminService_AddSearchAttribute_Result) (err error) {
+	AdminService_GetWorkflowExecutionRawHistory_Helper.UnwrapResponse = func(result *AdminService_GetWorkflowExecutionRawHistory_Result) (success *GetWorkflowExecutionRawHistoryResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -1828,25 +7007,41 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
+		if result.EntityNotExistError != nil {
+			err = result.EntityNotExistError
+			return
+		}
 		if result.ServiceBusyError != nil {
 			err = result.ServiceBusyError
 			return
 		}
+
+		if result.Success != nil {
+			success = result.Success
+			return
+		}
+
+		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// AdminService_AddSearchAttribute_Result represents the result of a AdminService.AddSearchAttribute function call.
+// AdminService_GetWorkflowExecutionRawHistory_Result represents the result of a AdminService.GetWorkflowExecutionRawHistory function call.
 //
-// The result of a AddSearchAttribute execution is sent and received over the wire as this struct.
-type AdminService_AddSearchAttribute_Result struct {
-	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError     `json:"serviceBusyError,omitempty"`
+// The result of a GetWorkflowExecutionRawHistory execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_GetWorkflowExecutionRawHistory_Result struct {
+	// Value returned by GetWorkflowExecutionRawHistory after a successful execution.
+	Success              *GetWorkflowExecutionRawHistoryResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError                 `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError            `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError            `json:"entityNotExistError,omitempty"`
+	ServiceBusyError     *shared.ServiceBusyError                `json:"serviceBusyError,omitempty"`
 }
 
-// ToWire translates a AdminService_AddSearchAttribute_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_GetWorkflowExecutionRawHistory_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -1861,14 +7056,22 @@ type AdminService_AddSearchAttribute_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_AddSearchAttribute_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [3]wire.Field
+		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
+	if v.Success != nil {
+		w, err = v.Success.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 0, Value: w}
+		i++
+	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -1885,45 +7088,41 @@ func (v *AdminService_AddSearchAttribute_Result) ToWire() (wire.Value, error) {
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		w, err = v.EntityNotExistError.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
 	if v.ServiceBusyError != nil {
 		w, err = v.ServiceBusyError.ToWire()
 		if err != nil {
 			return w, err
 		}
-		fields[i] = wire.Field{ID: 3, Value: w}
+		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
 
-	if i > 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_AddSearchAttribute_Result should have at most one field: got %v fields", i)
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_GetWorkflowExecutionRawHistory_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _BadRequestError_Read(w wire.Value) (*shared.BadRequestError, error) {
-	var v shared.BadRequestError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _InternalServiceError_Read(w wire.Value) (*shared.InternalServiceError, error) {
-	var v shared.InternalServiceError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _ServiceBusyError_Read(w wire.Value) (*shared.ServiceBusyError, error) {
-	var v shared.ServiceBusyError
+func _GetWorkflowExecutionRawHistoryResponse_Read(w wire.Value) (*GetWorkflowExecutionRawHistoryResponse, error) {
+	var v GetWorkflowExecutionRawHistoryResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_AddSearchAttribute_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_GetWorkflowExecutionRawHistory_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_AddSearchAttribute_Result struct
+// An error is returned if we were unable to build a AdminService_GetWorkflowExecutionRawHistory_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -1931,16 +7130,24 @@ func _ServiceBusyError_Read(w wire.Value) (*shared.ServiceBusyError, error) {
 //     return nil, err
 //   }
 //
-//   var v AdminService_AddSearchAttribute_Result
+//   var v AdminService_GetWorkflowExecutionRawHistory_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_AddSearchAttribute_Result) FromWire(w wire.Value) error {
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
+		case 0:
+			if field.Value.Type() == wire.TStruct {
+				v.Success, err = _GetWorkflowExecutionRawHistoryResponse_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -1958,6 +7165,14 @@ func (v *AdminService_AddSearchAttribute_Result) FromWire(w wire.Value) error {
 
 			}
 		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
 			if field.Value.Type() == wire.TStruct {
 				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
 				if err != nil {
@@ -1969,31 +7184,41 @@ func (v *AdminService_AddSearchAttribute_Result) FromWire(w wire.Value) error {
 	}
 
 	count := 0
+	if v.Success != nil {
+		count++
+	}
 	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
+	if v.EntityNotExistError != nil {
+		count++
+	}
 	if v.ServiceBusyError != nil {
 		count++
 	}
-	if count > 1 {
-		return fmt.Errorf("AdminService_AddSearchAttribute_Result should have at most one field: got %v fields", count)
+	if count != 1 {
+		return fmt.Errorf("AdminService_GetWorkflowExecutionRawHistory_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_AddSearchAttribute_Result
+// String returns a readable string representation of a AdminService_GetWorkflowExecutionRawHistory_Result
 // struct.
-func (v *AdminService_AddSearchAttribute_Result) String() string {
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [3]string
+	var fields [5]string
 	i := 0
+	if v.Success != nil {
+		fields[i] = fmt.Sprintf("Success: %v", v.Success)
+		i++
+	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -2002,30 +7227,40 @@ func (v *AdminService_AddSearchAttribute_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
+	if v.EntityNotExistError != nil {
+		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
+		i++
+	}
 	if v.ServiceBusyError != nil {
 		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_AddSearchAttribute_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_GetWorkflowExecutionRawHistory_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_AddSearchAttribute_Result match the
-// provided AdminService_AddSearchAttribute_Result.
+// Equals returns true if all the fields of this AdminService_GetWorkflowExecutionRawHistory_Result match the
+// provided AdminService_GetWorkflowExecutionRawHistory_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_AddSearchAttribute_Result) Equals(rhs *AdminService_AddSearchAttribute_Result) bool {
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) Equals(rhs *AdminService_GetWorkflowExecutionRawHistory_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
+	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
+		return false
+	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
+	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
+		return false
+	}
 	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
 		return false
 	}
@@ -2034,26 +7269,47 @@ func (v *AdminService_AddSearchAttribute_Result) Equals(rhs *AdminService_AddSea
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_AddSearchAttribute_Result.
-func (v *AdminService_AddSearchAttribute_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_GetWorkflowExecutionRawHistory_Result.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
+	if v.Success != nil {
+		err = multierr.Append(err, enc.AddObject("success", v.Success))
+	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
+	if v.EntityNotExistError != nil {
+		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
+	}
 	if v.ServiceBusyError != nil {
 		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
 	}
 	return err
 }
 
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetSuccess() (o *GetWorkflowExecutionRawHistoryResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_AddSearchAttribute_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -2062,13 +7318,13 @@ func (v *AdminService_AddSearchAttribute_Result) GetBadRequestError() (o *shared
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_AddSearchAttribute_Result) IsSetBadRequestError() bool {
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_AddSearchAttribute_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -2077,13 +7333,28 @@ func (v *AdminService_AddSearchAttribute_Result) GetInternalServiceError() (o *s
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_AddSearchAttribute_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
+// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
+// zero value if it is unset.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+	if v != nil && v.EntityNotExistError != nil {
+		return v.EntityNotExistError
+	}
+
+	return
+}
+
+// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetEntityNotExistError() bool {
+	return v != nil && v.EntityNotExistError != nil
+}
+
 // GetServiceBusyError returns the value of ServiceBusyError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_AddSearchAttribute_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
 	if v != nil && v.ServiceBusyError != nil {
 		return v.ServiceBusyError
 	}
@@ -2092,33 +7363,33 @@ func (v *AdminService_AddSearchAttribute_Result) GetServiceBusyError() (o *share
 }
 
 // IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *AdminService_AddSearchAttribute_Result) IsSetServiceBusyError() bool {
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetServiceBusyError() bool {
 	return v != nil && v.ServiceBusyError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "AddSearchAttribute" for this struct.
-func (v *AdminService_AddSearchAttribute_Result) MethodName() string {
-	return "AddSearchAttribute"
+// This will always be "GetWorkflowExecutionRawHistory" for this struct.
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) MethodName() string {
+	return "GetWorkflowExecutionRawHistory"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_AddSearchAttribute_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_GetWorkflowExecutionRawHistory_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_DescribeHistoryHost_Args represents the arguments for the AdminService.DescribeHistoryHost function.
+// AdminService_ListShardTasks_Args represents the arguments for the AdminService.ListShardTasks function.
 //
-// The arguments for DescribeHistoryHost are sent and received over the wire as this struct.
-type AdminService_DescribeHistoryHost_Args struct {
-	Request *shared.DescribeHistoryHostRequest `json:"request,omitempty"`
+// The arguments for ListShardTasks are sent and received over the wire as this struct.
+type AdminService_ListShardTasks_Args struct {
+	Request *shared.ListShardTasksRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_DescribeHistoryHost_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_ListShardTasks_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2133,7 +7404,7 @@ type AdminService_DescribeHistoryHost_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_DescribeHistoryHost_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_ListShardTasks_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -2153,17 +7424,17 @@ func (v *AdminService_DescribeHistoryHost_Args) ToWire() (wire.Value, error) {
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeHistoryHostRequest_Read(w wire.Value) (*shared.DescribeHistoryHostRequest, error) {
-	var v shared.DescribeHistoryHostRequest
+func _ListShardTasksRequest_Read(w wire.Value) (*shared.ListShardTasksRequest, error) {
+	var v shared.ListShardTasksRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_DescribeHistoryHost_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_ListShardTasks_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_DescribeHistoryHost_Args struct
+// An error is returned if we were unable to build a AdminService_ListShardTasks_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2171,19 +7442,19 @@ func _DescribeHistoryHostRequest_Read(w wire.Value) (*shared.DescribeHistoryHost
 //     return nil, err
 //   }
 //
-//   var v AdminService_DescribeHistoryHost_Args
+//   var v AdminService_ListShardTasks_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_DescribeHistoryHost_Args) FromWire(w wire.Value) error {
+func (v *AdminService_ListShardTasks_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _DescribeHistoryHostRequest_Read(field.Value)
+				v.Request, err = _ListShardTasksRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -2195,9 +7466,9 @@ func (v *AdminService_DescribeHistoryHost_Args) FromWire(w wire.Value) error {
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_DescribeHistoryHost_Args
+// String returns a readable string representation of a AdminService_ListShardTasks_Args
 // struct.
-func (v *AdminService_DescribeHistoryHost_Args) String() string {
+func (v *AdminService_ListShardTasks_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -2209,14 +7480,14 @@ func (v *AdminService_DescribeHistoryHost_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_DescribeHistoryHost_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_ListShardTasks_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_DescribeHistoryHost_Args match the
-// provided AdminService_DescribeHistoryHost_Args.
+// Equals returns true if all the fields of this AdminService_ListShardTasks_Args match the
+// provided AdminService_ListShardTasks_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_DescribeHistoryHost_Args) Equals(rhs *AdminService_DescribeHistoryHost_Args) bool {
+func (v *AdminService_ListShardTasks_Args) Equals(rhs *AdminService_ListShardTasks_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -2230,8 +7501,8 @@ func (v *AdminService_DescribeHistoryHost_Args) Equals(rhs *AdminService_Describ
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_DescribeHistoryHost_Args.
-func (v *AdminService_DescribeHistoryHost_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_ListShardTasks_Args.
+func (v *AdminService_ListShardTasks_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -2243,7 +7514,7 @@ func (v *AdminService_DescribeHistoryHost_Args) MarshalLogObject(enc zapcore.Obj
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeHistoryHost_Args) GetRequest() (o *shared.DescribeHistoryHostRequest) {
+func (v *AdminService_ListShardTasks_Args) GetRequest() (o *shared.ListShardTasksRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -2252,80 +7523,80 @@ func (v *AdminService_DescribeHistoryHost_Args) GetRequest() (o *shared.Describe
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_DescribeHistoryHost_Args) IsSetRequest() bool {
+func (v *AdminService_ListShardTasks_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "DescribeHistoryHost" for this struct.
-func (v *AdminService_DescribeHistoryHost_Args) MethodName() string {
-	return "DescribeHistoryHost"
+// This will always be "ListShardTasks" for this struct.
+func (v *AdminService_ListShardTasks_Args) MethodName() string {
+	return "ListShardTasks"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_DescribeHistoryHost_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_ListShardTasks_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_DescribeHistoryHost_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.DescribeHistoryHost
+// AdminService_ListShardTasks_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.ListShardTasks
 // function.
-var AdminService_DescribeHistoryHost_Helper = struct {
-	// Args accepts the parameters of DescribeHistoryHost in-order and returns
+var AdminService_ListShardTasks_Helper = struct {
+	// Args accepts the parameters of ListShardTasks in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *shared.DescribeHistoryHostRequest,
-	) *AdminService_DescribeHistoryHost_Args
+		request *shared.ListShardTasksRequest,
+	) *AdminService_ListShardTasks_Args
 
 	// IsException returns true if the given error can be thrown
-	// by DescribeHistoryHost.
+	// by ListShardTasks.
 	//
-	// An error can be thrown by DescribeHistoryHost only if the
+	// An error can be thrown by ListShardTasks only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for DescribeHistoryHost
+	// WrapResponse returns the result struct for ListShardTasks
 	// given its return value and error.
 	//
 	// This allows mapping values and errors returned by
-	// DescribeHistoryHost into a serializable result struct.
+	// ListShardTasks into a serializable result struct.
 	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by DescribeHistoryHost
+	// error cannot be thrown by ListShardTasks
 	//
-	//   value, err := DescribeHistoryHost(args)
-	//   result, err := AdminService_DescribeHistoryHost_Helper.WrapResponse(value, err)
+	//   value, err := ListShardTasks(args)
+	//   result, err := AdminService_ListShardTasks_Helper.WrapResponse(value, err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from DescribeHistoryHost: %v", err)
+	//     return fmt.Errorf("unexpected error from ListShardTasks: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*shared.DescribeHistoryHostResponse, error) (*AdminService_DescribeHistoryHost_Result, error)
+	WrapResponse func(*shared.ListShardTasksResponse, error) (*AdminService_ListShardTasks_Result, error)
 
-	// UnwrapResponse takes the result struct for DescribeHistoryHost
+	// UnwrapResponse takes the result struct for ListShardTasks
 	// and returns the value or error returned by it.
 	//
-	// The error is non-nil only if DescribeHistoryHost threw an
+	// The error is non-nil only if ListShardTasks threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_DescribeHistoryHost_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_DescribeHistoryHost_Result) (*shared.DescribeHistoryHostResponse, error)
+	//   value, err := AdminService_ListShardTasks_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_ListShardTasks_Result) (*shared.ListShardTasksResponse, error)
 }{}
 
 func init() {
-	AdminService_DescribeHistoryHost_Helper.Args = func(
-		request *shared.DescribeHistoryHostRequest,
-	) *AdminService_DescribeHistoryHost_Args {
-		return &AdminService_DescribeHistoryHost_Args{
+	AdminService_ListShardTasks_Helper.Args = func(
+		request *shared.ListShardTasksRequest,
+	) *AdminService_ListShardTasks_Args {
+		return &AdminService_ListShardTasks_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_DescribeHistoryHost_Helper.IsException = func(err error) bool {
+	AdminService_ListShardTasks_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
@@ -2338,32 +7609,32 @@ func init() {
 		}
 	}
 
-	AdminService_DescribeHistoryHost_Helper.WrapResponse = func(success *shared.DescribeHistoryHostResponse, err error) (*AdminService_DescribeHistoryHost_Result, error) {
+	AdminService_ListShardTasks_Helper.WrapResponse = func(success *shared.ListShardTasksResponse, err error) (*AdminService_ListShardTasks_Result, error) {
 		if err == nil {
-			return &AdminService_DescribeHistoryHost_Result{Success: success}, nil
+			return &AdminService_ListShardTasks_Result{Success: success}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListShardTasks_Result.BadRequestError")
 			}
-			return &AdminService_DescribeHistoryHost_Result{BadRequestError: e}, nil
+			return &AdminService_ListShardTasks_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListShardTasks_Result.InternalServiceError")
 			}
-			return &AdminService_DescribeHistoryHost_Result{InternalServiceError: e}, nil
+			return &AdminService_ListShardTasks_Result{InternalServiceError: e}, nil
 		case *shared.AccessDeniedError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeHistoryHost_Result.AccessDeniedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_ListShardTasks_Result.AccessDeniedError")
 			}
-			return &AdminService_DescribeHistoryHost_Result{AccessDeniedError: e}, nil
+			return &AdminService_ListShardTasks_Result{AccessDeniedError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_DescribeHistoryHost_Helper.UnwrapResponse = func(result *AdminService_DescribeHistoryHost_Result) (success *shared.DescribeHistoryHostResponse, err error) {
+	AdminService_ListShardTasks_Helper.UnwrapResponse = func(result *AdminService_ListShardTasks_Result) (success *shared.ListShardTasksResponse, err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -2387,21 +7658,21 @@ func init() {
 	}
 
 }
-
-// AdminService_DescribeHistoryHost_Result represents the result of a AdminService.DescribeHistoryHost function call.
-//
-// The result of a DescribeHistoryHost execution is sent and received over the wire as this struct.
-//
-// Success is set only if the function did not throw an exception.
-type AdminService_DescribeHistoryHost_Result struct {
-	// Value returned by DescribeHistoryHost after a successful execution.
-	Success              *shared.DescribeHistoryHostResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError             `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError        `json:"internalServiceError,omitempty"`
-	AccessDeniedError    *shared.AccessDeniedError           `json:"accessDeniedError,omitempty"`
+
+// AdminService_ListShardTasks_Result represents the result of a AdminService.ListShardTasks function call.
+//
+// The result of a ListShardTasks execution is sent and received over the wire as this struct.
+//
+// Success is set only if the function did not throw an exception.
+type AdminService_ListShardTasks_Result struct {
+	// Value returned by ListShardTasks after a successful execution.
+	Success              *shared.ListShardTasksResponse `json:"success,omitempty"`
+	BadRequestError      *shared.BadRequestError        `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError   `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError      `json:"accessDeniedError,omitempty"`
 }
 
-// ToWire translates a AdminService_DescribeHistoryHost_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_ListShardTasks_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2416,7 +7687,7 @@ type AdminService_DescribeHistoryHost_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_DescribeHistoryHost_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_ListShardTasks_Result) ToWire() (wire.Value, error) {
 	var (
 		fields [4]wire.Field
 		i      int = 0
@@ -2458,29 +7729,23 @@ func (v *AdminService_DescribeHistoryHost_Result) ToWire() (wire.Value, error) {
 	}
 
 	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_DescribeHistoryHost_Result should have exactly one field: got %v fields", i)
+		return wire.Value{}, fmt.Errorf("AdminService_ListShardTasks_Result should have exactly one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeHistoryHostResponse_Read(w wire.Value) (*shared.DescribeHistoryHostResponse, error) {
-	var v shared.DescribeHistoryHostResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _AccessDeniedError_Read(w wire.Value) (*shared.AccessDeniedError, error) {
-	var v shared.AccessDeniedError
+func _ListShardTasksResponse_Read(w wire.Value) (*shared.ListShardTasksResponse, error) {
+	var v shared.ListShardTasksResponse
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_DescribeHistoryHost_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_ListShardTasks_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_DescribeHistoryHost_Result struct
+// An error is returned if we were unable to build a AdminService_ListShardTasks_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2488,19 +7753,19 @@ func _AccessDeniedError_Read(w wire.Value) (*shared.AccessDeniedError, error) {
 //     return nil, err
 //   }
 //
-//   var v AdminService_DescribeHistoryHost_Result
+//   var v AdminService_ListShardTasks_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_DescribeHistoryHost_Result) FromWire(w wire.Value) error {
+func (v *AdminService_ListShardTasks_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _DescribeHistoryHostResponse_Read(field.Value)
+				v.Success, err = _ListShardTasksResponse_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -2547,15 +7812,15 @@ func (v *AdminService_DescribeHistoryHost_Result) FromWire(w wire.Value) error {
 		count++
 	}
 	if count != 1 {
-		return fmt.Errorf("AdminService_DescribeHistoryHost_Result should have exactly one field: got %v fields", count)
+		return fmt.Errorf("AdminService_ListShardTasks_Result should have exactly one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_DescribeHistoryHost_Result
+// String returns a readable string representation of a AdminService_ListShardTasks_Result
 // struct.
-func (v *AdminService_DescribeHistoryHost_Result) String() string {
+func (v *AdminService_ListShardTasks_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -2579,14 +7844,14 @@ func (v *AdminService_DescribeHistoryHost_Result) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_DescribeHistoryHost_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_ListShardTasks_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_DescribeHistoryHost_Result match the
-// provided AdminService_DescribeHistoryHost_Result.
+// Equals returns true if all the fields of this AdminService_ListShardTasks_Result match the
+// provided AdminService_ListShardTasks_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_DescribeHistoryHost_Result) Equals(rhs *AdminService_DescribeHistoryHost_Result) bool {
+func (v *AdminService_ListShardTasks_Result) Equals(rhs *AdminService_ListShardTasks_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -2609,8 +7874,8 @@ func (v *AdminService_DescribeHistoryHost_Result) Equals(rhs *AdminService_Descr
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_DescribeHistoryHost_Result.
-func (v *AdminService_DescribeHistoryHost_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_ListShardTasks_Result.
+func (v *AdminService_ListShardTasks_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -2631,7 +7896,7 @@ func (v *AdminService_DescribeHistoryHost_Result) MarshalLogObject(enc zapcore.O
 
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeHistoryHost_Result) GetSuccess() (o *shared.DescribeHistoryHostResponse) {
+func (v *AdminService_ListShardTasks_Result) GetSuccess() (o *shared.ListShardTasksResponse) {
 	if v != nil && v.Success != nil {
 		return v.Success
 	}
@@ -2640,13 +7905,13 @@ func (v *AdminService_DescribeHistoryHost_Result) GetSuccess() (o *shared.Descri
 }
 
 // IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_DescribeHistoryHost_Result) IsSetSuccess() bool {
+func (v *AdminService_ListShardTasks_Result) IsSetSuccess() bool {
 	return v != nil && v.Success != nil
 }
 
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeHistoryHost_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_ListShardTasks_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -2655,13 +7920,13 @@ func (v *AdminService_DescribeHistoryHost_Result) GetBadRequestError() (o *share
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_DescribeHistoryHost_Result) IsSetBadRequestError() bool {
+func (v *AdminService_ListShardTasks_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeHistoryHost_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_ListShardTasks_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -2670,13 +7935,13 @@ func (v *AdminService_DescribeHistoryHost_Result) GetInternalServiceError() (o *
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_DescribeHistoryHost_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_ListShardTasks_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
 // GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeHistoryHost_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+func (v *AdminService_ListShardTasks_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
 	if v != nil && v.AccessDeniedError != nil {
 		return v.AccessDeniedError
 	}
@@ -2685,33 +7950,33 @@ func (v *AdminService_DescribeHistoryHost_Result) GetAccessDeniedError() (o *sha
 }
 
 // IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
-func (v *AdminService_DescribeHistoryHost_Result) IsSetAccessDeniedError() bool {
+func (v *AdminService_ListShardTasks_Result) IsSetAccessDeniedError() bool {
 	return v != nil && v.AccessDeniedError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "DescribeHistoryHost" for this struct.
-func (v *AdminService_DescribeHistoryHost_Result) MethodName() string {
-	return "DescribeHistoryHost"
+// This will always be "ListShardTasks" for this struct.
+func (v *AdminService_ListShardTasks_Result) MethodName() string {
+	return "ListShardTasks"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_DescribeHistoryHost_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_ListShardTasks_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_DescribeWorkflowExecution_Args represents the arguments for the AdminService.DescribeWorkflowExecution function.
+// AdminService_RefreshWorkflowTasks_Args represents the arguments for the AdminService.RefreshWorkflowTasks function.
 //
-// The arguments for DescribeWorkflowExecution are sent and received over the wire as this struct.
-type AdminService_DescribeWorkflowExecution_Args struct {
-	Request *DescribeWorkflowExecutionRequest `json:"request,omitempty"`
+// The arguments for RefreshWorkflowTasks are sent and received over the wire as this struct.
+type AdminService_RefreshWorkflowTasks_Args struct {
+	Request *RefreshWorkflowTasksRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_DescribeWorkflowExecution_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_RefreshWorkflowTasks_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -2726,7 +7991,7 @@ type AdminService_DescribeWorkflowExecution_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_DescribeWorkflowExecution_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_RefreshWorkflowTasks_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -2746,17 +8011,17 @@ func (v *AdminService_DescribeWorkflowExecution_Args) ToWire() (wire.Value, erro
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeWorkflowExecutionRequest_Read(w wire.Value) (*DescribeWorkflowExecutionRequest, error) {
-	var v DescribeWorkflowExecutionRequest
+func _RefreshWorkflowTasksRequest_Read(w wire.Value) (*RefreshWorkflowTasksRequest, error) {
+	var v RefreshWorkflowTasksRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_DescribeWorkflowExecution_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_RefreshWorkflowTasks_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_DescribeWorkflowExecution_Args struct
+// An error is returned if we were unable to build a AdminService_RefreshWorkflowTasks_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -2764,19 +8029,19 @@ func _DescribeWorkflowExecutionRequest_Read(w wire.Value) (*DescribeWorkflowExec
 //     return nil, err
 //   }
 //
-//   var v AdminService_DescribeWorkflowExecution_Args
+//   var v AdminService_RefreshWorkflowTasks_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_DescribeWorkflowExecution_Args) FromWire(w wire.Value) error {
+func (v *AdminService_RefreshWorkflowTasks_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.Request, err = _DescribeWorkflowExecutionRequest_Read(field.Value)
+				v.Request, err = _RefreshWorkflowTasksRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -2788,9 +8053,9 @@ func (v *AdminService_DescribeWorkflowExecution_Args) FromWire(w wire.Value) err
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_DescribeWorkflowExecution_Args
+// String returns a readable string representation of a AdminService_RefreshWorkflowTasks_Args
 // struct.
-func (v *AdminService_DescribeWorkflowExecution_Args) String() string {
+func (v *AdminService_RefreshWorkflowTasks_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
@@ -2802,14 +8067,14 @@ func (v *AdminService_DescribeWorkflowExecution_Args) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_DescribeWorkflowExecution_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_RefreshWorkflowTasks_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_DescribeWorkflowExecution_Args match the
-// provided AdminService_DescribeWorkflowExecution_Args.
+// Equals returns true if all the fields of this AdminService_RefreshWorkflowTasks_Args match the
+// provided AdminService_RefreshWorkflowTasks_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_DescribeWorkflowExecution_Args) Equals(rhs *AdminService_DescribeWorkflowExecution_Args) bool {
+func (v *AdminService_RefreshWorkflowTasks_Args) Equals(rhs *AdminService_RefreshWorkflowTasks_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
@@ -2823,8 +8088,8 @@ func (v *AdminService_DescribeWorkflowExecution_Args) Equals(rhs *AdminService_D
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_DescribeWorkflowExecution_Args.
-func (v *AdminService_DescribeWorkflowExecution_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_RefreshWorkflowTasks_Args.
+func (v *AdminService_RefreshWorkflowTasks_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
@@ -2836,7 +8101,7 @@ func (v *AdminService_DescribeWorkflowExecution_Args) MarshalLogObject(enc zapco
 
 // GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Args) GetRequest() (o *DescribeWorkflowExecutionRequest) {
+func (v *AdminService_RefreshWorkflowTasks_Args) GetRequest() (o *RefreshWorkflowTasksRequest) {
 	if v != nil && v.Request != nil {
 		return v.Request
 	}
@@ -2845,80 +8110,81 @@ func (v *AdminService_DescribeWorkflowExecution_Args) GetRequest() (o *DescribeW
 }
 
 // IsSetRequest returns true if Request is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Args) IsSetRequest() bool {
+func (v *AdminService_RefreshWorkflowTasks_Args) IsSetRequest() bool {
 	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "DescribeWorkflowExecution" for this struct.
-func (v *AdminService_DescribeWorkflowExecution_Args) MethodName() string {
-	return "DescribeWorkflowExecution"
+// This will always be "RefreshWorkflowTasks" for this struct.
+func (v *AdminService_RefreshWorkflowTasks_Args) MethodName() string {
+	return "RefreshWorkflowTasks"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_DescribeWorkflowExecution_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_RefreshWorkflowTasks_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_DescribeWorkflowExecution_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.DescribeWorkflowExecution
+// AdminService_RefreshWorkflowTasks_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.RefreshWorkflowTasks
 // function.
-var AdminService_DescribeWorkflowExecution_Helper = struct {
-	// Args accepts the parameters of DescribeWorkflowExecution in-order and returns
+var AdminService_RefreshWorkflowTasks_Helper = struct {
+	// Args accepts the parameters of RefreshWorkflowTasks in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		request *DescribeWorkflowExecutionRequest,
-	) *AdminService_DescribeWorkflowExecution_Args
+		request *RefreshWorkflowTasksRequest,
+	) *AdminService_RefreshWorkflowTasks_Args
 
 	// IsException returns true if the given error can be thrown
-	// by DescribeWorkflowExecution.
+	// by RefreshWorkflowTasks.
 	//
-	// An error can be thrown by DescribeWorkflowExecution only if the
+	// An error can be thrown by RefreshWorkflowTasks only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for DescribeWorkflowExecution
-	// given its return value and error.
+	// WrapResponse returns the result struct for RefreshWorkflowTasks
+	// given the error returned by it. The provided error may
+	// be nil if RefreshWorkflowTasks did not fail.
 	//
-	// This allows mapping values and errors returned by
-	// DescribeWorkflowExecution into a serializable result struct.
-	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by DescribeWorkflowExecution
+	// This allows mapping errors returned by RefreshWorkflowTasks into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// RefreshWorkflowTasks
 	//
-	//   value, err := DescribeWorkflowExecution(args)
-	//   result, err := AdminService_DescribeWorkflowExecution_Helper.WrapResponse(value, err)
+	//   err := RefreshWorkflowTasks(args)
+	//   result, err := AdminService_RefreshWorkflowTasks_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from DescribeWorkflowExecution: %v", err)
+	//     return fmt.Errorf("unexpected error from RefreshWorkflowTasks: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*DescribeWorkflowExecutionResponse, error) (*AdminService_DescribeWorkflowExecution_Result, error)
+	WrapResponse func(error) (*AdminService_RefreshWorkflowTasks_Result, error)
 
-	// UnwrapResponse takes the result struct for DescribeWorkflowExecution
-	// and returns the value or error returned by it.
+	// UnwrapResponse takes the result struct for RefreshWorkflowTasks
+	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if DescribeWorkflowExecution threw an
+	// The error is non-nil only if RefreshWorkflowTasks threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_DescribeWorkflowExecution_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_DescribeWorkflowExecution_Result) (*DescribeWorkflowExecutionResponse, error)
+	//   err := AdminService_RefreshWorkflowTasks_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_RefreshWorkflowTasks_Result) error
 }{}
 
 func init() {
-	AdminService_DescribeWorkflowExecution_Helper.Args = func(
-		request *DescribeWorkflowExecutionRequest,
-	) *AdminService_DescribeWorkflowExecution_Args {
-		return &AdminService_DescribeWorkflowExecution_Args{
+	AdminService_RefreshWorkflowTasks_Helper.Args = func(
+		request *RefreshWorkflowTasksRequest,
+	) *AdminService_RefreshWorkflowTasks_Args {
+		return &AdminService_RefreshWorkflowTasks_Args{
 			Request: request,
 		}
 	}
 
-	AdminService_DescribeWorkflowExecution_Helper.IsException = func(err error) bool {
+	AdminService_RefreshWorkflowTasks_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
@@ -2933,37 +8199,37 @@ func init() {
 		}
 	}
 
-	AdminService_DescribeWorkflowExecution_Helper.WrapResponse = func(success *DescribeWorkflowExecutionResponse, err error) (*AdminService_DescribeWorkflowExecution_Result, error) {
+	AdminService_RefreshWorkflowTasks_Helper.WrapResponse = func(err error) (*AdminService_RefreshWorkflowTasks_Result, error) {
 		if err == nil {
-			return &AdminService_DescribeWorkflowExecution_Result{Success: success}, nil
+			return &AdminService_RefreshWorkflowTasks_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RefreshWorkflowTasks_Result.BadRequestError")
 			}
-			return &AdminService_DescribeWorkflowExecution_Result{BadRequestError: e}, nil
+			return &AdminService_RefreshWorkflowTasks_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.InternalServiceError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RefreshWorkflowTasks_Result.InternalServiceError")
 			}
-			return &AdminService_DescribeWorkflowExecution_Result{InternalServiceError: e}, nil
+			return &AdminService_RefreshWorkflowTasks_Result{InternalServiceError: e}, nil
 		case *shared.EntityNotExistsError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RefreshWorkflowTasks_Result.EntityNotExistError")
 			}
-			return &AdminService_DescribeWorkflowExecution_Result{EntityNotExistError: e}, nil
+			return &AdminService_RefreshWorkflowTasks_Result{EntityNotExistError: e}, nil
 		case *shared.AccessDeniedError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_DescribeWorkflowExecution_Result.AccessDeniedError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RefreshWorkflowTasks_Result.AccessDeniedError")
 			}
-			return &AdminService_DescribeWorkflowExecution_Result{AccessDeniedError: e}, nil
+			return &AdminService_RefreshWorkflowTasks_Result{AccessDeniedError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_DescribeWorkflowExecution_Helper.UnwrapResponse = func(result *AdminService_DescribeWorkflowExecution_Result) (success *DescribeWorkflowExecutionResponse, err error) {
+	AdminService_RefreshWorkflowTasks_Helper.UnwrapResponse = func(result *AdminService_RefreshWorkflowTasks_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -2980,33 +8246,22 @@ func init() {
 			err = result.AccessDeniedError
 			return
 		}
-
-		if result.Success != nil {
-			success = result.Success
-			return
-		}
-
-		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// AdminService_DescribeWorkflowExecution_Result represents the result of a AdminService.DescribeWorkflowExecution function call.
-//
-// The result of a DescribeWorkflowExecution execution is sent and received over the wire as this struct.
+// AdminService_RefreshWorkflowTasks_Result represents the result of a AdminService.RefreshWorkflowTasks function call.
 //
-// Success is set only if the function did not throw an exception.
-type AdminService_DescribeWorkflowExecution_Result struct {
-	// Value returned by DescribeWorkflowExecution after a successful execution.
-	Success              *DescribeWorkflowExecutionResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError            `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError       `json:"internalServiceError,omitempty"`
-	EntityNotExistError  *shared.EntityNotExistsError       `json:"entityNotExistError,omitempty"`
-	AccessDeniedError    *shared.AccessDeniedError          `json:"accessDeniedError,omitempty"`
+// The result of a RefreshWorkflowTasks execution is sent and received over the wire as this struct.
+type AdminService_RefreshWorkflowTasks_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	EntityNotExistError  *shared.EntityNotExistsError `json:"entityNotExistError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
 }
 
-// ToWire translates a AdminService_DescribeWorkflowExecution_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_RefreshWorkflowTasks_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3021,22 +8276,14 @@ type AdminService_DescribeWorkflowExecution_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_DescribeWorkflowExecution_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_RefreshWorkflowTasks_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Success != nil {
-		w, err = v.Success.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 0, Value: w}
-		i++
-	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -3070,30 +8317,18 @@ func (v *AdminService_DescribeWorkflowExecution_Result) ToWire() (wire.Value, er
 		i++
 	}
 
-	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_DescribeWorkflowExecution_Result should have exactly one field: got %v fields", i)
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_RefreshWorkflowTasks_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _DescribeWorkflowExecutionResponse_Read(w wire.Value) (*DescribeWorkflowExecutionResponse, error) {
-	var v DescribeWorkflowExecutionResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, error) {
-	var v shared.EntityNotExistsError
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a AdminService_DescribeWorkflowExecution_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_RefreshWorkflowTasks_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_DescribeWorkflowExecution_Result struct
+// An error is returned if we were unable to build a AdminService_RefreshWorkflowTasks_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3101,24 +8336,16 @@ func _EntityNotExistsError_Read(w wire.Value) (*shared.EntityNotExistsError, err
 //     return nil, err
 //   }
 //
-//   var v AdminService_DescribeWorkflowExecution_Result
+//   var v AdminService_RefreshWorkflowTasks_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_DescribeWorkflowExecution_Result) FromWire(w wire.Value) error {
+func (v *AdminService_RefreshWorkflowTasks_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 0:
-			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _DescribeWorkflowExecutionResponse_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -3155,9 +8382,6 @@ func (v *AdminService_DescribeWorkflowExecution_Result) FromWire(w wire.Value) e
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
@@ -3170,26 +8394,22 @@ func (v *AdminService_DescribeWorkflowExecution_Result) FromWire(w wire.Value) e
 	if v.AccessDeniedError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("AdminService_DescribeWorkflowExecution_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("AdminService_RefreshWorkflowTasks_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_DescribeWorkflowExecution_Result
+// String returns a readable string representation of a AdminService_RefreshWorkflowTasks_Result
 // struct.
-func (v *AdminService_DescribeWorkflowExecution_Result) String() string {
+func (v *AdminService_RefreshWorkflowTasks_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [4]string
 	i := 0
-	if v.Success != nil {
-		fields[i] = fmt.Sprintf("Success: %v", v.Success)
-		i++
-	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -3207,22 +8427,19 @@ func (v *AdminService_DescribeWorkflowExecution_Result) String() string {
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_DescribeWorkflowExecution_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_RefreshWorkflowTasks_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_DescribeWorkflowExecution_Result match the
-// provided AdminService_DescribeWorkflowExecution_Result.
+// Equals returns true if all the fields of this AdminService_RefreshWorkflowTasks_Result match the
+// provided AdminService_RefreshWorkflowTasks_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_DescribeWorkflowExecution_Result) Equals(rhs *AdminService_DescribeWorkflowExecution_Result) bool {
+func (v *AdminService_RefreshWorkflowTasks_Result) Equals(rhs *AdminService_RefreshWorkflowTasks_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
-		return false
-	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
@@ -3240,14 +8457,11 @@ func (v *AdminService_DescribeWorkflowExecution_Result) Equals(rhs *AdminService
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_DescribeWorkflowExecution_Result.
-func (v *AdminService_DescribeWorkflowExecution_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_RefreshWorkflowTasks_Result.
+func (v *AdminService_RefreshWorkflowTasks_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Success != nil {
-		err = multierr.Append(err, enc.AddObject("success", v.Success))
-	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
@@ -3263,24 +8477,9 @@ func (v *AdminService_DescribeWorkflowExecution_Result) MarshalLogObject(enc zap
 	return err
 }
 
-// GetSuccess returns the value of Success if it is set or its
-// zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Result) GetSuccess() (o *DescribeWorkflowExecutionResponse) {
-	if v != nil && v.Success != nil {
-		return v.Success
-	}
-
-	return
-}
-
-// IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Result) IsSetSuccess() bool {
-	return v != nil && v.Success != nil
-}
-
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_RefreshWorkflowTasks_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -3289,13 +8488,13 @@ func (v *AdminService_DescribeWorkflowExecution_Result) GetBadRequestError() (o
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Result) IsSetBadRequestError() bool {
+func (v *AdminService_RefreshWorkflowTasks_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_RefreshWorkflowTasks_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -3304,13 +8503,13 @@ func (v *AdminService_DescribeWorkflowExecution_Result) GetInternalServiceError(
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_RefreshWorkflowTasks_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
 // GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
+func (v *AdminService_RefreshWorkflowTasks_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
 	if v != nil && v.EntityNotExistError != nil {
 		return v.EntityNotExistError
 	}
@@ -3319,13 +8518,13 @@ func (v *AdminService_DescribeWorkflowExecution_Result) GetEntityNotExistError()
 }
 
 // IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Result) IsSetEntityNotExistError() bool {
+func (v *AdminService_RefreshWorkflowTasks_Result) IsSetEntityNotExistError() bool {
 	return v != nil && v.EntityNotExistError != nil
 }
 
 // GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_DescribeWorkflowExecution_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+func (v *AdminService_RefreshWorkflowTasks_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
 	if v != nil && v.AccessDeniedError != nil {
 		return v.AccessDeniedError
 	}
@@ -3334,33 +8533,33 @@ func (v *AdminService_DescribeWorkflowExecution_Result) GetAccessDeniedError() (
 }
 
 // IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
-func (v *AdminService_DescribeWorkflowExecution_Result) IsSetAccessDeniedError() bool {
+func (v *AdminService_RefreshWorkflowTasks_Result) IsSetAccessDeniedError() bool {
 	return v != nil && v.AccessDeniedError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "DescribeWorkflowExecution" for this struct.
-func (v *AdminService_DescribeWorkflowExecution_Result) MethodName() string {
-	return "DescribeWorkflowExecution"
+// This will always be "RefreshWorkflowTasks" for this struct.
+func (v *AdminService_RefreshWorkflowTasks_Result) MethodName() string {
+	return "RefreshWorkflowTasks"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_DescribeWorkflowExecution_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_RefreshWorkflowTasks_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
 
-// AdminService_GetWorkflowExecutionRawHistory_Args represents the arguments for the AdminService.GetWorkflowExecutionRawHistory function.
+// AdminService_RemoveShardTask_Args represents the arguments for the AdminService.RemoveShardTask function.
 //
-// The arguments for GetWorkflowExecutionRawHistory are sent and received over the wire as this struct.
-type AdminService_GetWorkflowExecutionRawHistory_Args struct {
-	GetRequest *GetWorkflowExecutionRawHistoryRequest `json:"getRequest,omitempty"`
+// The arguments for RemoveShardTask are sent and received over the wire as this struct.
+type AdminService_RemoveShardTask_Args struct {
+	Request *shared.RemoveShardTaskRequest `json:"request,omitempty"`
 }
 
-// ToWire translates a AdminService_GetWorkflowExecutionRawHistory_Args struct into a Thrift-level intermediate
+// ToWire translates a AdminService_RemoveShardTask_Args struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3375,7 +8574,7 @@ type AdminService_GetWorkflowExecutionRawHistory_Args struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) ToWire() (wire.Value, error) {
+func (v *AdminService_RemoveShardTask_Args) ToWire() (wire.Value, error) {
 	var (
 		fields [1]wire.Field
 		i      int = 0
@@ -3383,8 +8582,8 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Args) ToWire() (wire.Value,
 		err    error
 	)
 
-	if v.GetRequest != nil {
-		w, err = v.GetRequest.ToWire()
+	if v.Request != nil {
+		w, err = v.Request.ToWire()
 		if err != nil {
 			return w, err
 		}
@@ -3395,17 +8594,17 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Args) ToWire() (wire.Value,
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetWorkflowExecutionRawHistoryRequest_Read(w wire.Value) (*GetWorkflowExecutionRawHistoryRequest, error) {
-	var v GetWorkflowExecutionRawHistoryRequest
+func _RemoveShardTaskRequest_Read(w wire.Value) (*shared.RemoveShardTaskRequest, error) {
+	var v shared.RemoveShardTaskRequest
 	err := v.FromWire(w)
 	return &v, err
 }
 
-// FromWire deserializes a AdminService_GetWorkflowExecutionRawHistory_Args struct from its Thrift-level
+// FromWire deserializes a AdminService_RemoveShardTask_Args struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetWorkflowExecutionRawHistory_Args struct
+// An error is returned if we were unable to build a AdminService_RemoveShardTask_Args struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3413,19 +8612,19 @@ func _GetWorkflowExecutionRawHistoryRequest_Read(w wire.Value) (*GetWorkflowExec
 //     return nil, err
 //   }
 //
-//   var v AdminService_GetWorkflowExecutionRawHistory_Args
+//   var v AdminService_RemoveShardTask_Args
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) FromWire(w wire.Value) error {
+func (v *AdminService_RemoveShardTask_Args) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TStruct {
-				v.GetRequest, err = _GetWorkflowExecutionRawHistoryRequest_Read(field.Value)
+				v.Request, err = _RemoveShardTaskRequest_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -3437,34 +8636,34 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Args) FromWire(w wire.Value
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_GetWorkflowExecutionRawHistory_Args
+// String returns a readable string representation of a AdminService_RemoveShardTask_Args
 // struct.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) String() string {
+func (v *AdminService_RemoveShardTask_Args) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
 	var fields [1]string
 	i := 0
-	if v.GetRequest != nil {
-		fields[i] = fmt.Sprintf("GetRequest: %v", v.GetRequest)
+	if v.Request != nil {
+		fields[i] = fmt.Sprintf("Request: %v", v.Request)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_GetWorkflowExecutionRawHistory_Args{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_RemoveShardTask_Args{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetWorkflowExecutionRawHistory_Args match the
-// provided AdminService_GetWorkflowExecutionRawHistory_Args.
+// Equals returns true if all the fields of this AdminService_RemoveShardTask_Args match the
+// provided AdminService_RemoveShardTask_Args.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) Equals(rhs *AdminService_GetWorkflowExecutionRawHistory_Args) bool {
+func (v *AdminService_RemoveShardTask_Args) Equals(rhs *AdminService_RemoveShardTask_Args) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.GetRequest == nil && rhs.GetRequest == nil) || (v.GetRequest != nil && rhs.GetRequest != nil && v.GetRequest.Equals(rhs.GetRequest))) {
+	if !((v.Request == nil && rhs.Request == nil) || (v.Request != nil && rhs.Request != nil && v.Request.Equals(rhs.Request))) {
 		return false
 	}
 
@@ -3472,147 +8671,141 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Args) Equals(rhs *AdminServ
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetWorkflowExecutionRawHistory_Args.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_RemoveShardTask_Args.
+func (v *AdminService_RemoveShardTask_Args) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.GetRequest != nil {
-		err = multierr.Append(err, enc.AddObject("getRequest", v.GetRequest))
+	if v.Request != nil {
+		err = multierr.Append(err, enc.AddObject("request", v.Request))
 	}
 	return err
 }
 
-// GetGetRequest returns the value of GetRequest if it is set or its
+// GetRequest returns the value of Request if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) GetGetRequest() (o *GetWorkflowExecutionRawHistoryRequest) {
-	if v != nil && v.GetRequest != nil {
-		return v.GetRequest
+func (v *AdminService_RemoveShardTask_Args) GetRequest() (o *shared.RemoveShardTaskRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
 	}
 
 	return
 }
 
-// IsSetGetRequest returns true if GetRequest is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) IsSetGetRequest() bool {
-	return v != nil && v.GetRequest != nil
+// IsSetRequest returns true if Request is not nil.
+func (v *AdminService_RemoveShardTask_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
-// This will always be "GetWorkflowExecutionRawHistory" for this struct.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) MethodName() string {
-	return "GetWorkflowExecutionRawHistory"
+// This will always be "RemoveShardTask" for this struct.
+func (v *AdminService_RemoveShardTask_Args) MethodName() string {
+	return "RemoveShardTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Call for this struct.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Args) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_RemoveShardTask_Args) EnvelopeType() wire.EnvelopeType {
 	return wire.Call
 }
 
-// AdminService_GetWorkflowExecutionRawHistory_Helper provides functions that aid in handling the
-// parameters and return values of the AdminService.GetWorkflowExecutionRawHistory
+// AdminService_RemoveShardTask_Helper provides functions that aid in handling the
+// parameters and return values of the AdminService.RemoveShardTask
 // function.
-var AdminService_GetWorkflowExecutionRawHistory_Helper = struct {
-	// Args accepts the parameters of GetWorkflowExecutionRawHistory in-order and returns
+var AdminService_RemoveShardTask_Helper = struct {
+	// Args accepts the parameters of RemoveShardTask in-order and returns
 	// the arguments struct for the function.
 	Args func(
-		getRequest *GetWorkflowExecutionRawHistoryRequest,
-	) *AdminService_GetWorkflowExecutionRawHistory_Args
+		request *shared.RemoveShardTaskRequest,
+	) *AdminService_RemoveShardTask_Args
 
 	// IsException returns true if the given error can be thrown
-	// by GetWorkflowExecutionRawHistory.
+	// by RemoveShardTask.
 	//
-	// An error can be thrown by GetWorkflowExecutionRawHistory only if the
+	// An error can be thrown by RemoveShardTask only if the
 	// corresponding exception type was mentioned in the 'throws'
 	// section for it in the Thrift file.
 	IsException func(error) bool
 
-	// WrapResponse returns the result struct for GetWorkflowExecutionRawHistory
-	// given its return value and error.
+	// WrapResponse returns the result struct for RemoveShardTask
+	// given the error returned by it. The provided error may
+	// be nil if RemoveShardTask did not fail.
 	//
-	// This allows mapping values and errors returned by
-	// GetWorkflowExecutionRawHistory into a serializable result struct.
-	// WrapResponse returns a non-nil error if the provided
-	// error cannot be thrown by GetWorkflowExecutionRawHistory
+	// This allows mapping errors returned by RemoveShardTask into a
+	// serializable result struct. WrapResponse returns a
+	// non-nil error if the provided error cannot be thrown by
+	// RemoveShardTask
 	//
-	//   value, err := GetWorkflowExecutionRawHistory(args)
-	//   result, err := AdminService_GetWorkflowExecutionRawHistory_Helper.WrapResponse(value, err)
+	//   err := RemoveShardTask(args)
+	//   result, err := AdminService_RemoveShardTask_Helper.WrapResponse(err)
 	//   if err != nil {
-	//     return fmt.Errorf("unexpected error from GetWorkflowExecutionRawHistory: %v", err)
+	//     return fmt.Errorf("unexpected error from RemoveShardTask: %v", err)
 	//   }
 	//   serialize(result)
-	WrapResponse func(*GetWorkflowExecutionRawHistoryResponse, error) (*AdminService_GetWorkflowExecutionRawHistory_Result, error)
+	WrapResponse func(error) (*AdminService_RemoveShardTask_Result, error)
 
-	// UnwrapResponse takes the result struct for GetWorkflowExecutionRawHistory
-	// and returns the value or error returned by it.
+	// UnwrapResponse takes the result struct for RemoveShardTask
+	// and returns the erorr returned by it (if any).
 	//
-	// The error is non-nil only if GetWorkflowExecutionRawHistory threw an
+	// The error is non-nil only if RemoveShardTask threw an
 	// exception.
 	//
 	//   result := deserialize(bytes)
-	//   value, err := AdminService_GetWorkflowExecutionRawHistory_Helper.UnwrapResponse(result)
-	UnwrapResponse func(*AdminService_GetWorkflowExecutionRawHistory_Result) (*GetWorkflowExecutionRawHistoryResponse, error)
+	//   err := AdminService_RemoveShardTask_Helper.UnwrapResponse(result)
+	UnwrapResponse func(*AdminService_RemoveShardTask_Result) error
 }{}
 
 func init() {
-	AdminService_GetWorkflowExecutionRawHistory_Helper.Args = func(
-		getRequest *GetWorkflowExecutionRawHistoryRequest,
-	) *AdminService_GetWorkflowExecutionRawHistory_Args {
-		return &AdminService_GetWorkflowExecutionRawHistory_Args{
-			GetRequest: getRequest,
+	AdminService_RemoveShardTask_Helper.Args = func(
+		request *shared.RemoveShardTaskRequest,
+	) *AdminService_RemoveShardTask_Args {
+		return &AdminService_RemoveShardTask_Args{
+			Request: request,
 		}
 	}
 
-	AdminService_GetWorkflowExecutionRawHistory_Helper.IsException = func(err error) bool {
+	AdminService_RemoveShardTask_Helper.IsException = func(err error) bool {
 		switch err.(type) {
 		case *shared.BadRequestError:
 			return true
 		case *shared.InternalServiceError:
 			return true
-		case *shared.EntityNotExistsError:
-			return true
-		case *shared.ServiceBusyError:
+		case *shared.AccessDeniedError:
 			return true
 		default:
 			return false
 		}
 	}
 
-	AdminService_GetWorkflowExecutionRawHistory_Helper.WrapResponse = func(success *GetWorkflowExecutionRawHistoryResponse, err error) (*AdminService_GetWorkflowExecutionRawHistory_Result, error) {
+	AdminService_RemoveShardTask_Helper.WrapResponse = func(err error) (*AdminService_RemoveShardTask_Result, error) {
 		if err == nil {
-			return &AdminService_GetWorkflowExecutionRawHistory_Result{Success: success}, nil
+			return &AdminService_RemoveShardTask_Result{}, nil
 		}
 
 		switch e := err.(type) {
 		case *shared.BadRequestError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.BadRequestError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RemoveShardTask_Result.BadRequestError")
 			}
-			return &AdminService_GetWorkflowExecutionRawHistory_Result{BadRequestError: e}, nil
+			return &AdminService_RemoveShardTask_Result{BadRequestError: e}, nil
 		case *shared.InternalServiceError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.InternalServiceError")
-			}
-			return &AdminService_GetWorkflowExecutionRawHistory_Result{InternalServiceError: e}, nil
-		case *shared.EntityNotExistsError:
-			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.EntityNotExistError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RemoveShardTask_Result.InternalServiceError")
 			}
-			return &AdminService_GetWorkflowExecutionRawHistory_Result{EntityNotExistError: e}, nil
-		case *shared.ServiceBusyError:
+			return &AdminService_RemoveShardTask_Result{InternalServiceError: e}, nil
+		case *shared.AccessDeniedError:
 			if e == nil {
-				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_GetWorkflowExecutionRawHistory_Result.ServiceBusyError")
+				return nil, errors.New("WrapResponse received non-nil error type with nil value for AdminService_RemoveShardTask_Result.AccessDeniedError")
 			}
-			return &AdminService_GetWorkflowExecutionRawHistory_Result{ServiceBusyError: e}, nil
+			return &AdminService_RemoveShardTask_Result{AccessDeniedError: e}, nil
 		}
 
 		return nil, err
 	}
-	AdminService_GetWorkflowExecutionRawHistory_Helper.UnwrapResponse = func(result *AdminService_GetWorkflowExecutionRawHistory_Result) (success *GetWorkflowExecutionRawHistoryResponse, err error) {
+	AdminService_RemoveShardTask_Helper.UnwrapResponse = func(result *AdminService_RemoveShardTask_Result) (err error) {
 		if result.BadRequestError != nil {
 			err = result.BadRequestError
 			return
@@ -3621,41 +8814,25 @@ func init() {
 			err = result.InternalServiceError
 			return
 		}
-		if result.EntityNotExistError != nil {
-			err = result.EntityNotExistError
-			return
-		}
-		if result.ServiceBusyError != nil {
-			err = result.ServiceBusyError
-			return
-		}
-
-		if result.Success != nil {
-			success = result.Success
+		if result.AccessDeniedError != nil {
+			err = result.AccessDeniedError
 			return
 		}
-
-		err = errors.New("expected a non-void result")
 		return
 	}
 
 }
 
-// AdminService_GetWorkflowExecutionRawHistory_Result represents the result of a AdminService.GetWorkflowExecutionRawHistory function call.
+// AdminService_RemoveShardTask_Result represents the result of a AdminService.RemoveShardTask function call.
 //
-// The result of a GetWorkflowExecutionRawHistory execution is sent and received over the wire as this struct.
-//
-// Success is set only if the function did not throw an exception.
-type AdminService_GetWorkflowExecutionRawHistory_Result struct {
-	// Value returned by GetWorkflowExecutionRawHistory after a successful execution.
-	Success              *GetWorkflowExecutionRawHistoryResponse `json:"success,omitempty"`
-	BadRequestError      *shared.BadRequestError                 `json:"badRequestError,omitempty"`
-	InternalServiceError *shared.InternalServiceError            `json:"internalServiceError,omitempty"`
-	EntityNotExistError  *shared.EntityNotExistsError            `json:"entityNotExistError,omitempty"`
-	ServiceBusyError     *shared.ServiceBusyError                `json:"serviceBusyError,omitempty"`
+// The result of a RemoveShardTask execution is sent and received over the wire as this struct.
+type AdminService_RemoveShardTask_Result struct {
+	BadRequestError      *shared.BadRequestError      `json:"badRequestError,omitempty"`
+	InternalServiceError *shared.InternalServiceError `json:"internalServiceError,omitempty"`
+	AccessDeniedError    *shared.AccessDeniedError    `json:"accessDeniedError,omitempty"`
 }
 
-// ToWire translates a AdminService_GetWorkflowExecutionRawHistory_Result struct into a Thrift-level intermediate
+// ToWire translates a AdminService_RemoveShardTask_Result struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
 //
@@ -3670,22 +8847,14 @@ type AdminService_GetWorkflowExecutionRawHistory_Result struct {
 //   if err := binaryProtocol.Encode(x, writer); err != nil {
 //     return err
 //   }
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) ToWire() (wire.Value, error) {
+func (v *AdminService_RemoveShardTask_Result) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	if v.Success != nil {
-		w, err = v.Success.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 0, Value: w}
-		i++
-	}
 	if v.BadRequestError != nil {
 		w, err = v.BadRequestError.ToWire()
 		if err != nil {
@@ -3702,41 +8871,27 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) ToWire() (wire.Valu
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		w, err = v.EntityNotExistError.ToWire()
+	if v.AccessDeniedError != nil {
+		w, err = v.AccessDeniedError.ToWire()
 		if err != nil {
 			return w, err
 		}
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
-	if v.ServiceBusyError != nil {
-		w, err = v.ServiceBusyError.ToWire()
-		if err != nil {
-			return w, err
-		}
-		fields[i] = wire.Field{ID: 4, Value: w}
-		i++
-	}
 
-	if i != 1 {
-		return wire.Value{}, fmt.Errorf("AdminService_GetWorkflowExecutionRawHistory_Result should have exactly one field: got %v fields", i)
+	if i > 1 {
+		return wire.Value{}, fmt.Errorf("AdminService_RemoveShardTask_Result should have at most one field: got %v fields", i)
 	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
 
-func _GetWorkflowExecutionRawHistoryResponse_Read(w wire.Value) (*GetWorkflowExecutionRawHistoryResponse, error) {
-	var v GetWorkflowExecutionRawHistoryResponse
-	err := v.FromWire(w)
-	return &v, err
-}
-
-// FromWire deserializes a AdminService_GetWorkflowExecutionRawHistory_Result struct from its Thrift-level
+// FromWire deserializes a AdminService_RemoveShardTask_Result struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 //
-// An error is returned if we were unable to build a AdminService_GetWorkflowExecutionRawHistory_Result struct
+// An error is returned if we were unable to build a AdminService_RemoveShardTask_Result struct
 // from the provided intermediate representation.
 //
 //   x, err := binaryProtocol.Decode(reader, wire.TStruct)
@@ -3744,24 +8899,16 @@ func _GetWorkflowExecutionRawHistoryResponse_Read(w wire.Value) (*GetWorkflowExe
 //     return nil, err
 //   }
 //
-//   var v AdminService_GetWorkflowExecutionRawHistory_Result
+//   var v AdminService_RemoveShardTask_Result
 //   if err := v.FromWire(x); err != nil {
 //     return nil, err
 //   }
 //   return &v, nil
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) FromWire(w wire.Value) error {
+func (v *AdminService_RemoveShardTask_Result) FromWire(w wire.Value) error {
 	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
-		case 0:
-			if field.Value.Type() == wire.TStruct {
-				v.Success, err = _GetWorkflowExecutionRawHistoryResponse_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
 		case 1:
 			if field.Value.Type() == wire.TStruct {
 				v.BadRequestError, err = _BadRequestError_Read(field.Value)
@@ -3780,15 +8927,7 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) FromWire(w wire.Val
 			}
 		case 3:
 			if field.Value.Type() == wire.TStruct {
-				v.EntityNotExistError, err = _EntityNotExistsError_Read(field.Value)
-				if err != nil {
-					return err
-				}
-
-			}
-		case 4:
-			if field.Value.Type() == wire.TStruct {
-				v.ServiceBusyError, err = _ServiceBusyError_Read(field.Value)
+				v.AccessDeniedError, err = _AccessDeniedError_Read(field.Value)
 				if err != nil {
 					return err
 				}
@@ -3798,41 +8937,31 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) FromWire(w wire.Val
 	}
 
 	count := 0
-	if v.Success != nil {
-		count++
-	}
 	if v.BadRequestError != nil {
 		count++
 	}
 	if v.InternalServiceError != nil {
 		count++
 	}
-	if v.EntityNotExistError != nil {
-		count++
-	}
-	if v.ServiceBusyError != nil {
+	if v.AccessDeniedError != nil {
 		count++
 	}
-	if count != 1 {
-		return fmt.Errorf("AdminService_GetWorkflowExecutionRawHistory_Result should have exactly one field: got %v fields", count)
+	if count > 1 {
+		return fmt.Errorf("AdminService_RemoveShardTask_Result should have at most one field: got %v fields", count)
 	}
 
 	return nil
 }
 
-// String returns a readable string representation of a AdminService_GetWorkflowExecutionRawHistory_Result
+// String returns a readable string representation of a AdminService_RemoveShardTask_Result
 // struct.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) String() string {
+func (v *AdminService_RemoveShardTask_Result) String() string {
 	if v == nil {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [3]string
 	i := 0
-	if v.Success != nil {
-		fields[i] = fmt.Sprintf("Success: %v", v.Success)
-		i++
-	}
 	if v.BadRequestError != nil {
 		fields[i] = fmt.Sprintf("BadRequestError: %v", v.BadRequestError)
 		i++
@@ -3841,41 +8970,31 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) String() string {
 		fields[i] = fmt.Sprintf("InternalServiceError: %v", v.InternalServiceError)
 		i++
 	}
-	if v.EntityNotExistError != nil {
-		fields[i] = fmt.Sprintf("EntityNotExistError: %v", v.EntityNotExistError)
-		i++
-	}
-	if v.ServiceBusyError != nil {
-		fields[i] = fmt.Sprintf("ServiceBusyError: %v", v.ServiceBusyError)
+	if v.AccessDeniedError != nil {
+		fields[i] = fmt.Sprintf("AccessDeniedError: %v", v.AccessDeniedError)
 		i++
 	}
 
-	return fmt.Sprintf("AdminService_GetWorkflowExecutionRawHistory_Result{%v}", strings.Join(fields[:i], ", "))
+	return fmt.Sprintf("AdminService_RemoveShardTask_Result{%v}", strings.Join(fields[:i], ", "))
 }
 
-// Equals returns true if all the fields of this AdminService_GetWorkflowExecutionRawHistory_Result match the
-// provided AdminService_GetWorkflowExecutionRawHistory_Result.
+// Equals returns true if all the fields of this AdminService_RemoveShardTask_Result match the
+// provided AdminService_RemoveShardTask_Result.
 //
 // This function performs a deep comparison.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) Equals(rhs *AdminService_GetWorkflowExecutionRawHistory_Result) bool {
+func (v *AdminService_RemoveShardTask_Result) Equals(rhs *AdminService_RemoveShardTask_Result) bool {
 	if v == nil {
 		return rhs == nil
 	} else if rhs == nil {
 		return false
 	}
-	if !((v.Success == nil && rhs.Success == nil) || (v.Success != nil && rhs.Success != nil && v.Success.Equals(rhs.Success))) {
-		return false
-	}
 	if !((v.BadRequestError == nil && rhs.BadRequestError == nil) || (v.BadRequestError != nil && rhs.BadRequestError != nil && v.BadRequestError.Equals(rhs.BadRequestError))) {
 		return false
 	}
 	if !((v.InternalServiceError == nil && rhs.InternalServiceError == nil) || (v.InternalServiceError != nil && rhs.InternalServiceError != nil && v.InternalServiceError.Equals(rhs.InternalServiceError))) {
 		return false
 	}
-	if !((v.EntityNotExistError == nil && rhs.EntityNotExistError == nil) || (v.EntityNotExistError != nil && rhs.EntityNotExistError != nil && v.EntityNotExistError.Equals(rhs.EntityNotExistError))) {
-		return false
-	}
-	if !((v.ServiceBusyError == nil && rhs.ServiceBusyError == nil) || (v.ServiceBusyError != nil && rhs.ServiceBusyError != nil && v.ServiceBusyError.Equals(rhs.ServiceBusyError))) {
+	if !((v.AccessDeniedError == nil && rhs.AccessDeniedError == nil) || (v.AccessDeniedError != nil && rhs.AccessDeniedError != nil && v.AccessDeniedError.Equals(rhs.AccessDeniedError))) {
 		return false
 	}
 
@@ -3883,47 +9002,26 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) Equals(rhs *AdminSe
 }
 
 // MarshalLogObject implements zapcore.ObjectMarshaler, enabling
-// fast logging of AdminService_GetWorkflowExecutionRawHistory_Result.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
+// fast logging of AdminService_RemoveShardTask_Result.
+func (v *AdminService_RemoveShardTask_Result) MarshalLogObject(enc zapcore.ObjectEncoder) (err error) {
 	if v == nil {
 		return nil
 	}
-	if v.Success != nil {
-		err = multierr.Append(err, enc.AddObject("success", v.Success))
-	}
 	if v.BadRequestError != nil {
 		err = multierr.Append(err, enc.AddObject("badRequestError", v.BadRequestError))
 	}
 	if v.InternalServiceError != nil {
 		err = multierr.Append(err, enc.AddObject("internalServiceError", v.InternalServiceError))
 	}
-	if v.EntityNotExistError != nil {
-		err = multierr.Append(err, enc.AddObject("entityNotExistError", v.EntityNotExistError))
-	}
-	if v.ServiceBusyError != nil {
-		err = multierr.Append(err, enc.AddObject("serviceBusyError", v.ServiceBusyError))
+	if v.AccessDeniedError != nil {
+		err = multierr.Append(err, enc.AddObject("accessDeniedError", v.AccessDeniedError))
 	}
 	return err
 }
 
-// GetSuccess returns the value of Success if it is set or its
-// zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetSuccess() (o *GetWorkflowExecutionRawHistoryResponse) {
-	if v != nil && v.Success != nil {
-		return v.Success
-	}
-
-	return
-}
-
-// IsSetSuccess returns true if Success is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetSuccess() bool {
-	return v != nil && v.Success != nil
-}
-
 // GetBadRequestError returns the value of BadRequestError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetBadRequestError() (o *shared.BadRequestError) {
+func (v *AdminService_RemoveShardTask_Result) GetBadRequestError() (o *shared.BadRequestError) {
 	if v != nil && v.BadRequestError != nil {
 		return v.BadRequestError
 	}
@@ -3932,13 +9030,13 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetBadRequestError(
 }
 
 // IsSetBadRequestError returns true if BadRequestError is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetBadRequestError() bool {
+func (v *AdminService_RemoveShardTask_Result) IsSetBadRequestError() bool {
 	return v != nil && v.BadRequestError != nil
 }
 
 // GetInternalServiceError returns the value of InternalServiceError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
+func (v *AdminService_RemoveShardTask_Result) GetInternalServiceError() (o *shared.InternalServiceError) {
 	if v != nil && v.InternalServiceError != nil {
 		return v.InternalServiceError
 	}
@@ -3947,51 +9045,36 @@ func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetInternalServiceE
 }
 
 // IsSetInternalServiceError returns true if InternalServiceError is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetInternalServiceError() bool {
+func (v *AdminService_RemoveShardTask_Result) IsSetInternalServiceError() bool {
 	return v != nil && v.InternalServiceError != nil
 }
 
-// GetEntityNotExistError returns the value of EntityNotExistError if it is set or its
-// zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetEntityNotExistError() (o *shared.EntityNotExistsError) {
-	if v != nil && v.EntityNotExistError != nil {
-		return v.EntityNotExistError
-	}
-
-	return
-}
-
-// IsSetEntityNotExistError returns true if EntityNotExistError is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetEntityNotExistError() bool {
-	return v != nil && v.EntityNotExistError != nil
-}
-
-// GetServiceBusyError returns the value of ServiceBusyError if it is set or its
+// GetAccessDeniedError returns the value of AccessDeniedError if it is set or its
 // zero value if it is unset.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) GetServiceBusyError() (o *shared.ServiceBusyError) {
-	if v != nil && v.ServiceBusyError != nil {
-		return v.ServiceBusyError
+func (v *AdminService_RemoveShardTask_Result) GetAccessDeniedError() (o *shared.AccessDeniedError) {
+	if v != nil && v.AccessDeniedError != nil {
+		return v.AccessDeniedError
 	}
 
 	return
 }
 
-// IsSetServiceBusyError returns true if ServiceBusyError is not nil.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) IsSetServiceBusyError() bool {
-	return v != nil && v.ServiceBusyError != nil
+// IsSetAccessDeniedError returns true if AccessDeniedError is not nil.
+func (v *AdminService_RemoveShardTask_Result) IsSetAccessDeniedError() bool {
+	return v != nil && v.AccessDeniedError != nil
 }
 
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
-// This will always be "GetWorkflowExecutionRawHistory" for this struct.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) MethodName() string {
-	return "GetWorkflowExecutionRawHistory"
+// This will always be "RemoveShardTask" for this struct.
+func (v *AdminService_RemoveShardTask_Result) MethodName() string {
+	return "RemoveShardTask"
 }
 
 // EnvelopeType returns the kind of value inside this struct.
 //
 // This will always be Reply for this struct.
-func (v *AdminService_GetWorkflowExecutionRawHistory_Result) EnvelopeType() wire.EnvelopeType {
+func (v *AdminService_RemoveShardTask_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
@@ -42,12 +42,35 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	CloseShard(
+		ctx context.Context,
+		Request *shared.CloseShardRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	DeleteWorkflowExecution(
+		ctx context.Context,
+		Request *admin.DeleteWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	DescribeCluster(
+		ctx context.Context,
+		opts ...yarpc.CallOption,
+	) (*admin.DescribeClusterResponse, error)
+
 	DescribeHistoryHost(
 		ctx context.Context,
 		Request *shared.DescribeHistoryHostRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeHistoryHostResponse, error)
 
+	DescribeShardQueues(
+		ctx context.Context,
+		Request *shared.DescribeShardQueuesRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.DescribeShardQueuesResponse, error)
+
 	DescribeWorkflowExecution(
 		ctx context.Context,
 		Request *admin.DescribeWorkflowExecutionRequest,
@@ -59,6 +82,24 @@ type Interface interface {
 		GetRequest *admin.GetWorkflowExecutionRawHistoryRequest,
 		opts ...yarpc.CallOption,
 	) (*admin.GetWorkflowExecutionRawHistoryResponse, error)
+
+	ListShardTasks(
+		ctx context.Context,
+		Request *shared.ListShardTasksRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.ListShardTasksResponse, error)
+
+	RefreshWorkflowTasks(
+		ctx context.Context,
+		Request *admin.RefreshWorkflowTasksRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	RemoveShardTask(
+		ctx context.Context,
+		Request *shared.RemoveShardTaskRequest,
+		opts ...yarpc.CallOption,
+	) error
 }
 
 // New builds a new client for the AdminService service.
@@ -108,6 +149,74 @@ func (c client) AddSearchAttribute(
 	return
 }
 
+func (c client) CloseShard(
+	ctx context.Context,
+	_Request *shared.CloseShardRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_CloseShard_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_CloseShard_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_CloseShard_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) DeleteWorkflowExecution(
+	ctx context.Context,
+	_Request *admin.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_DeleteWorkflowExecution_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DeleteWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_DeleteWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) DescribeCluster(
+	ctx context.Context,
+	opts ...yarpc.CallOption,
+) (success *admin.DescribeClusterResponse, err error) {
+
+	args := admin.AdminService_DescribeCluster_Helper.Args()
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DescribeCluster_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_DescribeCluster_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeHistoryHost(
 	ctx context.Context,
 	_Request *shared.DescribeHistoryHostRequest,
@@ -131,6 +240,29 @@ func (c client) DescribeHistoryHost(
 	return
 }
 
+func (c client) DescribeShardQueues(
+	ctx context.Context,
+	_Request *shared.DescribeShardQueuesRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeShardQueuesResponse, err error) {
+
+	args := admin.AdminService_DescribeShardQueues_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_DescribeShardQueues_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_DescribeShardQueues_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeWorkflowExecution(
 	ctx context.Context,
 	_Request *admin.DescribeWorkflowExecutionRequest,
@@ -176,3 +308,72 @@ func (c client) GetWorkflowExecutionRawHistory(
 	success, err = admin.AdminService_GetWorkflowExecutionRawHistory_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) ListShardTasks(
+	ctx context.Context,
+	_Request *shared.ListShardTasksRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.ListShardTasksResponse, err error) {
+
+	args := admin.AdminService_ListShardTasks_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_ListShardTasks_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = admin.AdminService_ListShardTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) RefreshWorkflowTasks(
+	ctx context.Context,
+	_Request *admin.RefreshWorkflowTasksRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_RefreshWorkflowTasks_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_RefreshWorkflowTasks_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_RefreshWorkflowTasks_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) RemoveShardTask(
+	ctx context.Context,
+	_Request *shared.RemoveShardTaskRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := admin.AdminService_RemoveShardTask_Helper.Args(_Request)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result admin.AdminService_RemoveShardTask_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = admin.AdminService_RemoveShardTask_Helper.UnwrapResponse(&result)
+	return
+}
@@ -36,12 +36,36 @@ import (
 
 // Interface is a client for the WorkflowService service.
 type Interface interface {
+	BackfillSchedule(
+		ctx context.Context,
+		BackfillRequest *shared.BackfillScheduleRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	CancelDelayedSignal(
+		ctx context.Context,
+		CancelRequest *shared.CancelDelayedSignalRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	CountWorkflowExecutions(
 		ctx context.Context,
 		CountRequest *shared.CountWorkflowExecutionsRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.CountWorkflowExecutionsResponse, error)
 
+	CreateSchedule(
+		ctx context.Context,
+		CreateRequest *shared.CreateScheduleRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	DeleteSchedule(
+		ctx context.Context,
+		DeleteRequest *shared.DeleteScheduleRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	DeprecateDomain(
 		ctx context.Context,
 		DeprecateRequest *shared.DeprecateDomainRequest,
@@ -54,6 +78,12 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.DescribeDomainResponse, error)
 
+	DescribeSchedule(
+		ctx context.Context,
+		DescribeRequest *shared.DescribeScheduleRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.DescribeScheduleResponse, error)
+
 	DescribeTaskList(
 		ctx context.Context,
 		Request *shared.DescribeTaskListRequest,
@@ -101,6 +131,18 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) (*shared.ListWorkflowExecutionsResponse, error)
 
+	PauseSchedule(
+		ctx context.Context,
+		PauseRequest *shared.PauseScheduleRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	PauseWorkflowExecution(
+		ctx context.Context,
+		PauseRequest *shared.PauseWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	PollForActivityTask(
 		ctx context.Context,
 		PollRequest *shared.PollForActivityTaskRequest,
@@ -239,11 +281,29 @@ type Interface interface {
 		opts ...yarpc.CallOption,
 	) error
 
+	UnpauseWorkflowExecution(
+		ctx context.Context,
+		UnpauseRequest *shared.UnpauseWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) error
+
 	UpdateDomain(
 		ctx context.Context,
 		UpdateRequest *shared.UpdateDomainRequest,
 		opts ...yarpc.CallOption,
 	) (*shared.UpdateDomainResponse, error)
+
+	UpdateSchedule(
+		ctx context.Context,
+		UpdateRequest *shared.UpdateScheduleRequest,
+		opts ...yarpc.CallOption,
+	) error
+
+	UpdateWorkflowExecution(
+		ctx context.Context,
+		UpdateRequest *shared.UpdateWorkflowExecutionRequest,
+		opts ...yarpc.CallOption,
+	) (*shared.UpdateWorkflowExecutionResponse, error)
 }
 
 // New builds a new client for the WorkflowService service.
@@ -270,6 +330,52 @@ type client struct {
 	c thrift.Client
 }
 
+func (c client) BackfillSchedule(
+	ctx context.Context,
+	_BackfillRequest *shared.BackfillScheduleRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_BackfillSchedule_Helper.Args(_BackfillRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_BackfillSchedule_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_BackfillSchedule_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) CancelDelayedSignal(
+	ctx context.Context,
+	_CancelRequest *shared.CancelDelayedSignalRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_CancelDelayedSignal_Helper.Args(_CancelRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_CancelDelayedSignal_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_CancelDelayedSignal_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) CountWorkflowExecutions(
 	ctx context.Context,
 	_CountRequest *shared.CountWorkflowExecutionsRequest,
@@ -293,6 +399,52 @@ func (c client) CountWorkflowExecutions(
 	return
 }
 
+func (c client) CreateSchedule(
+	ctx context.Context,
+	_CreateRequest *shared.CreateScheduleRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_CreateSchedule_Helper.Args(_CreateRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_CreateSchedule_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_CreateSchedule_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) DeleteSchedule(
+	ctx context.Context,
+	_DeleteRequest *shared.DeleteScheduleRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_DeleteSchedule_Helper.Args(_DeleteRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_DeleteSchedule_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_DeleteSchedule_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DeprecateDomain(
 	ctx context.Context,
 	_DeprecateRequest *shared.DeprecateDomainRequest,
@@ -339,6 +491,29 @@ func (c client) DescribeDomain(
 	return
 }
 
+func (c client) DescribeSchedule(
+	ctx context.Context,
+	_DescribeRequest *shared.DescribeScheduleRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.DescribeScheduleResponse, err error) {
+
+	args := cadence.WorkflowService_DescribeSchedule_Helper.Args(_DescribeRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_DescribeSchedule_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = cadence.WorkflowService_DescribeSchedule_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) DescribeTaskList(
 	ctx context.Context,
 	_Request *shared.DescribeTaskListRequest,
@@ -522,6 +697,52 @@ func (c client) ListWorkflowExecutions(
 	return
 }
 
+func (c client) PauseSchedule(
+	ctx context.Context,
+	_PauseRequest *shared.PauseScheduleRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_PauseSchedule_Helper.Args(_PauseRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_PauseSchedule_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_PauseSchedule_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) PauseWorkflowExecution(
+	ctx context.Context,
+	_PauseRequest *shared.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_PauseWorkflowExecution_Helper.Args(_PauseRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_PauseWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_PauseWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) PollForActivityTask(
 	ctx context.Context,
 	_PollRequest *shared.PollForActivityTaskRequest,
@@ -1051,6 +1272,29 @@ func (c client) TerminateWorkflowExecution(
 	return
 }
 
+func (c client) UnpauseWorkflowExecution(
+	ctx context.Context,
+	_UnpauseRequest *shared.UnpauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_UnpauseWorkflowExecution_Helper.Args(_UnpauseRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_UnpauseWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_UnpauseWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
+
 func (c client) UpdateDomain(
 	ctx context.Context,
 	_UpdateRequest *shared.UpdateDomainRequest,
@@ -1073,3 +1317,49 @@ func (c client) UpdateDomain(
 	success, err = cadence.WorkflowService_UpdateDomain_Helper.UnwrapResponse(&result)
 	return
 }
+
+func (c client) UpdateSchedule(
+	ctx context.Context,
+	_UpdateRequest *shared.UpdateScheduleRequest,
+	opts ...yarpc.CallOption,
+) (err error) {
+
+	args := cadence.WorkflowService_UpdateSchedule_Helper.Args(_UpdateRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_UpdateSchedule_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	err = cadence.WorkflowService_UpdateSchedule_Helper.UnwrapResponse(&result)
+	return
+}
+
+func (c client) UpdateWorkflowExecution(
+	ctx context.Context,
+	_UpdateRequest *shared.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (success *shared.UpdateWorkflowExecutionResponse, err error) {
+
+	args := cadence.WorkflowService_UpdateWorkflowExecution_Helper.Args(_UpdateRequest)
+
+	var body wire.Value
+	body, err = c.c.Call(ctx, args, opts...)
+	if err != nil {
+		return
+	}
+
+	var result cadence.WorkflowService_UpdateWorkflowExecution_Result
+	if err = result.FromWire(body); err != nil {
+		return
+	}
+
+	success, err = cadence.WorkflowService_UpdateWorkflowExecution_Helper.UnwrapResponse(&result)
+	return
+}
@@ -114,6 +114,42 @@ func (c *metricClient) DescribeWorkflowExecution(
 	return resp, err
 }
 
+func (c *metricClient) DescribeSchedule(
+	ctx context.Context,
+	request *shared.DescribeScheduleRequest,
+	opts ...yarpc.CallOption,
+) (*shared.DescribeScheduleResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientDescribeScheduleScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientDescribeScheduleScope, metrics.CadenceClientLatency)
+	resp, err := c.client.DescribeSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientDescribeScheduleScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
+func (c *metricClient) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *shared.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) (*shared.UpdateWorkflowExecutionResponse, error) {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientUpdateWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientUpdateWorkflowExecutionScope, metrics.CadenceClientLatency)
+	resp, err := c.client.UpdateWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientUpdateWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return resp, err
+}
+
 func (c *metricClient) GetWorkflowExecutionHistory(
 	ctx context.Context,
 	request *shared.GetWorkflowExecutionHistoryRequest,
@@ -617,6 +653,114 @@ func (c *metricClient) SignalWorkflowExecution(
 	return err
 }
 
+func (c *metricClient) CancelDelayedSignal(
+	ctx context.Context,
+	request *shared.CancelDelayedSignalRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientCancelDelayedSignalScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientCancelDelayedSignalScope, metrics.CadenceClientLatency)
+	err := c.client.CancelDelayedSignal(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientCancelDelayedSignalScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) CreateSchedule(
+	ctx context.Context,
+	request *shared.CreateScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientCreateScheduleScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientCreateScheduleScope, metrics.CadenceClientLatency)
+	err := c.client.CreateSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientCreateScheduleScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) UpdateSchedule(
+	ctx context.Context,
+	request *shared.UpdateScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientUpdateScheduleScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientUpdateScheduleScope, metrics.CadenceClientLatency)
+	err := c.client.UpdateSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientUpdateScheduleScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) PauseSchedule(
+	ctx context.Context,
+	request *shared.PauseScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientPauseScheduleScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientPauseScheduleScope, metrics.CadenceClientLatency)
+	err := c.client.PauseSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientPauseScheduleScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) BackfillSchedule(
+	ctx context.Context,
+	request *shared.BackfillScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientBackfillScheduleScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientBackfillScheduleScope, metrics.CadenceClientLatency)
+	err := c.client.BackfillSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientBackfillScheduleScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) DeleteSchedule(
+	ctx context.Context,
+	request *shared.DeleteScheduleRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientDeleteScheduleScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientDeleteScheduleScope, metrics.CadenceClientLatency)
+	err := c.client.DeleteSchedule(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientDeleteScheduleScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *metricClient) StartWorkflowExecution(
 	ctx context.Context,
 	request *shared.StartWorkflowExecutionRequest,
@@ -653,6 +797,42 @@ func (c *metricClient) TerminateWorkflowExecution(
 	return err
 }
 
+func (c *metricClient) PauseWorkflowExecution(
+	ctx context.Context,
+	request *shared.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientPauseWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientPauseWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.PauseWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientPauseWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
+func (c *metricClient) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *shared.UnpauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption,
+) error {
+
+	c.metricsClient.IncCounter(metrics.FrontendClientUnpauseWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.FrontendClientUnpauseWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.UnpauseWorkflowExecution(ctx, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.FrontendClientUnpauseWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+	return err
+}
+
 func (c *metricClient) UpdateDomain(
 	ctx context.Context,
 	request *shared.UpdateDomainRequest,
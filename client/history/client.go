@@ -146,6 +146,141 @@ func (c *clientImpl) DescribeHistoryHost(
 	return response, nil
 }
 
+func (c *clientImpl) DescribeShardQueues(
+	ctx context.Context,
+	request *workflow.DescribeShardQueuesRequest,
+	opts ...yarpc.CallOption) (*workflow.DescribeShardQueuesResponse, error) {
+
+	client, err := c.getClientForShardID(int(request.GetShardID()))
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *workflow.DescribeShardQueuesResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.DescribeShardQueues(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) ListShardTasks(
+	ctx context.Context,
+	request *workflow.ListShardTasksRequest,
+	opts ...yarpc.CallOption) (*workflow.ListShardTasksResponse, error) {
+
+	client, err := c.getClientForShardID(int(request.GetShardID()))
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *workflow.ListShardTasksResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ListShardTasks(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) RemoveShardTask(
+	ctx context.Context,
+	request *workflow.RemoveShardTaskRequest,
+	opts ...yarpc.CallOption) error {
+
+	client, err := c.getClientForShardID(int(request.GetShardID()))
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.RemoveShardTask(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
+func (c *clientImpl) CloseShard(
+	ctx context.Context,
+	request *workflow.CloseShardRequest,
+	opts ...yarpc.CallOption) error {
+
+	var err error
+	var client historyserviceclient.Interface
+
+	if request.HostAddress != nil {
+		ret, err := c.clients.GetClientForClientKey(request.GetHostAddress())
+		if err != nil {
+			return err
+		}
+		client = ret.(historyserviceclient.Interface)
+	} else {
+		client, err = c.getClientForShardID(int(request.GetShardID()))
+	}
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.CloseShard(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
+func (c *clientImpl) RefreshWorkflowTasks(
+	ctx context.Context,
+	request *h.RefreshWorkflowTasksRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getClientForWorkflowID(request.Execution.GetWorkflowId())
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.RefreshWorkflowTasks(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
+func (c *clientImpl) DeleteWorkflowExecution(
+	ctx context.Context,
+	request *h.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getClientForWorkflowID(request.Execution.GetWorkflowId())
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.DeleteWorkflowExecution(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
 func (c *clientImpl) DescribeMutableState(
 	ctx context.Context,
 	request *h.DescribeMutableStateRequest,
@@ -218,6 +353,30 @@ func (c *clientImpl) DescribeWorkflowExecution(
 	return response, nil
 }
 
+func (c *clientImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *h.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (*workflow.UpdateWorkflowExecutionResponse, error) {
+	client, err := c.getClientForWorkflowID(*request.UpdateRequest.WorkflowExecution.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	var response *workflow.UpdateWorkflowExecutionResponse
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.UpdateWorkflowExecution(ctx, request, opts...)
+		return err
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) RecordDecisionTaskStarted(
 	ctx context.Context,
 	request *h.RecordDecisionTaskStartedRequest,
@@ -442,6 +601,25 @@ func (c *clientImpl) SignalWorkflowExecution(
 	return err
 }
 
+func (c *clientImpl) CancelDelayedSignal(
+	ctx context.Context,
+	request *h.CancelDelayedSignalRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getClientForWorkflowID(*request.CancelRequest.WorkflowExecution.WorkflowId)
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.CancelDelayedSignal(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+
+	return err
+}
+
 func (c *clientImpl) SignalWithStartWorkflowExecution(
 	ctx context.Context,
 	request *h.SignalWithStartWorkflowExecutionRequest,
@@ -503,6 +681,42 @@ func (c *clientImpl) TerminateWorkflowExecution(
 	return err
 }
 
+func (c *clientImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *h.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getClientForWorkflowID(*request.PauseRequest.WorkflowExecution.WorkflowId)
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.PauseWorkflowExecution(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
+func (c *clientImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *h.UnpauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	client, err := c.getClientForWorkflowID(*request.UnpauseRequest.WorkflowExecution.WorkflowId)
+	if err != nil {
+		return err
+	}
+	opts = common.AggregateYarpcOptions(ctx, opts...)
+	op := func(ctx context.Context, client historyserviceclient.Interface) error {
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		return client.UnpauseWorkflowExecution(ctx, request, opts...)
+	}
+	err = c.executeWithRedirect(ctx, client, op)
+	return err
+}
+
 func (c *clientImpl) ResetWorkflowExecution(
 	ctx context.Context,
 	request *h.ResetWorkflowExecutionRequest,
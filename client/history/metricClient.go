@@ -70,6 +70,52 @@ func (c *metricClient) DescribeHistoryHost(
 	return resp, err
 }
 
+func (c *metricClient) DescribeShardQueues(
+	context context.Context,
+	request *shared.DescribeShardQueuesRequest,
+	opts ...yarpc.CallOption) (*shared.DescribeShardQueuesResponse, error) {
+	resp, err := c.client.DescribeShardQueues(context, request, opts...)
+
+	return resp, err
+}
+
+func (c *metricClient) ListShardTasks(
+	context context.Context,
+	request *shared.ListShardTasksRequest,
+	opts ...yarpc.CallOption) (*shared.ListShardTasksResponse, error) {
+	resp, err := c.client.ListShardTasks(context, request, opts...)
+
+	return resp, err
+}
+
+func (c *metricClient) RemoveShardTask(
+	context context.Context,
+	request *shared.RemoveShardTaskRequest,
+	opts ...yarpc.CallOption) error {
+	return c.client.RemoveShardTask(context, request, opts...)
+}
+
+func (c *metricClient) CloseShard(
+	context context.Context,
+	request *shared.CloseShardRequest,
+	opts ...yarpc.CallOption) error {
+	return c.client.CloseShard(context, request, opts...)
+}
+
+func (c *metricClient) RefreshWorkflowTasks(
+	context context.Context,
+	request *h.RefreshWorkflowTasksRequest,
+	opts ...yarpc.CallOption) error {
+	return c.client.RefreshWorkflowTasks(context, request, opts...)
+}
+
+func (c *metricClient) DeleteWorkflowExecution(
+	context context.Context,
+	request *h.DeleteWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	return c.client.DeleteWorkflowExecution(context, request, opts...)
+}
+
 func (c *metricClient) DescribeMutableState(
 	context context.Context,
 	request *h.DescribeMutableStateRequest,
@@ -130,6 +176,23 @@ func (c *metricClient) DescribeWorkflowExecution(
 	return resp, err
 }
 
+func (c *metricClient) UpdateWorkflowExecution(
+	context context.Context,
+	request *h.UpdateWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) (*shared.UpdateWorkflowExecutionResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientUpdateWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientUpdateWorkflowExecutionScope, metrics.CadenceClientLatency)
+	resp, err := c.client.UpdateWorkflowExecution(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientUpdateWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) RecordDecisionTaskStarted(
 	context context.Context,
 	request *h.RecordDecisionTaskStartedRequest,
@@ -300,6 +363,23 @@ func (c *metricClient) SignalWorkflowExecution(
 	return err
 }
 
+func (c *metricClient) CancelDelayedSignal(
+	context context.Context,
+	request *h.CancelDelayedSignalRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientCancelDelayedSignalScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientCancelDelayedSignalScope, metrics.CadenceClientLatency)
+	err := c.client.CancelDelayedSignal(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientCancelDelayedSignalScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
 func (c *metricClient) SignalWithStartWorkflowExecution(
 	context context.Context,
 	request *h.SignalWithStartWorkflowExecutionRequest,
@@ -351,6 +431,40 @@ func (c *metricClient) TerminateWorkflowExecution(
 	return err
 }
 
+func (c *metricClient) PauseWorkflowExecution(
+	context context.Context,
+	request *h.PauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.PauseWorkflowExecution(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientPauseWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
+func (c *metricClient) UnpauseWorkflowExecution(
+	context context.Context,
+	request *h.UnpauseWorkflowExecutionRequest,
+	opts ...yarpc.CallOption) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientUnpauseWorkflowExecutionScope, metrics.CadenceClientRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientUnpauseWorkflowExecutionScope, metrics.CadenceClientLatency)
+	err := c.client.UnpauseWorkflowExecution(context, request, opts...)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientUnpauseWorkflowExecutionScope, metrics.CadenceClientFailures)
+	}
+
+	return err
+}
+
 func (c *metricClient) ResetWorkflowExecution(
 	context context.Context,
 	request *h.ResetWorkflowExecutionRequest,
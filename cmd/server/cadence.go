@@ -23,8 +23,11 @@ package main
 import (
 	"log"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/tools/cassandra"
 	"github.com/urfave/cli"
@@ -66,16 +69,33 @@ func startHandler(c *cli.Context) {
 		log.Fatal("Incompatible versions", err)
 	}
 
+	sigC := make(chan os.Signal, 1)
+	signal.Notify(sigC, syscall.SIGTERM, syscall.SIGINT)
+
 	services := getServices(c)
+	servers := make([]common.Daemon, 0, len(services))
 	for _, svc := range services {
 		if _, ok := cfg.Services[svc]; !ok {
 			log.Fatalf("`%v` service missing config", svc)
 		}
 		server := newServer(svc, &cfg)
 		server.Start()
+		servers = append(servers, server)
 	}
 
-	select {}
+	waitForShutdown(servers, sigC)
+}
+
+// waitForShutdown blocks until a signal is received on sigC and then stops the given
+// servers in reverse start order. Each Stop call returns only after the service has exited,
+// which for history means its shards have been released.
+func waitForShutdown(servers []common.Daemon, sigC <-chan os.Signal) {
+	sig := <-sigC
+	log.Printf("Received signal %v, shutting down\n", sig)
+	for i := len(servers) - 1; i >= 0; i-- {
+		servers[i].Stop()
+	}
+	log.Println("All services stopped")
 }
 
 func getEnvironment(c *cli.Context) string {
@@ -21,16 +21,30 @@
 package main
 
 import (
+	"os"
+	"sync/atomic"
+	"syscall"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common"
 )
 
-type CadenceSuite struct {
-	*require.Assertions
-	suite.Suite
-}
+type (
+	CadenceSuite struct {
+		*require.Assertions
+		suite.Suite
+	}
+
+	// fakeService mimics a service whose Start blocks until Stop is called
+	// and then takes some time to release its resources before returning
+	fakeService struct {
+		stopC    chan struct{}
+		released int32
+	}
+)
 
 func TestCadenceSuite(t *testing.T) {
 	suite.Run(t, new(CadenceSuite))
@@ -53,3 +67,54 @@ func (s *CadenceSuite) TestIsValidService() {
 func (s *CadenceSuite) TestPath() {
 	s.Equal("foo/bar", constructPath("foo", "bar"))
 }
+
+func (s *CadenceSuite) TestWaitForShutdown() {
+	services := []*fakeService{newFakeService(), newFakeService()}
+	servers := make([]common.Daemon, 0, len(services))
+	for i, svc := range services {
+		srv := &server{
+			name:   validServices[i],
+			doneC:  make(chan struct{}),
+			daemon: svc,
+		}
+		go execute(srv.daemon, srv.doneC)
+		servers = append(servers, srv)
+	}
+
+	sigC := make(chan os.Signal, 1)
+	doneC := make(chan struct{})
+	go func() {
+		waitForShutdown(servers, sigC)
+		close(doneC)
+	}()
+
+	select {
+	case <-doneC:
+		s.Fail("shutdown completed before a signal was received")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	sigC <- syscall.SIGTERM
+	select {
+	case <-doneC:
+	case <-time.After(10 * time.Second):
+		s.Fail("timed out waiting for shutdown")
+	}
+	for _, svc := range services {
+		s.Equal(int32(1), atomic.LoadInt32(&svc.released))
+	}
+}
+
+func newFakeService() *fakeService {
+	return &fakeService{stopC: make(chan struct{})}
+}
+
+func (f *fakeService) Start() {
+	<-f.stopC
+	time.Sleep(100 * time.Millisecond)
+	atomic.StoreInt32(&f.released, 1)
+}
+
+func (f *fakeService) Stop() {
+	close(f.stopC)
+}
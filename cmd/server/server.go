@@ -30,10 +30,13 @@ import (
 	"github.com/uber/cadence/common/archiver/provider"
 	"github.com/uber/cadence/common/cluster"
 	"github.com/uber/cadence/common/elasticsearch"
+	"github.com/uber/cadence/common/encryption"
+	"github.com/uber/cadence/common/healthcheck"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
 	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/common/service/dynamicconfig"
@@ -108,6 +111,14 @@ func (s *server) startService() common.Daemon {
 	params.Logger = loggerimpl.NewLogger(s.cfg.Log.NewZapLogger())
 	params.PersistenceConfig = s.cfg.Persistence
 
+	if s.cfg.Persistence.Encryption != nil {
+		keyProvider, err := encryption.NewKeyProvider(s.cfg.Persistence.Encryption)
+		if err != nil {
+			log.Fatalf("error creating encryption key provider: %v", err)
+		}
+		params.PayloadEncryptor = encryption.NewAESEncryptor(keyProvider)
+	}
+
 	params.MembershipFactory, err = s.cfg.Ringpop.NewFactory(params.Logger, params.Name)
 	if err != nil {
 		log.Fatalf("error creating ringpop factory: %v", err)
@@ -124,6 +135,7 @@ func (s *server) startService() common.Daemon {
 	params.MetricScope = svcCfg.Metrics.NewScope(params.Logger)
 	params.RPCFactory = svcCfg.RPC.NewFactory(params.Name, params.Logger)
 	params.PProfInitializer = svcCfg.PProf.NewInitializer(params.Logger)
+	params.HealthCheckServer = healthcheck.NewServer(svcCfg.HealthCheck.Port, params.Logger)
 
 	params.DCRedirectionPolicy = s.cfg.DCRedirectionPolicy
 
@@ -148,8 +160,8 @@ func (s *server) startService() common.Daemon {
 	params.ESConfig = &s.cfg.ElasticSearch
 	params.ESConfig.Enable = dc.GetBoolProperty(dynamicconfig.EnableVisibilityToKafka, params.ESConfig.Enable)() // force override with dynamic config
 	if params.ClusterMetadata.IsGlobalDomainEnabled() {
-		params.MessagingClient = messaging.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, zap.NewNop(), params.Logger, params.MetricScope, true, params.ESConfig.Enable)
-	} else if params.ESConfig.Enable {
+		params.MessagingClient = messaging.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, zap.NewNop(), params.Logger, params.MetricScope, true, params.ESConfig.IsKafkaRequired())
+	} else if params.ESConfig.IsKafkaRequired() {
 		params.MessagingClient = messaging.NewKafkaClient(&s.cfg.Kafka, params.MetricsClient, zap.NewNop(), params.Logger, params.MetricScope, false, params.ESConfig.Enable)
 	} else {
 		params.MessagingClient = nil
@@ -204,6 +216,13 @@ func (s *server) startService() common.Daemon {
 	params.ArchiverProvider = provider.NewArchiverProvider(historyArchiverProviderCfg, visibilityArchiverProviderCfg)
 
 	params.PersistenceConfig.TransactionSizeLimit = dc.GetIntProperty(dynamicconfig.TransactionSizeLimit, common.DefaultTransactionSizeLimit)
+	params.PersistenceConfig.FaultInjectionConfig = &config.FaultInjectionConfig{
+		Enabled:   dc.GetBoolProperty(dynamicconfig.EnablePersistenceFaultInjection, false),
+		ErrorRate: dc.GetFloat64Property(dynamicconfig.PersistenceFaultInjectionErrorRate, 0),
+		ErrorType: dc.GetStringProperty(dynamicconfig.PersistenceFaultInjectionErrorType, persistence.FaultInjectionErrorTypeTimeout),
+		Latency:   dc.GetDurationProperty(dynamicconfig.PersistenceFaultInjectionLatency, 0),
+	}
+	params.PersistenceConfig.EnableDomainMetrics = dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.EnablePersistenceDomainMetrics, false)
 
 	params.Logger.Info("Starting service " + s.name)
 
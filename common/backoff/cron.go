@@ -21,7 +21,10 @@
 package backoff
 
 import (
+	"fmt"
 	"math"
+	"math/rand"
+	"strings"
 	"time"
 
 	"github.com/robfig/cron"
@@ -31,46 +34,115 @@ import (
 // NoBackoff is used to represent backoff when no cron backoff is needed
 const NoBackoff = time.Duration(-1)
 
+// cronTimeZonePrefixes are the prefixes of a cron schedule naming the time zone it is evaluated in,
+// e.g. "CRON_TZ=America/New_York 0 9 * * *". Schedules without prefix are evaluated in UTC.
+var cronTimeZonePrefixes = []string{"CRON_TZ=", "TZ="}
+
 // ValidateSchedule validates a cron schedule spec
 func ValidateSchedule(cronSchedule string) error {
 	if cronSchedule == "" {
 		return nil
 	}
-	if _, err := cron.Parse(cronSchedule); err != nil {
+	spec, _, err := splitCronTimeZone(cronSchedule)
+	if err != nil {
+		return &workflow.BadRequestError{Message: "Invalid CronSchedule."}
+	}
+	if _, err := cron.Parse(spec); err != nil {
 		return &workflow.BadRequestError{Message: "Invalid CronSchedule."}
 	}
 	return nil
 }
 
+// ValidateCronMaxJitter validates the max jitter of a cron schedule
+func ValidateCronMaxJitter(cronMaxJitterSeconds int32) error {
+	if cronMaxJitterSeconds < 0 {
+		return &workflow.BadRequestError{Message: "Invalid CronMaxJitterSeconds."}
+	}
+	return nil
+}
+
 // GetBackoffForNextSchedule calculates the backoff time for the next run given
-// a cronSchedule, workflow start time and workflow close time
-func GetBackoffForNextSchedule(cronSchedule string, startTime time.Time, closeTime time.Time) time.Duration {
+// a cronSchedule, workflow start time and workflow close time. A random jitter of up to
+// cronMaxJitter is added, bounded so that the run never slips past the following schedule time.
+func GetBackoffForNextSchedule(cronSchedule string, startTime time.Time, closeTime time.Time, cronMaxJitter time.Duration) time.Duration {
 	if len(cronSchedule) == 0 {
 		return NoBackoff
 	}
 
-	schedule, err := cron.ParseStandard(cronSchedule)
+	schedule, location, err := parseCronSchedule(cronSchedule)
 	if err != nil {
 		return NoBackoff
 	}
-	startUTCTime := startTime.In(time.UTC)
-	closeUTCTime := closeTime.In(time.UTC)
-	nextScheduleTime := schedule.Next(startUTCTime)
+	startLocalTime := startTime.In(location)
+	closeLocalTime := closeTime.In(location)
+	nextScheduleTime := schedule.Next(startLocalTime)
 	// Calculate the next schedule start time which is nearest to the close time
-	for nextScheduleTime.Before(closeUTCTime) {
+	for nextScheduleTime.Before(closeLocalTime) {
 		nextScheduleTime = schedule.Next(nextScheduleTime)
 	}
-	backoffInterval := nextScheduleTime.Sub(closeUTCTime)
+	backoffInterval := nextScheduleTime.Sub(closeLocalTime)
+	if cronMaxJitter > 0 {
+		if interval := schedule.Next(nextScheduleTime).Sub(nextScheduleTime); interval > 0 && cronMaxJitter > interval {
+			cronMaxJitter = interval
+		}
+		backoffInterval += time.Duration(rand.Int63n(int64(cronMaxJitter)))
+	}
 	roundedInterval := time.Second * time.Duration(math.Ceil(backoffInterval.Seconds()))
 	return roundedInterval
 }
 
 // GetBackoffForNextScheduleInSeconds calculates the backoff time in seconds for the
 // next run given a cronSchedule and current time
-func GetBackoffForNextScheduleInSeconds(cronSchedule string, startTime time.Time, closeTime time.Time) int32 {
-	backoffDuration := GetBackoffForNextSchedule(cronSchedule, startTime, closeTime)
+func GetBackoffForNextScheduleInSeconds(cronSchedule string, startTime time.Time, closeTime time.Time, cronMaxJitterSeconds int32) int32 {
+	backoffDuration := GetBackoffForNextSchedule(cronSchedule, startTime, closeTime, time.Duration(cronMaxJitterSeconds)*time.Second)
 	if backoffDuration == NoBackoff {
 		return 0
 	}
 	return int32(math.Ceil(backoffDuration.Seconds()))
 }
+
+// GetNextScheduleTime returns the first time after the given time at which the cronSchedule fires.
+// Zero time is returned if the cronSchedule is empty or invalid.
+func GetNextScheduleTime(cronSchedule string, after time.Time) time.Time {
+	if len(cronSchedule) == 0 {
+		return time.Time{}
+	}
+
+	schedule, location, err := parseCronSchedule(cronSchedule)
+	if err != nil {
+		return time.Time{}
+	}
+	return schedule.Next(after.In(location))
+}
+
+func parseCronSchedule(cronSchedule string) (cron.Schedule, *time.Location, error) {
+	spec, location, err := splitCronTimeZone(cronSchedule)
+	if err != nil {
+		return nil, nil, err
+	}
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	return schedule, location, nil
+}
+
+// splitCronTimeZone strips the time zone prefix from the cron schedule, and returns the remaining spec
+// along with the time zone it is evaluated in
+func splitCronTimeZone(cronSchedule string) (string, *time.Location, error) {
+	for _, prefix := range cronTimeZonePrefixes {
+		if !strings.HasPrefix(cronSchedule, prefix) {
+			continue
+		}
+		parts := strings.SplitN(strings.TrimPrefix(cronSchedule, prefix), " ", 2)
+		if len(parts) != 2 {
+			return "", nil, fmt.Errorf("missing cron spec after time zone in %q", cronSchedule)
+		}
+		location, err := time.LoadLocation(parts[0])
+		if err != nil {
+			return "", nil, err
+		}
+		return strings.TrimSpace(parts[1]), location, nil
+	}
+	return cronSchedule, time.UTC, nil
+}
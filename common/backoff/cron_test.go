@@ -46,6 +46,11 @@ var crontests = []struct {
 	{"invalid-cron-spec", "2018-12-17T00:04:00+00:00", "2018-12-17T01:02:00+00:00", NoBackoff},
 	{"@every 5h", "2018-12-17T08:00:00+00:00", "2018-12-17T09:00:00+00:00", time.Hour * 4},
 	{"@every 5h", "2018-12-17T08:00:00+00:00", "2018-12-18T00:00:00+00:00", time.Hour * 4},
+	{"CRON_TZ=America/New_York 0 9 * * *", "2019-03-09T15:00:00+00:00", "", time.Hour * 22},
+	{"CRON_TZ=America/New_York 0 9 * * *", "2019-03-10T14:00:00+00:00", "", time.Hour * 23},
+	{"TZ=Asia/Tokyo 0 9 * * *", "2018-12-17T08:00:00+00:00", "", time.Hour * 16},
+	{"CRON_TZ=Invalid/Zone 0 9 * * *", "2018-12-17T08:00:00+00:00", "", NoBackoff},
+	{"CRON_TZ=America/New_York", "2018-12-17T08:00:00+00:00", "", NoBackoff},
 }
 
 func TestCron(t *testing.T) {
@@ -56,8 +61,43 @@ func TestCron(t *testing.T) {
 			if tt.endTime != "" {
 				end, _ = time.Parse(time.RFC3339, tt.endTime)
 			}
-			backoff := GetBackoffForNextSchedule(tt.cron, start, end)
+			backoff := GetBackoffForNextSchedule(tt.cron, start, end, 0)
 			assert.Equal(t, tt.result, backoff, "The cron spec is %s and the expected result is %s", tt.cron, tt.result)
 		})
 	}
 }
+
+func TestGetNextScheduleTime(t *testing.T) {
+	after, _ := time.Parse(time.RFC3339, "2018-12-17T08:08:00+00:00")
+	expected, _ := time.Parse(time.RFC3339, "2018-12-17T09:00:00+00:00")
+	assert.True(t, expected.Equal(GetNextScheduleTime("0 * * * *", after)))
+	assert.True(t, GetNextScheduleTime("", after).IsZero())
+	assert.True(t, GetNextScheduleTime("invalid-cron-spec", after).IsZero())
+	expected, _ = time.Parse(time.RFC3339, "2018-12-18T00:00:00+00:00")
+	assert.True(t, expected.Equal(GetNextScheduleTime("CRON_TZ=Asia/Tokyo 0 9 * * *", after)))
+}
+
+func TestCronMaxJitter(t *testing.T) {
+	start, _ := time.Parse(time.RFC3339, "2018-12-17T08:08:00+00:00")
+	for i := 0; i < 100; i++ {
+		backoff := GetBackoffForNextSchedule("0 * * * *", start, start, time.Minute*5)
+		assert.True(t, backoff >= time.Minute*52 && backoff <= time.Minute*57, "backoff %v out of jitter range", backoff)
+	}
+	// the jitter is bounded by the interval of the schedule
+	for i := 0; i < 100; i++ {
+		backoff := GetBackoffForNextSchedule("* * * * *", start, start, time.Hour)
+		assert.True(t, backoff >= time.Minute && backoff <= time.Minute*2, "backoff %v out of jitter range", backoff)
+	}
+	assert.Equal(t, int32(3120), GetBackoffForNextScheduleInSeconds("0 * * * *", start, start, 0))
+}
+
+func TestValidateSchedule(t *testing.T) {
+	assert.NoError(t, ValidateSchedule(""))
+	assert.NoError(t, ValidateSchedule("0 9 * * *"))
+	assert.NoError(t, ValidateSchedule("CRON_TZ=America/New_York 0 9 * * *"))
+	assert.Error(t, ValidateSchedule("CRON_TZ=Invalid/Zone 0 9 * * *"))
+	assert.Error(t, ValidateSchedule("CRON_TZ=America/New_York"))
+	assert.Error(t, ValidateSchedule("invalid-cron-spec"))
+	assert.NoError(t, ValidateCronMaxJitter(0))
+	assert.Error(t, ValidateCronMaxJitter(-1))
+}
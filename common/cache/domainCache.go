@@ -638,6 +638,7 @@ func (entry *DomainCacheEntry) duplicate() *DomainCacheEntry {
 		VisibilityArchivalStatus: entry.config.VisibilityArchivalStatus,
 		VisibilityArchivalURI:    entry.config.VisibilityArchivalURI,
 		BadBinaries:              copyResetBinary(entry.config.BadBinaries),
+		WorkerVersioning:         entry.config.WorkerVersioning,
 	}
 	result.replicationConfig = &persistence.DomainReplicationConfig{
 		ActiveClusterName: entry.replicationConfig.ActiveClusterName,
@@ -752,6 +753,9 @@ var SampleRetentionKey = "sample_retention_days"
 // SampleRateKey is key to specify sample rate
 var SampleRateKey = "sample_retention_rate"
 
+// AutoResetBadBinariesKey is key to enable auto reset of open workflows affected by newly added bad binaries
+var AutoResetBadBinariesKey = "auto_reset_bad_binaries"
+
 // GetRetentionDays returns retention in days for given workflow
 func (entry *DomainCacheEntry) GetRetentionDays(workflowID string) int32 {
 	if entry.IsSampledForLongerRetention(workflowID) {
@@ -772,6 +776,20 @@ func (entry *DomainCacheEntry) IsSampledForLongerRetentionEnabled(workflowID str
 	return ok
 }
 
+// IsAutoResetBadBinariesEnabled return whether open workflows affected by newly added bad binaries should be reset automatically
+func (entry *DomainCacheEntry) IsAutoResetBadBinariesEnabled() bool {
+	return IsAutoResetBadBinariesEnabledInData(entry.info.Data)
+}
+
+// IsAutoResetBadBinariesEnabledInData return whether auto reset of bad binaries is enabled by the given domain data
+func IsAutoResetBadBinariesEnabledInData(data map[string]string) bool {
+	if value, ok := data[AutoResetBadBinariesKey]; ok {
+		enabled, err := strconv.ParseBool(value)
+		return err == nil && enabled
+	}
+	return false
+}
+
 // IsSampledForLongerRetention return should given workflow been sampled or not
 func (entry *DomainCacheEntry) IsSampledForLongerRetention(workflowID string) bool {
 	if sampledRateValue, ok := entry.info.Data[SampleRateKey]; ok {
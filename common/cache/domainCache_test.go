@@ -708,3 +708,24 @@ func Test_IsSampledForLongerRetention(t *testing.T) {
 	d.info.Data[SampleRateKey] = "invalid-value"
 	require.False(t, d.IsSampledForLongerRetention(wid))
 }
+
+func Test_IsAutoResetBadBinariesEnabled(t *testing.T) {
+	d := &DomainCacheEntry{
+		info: &persistence.DomainInfo{
+			Data: make(map[string]string),
+		},
+		config: &persistence.DomainConfig{
+			Retention: 7,
+		},
+	}
+	require.False(t, d.IsAutoResetBadBinariesEnabled())
+
+	d.info.Data[AutoResetBadBinariesKey] = "true"
+	require.True(t, d.IsAutoResetBadBinariesEnabled())
+
+	d.info.Data[AutoResetBadBinariesKey] = "false"
+	require.False(t, d.IsAutoResetBadBinariesEnabled())
+
+	d.info.Data[AutoResetBadBinariesKey] = "invalid-value"
+	require.False(t, d.IsAutoResetBadBinariesEnabled())
+}
@@ -153,6 +153,16 @@ func ClientArchivalStatusPtr(t shared.ArchivalStatus) *shared.ArchivalStatus {
 	return &t
 }
 
+// WorkflowIDReusePolicyPtr makes a copy and returns the pointer to a WorkflowIdReusePolicy.
+func WorkflowIDReusePolicyPtr(t s.WorkflowIdReusePolicy) *s.WorkflowIdReusePolicy {
+	return &t
+}
+
+// ResetReapplyPolicyPtr makes a copy and returns the pointer to a ResetReapplyPolicy.
+func ResetReapplyPolicyPtr(t s.ResetReapplyPolicy) *s.ResetReapplyPolicy {
+	return &t
+}
+
 // StringDefault returns value if string pointer is set otherwise default value of string
 func StringDefault(v *string) string {
 	var defaultString string
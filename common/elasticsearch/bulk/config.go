@@ -0,0 +1,39 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package bulk writes visibility records into ElasticSearch through the bulk
+// API, either for the worker indexer that consumes them from kafka, or for
+// history when it writes them directly without kafka
+package bulk
+
+import (
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+// Config contains all configs for writing visibility records into ElasticSearch
+type Config struct {
+	IndexerConcurrency       dynamicconfig.IntPropertyFn
+	ESProcessorNumOfWorkers  dynamicconfig.IntPropertyFn
+	ESProcessorBulkActions   dynamicconfig.IntPropertyFn // max number of requests in bulk
+	ESProcessorBulkSize      dynamicconfig.IntPropertyFn // max total size of bytes in bulk
+	ESProcessorFlushInterval dynamicconfig.DurationPropertyFn
+	ESProcessorAckTimeout    dynamicconfig.DurationPropertyFn // only used when writing to ES without kafka
+	ValidSearchAttributes    dynamicconfig.MapPropertyFn
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bulk
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/.gen/go/shared"
+	es "github.com/uber/cadence/common/elasticsearch"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	// esProducer implements messaging.Producer by writing visibility messages directly
+	// into ElasticSearch through ESProcessor, instead of publishing them to kafka.
+	// Publish blocks until the bulk request is acked by ElasticSearch, so callers
+	// like history transfer task processing keep their at-least-once guarantee.
+	esProducer struct {
+		esProcessor   ESProcessor
+		esWriter      *VisibilityWriter
+		config        *Config
+		logger        log.Logger
+		metricsClient metrics.Client
+		sequenceID    int64
+	}
+
+	// esAckMessage is an in-memory messaging.Message used to get notified when
+	// ESProcessor acks or nacks the corresponding ES request
+	esAckMessage struct {
+		sequenceID int64
+		ackCh      chan bool
+	}
+)
+
+const (
+	esProducerProcessorName = "visibility-direct-processor"
+)
+
+var (
+	errESRequestFailed  = &shared.InternalServiceError{Message: "ElasticSearch request failed"}
+	errESRequestTimeout = &shared.InternalServiceError{Message: "timeout waiting for ElasticSearch ack"}
+)
+
+var _ messaging.Producer = (*esProducer)(nil)
+var _ messaging.Message = (*esAckMessage)(nil)
+
+// NewESProducer create a new producer which writes visibility messages directly into ElasticSearch
+func NewESProducer(config *Config, esClient es.Client, esIndexName string,
+	logger log.Logger, metricsClient metrics.Client) (messaging.Producer, error) {
+	logger = logger.WithTags(tag.ComponentIndexer)
+
+	esProcessor, err := NewESProcessorAndStart(config, esClient, esProducerProcessorName, logger, metricsClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return newESProducer(esProcessor, esIndexName, config, logger, metricsClient), nil
+}
+
+func newESProducer(esProcessor ESProcessor, esIndexName string, config *Config,
+	logger log.Logger, metricsClient metrics.Client) *esProducer {
+	return &esProducer{
+		esProcessor:   esProcessor,
+		esWriter:      NewVisibilityWriter(esProcessor, esIndexName, config, logger, metricsClient),
+		config:        config,
+		logger:        logger,
+		metricsClient: metricsClient,
+	}
+}
+
+// Publish writes the visibility message into ElasticSearch and waits for the result
+func (p *esProducer) Publish(msg interface{}) error {
+	indexMsg, ok := msg.(*indexer.Message)
+	if !ok {
+		return errUnknownMessageType
+	}
+
+	ackMsg := newESAckMessage(atomic.AddInt64(&p.sequenceID, 1))
+	logger := p.logger.WithTags(tag.WorkflowID(indexMsg.GetWorkflowID()), tag.WorkflowRunID(indexMsg.GetRunID()))
+	if err := p.esWriter.Add(indexMsg, ackMsg, logger); err != nil {
+		return err
+	}
+
+	timer := time.NewTimer(p.config.ESProcessorAckTimeout())
+	defer timer.Stop()
+
+	select {
+	case success := <-ackMsg.ackCh:
+		if !success {
+			return errESRequestFailed
+		}
+		return nil
+	case <-timer.C:
+		logger.Warn("Timeout waiting for ElasticSearch ack.")
+		p.metricsClient.IncCounter(metrics.ESProcessorScope, metrics.ESProcessorFailures)
+		return errESRequestTimeout
+	}
+}
+
+// PublishBatch writes visibility messages into ElasticSearch one after another
+func (p *esProducer) PublishBatch(msgs []interface{}) error {
+	for _, msg := range msgs {
+		if err := p.Publish(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close stops the underlying ESProcessor
+func (p *esProducer) Close() error {
+	p.esProcessor.Stop()
+	return nil
+}
+
+func newESAckMessage(sequenceID int64) *esAckMessage {
+	return &esAckMessage{
+		sequenceID: sequenceID,
+		ackCh:      make(chan bool, 1),
+	}
+}
+
+func (m *esAckMessage) Value() []byte {
+	return nil
+}
+
+func (m *esAckMessage) Partition() int32 {
+	return 0
+}
+
+func (m *esAckMessage) Offset() int64 {
+	return m.sequenceID
+}
+
+func (m *esAckMessage) Ack() error {
+	m.notify(true)
+	return nil
+}
+
+func (m *esAckMessage) Nack() error {
+	m.notify(false)
+	return nil
+}
+
+func (m *esAckMessage) notify(success bool) {
+	select {
+	case m.ackCh <- success:
+	default:
+		// already notified
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bulk
+
+import (
+	"testing"
+	"time"
+
+	"github.com/olivere/elastic"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+	"go.uber.org/zap"
+)
+
+type (
+	esProducerSuite struct {
+		suite.Suite
+		esProcessor *fakeESProcessor
+		producer    *esProducer
+	}
+
+	fakeESProcessor struct {
+		requests chan elastic.BulkableRequest
+		msgs     chan messaging.Message
+	}
+)
+
+func TestESProducerSuite(t *testing.T) {
+	s := new(esProducerSuite)
+	suite.Run(t, s)
+}
+
+func (s *esProducerSuite) SetupTest() {
+	config := &Config{
+		IndexerConcurrency:    dynamicconfig.GetIntPropertyFn(32),
+		ESProcessorAckTimeout: dynamicconfig.GetDurationPropertyFn(100 * time.Millisecond),
+		ValidSearchAttributes: dynamicconfig.GetMapPropertyFn(map[string]interface{}{}),
+	}
+	zapLogger, err := zap.NewDevelopment()
+	s.Require().NoError(err)
+	logger := loggerimpl.NewLogger(zapLogger)
+	metricsClient := metrics.NewClient(tally.NoopScope, metrics.Worker)
+
+	s.esProcessor = &fakeESProcessor{
+		requests: make(chan elastic.BulkableRequest, 1),
+		msgs:     make(chan messaging.Message, 1),
+	}
+	s.producer = newESProducer(s.esProcessor, testIndex, config, logger, metricsClient)
+}
+
+func (s *esProducerSuite) TestPublish_Ack() {
+	go func() {
+		msg := <-s.esProcessor.msgs
+		msg.Ack()
+	}()
+	s.NoError(s.producer.Publish(s.newIndexMessage()))
+
+	request := <-s.esProcessor.requests
+	source, err := request.Source()
+	s.NoError(err)
+	s.Equal(2, len(source))
+}
+
+func (s *esProducerSuite) TestPublish_Nack() {
+	go func() {
+		msg := <-s.esProcessor.msgs
+		msg.Nack()
+	}()
+	s.Equal(errESRequestFailed, s.producer.Publish(s.newIndexMessage()))
+}
+
+func (s *esProducerSuite) TestPublish_Timeout() {
+	s.Equal(errESRequestTimeout, s.producer.Publish(s.newIndexMessage()))
+}
+
+func (s *esProducerSuite) TestPublish_UnknownMessage() {
+	s.Equal(errUnknownMessageType, s.producer.Publish("some message"))
+}
+
+func (s *esProducerSuite) TestPublish_UniqueKeys() {
+	go func() {
+		for i := 0; i < 2; i++ {
+			msg := <-s.esProcessor.msgs
+			msg.Ack()
+		}
+	}()
+	s.NoError(s.producer.Publish(s.newIndexMessage()))
+	first := <-s.esProcessor.requests
+	s.NoError(s.producer.Publish(s.newIndexMessage()))
+	second := <-s.esProcessor.requests
+	s.NotEqual(first.String(), second.String())
+}
+
+func (s *esProducerSuite) newIndexMessage() *indexer.Message {
+	messageType := indexer.MessageTypeIndex
+	return &indexer.Message{
+		MessageType: &messageType,
+		DomainID:    common.StringPtr("domain-id"),
+		WorkflowID:  common.StringPtr("workflow-id"),
+		RunID:       common.StringPtr("run-id"),
+		Version:     common.Int64Ptr(1),
+		Fields:      map[string]*indexer.Field{},
+	}
+}
+
+func (p *fakeESProcessor) Stop() {}
+
+func (p *fakeESProcessor) Add(request elastic.BulkableRequest, key string, kafkaMsg messaging.Message) {
+	p.requests <- request
+	p.msgs <- kafkaMsg
+}
@@ -0,0 +1,171 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bulk
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/olivere/elastic"
+	"github.com/uber/cadence/.gen/go/indexer"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
+	"github.com/uber/cadence/common/metrics"
+)
+
+type (
+	// VisibilityWriter converts visibility messages into ElasticSearch
+	// requests and adds them to the bulk requests of an ESProcessor
+	VisibilityWriter struct {
+		esProcessor   ESProcessor
+		esIndexName   string
+		config        *Config
+		logger        log.Logger
+		metricsClient metrics.Client
+	}
+)
+
+const (
+	esDocIDDelimiter = "~"
+	esDocType        = "_doc"
+
+	versionTypeExternal = "external"
+)
+
+var (
+	errUnknownMessageType = &shared.BadRequestError{Message: "unknown message type"}
+)
+
+// NewVisibilityWriter creates a new VisibilityWriter which writes into the given index
+func NewVisibilityWriter(esProcessor ESProcessor, esIndexName string, config *Config,
+	logger log.Logger, metricsClient metrics.Client) *VisibilityWriter {
+	return &VisibilityWriter{
+		esProcessor:   esProcessor,
+		esIndexName:   esIndexName,
+		config:        config,
+		logger:        logger,
+		metricsClient: metricsClient,
+	}
+}
+
+// Add adds the ElasticSearch request of the visibility message, kafkaMsg
+// is acked or nacked once ElasticSearch processed the request
+func (w *VisibilityWriter) Add(indexMsg *indexer.Message, kafkaMsg messaging.Message, logger log.Logger) error {
+	docID := indexMsg.GetWorkflowID() + esDocIDDelimiter + indexMsg.GetRunID()
+
+	var keyToKafkaMsg string
+	var req elastic.BulkableRequest
+	switch indexMsg.GetMessageType() {
+	case indexer.MessageTypeIndex:
+		keyToKafkaMsg = fmt.Sprintf("%v-%v", kafkaMsg.Partition(), kafkaMsg.Offset())
+		doc := w.generateESDoc(indexMsg, keyToKafkaMsg)
+		req = elastic.NewBulkIndexRequest().
+			Index(w.esIndexName).
+			Type(esDocType).
+			Id(docID).
+			VersionType(versionTypeExternal).
+			Version(indexMsg.GetVersion()).
+			Doc(doc)
+	case indexer.MessageTypeDelete:
+		keyToKafkaMsg = docID
+		req = elastic.NewBulkDeleteRequest().
+			Index(w.esIndexName).
+			Type(esDocType).
+			Id(docID).
+			VersionType(versionTypeExternal).
+			Version(indexMsg.GetVersion())
+	default:
+		logger.Error("Unknown message type")
+		w.metricsClient.IncCounter(metrics.IndexProcessorScope, metrics.IndexProcessorCorruptedData)
+		return errUnknownMessageType
+	}
+
+	w.esProcessor.Add(req, keyToKafkaMsg, kafkaMsg)
+	return nil
+}
+
+func (w *VisibilityWriter) generateESDoc(msg *indexer.Message, keyToKafkaMsg string) map[string]interface{} {
+	doc := w.dumpFieldsToMap(msg.Fields)
+	fulfillDoc(doc, msg, keyToKafkaMsg)
+	return doc
+}
+
+func (w *VisibilityWriter) decodeSearchAttrBinary(bytes []byte, key string) interface{} {
+	var val interface{}
+	err := json.Unmarshal(bytes, &val)
+	if err != nil {
+		w.logger.Error("Error when decode search attributes values.", tag.Error(err), tag.ESField(key))
+		w.metricsClient.IncCounter(metrics.IndexProcessorScope, metrics.IndexProcessorCorruptedData)
+	}
+	return val
+}
+
+func (w *VisibilityWriter) dumpFieldsToMap(fields map[string]*indexer.Field) map[string]interface{} {
+	doc := make(map[string]interface{})
+	attr := make(map[string]interface{})
+	for k, v := range fields {
+		if !w.isValidFieldToES(k) {
+			w.logger.Error("Unregistered field.", tag.ESField(k))
+			w.metricsClient.IncCounter(metrics.IndexProcessorScope, metrics.IndexProcessorCorruptedData)
+			continue
+		}
+
+		switch v.GetType() {
+		case indexer.FieldTypeString:
+			doc[k] = v.GetStringData()
+		case indexer.FieldTypeInt:
+			doc[k] = v.GetIntData()
+		case indexer.FieldTypeBool:
+			doc[k] = v.GetBoolData()
+		case indexer.FieldTypeBinary:
+			if k == definition.Memo {
+				doc[k] = v.GetBinaryData()
+			} else { // custom search attributes
+				attr[k] = w.decodeSearchAttrBinary(v.GetBinaryData(), k)
+			}
+		default:
+			// must be bug in code and bad deployment, check data sent from producer
+			w.logger.Fatal("Unknown field type")
+		}
+	}
+	doc[definition.Attr] = attr
+	return doc
+}
+
+func (w *VisibilityWriter) isValidFieldToES(field string) bool {
+	if _, ok := w.config.ValidSearchAttributes()[field]; ok {
+		return true
+	}
+	if field == definition.Memo || field == definition.KafkaKey || field == definition.Encoding {
+		return true
+	}
+	return false
+}
+
+func fulfillDoc(doc map[string]interface{}, msg *indexer.Message, keyToKafkaMsg string) {
+	doc[definition.DomainID] = msg.GetDomainID()
+	doc[definition.WorkflowID] = msg.GetWorkflowID()
+	doc[definition.RunID] = msg.GetRunID()
+	doc[definition.KafkaKey] = keyToKafkaMsg
+}
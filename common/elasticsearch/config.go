@@ -31,6 +31,9 @@ type (
 		Enable  bool              `yaml:enable`
 		URL     url.URL           `yaml:url`
 		Indices map[string]string `yaml:indices`
+		// WriteDirect makes history write visibility records into ElasticSearch directly,
+		// instead of publishing them to kafka to be indexed by worker
+		WriteDirect bool `yaml:"writeDirect"`
 	}
 )
 
@@ -38,3 +41,8 @@ type (
 func (cfg *Config) GetVisibilityIndex() string {
 	return cfg.Indices[common.VisibilityAppName]
 }
+
+// IsKafkaRequired return true if visibility records go through kafka
+func (cfg *Config) IsKafkaRequired() bool {
+	return cfg.Enable && !cfg.WriteDirect
+}
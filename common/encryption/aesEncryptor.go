@@ -0,0 +1,127 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"io"
+)
+
+const (
+	// the version of the encrypted payload format
+	payloadVersion1 byte = 0x01
+	// maximum length of a key ID, the length is stored in a single byte
+	maxKeyIDLength = 255
+)
+
+// payloadPreamble marks an encrypted payload. Neither thriftrw nor json encoded payloads start with
+// a zero byte, so encrypted and plaintext payloads can be told apart.
+var payloadPreamble = []byte{0x00, 'C', 'E', 'N', 'C'}
+
+type aesEncryptor struct {
+	keyProvider KeyProvider
+}
+
+// NewAESEncryptor returns an Encryptor using AES-GCM with the keys of the given provider.
+// An encrypted payload has the layout:
+//
+//     preamble | version | key ID length | key ID | nonce | ciphertext
+func NewAESEncryptor(keyProvider KeyProvider) Encryptor {
+	return &aesEncryptor{
+		keyProvider: keyProvider,
+	}
+}
+
+// IsEncrypted returns true if the given payload is encrypted
+func IsEncrypted(data []byte) bool {
+	if len(data) < len(payloadPreamble) {
+		return false
+	}
+	for i, b := range payloadPreamble {
+		if data[i] != b {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *aesEncryptor) Encrypt(data []byte) ([]byte, error) {
+	keyID, key, err := e.keyProvider.GetCurrentKey()
+	if err != nil {
+		return nil, err
+	}
+	if len(keyID) > maxKeyIDLength {
+		return nil, ErrKeyNotFound
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	headerLen := len(payloadPreamble) + 2 + len(keyID)
+	result := make([]byte, headerLen+aead.NonceSize(), headerLen+aead.NonceSize()+len(data)+aead.Overhead())
+	copy(result, payloadPreamble)
+	result[len(payloadPreamble)] = payloadVersion1
+	result[len(payloadPreamble)+1] = byte(len(keyID))
+	copy(result[len(payloadPreamble)+2:], keyID)
+
+	nonce := result[headerLen:]
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	// the header is authenticated as additional data, so the key ID cannot be tampered with
+	return aead.Seal(result, nonce, data, result[:headerLen]), nil
+}
+
+func (e *aesEncryptor) Decrypt(data []byte) ([]byte, error) {
+	if !IsEncrypted(data) || len(data) < len(payloadPreamble)+2 || data[len(payloadPreamble)] != payloadVersion1 {
+		return nil, ErrMalformedPayload
+	}
+	headerLen := len(payloadPreamble) + 2 + int(data[len(payloadPreamble)+1])
+	if len(data) < headerLen {
+		return nil, ErrMalformedPayload
+	}
+	keyID := string(data[len(payloadPreamble)+2 : headerLen])
+
+	key, err := e.keyProvider.GetKey(keyID)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < headerLen+aead.NonceSize() {
+		return nil, ErrMalformedPayload
+	}
+	nonce := data[headerLen : headerLen+aead.NonceSize()]
+	return aead.Open(nil, nonce, data[headerLen+aead.NonceSize():], data[:headerLen])
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
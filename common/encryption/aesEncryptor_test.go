@@ -0,0 +1,164 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	encryptionSuite struct {
+		suite.Suite
+		*require.Assertions
+		keyFile string
+	}
+)
+
+var (
+	testKey1 = []byte("0123456789abcdef0123456789abcdef")
+	testKey2 = []byte("fedcba9876543210")
+)
+
+func TestEncryptionSuite(t *testing.T) {
+	s := new(encryptionSuite)
+	suite.Run(t, s)
+}
+
+func (s *encryptionSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	file, err := ioutil.TempFile("", "cadence-encryption-keys")
+	s.NoError(err)
+	s.NoError(file.Close())
+	s.keyFile = file.Name()
+}
+
+func (s *encryptionSuite) TearDownTest() {
+	os.Remove(s.keyFile)
+}
+
+func (s *encryptionSuite) writeKeyFile(currentKeyID string) {
+	content := "currentKeyID: " + currentKeyID + "\n" +
+		"keys:\n" +
+		"  key-1: " + base64.StdEncoding.EncodeToString(testKey1) + "\n" +
+		"  key-2: " + base64.StdEncoding.EncodeToString(testKey2) + "\n"
+	s.NoError(ioutil.WriteFile(s.keyFile, []byte(content), 0600))
+}
+
+func (s *encryptionSuite) newEncryptor(currentKeyID string) Encryptor {
+	s.writeKeyFile(currentKeyID)
+	keyProvider, err := NewKeyProvider(&config.Encryption{
+		FileKeyProvider: &config.FileKeyProvider{KeyFile: s.keyFile},
+	})
+	s.NoError(err)
+	return NewAESEncryptor(keyProvider)
+}
+
+func (s *encryptionSuite) TestEncryptDecrypt() {
+	encryptor := s.newEncryptor("key-1")
+	payload := []byte("some history event payload")
+
+	encrypted, err := encryptor.Encrypt(payload)
+	s.NoError(err)
+	s.True(IsEncrypted(encrypted))
+	s.NotContains(string(encrypted), string(payload))
+
+	decrypted, err := encryptor.Decrypt(encrypted)
+	s.NoError(err)
+	s.Equal(payload, decrypted)
+	s.False(IsEncrypted(payload))
+}
+
+func (s *encryptionSuite) TestKeyRotation() {
+	payload := []byte("some history event payload")
+	encrypted, err := s.newEncryptor("key-1").Encrypt(payload)
+	s.NoError(err)
+
+	rotated := s.newEncryptor("key-2")
+	decrypted, err := rotated.Decrypt(encrypted)
+	s.NoError(err)
+	s.Equal(payload, decrypted)
+
+	reencrypted, err := rotated.Encrypt(payload)
+	s.NoError(err)
+	s.NotEqual(encrypted, reencrypted)
+	decrypted, err = rotated.Decrypt(reencrypted)
+	s.NoError(err)
+	s.Equal(payload, decrypted)
+}
+
+func (s *encryptionSuite) TestDecrypt_UnknownKey() {
+	keyProvider := &staticKeyProvider{keyID: "key-3", key: testKey1}
+	encrypted, err := NewAESEncryptor(keyProvider).Encrypt([]byte("payload"))
+	s.NoError(err)
+
+	_, err = s.newEncryptor("key-1").Decrypt(encrypted)
+	s.Equal(ErrKeyNotFound, err)
+}
+
+func (s *encryptionSuite) TestDecrypt_Tampered() {
+	encryptor := s.newEncryptor("key-1")
+	encrypted, err := encryptor.Encrypt([]byte("payload"))
+	s.NoError(err)
+
+	tampered := append([]byte{}, encrypted...)
+	tampered[len(tampered)-1] ^= 0xff
+	_, err = encryptor.Decrypt(tampered)
+	s.Error(err)
+
+	_, err = encryptor.Decrypt(encrypted[:len(payloadPreamble)+1])
+	s.Equal(ErrMalformedPayload, err)
+}
+
+func (s *encryptionSuite) TestNewFileKeyProvider_Invalid() {
+	s.writeKeyFile("key-3")
+	_, err := NewFileKeyProvider(&config.FileKeyProvider{KeyFile: s.keyFile})
+	s.Error(err)
+
+	s.NoError(ioutil.WriteFile(s.keyFile, []byte("currentKeyID: key-1\nkeys:\n  key-1: c2hvcnQ=\n"), 0600))
+	_, err = NewFileKeyProvider(&config.FileKeyProvider{KeyFile: s.keyFile})
+	s.Error(err)
+
+	_, err = NewKeyProvider(&config.Encryption{})
+	s.Equal(ErrKeyProviderNotConfigured, err)
+}
+
+type staticKeyProvider struct {
+	keyID string
+	key   []byte
+}
+
+func (p *staticKeyProvider) GetCurrentKey() (string, []byte, error) {
+	return p.keyID, p.key, nil
+}
+
+func (p *staticKeyProvider) GetKey(keyID string) ([]byte, error) {
+	if keyID != p.keyID {
+		return nil, ErrKeyNotFound
+	}
+	return p.key, nil
+}
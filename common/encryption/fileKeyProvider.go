@@ -0,0 +1,98 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/uber/cadence/common/service/config"
+	"gopkg.in/yaml.v2"
+)
+
+type (
+	// keyFile is the content of the key file, for example:
+	//
+	//     currentKeyID: key-2
+	//     keys:
+	//       key-1: <base64 encoded 16, 24 or 32 byte AES key>
+	//       key-2: <base64 encoded 16, 24 or 32 byte AES key>
+	keyFile struct {
+		CurrentKeyID string            `yaml:"currentKeyID"`
+		Keys         map[string]string `yaml:"keys"`
+	}
+
+	fileKeyProvider struct {
+		currentKeyID string
+		keys         map[string][]byte
+	}
+)
+
+// NewFileKeyProvider returns a KeyProvider serving the keys of a local yaml file. The file is
+// read once, keys are rotated by adding a new key, making it the current one and restarting
+// the hosts. Previous keys have to be kept in the file for as long as data encrypted with
+// them is retained.
+func NewFileKeyProvider(cfg *config.FileKeyProvider) (KeyProvider, error) {
+	content, err := ioutil.ReadFile(cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read encryption key file %v: %v", cfg.KeyFile, err)
+	}
+	var file keyFile
+	if err := yaml.Unmarshal(content, &file); err != nil {
+		return nil, fmt.Errorf("failed to decode encryption key file %v: %v", cfg.KeyFile, err)
+	}
+
+	keys := make(map[string][]byte, len(file.Keys))
+	for keyID, encodedKey := range file.Keys {
+		if len(keyID) == 0 || len(keyID) > maxKeyIDLength {
+			return nil, fmt.Errorf("invalid encryption key ID %q", keyID)
+		}
+		key, err := base64.StdEncoding.DecodeString(encodedKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode encryption key %v: %v", keyID, err)
+		}
+		if _, err := newAEAD(key); err != nil {
+			return nil, fmt.Errorf("invalid encryption key %v: %v", keyID, err)
+		}
+		keys[keyID] = key
+	}
+	if _, ok := keys[file.CurrentKeyID]; !ok {
+		return nil, fmt.Errorf("current encryption key %q not found in %v", file.CurrentKeyID, cfg.KeyFile)
+	}
+
+	return &fileKeyProvider{
+		currentKeyID: file.CurrentKeyID,
+		keys:         keys,
+	}, nil
+}
+
+func (p *fileKeyProvider) GetCurrentKey() (string, []byte, error) {
+	return p.currentKeyID, p.keys[p.currentKeyID], nil
+}
+
+func (p *fileKeyProvider) GetKey(keyID string) ([]byte, error) {
+	key, ok := p.keys[keyID]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	return key, nil
+}
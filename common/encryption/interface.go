@@ -0,0 +1,64 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package encryption
+
+import (
+	"errors"
+
+	"github.com/uber/cadence/common/service/config"
+)
+
+type (
+	// KeyProvider provides the keys used to encrypt and decrypt payloads. Each key is identified by
+	// an ID which is stored alongside the encrypted payload, so keys can be rotated by changing the
+	// current key while keeping the previous keys available for decryption.
+	KeyProvider interface {
+		// GetCurrentKey returns the key new payloads are encrypted with, and its ID
+		GetCurrentKey() (keyID string, key []byte, err error)
+		// GetKey returns the key with the given ID
+		GetKey(keyID string) ([]byte, error)
+	}
+
+	// Encryptor encrypts and decrypts payloads
+	Encryptor interface {
+		// Encrypt encrypts the given payload with the current key
+		Encrypt(data []byte) ([]byte, error)
+		// Decrypt decrypts the given payload with the key it was encrypted with
+		Decrypt(data []byte) ([]byte, error)
+	}
+)
+
+var (
+	// ErrKeyNotFound is the error for an unknown key ID
+	ErrKeyNotFound = errors.New("encryption key not found")
+	// ErrMalformedPayload is the error for an encrypted payload which cannot be parsed
+	ErrMalformedPayload = errors.New("malformed encrypted payload")
+	// ErrKeyProviderNotConfigured is the error for an encryption config without a key provider
+	ErrKeyProviderNotConfigured = errors.New("no encryption key provider is configured")
+)
+
+// NewKeyProvider returns the key provider specified by the given config
+func NewKeyProvider(cfg *config.Encryption) (KeyProvider, error) {
+	if cfg.FileKeyProvider != nil {
+		return NewFileKeyProvider(cfg.FileKeyProvider)
+	}
+	return nil, ErrKeyProviderNotConfigured
+}
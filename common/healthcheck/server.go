@@ -0,0 +1,191 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package healthcheck
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+)
+
+type (
+	// Check returns an error if the component it verifies is not healthy
+	Check func() error
+
+	// Server serves the liveness and readiness endpoints of a service over HTTP,
+	// for use by load balancers and orchestrators such as kubernetes
+	Server interface {
+		common.Daemon
+		// AddReadinessCheck registers a check which has to pass for the service to be ready
+		AddReadinessCheck(name string, check Check)
+		// SetServing marks whether the service accepts requests, the service is never
+		// ready before it is started or after it starts shutting down
+		SetServing(serving bool)
+	}
+
+	namedCheck struct {
+		name  string
+		check Check
+	}
+
+	readinessResponse struct {
+		Status string            `json:"status"`
+		Checks map[string]string `json:"checks,omitempty"`
+	}
+
+	serverImpl struct {
+		sync.RWMutex
+		status     int32
+		serving    int32
+		port       int
+		logger     log.Logger
+		checks     []namedCheck
+		httpServer *http.Server
+	}
+)
+
+const (
+	// LivenessPath is the path of the liveness endpoint
+	LivenessPath = "/health/live"
+	// ReadinessPath is the path of the readiness endpoint
+	ReadinessPath = "/health/ready"
+
+	statusReady      = "ready"
+	statusNotReady   = "not ready"
+	statusNotServing = "not serving"
+	checkPassed      = "ok"
+)
+
+var _ Server = (*serverImpl)(nil)
+
+// NewServer creates a health check server listening on the given port,
+// no endpoint is served if the port is 0
+func NewServer(port int, logger log.Logger) Server {
+	s := &serverImpl{
+		port:   port,
+		logger: logger,
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(LivenessPath, s.handleLiveness)
+	mux.HandleFunc(ReadinessPath, s.handleReadiness)
+	s.httpServer = &http.Server{Handler: mux}
+	return s
+}
+
+// Start starts serving the health check endpoints
+func (s *serverImpl) Start() {
+	if !atomic.CompareAndSwapInt32(&s.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return
+	}
+	if s.port == 0 {
+		s.logger.Info("Health check endpoints not started due to port not set")
+		return
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", s.port))
+	if err != nil {
+		s.logger.Error("Failed to listen for health check endpoints", tag.Port(s.port), tag.Error(err))
+		return
+	}
+	s.logger.Info("Health check endpoints listen on ", tag.Port(s.port))
+	go func() {
+		if err := s.httpServer.Serve(listener); err != nil && err != http.ErrServerClosed {
+			s.logger.Error("Health check server stopped unexpectedly", tag.Error(err))
+		}
+	}()
+}
+
+// Stop stops serving the health check endpoints
+func (s *serverImpl) Stop() {
+	if !atomic.CompareAndSwapInt32(&s.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	s.SetServing(false)
+	if s.port != 0 {
+		s.httpServer.Close()
+	}
+}
+
+// AddReadinessCheck registers a check which has to pass for the service to be ready
+func (s *serverImpl) AddReadinessCheck(name string, check Check) {
+	s.Lock()
+	defer s.Unlock()
+	s.checks = append(s.checks, namedCheck{name: name, check: check})
+}
+
+// SetServing marks whether the service accepts requests
+func (s *serverImpl) SetServing(serving bool) {
+	if serving {
+		atomic.StoreInt32(&s.serving, 1)
+	} else {
+		atomic.StoreInt32(&s.serving, 0)
+	}
+}
+
+func (s *serverImpl) handleLiveness(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintln(w, "alive")
+}
+
+func (s *serverImpl) handleReadiness(w http.ResponseWriter, r *http.Request) {
+	ready, response := s.readiness()
+	w.Header().Set("Content-Type", "application/json")
+	if ready {
+		w.WriteHeader(http.StatusOK)
+	} else {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(response)
+}
+
+func (s *serverImpl) readiness() (bool, *readinessResponse) {
+	if atomic.LoadInt32(&s.serving) == 0 {
+		return false, &readinessResponse{Status: statusNotServing}
+	}
+
+	s.RLock()
+	checks := s.checks
+	s.RUnlock()
+
+	ready := true
+	results := make(map[string]string, len(checks))
+	for _, c := range checks {
+		if err := c.check(); err != nil {
+			ready = false
+			results[c.name] = err.Error()
+			continue
+		}
+		results[c.name] = checkPassed
+	}
+
+	response := &readinessResponse{Status: statusReady, Checks: results}
+	if !ready {
+		response.Status = statusNotReady
+	}
+	return ready, response
+}
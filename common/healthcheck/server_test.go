@@ -0,0 +1,109 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package healthcheck
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/uber/cadence/common/log/loggerimpl"
+)
+
+type (
+	serverSuite struct {
+		suite.Suite
+		server *serverImpl
+	}
+)
+
+func TestServerSuite(t *testing.T) {
+	suite.Run(t, new(serverSuite))
+}
+
+func (s *serverSuite) SetupTest() {
+	s.server = NewServer(0, loggerimpl.NewNopLogger()).(*serverImpl)
+}
+
+func (s *serverSuite) TestLiveness() {
+	recorder := s.request(LivenessPath)
+	s.Equal(http.StatusOK, recorder.Code)
+}
+
+func (s *serverSuite) TestReadiness_NotServing() {
+	s.server.AddReadinessCheck("check", func() error { return nil })
+
+	recorder := s.request(ReadinessPath)
+	s.Equal(http.StatusServiceUnavailable, recorder.Code)
+	s.Equal(statusNotServing, s.decode(recorder).Status)
+}
+
+func (s *serverSuite) TestReadiness_Ready() {
+	s.server.AddReadinessCheck("persistence", func() error { return nil })
+	s.server.AddReadinessCheck("membership", func() error { return nil })
+	s.server.SetServing(true)
+
+	recorder := s.request(ReadinessPath)
+	s.Equal(http.StatusOK, recorder.Code)
+	response := s.decode(recorder)
+	s.Equal(statusReady, response.Status)
+	s.Equal(map[string]string{"persistence": checkPassed, "membership": checkPassed}, response.Checks)
+}
+
+func (s *serverSuite) TestReadiness_CheckFailed() {
+	s.server.AddReadinessCheck("persistence", func() error { return nil })
+	s.server.AddReadinessCheck("membership", func() error { return errors.New("host not in ring") })
+	s.server.SetServing(true)
+
+	recorder := s.request(ReadinessPath)
+	s.Equal(http.StatusServiceUnavailable, recorder.Code)
+	response := s.decode(recorder)
+	s.Equal(statusNotReady, response.Status)
+	s.Equal(map[string]string{"persistence": checkPassed, "membership": "host not in ring"}, response.Checks)
+
+	s.server.SetServing(false)
+	recorder = s.request(ReadinessPath)
+	s.Equal(http.StatusServiceUnavailable, recorder.Code)
+	s.Equal(statusNotServing, s.decode(recorder).Status)
+}
+
+func (s *serverSuite) TestStartStop() {
+	server := NewServer(0, loggerimpl.NewNopLogger())
+	server.Start()
+	server.SetServing(true)
+	server.Stop()
+	s.Equal(int32(0), server.(*serverImpl).serving)
+}
+
+func (s *serverSuite) request(path string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	s.server.httpServer.Handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+	return recorder
+}
+
+func (s *serverSuite) decode(recorder *httptest.ResponseRecorder) *readinessResponse {
+	response := &readinessResponse{}
+	s.NoError(json.NewDecoder(recorder.Body).Decode(response))
+	return response
+}
@@ -116,6 +116,16 @@ func WorkflowBinaryChecksum(cs string) Tag {
 	return newStringTag("wf-binary-checksum", cs)
 }
 
+// WorkflowRequestIdentity returns tag for WorkflowRequestIdentity
+func WorkflowRequestIdentity(identity string) Tag {
+	return newStringTag("wf-request-identity", identity)
+}
+
+// WorkflowRequestReason returns tag for WorkflowRequestReason
+func WorkflowRequestReason(reason string) Tag {
+	return newStringTag("wf-request-reason", reason)
+}
+
 // WorkflowActivityID returns tag for WorkflowActivityID
 func WorkflowActivityID(id string) Tag {
 	return newStringTag("wf-activity-id", id)
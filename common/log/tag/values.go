@@ -36,6 +36,12 @@ var (
 	WorkflowActionWorkflowSignaled               = workflowAction("add-workflow-signaled-event")
 	WorkflowActionWorkflowRecordMarker           = workflowAction("add-workflow-marker-record-event")
 	WorkflowActionUpsertWorkflowSearchAttributes = workflowAction("add-workflow-upsert-search-attributes-event")
+	WorkflowActionWorkflowUpdateRequested        = workflowAction("add-workflow-update-requested-event")
+	WorkflowActionWorkflowUpdateCompleted        = workflowAction("add-workflow-update-completed-event")
+	WorkflowActionWorkflowPaused                 = workflowAction("add-workflow-paused-event")
+	WorkflowActionWorkflowUnpaused               = workflowAction("add-workflow-unpaused-event")
+	WorkflowActionWorkflowDelayedSignalRequested = workflowAction("add-workflow-delayed-signal-requested-event")
+	WorkflowActionWorkflowDelayedSignalCanceled  = workflowAction("add-workflow-delayed-signal-canceled-event")
 
 	// decision
 	WorkflowActionDecisionTaskScheduled = workflowAction("add-decisiontask-scheduled-event")
@@ -115,6 +121,7 @@ var (
 	ComponentESVisibilityManager      = component("es-visibility-manager")
 	ComponentArchiver                 = component("archiver")
 	ComponentBatcher                  = component("batcher")
+	ComponentScheduler                = component("scheduler")
 	ComponentWorker                   = component("worker")
 	ComponentServiceResolver          = component("service-resolver")
 )
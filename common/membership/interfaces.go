@@ -74,5 +74,7 @@ type (
 		AddListener(name string, notifyChannel chan<- *ChangedEvent) error
 		// RemoveListener removes a listener for this service.
 		RemoveListener(name string) error
+		// Members returns all the member hosts of this service
+		Members() []*HostInfo
 	}
 )
@@ -135,6 +135,18 @@ func (r *ringpopServiceResolver) Lookup(key string) (*HostInfo, error) {
 	return NewHostInfo(addr, r.getLabelsMap()), nil
 }
 
+// Members returns all the hosts currently in the ring
+func (r *ringpopServiceResolver) Members() []*HostInfo {
+	r.ringLock.RLock()
+	defer r.ringLock.RUnlock()
+
+	var hosts []*HostInfo
+	for _, addr := range r.ring.Servers() {
+		hosts = append(hosts, NewHostInfo(addr, r.getLabelsMap()))
+	}
+	return hosts
+}
+
 func (r *ringpopServiceResolver) AddListener(name string, notifyChannel chan<- *ChangedEvent) error {
 	r.listenerLock.Lock()
 	defer r.listenerLock.Unlock()
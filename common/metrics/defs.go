@@ -258,12 +258,20 @@ const (
 	HistoryClientRequestCancelWorkflowExecutionScope
 	// HistoryClientSignalWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientSignalWorkflowExecutionScope
+	// HistoryClientCancelDelayedSignalScope tracks RPC calls to history service
+	HistoryClientCancelDelayedSignalScope
+	// HistoryClientUpdateWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientUpdateWorkflowExecutionScope
 	// HistoryClientSignalWithStartWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientSignalWithStartWorkflowExecutionScope
 	// HistoryClientRemoveSignalMutableStateScope tracks RPC calls to history service
 	HistoryClientRemoveSignalMutableStateScope
 	// HistoryClientTerminateWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientTerminateWorkflowExecutionScope
+	// HistoryClientPauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientPauseWorkflowExecutionScope
+	// HistoryClientUnpauseWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientUnpauseWorkflowExecutionScope
 	// HistoryClientResetWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientResetWorkflowExecutionScope
 	// HistoryClientScheduleDecisionTaskScope tracks RPC calls to history service
@@ -350,10 +358,30 @@ const (
 	FrontendClientSignalWithStartWorkflowExecutionScope
 	// FrontendClientSignalWorkflowExecutionScope tracks RPC calls to frontend service
 	FrontendClientSignalWorkflowExecutionScope
+	// FrontendClientCancelDelayedSignalScope tracks RPC calls to frontend service
+	FrontendClientCancelDelayedSignalScope
+	// FrontendClientCreateScheduleScope tracks RPC calls to frontend service
+	FrontendClientCreateScheduleScope
+	// FrontendClientDescribeScheduleScope tracks RPC calls to frontend service
+	FrontendClientDescribeScheduleScope
+	// FrontendClientUpdateScheduleScope tracks RPC calls to frontend service
+	FrontendClientUpdateScheduleScope
+	// FrontendClientPauseScheduleScope tracks RPC calls to frontend service
+	FrontendClientPauseScheduleScope
+	// FrontendClientBackfillScheduleScope tracks RPC calls to frontend service
+	FrontendClientBackfillScheduleScope
+	// FrontendClientDeleteScheduleScope tracks RPC calls to frontend service
+	FrontendClientDeleteScheduleScope
+	// FrontendClientUpdateWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientUpdateWorkflowExecutionScope
 	// FrontendClientStartWorkflowExecutionScope tracks RPC calls to frontend service
 	FrontendClientStartWorkflowExecutionScope
 	// FrontendClientTerminateWorkflowExecutionScope tracks RPC calls to frontend service
 	FrontendClientTerminateWorkflowExecutionScope
+	// FrontendClientPauseWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientPauseWorkflowExecutionScope
+	// FrontendClientUnpauseWorkflowExecutionScope tracks RPC calls to frontend service
+	FrontendClientUnpauseWorkflowExecutionScope
 	// FrontendClientUpdateDomainScope tracks RPC calls to frontend service
 	FrontendClientUpdateDomainScope
 	// AdminClientAddSearchAttributeScope tracks RPC calls to admin service
@@ -364,6 +392,20 @@ const (
 	AdminClientDescribeWorkflowExecutionScope
 	// AdminClientGetWorkflowExecutionRawHistoryScope tracks RPC calls to admin service
 	AdminClientGetWorkflowExecutionRawHistoryScope
+	// AdminClientDescribeShardQueuesScope tracks RPC calls to admin service
+	AdminClientDescribeShardQueuesScope
+	// AdminClientListShardTasksScope tracks RPC calls to admin service
+	AdminClientListShardTasksScope
+	// AdminClientRemoveShardTaskScope tracks RPC calls to admin service
+	AdminClientRemoveShardTaskScope
+	// AdminClientCloseShardScope tracks RPC calls to admin service
+	AdminClientCloseShardScope
+	// AdminClientDescribeClusterScope tracks RPC calls to admin service
+	AdminClientDescribeClusterScope
+	// AdminClientRefreshWorkflowTasksScope tracks RPC calls to admin service
+	AdminClientRefreshWorkflowTasksScope
+	// AdminClientDeleteWorkflowExecutionScope tracks RPC calls to admin service
+	AdminClientDeleteWorkflowExecutionScope
 	// DCRedirectionDeprecateDomainScope tracks RPC calls for dc redirection
 	DCRedirectionDeprecateDomainScope
 	// DCRedirectionDescribeDomainScope tracks RPC calls for dc redirection
@@ -428,10 +470,30 @@ const (
 	DCRedirectionSignalWithStartWorkflowExecutionScope
 	// DCRedirectionSignalWorkflowExecutionScope tracks RPC calls for dc redirection
 	DCRedirectionSignalWorkflowExecutionScope
+	// DCRedirectionCancelDelayedSignalScope tracks RPC calls for dc redirection
+	DCRedirectionCancelDelayedSignalScope
+	// DCRedirectionCreateScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionCreateScheduleScope
+	// DCRedirectionDescribeScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionDescribeScheduleScope
+	// DCRedirectionUpdateScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionUpdateScheduleScope
+	// DCRedirectionPauseScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionPauseScheduleScope
+	// DCRedirectionBackfillScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionBackfillScheduleScope
+	// DCRedirectionDeleteScheduleScope tracks RPC calls for dc redirection
+	DCRedirectionDeleteScheduleScope
+	// DCRedirectionUpdateWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionUpdateWorkflowExecutionScope
 	// DCRedirectionStartWorkflowExecutionScope tracks RPC calls for dc redirection
 	DCRedirectionStartWorkflowExecutionScope
 	// DCRedirectionTerminateWorkflowExecutionScope tracks RPC calls for dc redirection
 	DCRedirectionTerminateWorkflowExecutionScope
+	// DCRedirectionPauseWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionPauseWorkflowExecutionScope
+	// DCRedirectionUnpauseWorkflowExecutionScope tracks RPC calls for dc redirection
+	DCRedirectionUnpauseWorkflowExecutionScope
 	// DCRedirectionUpdateDomainScope tracks RPC calls for dc redirection
 	DCRedirectionUpdateDomainScope
 
@@ -515,6 +577,20 @@ const (
 	AdminDescribeWorkflowExecutionScope
 	// AdminGetWorkflowExecutionRawHistoryScope is the metric scope for admin.GetWorkflowExecutionRawHistoryScope
 	AdminGetWorkflowExecutionRawHistoryScope
+	// AdminDescribeShardQueuesScope is the metric scope for admin.DescribeShardQueues
+	AdminDescribeShardQueuesScope
+	// AdminListShardTasksScope is the metric scope for admin.ListShardTasks
+	AdminListShardTasksScope
+	// AdminRemoveShardTaskScope is the metric scope for admin.RemoveShardTask
+	AdminRemoveShardTaskScope
+	// AdminCloseShardScope is the metric scope for admin.CloseShard
+	AdminCloseShardScope
+	// AdminDescribeClusterScope is the metric scope for admin.DescribeCluster
+	AdminDescribeClusterScope
+	// AdminRefreshWorkflowTasksScope is the metric scope for admin.RefreshWorkflowTasks
+	AdminRefreshWorkflowTasksScope
+	// AdminDeleteWorkflowExecutionScope is the metric scope for admin.DeleteWorkflowExecution
+	AdminDeleteWorkflowExecutionScope
 
 	NumAdminScopes
 )
@@ -553,10 +629,30 @@ const (
 	FrontendGetWorkflowExecutionHistoryScope
 	// FrontendSignalWorkflowExecutionScope is the metric scope for frontend.SignalWorkflowExecution
 	FrontendSignalWorkflowExecutionScope
+	// FrontendCancelDelayedSignalScope is the metric scope for frontend.CancelDelayedSignal
+	FrontendCancelDelayedSignalScope
+	// FrontendCreateScheduleScope is the metric scope for frontend.CreateSchedule
+	FrontendCreateScheduleScope
+	// FrontendDescribeScheduleScope is the metric scope for frontend.DescribeSchedule
+	FrontendDescribeScheduleScope
+	// FrontendUpdateScheduleScope is the metric scope for frontend.UpdateSchedule
+	FrontendUpdateScheduleScope
+	// FrontendPauseScheduleScope is the metric scope for frontend.PauseSchedule
+	FrontendPauseScheduleScope
+	// FrontendBackfillScheduleScope is the metric scope for frontend.BackfillSchedule
+	FrontendBackfillScheduleScope
+	// FrontendDeleteScheduleScope is the metric scope for frontend.DeleteSchedule
+	FrontendDeleteScheduleScope
+	// FrontendUpdateWorkflowExecutionScope is the metric scope for frontend.UpdateWorkflowExecution
+	FrontendUpdateWorkflowExecutionScope
 	// FrontendSignalWithStartWorkflowExecutionScope is the metric scope for frontend.SignalWithStartWorkflowExecution
 	FrontendSignalWithStartWorkflowExecutionScope
 	// FrontendTerminateWorkflowExecutionScope is the metric scope for frontend.TerminateWorkflowExecution
 	FrontendTerminateWorkflowExecutionScope
+	// FrontendPauseWorkflowExecutionScope is the metric scope for frontend.PauseWorkflowExecution
+	FrontendPauseWorkflowExecutionScope
+	// FrontendUnpauseWorkflowExecutionScope is the metric scope for frontend.UnpauseWorkflowExecution
+	FrontendUnpauseWorkflowExecutionScope
 	// FrontendRequestCancelWorkflowExecutionScope is the metric scope for frontend.RequestCancelWorkflowExecution
 	FrontendRequestCancelWorkflowExecutionScope
 	// FrontendListOpenWorkflowExecutionsScope is the metric scope for frontend.ListOpenWorkflowExecutions
@@ -623,12 +719,20 @@ const (
 	HistoryRecordActivityTaskStartedScope
 	// HistorySignalWorkflowExecutionScope tracks SignalWorkflowExecution API calls received by service
 	HistorySignalWorkflowExecutionScope
+	// HistoryCancelDelayedSignalScope tracks CancelDelayedSignal API calls received by service
+	HistoryCancelDelayedSignalScope
+	// HistoryUpdateWorkflowExecutionScope tracks UpdateWorkflowExecution API calls received by service
+	HistoryUpdateWorkflowExecutionScope
 	// HistorySignalWithStartWorkflowExecutionScope tracks SignalWithStartWorkflowExecution API calls received by service
 	HistorySignalWithStartWorkflowExecutionScope
 	// HistoryRemoveSignalMutableStateScope tracks RemoveSignalMutableState API calls received by service
 	HistoryRemoveSignalMutableStateScope
 	// HistoryTerminateWorkflowExecutionScope tracks TerminateWorkflowExecution API calls received by service
 	HistoryTerminateWorkflowExecutionScope
+	// HistoryPauseWorkflowExecutionScope tracks PauseWorkflowExecution API calls received by service
+	HistoryPauseWorkflowExecutionScope
+	// HistoryUnpauseWorkflowExecutionScope tracks UnpauseWorkflowExecution API calls received by service
+	HistoryUnpauseWorkflowExecutionScope
 	// HistoryScheduleDecisionTaskScope tracks ScheduleDecisionTask API calls received by service
 	HistoryScheduleDecisionTaskScope
 	// HistoryRecordChildExecutionCompletedScope tracks CompleteChildExecution API calls received by service
@@ -645,6 +749,18 @@ const (
 	HistorySyncActivityScope
 	// HistoryDescribeMutableStateScope tracks HistoryActivity API calls received by service
 	HistoryDescribeMutableStateScope
+	// HistoryDescribeShardQueuesScope tracks DescribeShardQueues API calls received by service
+	HistoryDescribeShardQueuesScope
+	// HistoryListShardTasksScope tracks ListShardTasks API calls received by service
+	HistoryListShardTasksScope
+	// HistoryRemoveShardTaskScope tracks RemoveShardTask API calls received by service
+	HistoryRemoveShardTaskScope
+	// HistoryCloseShardScope tracks CloseShard API calls received by service
+	HistoryCloseShardScope
+	// HistoryRefreshWorkflowTasksScope tracks RefreshWorkflowTasks API calls received by service
+	HistoryRefreshWorkflowTasksScope
+	// HistoryDeleteWorkflowExecutionScope tracks DeleteWorkflowExecution API calls received by service
+	HistoryDeleteWorkflowExecutionScope
 	// HistoryShardControllerScope is the scope used by shard controller
 	HistoryShardControllerScope
 	// TransferQueueProcessorScope is the scope used by all metric emitted by transfer queue processor
@@ -707,6 +823,8 @@ const (
 	TimerActiveTaskActivityRetryTimerScope
 	// TimerActiveTaskWorkflowBackoffTimerScope is the scope used by metric emitted by timer queue processor for processing retry task.
 	TimerActiveTaskWorkflowBackoffTimerScope
+	// TimerActiveTaskDelayedSignalTimerScope is the scope used by metric emitted by timer queue processor for processing delayed signal task.
+	TimerActiveTaskDelayedSignalTimerScope
 	// TimerActiveTaskDeleteHistoryEventScope is the scope used by metric emitted by timer queue processor for processing history event cleanup
 	TimerActiveTaskDeleteHistoryEventScope
 	// TimerStandbyTaskActivityTimeoutScope is the scope used by metric emitted by timer queue processor for processing activity timeouts
@@ -723,6 +841,8 @@ const (
 	TimerStandbyTaskDeleteHistoryEventScope
 	// TimerStandbyTaskWorkflowBackoffTimerScope is the scope used by metric emitted by timer queue processor for processing retry task.
 	TimerStandbyTaskWorkflowBackoffTimerScope
+	// TimerStandbyTaskDelayedSignalTimerScope is the scope used by metric emitted by timer queue processor for processing delayed signal task.
+	TimerStandbyTaskDelayedSignalTimerScope
 	// HistoryEventNotificationScope is the scope used by shard history event nitification
 	HistoryEventNotificationScope
 	// ReplicatorQueueProcessorScope is the scope used by all metric emitted by replicator queue processor
@@ -829,6 +949,8 @@ const (
 	TaskListScavengerScope
 	// BatcherScope is scope used by all metrics emitted by worker.Batcher module
 	BatcherScope
+	// SchedulerScope is scope used by all metrics emitted by worker.Scheduler module
+	SchedulerScope
 
 	NumWorkerScopes
 )
@@ -912,9 +1034,13 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryClientRecordActivityTaskStartedScope:         {operation: "HistoryClientRecordActivityTaskStarted", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRequestCancelWorkflowExecutionScope:    {operation: "HistoryClientRequestCancelWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientSignalWorkflowExecutionScope:           {operation: "HistoryClientSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientCancelDelayedSignalScope:               {operation: "HistoryClientCancelDelayedSignal", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientUpdateWorkflowExecutionScope:           {operation: "HistoryClientUpdateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientSignalWithStartWorkflowExecutionScope:  {operation: "HistoryClientSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRemoveSignalMutableStateScope:          {operation: "HistoryClientRemoveSignalMutableStateScope", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientTerminateWorkflowExecutionScope:        {operation: "HistoryClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientPauseWorkflowExecutionScope:            {operation: "HistoryClientPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
+		HistoryClientUnpauseWorkflowExecutionScope:          {operation: "HistoryClientUnpauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientResetWorkflowExecutionScope:            {operation: "HistoryClientResetWorkflowExecution", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientScheduleDecisionTaskScope:              {operation: "HistoryClientScheduleDecisionTask", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
 		HistoryClientRecordChildExecutionCompletedScope:     {operation: "HistoryClientRecordChildExecutionCompleted", tags: map[string]string{CadenceRoleTagName: HistoryRoleTagValue}},
@@ -958,13 +1084,30 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendClientRespondQueryTaskCompletedScope:        {operation: "FrontendClientRespondQueryTaskCompleted", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientSignalWithStartWorkflowExecutionScope: {operation: "FrontendClientSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientSignalWorkflowExecutionScope:          {operation: "FrontendClientSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientCancelDelayedSignalScope:              {operation: "FrontendClientCancelDelayedSignal", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientCreateScheduleScope:                   {operation: "FrontendClientCreateSchedule", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientDescribeScheduleScope:                 {operation: "FrontendClientDescribeSchedule", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientUpdateScheduleScope:                   {operation: "FrontendClientUpdateSchedule", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientPauseScheduleScope:                    {operation: "FrontendClientPauseSchedule", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientBackfillScheduleScope:                 {operation: "FrontendClientBackfillSchedule", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientDeleteScheduleScope:                   {operation: "FrontendClientDeleteSchedule", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientUpdateWorkflowExecutionScope:          {operation: "FrontendClientUpdateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientStartWorkflowExecutionScope:           {operation: "FrontendClientStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientTerminateWorkflowExecutionScope:       {operation: "FrontendClientTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientPauseWorkflowExecutionScope:           {operation: "FrontendClientPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
+		FrontendClientUnpauseWorkflowExecutionScope:         {operation: "FrontendClientUnpauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		FrontendClientUpdateDomainScope:                     {operation: "FrontendClientUpdateDomain", tags: map[string]string{CadenceRoleTagName: FrontendRoleTagValue}},
 		AdminClientAddSearchAttributeScope:                  {operation: "AdminClientAddSearchAttribute", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientDescribeHistoryHostScope:                 {operation: "AdminClientDescribeHistoryHost", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientDescribeWorkflowExecutionScope:           {operation: "AdminClientDescribeWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		AdminClientGetWorkflowExecutionRawHistoryScope:      {operation: "AdminClientGetWorkflowExecutionRawHistory", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeShardQueuesScope:                 {operation: "AdminClientDescribeShardQueues", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientListShardTasksScope:                      {operation: "AdminClientListShardTasks", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientRemoveShardTaskScope:                     {operation: "AdminClientRemoveShardTask", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientCloseShardScope:                          {operation: "AdminClientCloseShard", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientDescribeClusterScope:                     {operation: "AdminClientDescribeCluster", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientRefreshWorkflowTasksScope:                {operation: "AdminClientRefreshWorkflowTasks", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
+		AdminClientDeleteWorkflowExecutionScope:             {operation: "AdminClientDeleteWorkflowExecution", tags: map[string]string{CadenceRoleTagName: AdminRoleTagValue}},
 		DCRedirectionDeprecateDomainScope:                   {operation: "DCRedirectionDeprecateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionDescribeDomainScope:                    {operation: "DCRedirectionDescribeDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionDescribeTaskListScope:                  {operation: "DCRedirectionDescribeTaskList", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
@@ -997,8 +1140,18 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		DCRedirectionRespondQueryTaskCompletedScope:         {operation: "DCRedirectionRespondQueryTaskCompleted", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionSignalWithStartWorkflowExecutionScope:  {operation: "DCRedirectionSignalWithStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionSignalWorkflowExecutionScope:           {operation: "DCRedirectionSignalWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionCancelDelayedSignalScope:               {operation: "DCRedirectionCancelDelayedSignal", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionCreateScheduleScope:                    {operation: "DCRedirectionCreateSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionDescribeScheduleScope:                  {operation: "DCRedirectionDescribeSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUpdateScheduleScope:                    {operation: "DCRedirectionUpdateSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionPauseScheduleScope:                     {operation: "DCRedirectionPauseSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionBackfillScheduleScope:                  {operation: "DCRedirectionBackfillSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionDeleteScheduleScope:                    {operation: "DCRedirectionDeleteSchedule", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUpdateWorkflowExecutionScope:           {operation: "DCRedirectionUpdateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionStartWorkflowExecutionScope:            {operation: "DCRedirectionStartWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionTerminateWorkflowExecutionScope:        {operation: "DCRedirectionTerminateWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionPauseWorkflowExecutionScope:            {operation: "DCRedirectionPauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
+		DCRedirectionUnpauseWorkflowExecutionScope:          {operation: "DCRedirectionUnpauseWorkflowExecution", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 		DCRedirectionUpdateDomainScope:                      {operation: "DCRedirectionUpdateDomain", tags: map[string]string{CadenceRoleTagName: DCRedirectionRoleTagValue}},
 
 		MessagingClientPublishScope:      {operation: "MessagingClientPublish"},
@@ -1035,6 +1188,13 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		AdminDescribeHistoryHostScope:            {operation: "DescribeHistoryHost"},
 		AdminDescribeWorkflowExecutionScope:      {operation: "DescribeWorkflowExecution"},
 		AdminGetWorkflowExecutionRawHistoryScope: {operation: "GetWorkflowExecutionRawHistory"},
+		AdminDescribeShardQueuesScope:            {operation: "DescribeShardQueues"},
+		AdminListShardTasksScope:                 {operation: "ListShardTasks"},
+		AdminRemoveShardTaskScope:                {operation: "RemoveShardTask"},
+		AdminCloseShardScope:                     {operation: "CloseShard"},
+		AdminDescribeClusterScope:                {operation: "DescribeCluster"},
+		AdminRefreshWorkflowTasksScope:           {operation: "RefreshWorkflowTasks"},
+		AdminDeleteWorkflowExecutionScope:        {operation: "DeleteWorkflowExecution"},
 
 		FrontendStartWorkflowExecutionScope:           {operation: "StartWorkflowExecution"},
 		FrontendPollForDecisionTaskScope:              {operation: "PollForDecisionTask"},
@@ -1052,8 +1212,18 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		FrontendRespondActivityTaskCanceledByIDScope:  {operation: "RespondActivityTaskCanceledByID"},
 		FrontendGetWorkflowExecutionHistoryScope:      {operation: "GetWorkflowExecutionHistory"},
 		FrontendSignalWorkflowExecutionScope:          {operation: "SignalWorkflowExecution"},
+		FrontendCancelDelayedSignalScope:              {operation: "CancelDelayedSignal"},
+		FrontendCreateScheduleScope:                   {operation: "CreateSchedule"},
+		FrontendDescribeScheduleScope:                 {operation: "DescribeSchedule"},
+		FrontendUpdateScheduleScope:                   {operation: "UpdateSchedule"},
+		FrontendPauseScheduleScope:                    {operation: "PauseSchedule"},
+		FrontendBackfillScheduleScope:                 {operation: "BackfillSchedule"},
+		FrontendDeleteScheduleScope:                   {operation: "DeleteSchedule"},
+		FrontendUpdateWorkflowExecutionScope:          {operation: "UpdateWorkflowExecution"},
 		FrontendSignalWithStartWorkflowExecutionScope: {operation: "SignalWithStartWorkflowExecution"},
 		FrontendTerminateWorkflowExecutionScope:       {operation: "TerminateWorkflowExecution"},
+		FrontendPauseWorkflowExecutionScope:           {operation: "PauseWorkflowExecution"},
+		FrontendUnpauseWorkflowExecutionScope:         {operation: "UnpauseWorkflowExecution"},
 		FrontendResetWorkflowExecutionScope:           {operation: "ResetWorkflowExecution"},
 		FrontendRequestCancelWorkflowExecutionScope:   {operation: "RequestCancelWorkflowExecution"},
 		FrontendListOpenWorkflowExecutionsScope:       {operation: "ListOpenWorkflowExecutions"},
@@ -1087,9 +1257,13 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistoryRecordDecisionTaskStartedScope:                  {operation: "RecordDecisionTaskStarted"},
 		HistoryRecordActivityTaskStartedScope:                  {operation: "RecordActivityTaskStarted"},
 		HistorySignalWorkflowExecutionScope:                    {operation: "SignalWorkflowExecution"},
+		HistoryCancelDelayedSignalScope:                        {operation: "CancelDelayedSignal"},
+		HistoryUpdateWorkflowExecutionScope:                    {operation: "UpdateWorkflowExecution"},
 		HistorySignalWithStartWorkflowExecutionScope:           {operation: "SignalWithStartWorkflowExecution"},
 		HistoryRemoveSignalMutableStateScope:                   {operation: "RemoveSignalMutableState"},
 		HistoryTerminateWorkflowExecutionScope:                 {operation: "TerminateWorkflowExecution"},
+		HistoryPauseWorkflowExecutionScope:                     {operation: "PauseWorkflowExecution"},
+		HistoryUnpauseWorkflowExecutionScope:                   {operation: "UnpauseWorkflowExecution"},
 		HistoryResetWorkflowExecutionScope:                     {operation: "ResetWorkflowExecution"},
 		HistoryProcessDeleteHistoryEventScope:                  {operation: "ProcessDeleteHistoryEvent"},
 		HistoryScheduleDecisionTaskScope:                       {operation: "ScheduleDecisionTask"},
@@ -1100,6 +1274,12 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		HistorySyncShardStatusScope:                            {operation: "SyncShardStatus"},
 		HistorySyncActivityScope:                               {operation: "SyncActivity"},
 		HistoryDescribeMutableStateScope:                       {operation: "DescribeMutableState"},
+		HistoryDescribeShardQueuesScope:                        {operation: "DescribeShardQueues"},
+		HistoryListShardTasksScope:                             {operation: "ListShardTasks"},
+		HistoryRemoveShardTaskScope:                            {operation: "RemoveShardTask"},
+		HistoryCloseShardScope:                                 {operation: "CloseShard"},
+		HistoryRefreshWorkflowTasksScope:                       {operation: "RefreshWorkflowTasks"},
+		HistoryDeleteWorkflowExecutionScope:                    {operation: "DeleteWorkflowExecution"},
 		HistoryShardControllerScope:                            {operation: "ShardController"},
 		TransferQueueProcessorScope:                            {operation: "TransferQueueProcessor"},
 		TransferActiveQueueProcessorScope:                      {operation: "TransferActiveQueueProcessor"},
@@ -1131,6 +1311,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TimerActiveTaskWorkflowTimeoutScope:                    {operation: "TimerActiveTaskWorkflowTimeout"},
 		TimerActiveTaskActivityRetryTimerScope:                 {operation: "TimerActiveTaskActivityRetryTimer"},
 		TimerActiveTaskWorkflowBackoffTimerScope:               {operation: "TimerActiveTaskWorkflowBackoffTimer"},
+		TimerActiveTaskDelayedSignalTimerScope:                 {operation: "TimerActiveTaskDelayedSignalTimer"},
 		TimerActiveTaskDeleteHistoryEventScope:                 {operation: "TimerActiveTaskDeleteHistoryEvent"},
 		TimerStandbyTaskActivityTimeoutScope:                   {operation: "TimerStandbyTaskActivityTimeout"},
 		TimerStandbyTaskDecisionTimeoutScope:                   {operation: "TimerStandbyTaskDecisionTimeout"},
@@ -1138,6 +1319,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		TimerStandbyTaskWorkflowTimeoutScope:                   {operation: "TimerStandbyTaskWorkflowTimeout"},
 		TimerStandbyTaskActivityRetryTimerScope:                {operation: "TimerStandbyTaskActivityRetryTimer"},
 		TimerStandbyTaskWorkflowBackoffTimerScope:              {operation: "TimerStandbyTaskWorkflowBackoffTimer"},
+		TimerStandbyTaskDelayedSignalTimerScope:                {operation: "TimerStandbyTaskDelayedSignalTimer"},
 		TimerStandbyTaskDeleteHistoryEventScope:                {operation: "TimerStandbyTaskDeleteHistoryEvent"},
 		HistoryEventNotificationScope:                          {operation: "HistoryEventNotification"},
 		ReplicatorQueueProcessorScope:                          {operation: "ReplicatorQueueProcessor"},
@@ -1190,6 +1372,7 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		ArchiverArchivalWorkflowScope:       {operation: "ArchiverArchivalWorkflow"},
 		TaskListScavengerScope:              {operation: "tasklistscavenger"},
 		BatcherScope:                        {operation: "batcher"},
+		SchedulerScope:                      {operation: "scheduler"},
 	},
 }
 
@@ -1225,6 +1408,10 @@ const (
 	PersistenceErrDomainAlreadyExistsCounter
 	PersistenceErrBadRequestCounter
 	PersistenceSampledCounter
+	PersistenceRequestsPerDomain
+	PersistenceFailuresPerDomain
+	PersistenceLatencyPerDomain
+	PersistenceErrBusyCounterPerDomain
 
 	CadenceClientRequests
 	CadenceClientFailures
@@ -1292,6 +1479,7 @@ const (
 	AckLevelUpdateCounter
 	AckLevelUpdateFailedCounter
 	DecisionTypeScheduleActivityCounter
+	ActivityLocalDispatchCounter
 	DecisionTypeCompleteWorkflowCounter
 	DecisionTypeFailWorkflowCounter
 	DecisionTypeCancelWorkflowCounter
@@ -1304,6 +1492,7 @@ const (
 	DecisionTypeContinueAsNewCounter
 	DecisionTypeSignalExternalWorkflowCounter
 	DecisionTypeUpsertWorkflowSearchAttributesCounter
+	DecisionTypeCompleteWorkflowUpdateCounter
 	MultipleCompletionDecisionsCounter
 	FailedDecisionsCounter
 	StaleMutableStateCounter
@@ -1361,6 +1550,7 @@ const (
 	CacheLatency
 	CacheMissCounter
 	AcquireLockFailedCounter
+	ParentClosePolicySkippedCounter
 	WorkflowContextCleared
 	MutableStateSize
 	ExecutionInfoSize
@@ -1478,6 +1668,8 @@ const (
 	ExecutorTasksDroppedCount
 	BatcherProcessorSuccess
 	BatcherProcessorFailures
+	SchedulerActionsStarted
+	SchedulerActionFailures
 	NumWorkerMetrics
 )
 
@@ -1514,6 +1706,10 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		PersistenceErrDomainAlreadyExistsCounter:            {metricName: "persistence_errors_domain_already_exists", metricType: Counter},
 		PersistenceErrBadRequestCounter:                     {metricName: "persistence_errors_bad_request", metricType: Counter},
 		PersistenceSampledCounter:                           {metricName: "persistence_sampled", metricType: Counter},
+		PersistenceRequestsPerDomain:                        {metricName: "persistence_requests_per_domain", metricType: Counter},
+		PersistenceFailuresPerDomain:                        {metricName: "persistence_errors_per_domain", metricType: Counter},
+		PersistenceLatencyPerDomain:                         {metricName: "persistence_latency_per_domain", metricType: Timer},
+		PersistenceErrBusyCounterPerDomain:                  {metricName: "persistence_errors_busy_per_domain", metricType: Counter},
 		CadenceClientRequests:                               {metricName: "cadence_client_requests", metricType: Counter},
 		CadenceClientFailures:                               {metricName: "cadence_client_errors", metricType: Counter},
 		CadenceClientLatency:                                {metricName: "cadence_client_latency", metricType: Timer},
@@ -1567,6 +1763,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		AckLevelUpdateCounter:                             {metricName: "ack_level_update", metricType: Counter},
 		AckLevelUpdateFailedCounter:                       {metricName: "ack_level_update_failed", metricType: Counter},
 		DecisionTypeScheduleActivityCounter:               {metricName: "schedule_activity_decision", metricType: Counter},
+		ActivityLocalDispatchCounter:                      {metricName: "activity_local_dispatch", metricType: Counter},
 		DecisionTypeCompleteWorkflowCounter:               {metricName: "complete_workflow_decision", metricType: Counter},
 		DecisionTypeFailWorkflowCounter:                   {metricName: "fail_workflow_decision", metricType: Counter},
 		DecisionTypeCancelWorkflowCounter:                 {metricName: "cancel_workflow_decision", metricType: Counter},
@@ -1578,6 +1775,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		DecisionTypeContinueAsNewCounter:                  {metricName: "continue_as_new_decision", metricType: Counter},
 		DecisionTypeSignalExternalWorkflowCounter:         {metricName: "signal_external_workflow_decision", metricType: Counter},
 		DecisionTypeUpsertWorkflowSearchAttributesCounter: {metricName: "upsert_workflow_search_attributes_decision", metricType: Counter},
+		DecisionTypeCompleteWorkflowUpdateCounter:         {metricName: "complete_workflow_update_decision", metricType: Counter},
 		DecisionTypeChildWorkflowCounter:                  {metricName: "child_workflow_decision", metricType: Counter},
 		MultipleCompletionDecisionsCounter:                {metricName: "multiple_completion_decisions", metricType: Counter},
 		FailedDecisionsCounter:                            {metricName: "failed_decisions", metricType: Counter},
@@ -1636,6 +1834,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		CacheLatency:                                      {metricName: "cache_latency", metricType: Timer},
 		CacheMissCounter:                                  {metricName: "cache_miss", metricType: Counter},
 		AcquireLockFailedCounter:                          {metricName: "acquire_lock_failed", metricType: Counter},
+		ParentClosePolicySkippedCounter:                   {metricName: "parent_close_policy_skipped", metricType: Counter},
 		WorkflowContextCleared:                            {metricName: "workflow_context_cleared", metricType: Counter},
 		MutableStateSize:                                  {metricName: "mutable_state_size", metricType: Timer},
 		ExecutionInfoSize:                                 {metricName: "execution_info_size", metricType: Timer},
@@ -1745,6 +1944,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		ExecutorTasksDroppedCount:                {metricName: "executor_dropped", metricType: Counter},
 		BatcherProcessorSuccess:                  {metricName: "batcher_processor_requests", metricType: Counter},
 		BatcherProcessorFailures:                 {metricName: "batcher_processor_errors", metricType: Counter},
+		SchedulerActionsStarted:                  {metricName: "scheduler_actions_started", metricType: Counter},
+		SchedulerActionFailures:                  {metricName: "scheduler_action_errors", metricType: Counter},
 	},
 }
 
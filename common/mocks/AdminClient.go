@@ -58,6 +58,29 @@ func (_m *AdminClient) AddSearchAttribute(ctx context.Context, Request *admin.Ad
 	return r0
 }
 
+// DescribeCluster provides a mock function with given fields: ctx
+func (_m *AdminClient) DescribeCluster(ctx context.Context, opts ...yarpc.CallOption) (*admin.DescribeClusterResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *admin.DescribeClusterResponse
+	if rf, ok := ret.Get(0).(func(context.Context) *admin.DescribeClusterResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*admin.DescribeClusterResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DescribeHistoryHost provides a mock function with given fields: ctx, request
 func (_m *AdminClient) DescribeHistoryHost(ctx context.Context, request *shared.DescribeHistoryHostRequest, opts ...yarpc.CallOption) (*shared.DescribeHistoryHostResponse, error) {
 	ret := _m.Called(ctx, request)
@@ -81,6 +104,108 @@ func (_m *AdminClient) DescribeHistoryHost(ctx context.Context, request *shared.
 	return r0, r1
 }
 
+// DescribeShardQueues provides a mock function with given fields: ctx, request
+func (_m *AdminClient) DescribeShardQueues(ctx context.Context, request *shared.DescribeShardQueuesRequest, opts ...yarpc.CallOption) (*shared.DescribeShardQueuesResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.DescribeShardQueuesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.DescribeShardQueuesRequest) *shared.DescribeShardQueuesResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.DescribeShardQueuesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.DescribeShardQueuesRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListShardTasks provides a mock function with given fields: ctx, request
+func (_m *AdminClient) ListShardTasks(ctx context.Context, request *shared.ListShardTasksRequest, opts ...yarpc.CallOption) (*shared.ListShardTasksResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.ListShardTasksResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.ListShardTasksRequest) *shared.ListShardTasksResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ListShardTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.ListShardTasksRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveShardTask provides a mock function with given fields: ctx, request
+func (_m *AdminClient) RemoveShardTask(ctx context.Context, request *shared.RemoveShardTaskRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.RemoveShardTaskRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CloseShard provides a mock function with given fields: ctx, request
+func (_m *AdminClient) CloseShard(ctx context.Context, request *shared.CloseShardRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.CloseShardRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RefreshWorkflowTasks provides a mock function with given fields: ctx, request
+func (_m *AdminClient) RefreshWorkflowTasks(ctx context.Context, request *admin.RefreshWorkflowTasksRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.RefreshWorkflowTasksRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteWorkflowExecution provides a mock function with given fields: ctx, request
+func (_m *AdminClient) DeleteWorkflowExecution(ctx context.Context, request *admin.DeleteWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *admin.DeleteWorkflowExecutionRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DescribeWorkflowExecution provides a mock function with given fields: ctx, request
 func (_m *AdminClient) DescribeWorkflowExecution(ctx context.Context, request *admin.DescribeWorkflowExecutionRequest, opts ...yarpc.CallOption) (*admin.DescribeWorkflowExecutionResponse, error) {
 	ret := _m.Called(ctx, request)
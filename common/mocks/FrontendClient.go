@@ -149,6 +149,66 @@ func (_m *FrontendClient) DescribeWorkflowExecution(ctx context.Context, Describ
 	return r0, r1
 }
 
+// DescribeSchedule provides a mock function with given fields: ctx, DescribeRequest, opts
+func (_m *FrontendClient) DescribeSchedule(ctx context.Context, DescribeRequest *shared.DescribeScheduleRequest, opts ...yarpc.CallOption) (*shared.DescribeScheduleResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, DescribeRequest)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *shared.DescribeScheduleResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.DescribeScheduleRequest, ...yarpc.CallOption) *shared.DescribeScheduleResponse); ok {
+		r0 = rf(ctx, DescribeRequest, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.DescribeScheduleResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.DescribeScheduleRequest, ...yarpc.CallOption) error); ok {
+		r1 = rf(ctx, DescribeRequest, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateWorkflowExecution provides a mock function with given fields: ctx, UpdateRequest, opts
+func (_m *FrontendClient) UpdateWorkflowExecution(ctx context.Context, UpdateRequest *shared.UpdateWorkflowExecutionRequest, opts ...yarpc.CallOption) (*shared.UpdateWorkflowExecutionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, UpdateRequest)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *shared.UpdateWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.UpdateWorkflowExecutionRequest, ...yarpc.CallOption) *shared.UpdateWorkflowExecutionResponse); ok {
+		r0 = rf(ctx, UpdateRequest, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.UpdateWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.UpdateWorkflowExecutionRequest, ...yarpc.CallOption) error); ok {
+		r1 = rf(ctx, UpdateRequest, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetWorkflowExecutionHistory provides a mock function with given fields: ctx, GetRequest, opts
 func (_m *FrontendClient) GetWorkflowExecutionHistory(ctx context.Context, GetRequest *shared.GetWorkflowExecutionHistoryRequest, opts ...yarpc.CallOption) (*shared.GetWorkflowExecutionHistoryResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -890,6 +950,132 @@ func (_m *FrontendClient) SignalWorkflowExecution(ctx context.Context, SignalReq
 	return r0
 }
 
+// CancelDelayedSignal provides a mock function with given fields: ctx, CancelRequest, opts
+func (_m *FrontendClient) CancelDelayedSignal(ctx context.Context, CancelRequest *shared.CancelDelayedSignalRequest, opts ...yarpc.CallOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, CancelRequest)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.CancelDelayedSignalRequest, ...yarpc.CallOption) error); ok {
+		r0 = rf(ctx, CancelRequest, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateSchedule provides a mock function with given fields: ctx, CreateRequest, opts
+func (_m *FrontendClient) CreateSchedule(ctx context.Context, CreateRequest *shared.CreateScheduleRequest, opts ...yarpc.CallOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, CreateRequest)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.CreateScheduleRequest, ...yarpc.CallOption) error); ok {
+		r0 = rf(ctx, CreateRequest, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateSchedule provides a mock function with given fields: ctx, UpdateRequest, opts
+func (_m *FrontendClient) UpdateSchedule(ctx context.Context, UpdateRequest *shared.UpdateScheduleRequest, opts ...yarpc.CallOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, UpdateRequest)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.UpdateScheduleRequest, ...yarpc.CallOption) error); ok {
+		r0 = rf(ctx, UpdateRequest, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PauseSchedule provides a mock function with given fields: ctx, PauseRequest, opts
+func (_m *FrontendClient) PauseSchedule(ctx context.Context, PauseRequest *shared.PauseScheduleRequest, opts ...yarpc.CallOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, PauseRequest)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.PauseScheduleRequest, ...yarpc.CallOption) error); ok {
+		r0 = rf(ctx, PauseRequest, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BackfillSchedule provides a mock function with given fields: ctx, BackfillRequest, opts
+func (_m *FrontendClient) BackfillSchedule(ctx context.Context, BackfillRequest *shared.BackfillScheduleRequest, opts ...yarpc.CallOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, BackfillRequest)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.BackfillScheduleRequest, ...yarpc.CallOption) error); ok {
+		r0 = rf(ctx, BackfillRequest, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteSchedule provides a mock function with given fields: ctx, DeleteRequest, opts
+func (_m *FrontendClient) DeleteSchedule(ctx context.Context, DeleteRequest *shared.DeleteScheduleRequest, opts ...yarpc.CallOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, DeleteRequest)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.DeleteScheduleRequest, ...yarpc.CallOption) error); ok {
+		r0 = rf(ctx, DeleteRequest, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // StartWorkflowExecution provides a mock function with given fields: ctx, StartRequest, opts
 func (_m *FrontendClient) StartWorkflowExecution(ctx context.Context, StartRequest *shared.StartWorkflowExecutionRequest, opts ...yarpc.CallOption) (*shared.StartWorkflowExecutionResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -941,6 +1127,48 @@ func (_m *FrontendClient) TerminateWorkflowExecution(ctx context.Context, Termin
 	return r0
 }
 
+// PauseWorkflowExecution provides a mock function with given fields: ctx, PauseRequest, opts
+func (_m *FrontendClient) PauseWorkflowExecution(ctx context.Context, PauseRequest *shared.PauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, PauseRequest)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.PauseWorkflowExecutionRequest, ...yarpc.CallOption) error); ok {
+		r0 = rf(ctx, PauseRequest, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnpauseWorkflowExecution provides a mock function with given fields: ctx, UnpauseRequest, opts
+func (_m *FrontendClient) UnpauseWorkflowExecution(ctx context.Context, UnpauseRequest *shared.UnpauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, UnpauseRequest)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.UnpauseWorkflowExecutionRequest, ...yarpc.CallOption) error); ok {
+		r0 = rf(ctx, UnpauseRequest, opts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateDomain provides a mock function with given fields: ctx, UpdateRequest, opts
 func (_m *FrontendClient) UpdateDomain(ctx context.Context, UpdateRequest *shared.UpdateDomainRequest, opts ...yarpc.CallOption) (*shared.UpdateDomainResponse, error) {
 	_va := make([]interface{}, len(opts))
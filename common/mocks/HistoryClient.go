@@ -61,6 +61,108 @@ func (_m *HistoryClient) DescribeHistoryHost(ctx context.Context, request *share
 	return r0, r1
 }
 
+// DescribeShardQueues provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) DescribeShardQueues(ctx context.Context, request *shared.DescribeShardQueuesRequest, opts ...yarpc.CallOption) (*shared.DescribeShardQueuesResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.DescribeShardQueuesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.DescribeShardQueuesRequest) *shared.DescribeShardQueuesResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.DescribeShardQueuesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.DescribeShardQueuesRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListShardTasks provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) ListShardTasks(ctx context.Context, request *shared.ListShardTasksRequest, opts ...yarpc.CallOption) (*shared.ListShardTasksResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.ListShardTasksResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.ListShardTasksRequest) *shared.ListShardTasksResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ListShardTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.ListShardTasksRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveShardTask provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) RemoveShardTask(ctx context.Context, request *shared.RemoveShardTaskRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.RemoveShardTaskRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CloseShard provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) CloseShard(ctx context.Context, request *shared.CloseShardRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.CloseShardRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RefreshWorkflowTasks provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) RefreshWorkflowTasks(ctx context.Context, request *history.RefreshWorkflowTasksRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *history.RefreshWorkflowTasksRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteWorkflowExecution provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) DeleteWorkflowExecution(ctx context.Context, request *history.DeleteWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *history.DeleteWorkflowExecutionRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DescribeMutableState provides a mock function with given fields: ctx, getRequest
 func (_m *HistoryClient) DescribeMutableState(ctx context.Context, request *history.DescribeMutableStateRequest, opts ...yarpc.CallOption) (*history.DescribeMutableStateResponse, error) {
 	ret := _m.Called(ctx, request)
@@ -153,6 +255,29 @@ func (_m *HistoryClient) DescribeWorkflowExecution(ctx context.Context, request
 	return r0, r1
 }
 
+// UpdateWorkflowExecution provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) UpdateWorkflowExecution(ctx context.Context, request *history.UpdateWorkflowExecutionRequest, opts ...yarpc.CallOption) (*shared.UpdateWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.UpdateWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *history.UpdateWorkflowExecutionRequest) *shared.UpdateWorkflowExecutionResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.UpdateWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *history.UpdateWorkflowExecutionRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RecordActivityTaskHeartbeat provides a mock function with given fields: ctx, heartbeatRequest
 func (_m *HistoryClient) RecordActivityTaskHeartbeat(ctx context.Context, heartbeatRequest *history.RecordActivityTaskHeartbeatRequest, opts ...yarpc.CallOption) (*shared.RecordActivityTaskHeartbeatResponse, error) {
 	ret := _m.Called(ctx, heartbeatRequest)
@@ -315,6 +440,20 @@ func (_m *HistoryClient) SignalWorkflowExecution(ctx context.Context, signalRequ
 	return r0
 }
 
+// CancelDelayedSignal provides a mock function with given fields: ctx, cancelRequest
+func (_m *HistoryClient) CancelDelayedSignal(ctx context.Context, cancelRequest *history.CancelDelayedSignalRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, cancelRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *history.CancelDelayedSignalRequest) error); ok {
+		r0 = rf(ctx, cancelRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SignalWithStartWorkflowExecution provides a mock function with given fields: ctx, signalWithStartRequest
 func (_m *HistoryClient) SignalWithStartWorkflowExecution(ctx context.Context,
 	signalWithStartRequest *history.SignalWithStartWorkflowExecutionRequest,
@@ -405,6 +544,34 @@ func (_m *HistoryClient) TerminateWorkflowExecution(ctx context.Context, termina
 	return r0
 }
 
+// PauseWorkflowExecution provides a mock function with given fields: ctx, pauseRequest
+func (_m *HistoryClient) PauseWorkflowExecution(ctx context.Context, pauseRequest *history.PauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, pauseRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *history.PauseWorkflowExecutionRequest) error); ok {
+		r0 = rf(ctx, pauseRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnpauseWorkflowExecution provides a mock function with given fields: ctx, unpauseRequest
+func (_m *HistoryClient) UnpauseWorkflowExecution(ctx context.Context, unpauseRequest *history.UnpauseWorkflowExecutionRequest, opts ...yarpc.CallOption) error {
+	ret := _m.Called(ctx, unpauseRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *history.UnpauseWorkflowExecutionRequest) error); ok {
+		r0 = rf(ctx, unpauseRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResetWorkflowExecution provides a mock function with given fields: ctx, terminateRequest
 func (_m *HistoryClient) ResetWorkflowExecution(ctx context.Context, resetRequest *history.ResetWorkflowExecutionRequest, opts ...yarpc.CallOption) (*shared.ResetWorkflowExecutionResponse, error) {
 	ret := _m.Called(ctx, resetRequest)
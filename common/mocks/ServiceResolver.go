@@ -79,4 +79,20 @@ func (_m *ServiceResolver) RemoveListener(name string) error {
 	return r0
 }
 
+// Members is am mock implementation
+func (_m *ServiceResolver) Members() []*membership.HostInfo {
+	ret := _m.Called()
+
+	var r0 []*membership.HostInfo
+	if rf, ok := ret.Get(0).(func() []*membership.HostInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*membership.HostInfo)
+		}
+	}
+
+	return r0
+}
+
 var _ membership.ServiceResolver = (*ServiceResolver)(nil)
@@ -52,7 +52,9 @@ const (
 		`visibility_archival_status: ?, ` +
 		`visibility_archival_uri: ?, ` +
 		`bad_binaries: ?,` +
-		`bad_binaries_encoding: ?` +
+		`bad_binaries_encoding: ?, ` +
+		`worker_versioning: ?, ` +
+		`worker_versioning_encoding: ?` +
 		`}`
 
 	templateDomainReplicationConfigType = `{` +
@@ -78,6 +80,7 @@ const (
 		`config.history_archival_status, config.history_archival_uri, ` +
 		`config.visibility_archival_status, config.visibility_archival_uri, ` +
 		`config.bad_binaries, config.bad_binaries_encoding, ` +
+		`config.worker_versioning, config.worker_versioning_encoding, ` +
 		`replication_config.active_cluster_name, replication_config.clusters, ` +
 		`is_global_domain, ` +
 		`config_version, ` +
@@ -174,6 +177,8 @@ func (m *cassandraMetadataPersistence) CreateDomain(request *p.InternalCreateDom
 		request.Config.VisibilityArchivalURI,
 		request.Config.BadBinaries.Data,
 		string(request.Config.BadBinaries.GetEncoding()),
+		request.Config.WorkerVersioning.Data,
+		string(request.Config.WorkerVersioning.GetEncoding()),
 		request.ReplicationConfig.ActiveClusterName,
 		p.SerializeClusterConfigs(request.ReplicationConfig.Clusters),
 		request.IsGlobalDomain,
@@ -259,6 +264,8 @@ func (m *cassandraMetadataPersistence) GetDomain(request *p.GetDomainRequest) (*
 
 	var badBinariesData []byte
 	var badBinariesDataEncoding string
+	var workerVersioningData []byte
+	var workerVersioningDataEncoding string
 	query = m.session.Query(templateGetDomainByNameQuery, domainName)
 	err = query.Scan(
 		&info.ID,
@@ -277,6 +284,8 @@ func (m *cassandraMetadataPersistence) GetDomain(request *p.GetDomainRequest) (*
 		&config.VisibilityArchivalURI,
 		&badBinariesData,
 		&badBinariesDataEncoding,
+		&workerVersioningData,
+		&workerVersioningDataEncoding,
 		&replicationConfig.ActiveClusterName,
 		&replicationClusters,
 		&isGlobalDomain,
@@ -293,6 +302,7 @@ func (m *cassandraMetadataPersistence) GetDomain(request *p.GetDomainRequest) (*
 		info.Data = map[string]string{}
 	}
 	config.BadBinaries = p.NewDataBlob(badBinariesData, common.EncodingType(badBinariesDataEncoding))
+	config.WorkerVersioning = p.NewDataBlob(workerVersioningData, common.EncodingType(workerVersioningDataEncoding))
 	replicationConfig.ActiveClusterName = p.GetOrUseDefaultActiveCluster(m.currentClusterName, replicationConfig.ActiveClusterName)
 	replicationConfig.Clusters = p.DeserializeClusterConfigs(replicationClusters)
 	replicationConfig.Clusters = p.GetOrUseDefaultClusters(m.currentClusterName, replicationConfig.Clusters)
@@ -333,6 +343,8 @@ func (m *cassandraMetadataPersistence) UpdateDomain(request *p.InternalUpdateDom
 		request.Config.VisibilityArchivalURI,
 		request.Config.BadBinaries.Data,
 		string(request.Config.BadBinaries.GetEncoding()),
+		request.Config.WorkerVersioning.Data,
+		string(request.Config.WorkerVersioning.GetEncoding()),
 		request.ReplicationConfig.ActiveClusterName,
 		p.SerializeClusterConfigs(request.ReplicationConfig.Clusters),
 		request.ConfigVersion,
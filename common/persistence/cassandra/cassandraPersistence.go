@@ -167,7 +167,11 @@ const (
 		`cron_schedule: ?, ` +
 		`expiration_seconds: ?, ` +
 		`search_attributes: ?, ` +
-		`memo: ? ` +
+		`memo: ?, ` +
+		`paused: ?, ` +
+		`cron_max_jitter_seconds: ?, ` +
+		`task_priority: ?, ` +
+		`build_id: ? ` +
 		`}`
 
 	templateReplicationStateType = `{` +
@@ -258,6 +262,7 @@ const (
 		`non_retriable_errors: ?, ` +
 		`last_failure_reason: ?, ` +
 		`last_worker_identity: ?, ` +
+		`task_priority: ?, ` +
 		`event_data_encoding: ?` +
 		`}`
 
@@ -299,6 +304,16 @@ const (
 		`control: ?` +
 		`}`
 
+	templateDelayedSignalInfoType = `{` +
+		`version: ?,` +
+		`request_id: ?, ` +
+		`signal_name: ?, ` +
+		`input: ?, ` +
+		`control: ?, ` +
+		`identity: ?, ` +
+		`fire_time: ?` +
+		`}`
+
 	templateSerializedEventBatch = `{` +
 		`encoding_type: ?, ` +
 		`version: ?, ` +
@@ -319,7 +334,8 @@ const (
 		`workflow_id: ?, ` +
 		`run_id: ?, ` +
 		`schedule_id: ?,` +
-		`created_time: ? ` +
+		`created_time: ?, ` +
+		`priority: ? ` +
 		`}`
 
 	templateCreateShardQuery = `INSERT INTO executions (` +
@@ -401,7 +417,7 @@ workflow_state = ? ` +
 		`and task_id = ? ` +
 		`IF range_id = ?`
 
-	templateGetWorkflowExecutionQuery = `SELECT execution, replication_state, activity_map, timer_map, child_executions_map, request_cancel_map, signal_map, signal_requested, buffered_events_list, buffered_replication_tasks_map ` +
+	templateGetWorkflowExecutionQuery = `SELECT execution, replication_state, activity_map, timer_map, child_executions_map, request_cancel_map, signal_map, signal_requested, delayed_signal_map, update_requested, buffered_events_list, buffered_replication_tasks_map ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
@@ -554,6 +570,26 @@ workflow_state = ? ` +
 		`and visibility_ts = ? ` +
 		`and task_id = ? `
 
+	templateUpdateDelayedSignalInfoQuery = `UPDATE executions ` +
+		`SET delayed_signal_map[ ? ] =` + templateDelayedSignalInfoType + ` ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ? ` +
+		`and task_id = ? `
+
+	templateResetDelayedSignalInfoQuery = `UPDATE executions ` +
+		`SET delayed_signal_map = ?` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ? ` +
+		`and task_id = ? `
+
 	templateUpdateSignalRequestedQuery = `UPDATE executions ` +
 		`SET signal_requested = signal_requested + ? ` +
 		`WHERE shard_id = ? ` +
@@ -574,6 +610,36 @@ workflow_state = ? ` +
 		`and visibility_ts = ? ` +
 		`and task_id = ? `
 
+	templateUpdateUpdateRequestedQuery = `UPDATE executions ` +
+		`SET update_requested = update_requested + ? ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ? ` +
+		`and task_id = ? `
+
+	templateResetUpdateRequestedQuery = `UPDATE executions ` +
+		`SET update_requested = ? ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ? ` +
+		`and task_id = ? `
+
+	templateDeleteUpdateRequestedQuery = `DELETE update_requested[ ? ] ` +
+		`FROM executions ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ? ` +
+		`and task_id = ? `
+
 	templateAppendBufferedEventsQuery = `UPDATE executions ` +
 		`SET buffered_events_list = buffered_events_list + ? ` +
 		`WHERE shard_id = ? ` +
@@ -644,6 +710,16 @@ workflow_state = ? ` +
 		`and visibility_ts = ? ` +
 		`and task_id = ? `
 
+	templateDeleteDelayedSignalInfoQuery = `DELETE delayed_signal_map[ ? ] ` +
+		`FROM executions ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and visibility_ts = ? ` +
+		`and task_id = ? `
+
 	templateDeleteWorkflowExecutionMutableStateQuery = `DELETE FROM executions ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
@@ -1265,6 +1341,16 @@ func (d *cassandraPersistence) GetWorkflowExecution(request *p.GetWorkflowExecut
 	}
 	state.SignalRequestedIDs = signalRequestedIDs
 
+	delayedSignalInfos := make(map[string]*p.DelayedSignalInfo)
+	dMap := result["delayed_signal_map"].(map[string]map[string]interface{})
+	for key, value := range dMap {
+		info := createDelayedSignalInfo(value)
+		delayedSignalInfos[key] = info
+	}
+	state.DelayedSignalInfos = delayedSignalInfos
+
+	state.UpdateRequestedIDs = result["update_requested"].(map[string]int64)
+
 	eList := result["buffered_events_list"].([]map[string]interface{})
 	bufferedEventsBlobs := make([]*p.DataBlob, 0, len(eList))
 	for _, v := range eList {
@@ -2225,7 +2311,8 @@ func (d *cassandraPersistence) CreateTasks(request *p.CreateTasksRequest) (*p.Cr
 				task.Execution.GetWorkflowId(),
 				task.Execution.GetRunId(),
 				scheduleID,
-				cqlNowTimestamp)
+				cqlNowTimestamp,
+				task.Data.Priority)
 		} else {
 			if ttl > maxCassandraTTL {
 				ttl = maxCassandraTTL
@@ -2241,6 +2328,7 @@ func (d *cassandraPersistence) CreateTasks(request *p.CreateTasksRequest) (*p.Cr
 				task.Execution.GetRunId(),
 				scheduleID,
 				cqlNowTimestamp,
+				task.Data.Priority,
 				ttl)
 		}
 	}
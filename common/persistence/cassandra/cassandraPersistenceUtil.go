@@ -120,6 +120,26 @@ func applyWorkflowMutationBatch(
 		runID,
 	)
 
+	updateDelayedSignalInfos(
+		batch,
+		workflowMutation.UpsertDelayedSignalInfos,
+		workflowMutation.DeleteDelayedSignalInfos,
+		shardID,
+		domainID,
+		workflowID,
+		runID,
+	)
+
+	updateUpdatesRequested(
+		batch,
+		workflowMutation.UpsertUpdateRequestedIDs,
+		workflowMutation.DeleteUpdateRequestedIDs,
+		shardID,
+		domainID,
+		workflowID,
+		runID,
+	)
+
 	updateBufferedEvents(
 		batch,
 		workflowMutation.NewBufferedEvents,
@@ -227,6 +247,24 @@ func applyWorkflowSnapshotBatchAsReset(
 		runID,
 	)
 
+	resetDelayedSignalInfos(
+		batch,
+		workflowSnapshot.DelayedSignalInfos,
+		shardID,
+		domainID,
+		workflowID,
+		runID,
+	)
+
+	resetUpdateRequested(
+		batch,
+		workflowSnapshot.UpdateRequestedIDs,
+		shardID,
+		domainID,
+		workflowID,
+		runID,
+	)
+
 	deleteBufferedEvents(
 		batch,
 		shardID,
@@ -336,6 +374,26 @@ func applyWorkflowSnapshotBatchAsNew(
 		runID,
 	)
 
+	updateDelayedSignalInfos(
+		batch,
+		workflowSnapshot.DelayedSignalInfos,
+		nil,
+		shardID,
+		domainID,
+		workflowID,
+		runID,
+	)
+
+	updateUpdatesRequested(
+		batch,
+		workflowSnapshot.UpdateRequestedIDs,
+		nil,
+		shardID,
+		domainID,
+		workflowID,
+		runID,
+	)
+
 	// transfer / replication / timer tasks
 	return applyTasks(
 		batch,
@@ -449,6 +507,10 @@ func createExecution(
 			executionInfo.ExpirationSeconds,
 			executionInfo.SearchAttributes,
 			executionInfo.Memo,
+			executionInfo.Paused,
+			executionInfo.CronMaxJitterSeconds,
+			executionInfo.TaskPriority,
+			executionInfo.BuildID,
 			executionInfo.NextEventID,
 			defaultVisibilityTimestamp,
 			rowTypeExecutionTaskID)
@@ -521,6 +583,10 @@ func createExecution(
 			executionInfo.ExpirationSeconds,
 			executionInfo.SearchAttributes,
 			executionInfo.Memo,
+			executionInfo.Paused,
+			executionInfo.CronMaxJitterSeconds,
+			executionInfo.TaskPriority,
+			executionInfo.BuildID,
 			replicationState.CurrentVersion,
 			replicationState.StartVersion,
 			replicationState.LastWriteVersion,
@@ -628,6 +694,10 @@ func updateExecution(
 			executionInfo.ExpirationSeconds,
 			executionInfo.SearchAttributes,
 			executionInfo.Memo,
+			executionInfo.Paused,
+			executionInfo.CronMaxJitterSeconds,
+			executionInfo.TaskPriority,
+			executionInfo.BuildID,
 			executionInfo.NextEventID,
 			shardID,
 			rowTypeExecution,
@@ -701,6 +771,10 @@ func updateExecution(
 			executionInfo.ExpirationSeconds,
 			executionInfo.SearchAttributes,
 			executionInfo.Memo,
+			executionInfo.Paused,
+			executionInfo.CronMaxJitterSeconds,
+			executionInfo.TaskPriority,
+			executionInfo.BuildID,
 			replicationState.CurrentVersion,
 			replicationState.StartVersion,
 			replicationState.LastWriteVersion,
@@ -973,6 +1047,9 @@ func createTimerTasks(
 		case *p.WorkflowTimeoutTask:
 			// noop
 
+		case *p.DelayedSignalTimerTask:
+			// noop
+
 		case *p.DeleteHistoryEventTask:
 			// noop
 
@@ -1157,6 +1234,7 @@ func updateActivityInfos(
 			a.NonRetriableErrors,
 			a.LastFailureReason,
 			a.LastWorkerIdentity,
+			a.TaskPriority,
 			scheduleEncoding,
 			shardID,
 			rowTypeExecution,
@@ -1548,6 +1626,123 @@ func resetSignalRequested(
 		rowTypeExecutionTaskID)
 }
 
+func updateDelayedSignalInfos(
+	batch *gocql.Batch,
+	delayedSignalInfos []*p.DelayedSignalInfo,
+	deleteInfos []string,
+	shardID int,
+	domainID string,
+	workflowID string,
+	runID string,
+) {
+
+	for _, d := range delayedSignalInfos {
+		batch.Query(templateUpdateDelayedSignalInfoQuery,
+			d.RequestID,
+			d.Version,
+			d.RequestID,
+			d.SignalName,
+			d.Input,
+			d.Control,
+			d.Identity,
+			d.FireTime,
+			shardID,
+			rowTypeExecution,
+			domainID,
+			workflowID,
+			runID,
+			defaultVisibilityTimestamp,
+			rowTypeExecutionTaskID)
+	}
+
+	for _, r := range deleteInfos {
+		batch.Query(templateDeleteDelayedSignalInfoQuery,
+			r,
+			shardID,
+			rowTypeExecution,
+			domainID,
+			workflowID,
+			runID,
+			defaultVisibilityTimestamp,
+			rowTypeExecutionTaskID)
+	}
+}
+
+func resetDelayedSignalInfos(
+	batch *gocql.Batch,
+	delayedSignalInfos []*p.DelayedSignalInfo,
+	shardID int,
+	domainID string,
+	workflowID string,
+	runID string,
+) {
+
+	batch.Query(templateResetDelayedSignalInfoQuery,
+		resetDelayedSignalInfoMap(delayedSignalInfos),
+		shardID,
+		rowTypeExecution,
+		domainID,
+		workflowID,
+		runID,
+		defaultVisibilityTimestamp,
+		rowTypeExecutionTaskID)
+}
+
+func updateUpdatesRequested(
+	batch *gocql.Batch,
+	updateReqIDs map[string]int64,
+	deleteReqIDs []string,
+	shardID int,
+	domainID string,
+	workflowID string,
+	runID string,
+) {
+
+	if len(updateReqIDs) > 0 {
+		batch.Query(templateUpdateUpdateRequestedQuery,
+			updateReqIDs,
+			shardID,
+			rowTypeExecution,
+			domainID,
+			workflowID,
+			runID,
+			defaultVisibilityTimestamp,
+			rowTypeExecutionTaskID)
+	}
+
+	for _, deleteReqID := range deleteReqIDs {
+		batch.Query(templateDeleteUpdateRequestedQuery,
+			deleteReqID,
+			shardID,
+			rowTypeExecution,
+			domainID,
+			workflowID,
+			runID,
+			defaultVisibilityTimestamp,
+			rowTypeExecutionTaskID)
+	}
+}
+
+func resetUpdateRequested(
+	batch *gocql.Batch,
+	updateRequested map[string]int64,
+	shardID int,
+	domainID string,
+	workflowID string,
+	runID string,
+) {
+
+	batch.Query(templateResetUpdateRequestedQuery,
+		updateRequested,
+		shardID,
+		rowTypeExecution,
+		domainID,
+		workflowID,
+		runID,
+		defaultVisibilityTimestamp,
+		rowTypeExecutionTaskID)
+}
+
 func updateBufferedEvents(
 	batch *gocql.Batch,
 	newBufferedEvents *p.DataBlob,
@@ -1764,6 +1959,14 @@ func createWorkflowExecutionInfo(
 			info.SearchAttributes = v.(map[string][]byte)
 		case "memo":
 			info.Memo = v.(map[string][]byte)
+		case "paused":
+			info.Paused = v.(bool)
+		case "cron_max_jitter_seconds":
+			info.CronMaxJitterSeconds = int32(v.(int))
+		case "task_priority":
+			info.TaskPriority = int32(v.(int))
+		case "build_id":
+			info.BuildID = v.(string)
 		}
 	}
 	info.CompletionEvent = p.NewDataBlob(completionEventData, completionEventEncoding)
@@ -1965,6 +2168,8 @@ func createActivityInfo(
 			info.LastFailureReason = v.(string)
 		case "last_worker_identity":
 			info.LastWorkerIdentity = v.(string)
+		case "task_priority":
+			info.TaskPriority = int32(v.(int))
 		case "event_data_encoding":
 			sharedEncoding = common.EncodingType(v.(string))
 		}
@@ -2083,6 +2288,33 @@ func createSignalInfo(
 	return info
 }
 
+func createDelayedSignalInfo(
+	result map[string]interface{},
+) *p.DelayedSignalInfo {
+
+	info := &p.DelayedSignalInfo{}
+	for k, v := range result {
+		switch k {
+		case "version":
+			info.Version = v.(int64)
+		case "request_id":
+			info.RequestID = v.(string)
+		case "signal_name":
+			info.SignalName = v.(string)
+		case "input":
+			info.Input = v.([]byte)
+		case "control":
+			info.Control = v.([]byte)
+		case "identity":
+			info.Identity = v.(string)
+		case "fire_time":
+			info.FireTime = v.(time.Time)
+		}
+	}
+
+	return info
+}
+
 func resetActivityInfoMap(
 	activityInfos []*p.InternalActivityInfo,
 ) (map[int64]map[string]interface{}, error) {
@@ -2127,6 +2359,7 @@ func resetActivityInfoMap(
 		aInfo["non_retriable_errors"] = a.NonRetriableErrors
 		aInfo["last_failure_reason"] = a.LastFailureReason
 		aInfo["last_worker_identity"] = a.LastWorkerIdentity
+		aInfo["task_priority"] = a.TaskPriority
 
 		aMap[a.ScheduleID] = aInfo
 	}
@@ -2225,6 +2458,27 @@ func resetSignalInfoMap(
 	return sMap
 }
 
+func resetDelayedSignalInfoMap(
+	delayedSignalInfos []*p.DelayedSignalInfo,
+) map[string]map[string]interface{} {
+
+	dMap := make(map[string]map[string]interface{})
+	for _, d := range delayedSignalInfos {
+		dInfo := make(map[string]interface{})
+		dInfo["version"] = d.Version
+		dInfo["request_id"] = d.RequestID
+		dInfo["signal_name"] = d.SignalName
+		dInfo["input"] = d.Input
+		dInfo["control"] = d.Control
+		dInfo["identity"] = d.Identity
+		dInfo["fire_time"] = d.FireTime
+
+		dMap[d.RequestID] = dInfo
+	}
+
+	return dMap
+}
+
 func createHistoryEventBatchBlob(
 	result map[string]interface{},
 ) *p.DataBlob {
@@ -2259,6 +2513,8 @@ func createTaskInfo(
 			info.ScheduleID = v.(int64)
 		case "created_time":
 			info.CreatedTime = v.(time.Time)
+		case "priority":
+			info.Priority = int32(v.(int))
 		}
 	}
 
@@ -56,6 +56,18 @@ const (
 		`AND start_time = ? ` +
 		`AND run_id = ?`
 
+	templateDeleteWorkflowExecutionClosed = `DELETE FROM closed_executions ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? ` +
+		`AND start_time = ? ` +
+		`AND run_id = ?`
+
+	templateDeleteWorkflowExecutionClosedV2 = `DELETE FROM closed_executions_v2 ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? ` +
+		`AND close_time = ? ` +
+		`AND run_id = ?`
+
 	templateCreateWorkflowExecutionClosedWithTTL = `INSERT INTO closed_executions (` +
 		`domain_id, domain_partition, workflow_id, run_id, start_time, execution_time, close_time, workflow_type_name, status, history_length, memo, encoding) ` +
 		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?) using TTL ?`
@@ -655,8 +667,45 @@ func (v *cassandraVisibilityPersistence) GetClosedWorkflowExecution(
 	}, nil
 }
 
-// DeleteWorkflowExecution is a no-op since deletes are auto-handled by cassandra TTLs
+// DeleteWorkflowExecution is a no-op unless the start timestamp is given since deletes are auto-handled by cassandra TTLs
 func (v *cassandraVisibilityPersistence) DeleteWorkflowExecution(request *p.VisibilityDeleteWorkflowExecutionRequest) error {
+	if request.StartTimestamp == 0 {
+		return nil
+	}
+
+	batch := v.session.NewBatch(gocql.LoggedBatch)
+	batch.Query(templateDeleteWorkflowExecutionStarted,
+		request.DomainID,
+		domainPartition,
+		p.UnixNanoToDBTimestamp(request.StartTimestamp),
+		request.RunID,
+	)
+	if request.CloseTimestamp != 0 {
+		batch.Query(templateDeleteWorkflowExecutionClosed,
+			request.DomainID,
+			domainPartition,
+			p.UnixNanoToDBTimestamp(request.StartTimestamp),
+			request.RunID,
+		)
+		batch.Query(templateDeleteWorkflowExecutionClosedV2,
+			request.DomainID,
+			domainPartition,
+			p.UnixNanoToDBTimestamp(request.CloseTimestamp),
+			request.RunID,
+		)
+	}
+
+	err := v.session.ExecuteBatch(batch)
+	if err != nil {
+		if isThrottlingError(err) {
+			return &workflow.ServiceBusyError{
+				Message: fmt.Sprintf("DeleteWorkflowExecution operation failed. Error: %v", err),
+			}
+		}
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("DeleteWorkflowExecution operation failed. Error: %v", err),
+		}
+	}
 	return nil
 }
 
@@ -314,7 +314,6 @@ func (v *cassandraVisibilityPersistenceV2) CountWorkflowExecutions(request *p.Co
 	return v.persistence.CountWorkflowExecutions(request)
 }
 
-// DeleteWorkflowExecution is a no-op since deletes are auto-handled by cassandra TTLs
 func (v *cassandraVisibilityPersistenceV2) DeleteWorkflowExecution(request *p.VisibilityDeleteWorkflowExecutionRequest) error {
-	return nil
+	return v.persistence.DeleteWorkflowExecution(request)
 }
@@ -119,6 +119,7 @@ const (
 	TaskTypeDeleteHistoryEvent
 	TaskTypeActivityRetryTimer
 	TaskTypeWorkflowBackoffTimer
+	TaskTypeDelayedSignalTimer
 )
 
 // UnknownNumRowsAffected is returned when the number of rows that an API affected cannot be determined
@@ -260,6 +261,7 @@ type (
 		DecisionScheduledTimestamp   int64
 		CancelRequested              bool
 		CancelRequestID              string
+		Paused                       bool
 		StickyTaskList               string
 		StickyScheduleToStartTimeout int32
 		ClientLibraryVersion         string
@@ -281,8 +283,13 @@ type (
 		EventStoreVersion int32
 		BranchToken       []byte
 		// Cron
-		CronSchedule      string
-		ExpirationSeconds int32
+		CronSchedule         string
+		CronMaxJitterSeconds int32
+		ExpirationSeconds    int32
+		// TaskPriority is applied to the decision tasks of this workflow
+		TaskPriority int32
+		// BuildID is the worker build that completed the first decision task
+		BuildID string
 	}
 
 	// ExecutionStats is the statistics about workflow execution
@@ -373,6 +380,8 @@ type (
 		ScheduleToStartTimeout int32
 		Expiry                 time.Time
 		CreatedTime            time.Time
+		// Priority orders dispatch within a task list, higher values are dispatched first
+		Priority int32
 	}
 
 	// Task is the generic interface for workflow tasks
@@ -529,6 +538,13 @@ type (
 		TimeoutType         int // 0 for retry, 1 for cron.
 	}
 
+	// DelayedSignalTimerTask to deliver pending delayed signals which are due
+	DelayedSignalTimerTask struct {
+		VisibilityTimestamp time.Time
+		TaskID              int64
+		Version             int64
+	}
+
 	// HistoryReplicationTask is the replication task created for shipping history replication events to other clusters
 	HistoryReplicationTask struct {
 		VisibilityTimestamp     time.Time
@@ -566,6 +582,8 @@ type (
 		RequestCancelInfos  map[int64]*RequestCancelInfo
 		SignalInfos         map[int64]*SignalInfo
 		SignalRequestedIDs  map[string]struct{}
+		DelayedSignalInfos  map[string]*DelayedSignalInfo
+		UpdateRequestedIDs  map[string]int64 // request ID -> event ID to look up the update result from
 		ExecutionInfo       *WorkflowExecutionInfo
 		ExecutionStats      *ExecutionStats
 		ReplicationState    *ReplicationState
@@ -607,6 +625,7 @@ type (
 		NonRetriableErrors []string
 		LastFailureReason  string
 		LastWorkerIdentity string
+		TaskPriority       int32
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibility int64
 	}
@@ -652,6 +671,17 @@ type (
 		Control         []byte
 	}
 
+	// DelayedSignalInfo has details for pending signal which will be delivered after a delay
+	DelayedSignalInfo struct {
+		Version    int64
+		RequestID  string
+		SignalName string
+		Input      []byte
+		Control    []byte
+		Identity   string
+		FireTime   time.Time
+	}
+
 	// CreateShardRequest is used to create a shard in executions table
 	CreateShardRequest struct {
 		ShardInfo *ShardInfo
@@ -683,6 +713,8 @@ type (
 		PreviousLastWriteVersion int64
 
 		NewWorkflowSnapshot WorkflowSnapshot
+
+		Encoding common.EncodingType // optional binary encoding type
 	}
 
 	// CreateWorkflowExecutionResponse is the response to CreateWorkflowExecutionRequest
@@ -793,6 +825,10 @@ type (
 		DeleteSignalInfo          *int64
 		UpsertSignalRequestedIDs  []string
 		DeleteSignalRequestedID   string
+		UpsertDelayedSignalInfos  []*DelayedSignalInfo
+		DeleteDelayedSignalInfos  []string
+		UpsertUpdateRequestedIDs  map[string]int64
+		DeleteUpdateRequestedIDs  []string
 		NewBufferedEvents         []*workflow.HistoryEvent
 		ClearBufferedEvents       bool
 
@@ -815,6 +851,8 @@ type (
 		RequestCancelInfos  []*RequestCancelInfo
 		SignalInfos         []*SignalInfo
 		SignalRequestedIDs  []string
+		DelayedSignalInfos  []*DelayedSignalInfo
+		UpdateRequestedIDs  map[string]int64
 
 		TransferTasks    []Task
 		ReplicationTasks []Task
@@ -1080,6 +1118,7 @@ type (
 		VisibilityArchivalStatus workflow.ArchivalStatus
 		VisibilityArchivalURI    string
 		BadBinaries              workflow.BadBinaries
+		WorkerVersioning         workflow.WorkerVersioning
 	}
 
 	// DomainReplicationConfig describes the cross DC domain replication configuration
@@ -1910,6 +1949,41 @@ func (r *WorkflowBackoffTimerTask) SetVisibilityTimestamp(t time.Time) {
 	r.VisibilityTimestamp = t
 }
 
+// GetType returns the type of the delayed signal timer task
+func (r *DelayedSignalTimerTask) GetType() int {
+	return TaskTypeDelayedSignalTimer
+}
+
+// GetVersion returns the version of the delayed signal timer task
+func (r *DelayedSignalTimerTask) GetVersion() int64 {
+	return r.Version
+}
+
+// SetVersion returns the version of the delayed signal timer task
+func (r *DelayedSignalTimerTask) SetVersion(version int64) {
+	r.Version = version
+}
+
+// GetTaskID returns the sequence ID.
+func (r *DelayedSignalTimerTask) GetTaskID() int64 {
+	return r.TaskID
+}
+
+// SetTaskID sets the sequence ID.
+func (r *DelayedSignalTimerTask) SetTaskID(id int64) {
+	r.TaskID = id
+}
+
+// GetVisibilityTimestamp gets the visibility time stamp
+func (r *DelayedSignalTimerTask) GetVisibilityTimestamp() time.Time {
+	return r.VisibilityTimestamp
+}
+
+// SetVisibilityTimestamp gets the visibility time stamp
+func (r *DelayedSignalTimerTask) SetVisibilityTimestamp(t time.Time) {
+	r.VisibilityTimestamp = t
+}
+
 // GetType returns the type of the timeout task.
 func (u *WorkflowTimeoutTask) GetType() int {
 	return TaskTypeWorkflowTimeout
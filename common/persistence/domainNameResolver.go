@@ -0,0 +1,92 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/quotas"
+)
+
+// DomainNameResolver returns the name of the domain with the given ID, it is used by the persistence
+// clients to emit per-domain metrics and to apply per-domain rate limits
+type DomainNameResolver func(domainID string) (string, error)
+
+func resolveDomainName(domainNameResolver DomainNameResolver, domainID string) string {
+	if domainNameResolver == nil || len(domainID) == 0 {
+		return ""
+	}
+	domainName, err := domainNameResolver(domainID)
+	if err != nil {
+		return ""
+	}
+	return domainName
+}
+
+// domainQuotaInfo returns the rate limit info of a persistence call, calls whose domain can
+// not be resolved are only subject to the host level rate limit
+func domainQuotaInfo(domainNameResolver DomainNameResolver, domainID string) quotas.Info {
+	return quotas.Info{Domain: resolveDomainName(domainNameResolver, domainID)}
+}
+
+// startDomainMetrics emits the request counter of a persistence call tagged with the domain of
+// the request, the returned function records the latency and the failure of the call. Nothing is
+// emitted for calls whose domain can not be resolved
+func startDomainMetrics(metricClient metrics.Client, domainNameResolver DomainNameResolver, scope int, domainID string) func(err error) {
+	domainName := resolveDomainName(domainNameResolver, domainID)
+	if len(domainName) == 0 {
+		return func(err error) {}
+	}
+	domainScope := metricClient.Scope(scope, metrics.DomainTag(domainName))
+	domainScope.IncCounter(metrics.PersistenceRequestsPerDomain)
+	sw := domainScope.StartTimer(metrics.PersistenceLatencyPerDomain)
+	return func(err error) {
+		sw.Stop()
+		switch err.(type) {
+		case nil,
+			*ShardOwnershipLostError,
+			*ConditionFailedError,
+			*CurrentWorkflowConditionFailedError,
+			*WorkflowExecutionAlreadyStartedError,
+			*workflow.EntityNotExistsError:
+			// not failures of the persistence layer
+		case *workflow.ServiceBusyError:
+			domainScope.IncCounter(metrics.PersistenceErrBusyCounterPerDomain)
+			domainScope.IncCounter(metrics.PersistenceFailuresPerDomain)
+		default:
+			domainScope.IncCounter(metrics.PersistenceFailuresPerDomain)
+		}
+	}
+}
+
+func executionDomainID(executionInfo *WorkflowExecutionInfo) string {
+	if executionInfo == nil {
+		return ""
+	}
+	return executionInfo.DomainID
+}
+
+func taskListDomainID(taskListInfo *TaskListInfo) string {
+	if taskListInfo == nil {
+		return ""
+	}
+	return taskListInfo.DomainID
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/quotas"
+)
+
+type (
+	domainNameResolverSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+
+	testTaskManager struct {
+		TaskManager
+		getTasksCount int
+	}
+)
+
+func TestDomainNameResolverSuite(t *testing.T) {
+	s := new(domainNameResolverSuite)
+	suite.Run(t, s)
+}
+
+func (s *domainNameResolverSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+}
+
+func (s *domainNameResolverSuite) TestResolveDomainName() {
+	resolver := func(domainID string) (string, error) {
+		if domainID == "unknown-id" {
+			return "", errors.New("domain not found")
+		}
+		return domainID + "-name", nil
+	}
+
+	s.Equal("", resolveDomainName(nil, "domain-id"))
+	s.Equal("", resolveDomainName(resolver, ""))
+	s.Equal("", resolveDomainName(resolver, "unknown-id"))
+	s.Equal("domain-id-name", resolveDomainName(resolver, "domain-id"))
+	s.Equal(quotas.Info{Domain: "domain-id-name"}, domainQuotaInfo(resolver, "domain-id"))
+}
+
+func (s *domainNameResolverSuite) TestStartDomainMetrics() {
+	scope := tally.NewTestScope("test", nil)
+	metricsClient := metrics.NewClient(scope, metrics.History)
+	resolver := func(domainID string) (string, error) {
+		return "test-domain", nil
+	}
+
+	startDomainMetrics(metricsClient, resolver, metrics.PersistenceGetTasksScope, "domain-id")(nil)
+	startDomainMetrics(metricsClient, resolver, metrics.PersistenceGetTasksScope, "domain-id")(&ConditionFailedError{})
+	startDomainMetrics(metricsClient, resolver, metrics.PersistenceGetTasksScope, "domain-id")(&workflow.ServiceBusyError{})
+	startDomainMetrics(metricsClient, resolver, metrics.PersistenceGetTasksScope, "domain-id")(&workflow.InternalServiceError{})
+
+	counters := make(map[string]int64)
+	for _, counter := range scope.Snapshot().Counters() {
+		s.Equal("test-domain", counter.Tags()["domain"])
+		counters[counter.Name()] = counter.Value()
+	}
+	s.Equal(int64(4), counters["test.persistence_requests_per_domain"])
+	s.Equal(int64(2), counters["test.persistence_errors_per_domain"])
+	s.Equal(int64(1), counters["test.persistence_errors_busy_per_domain"])
+	for _, timer := range scope.Snapshot().Timers() {
+		s.Equal("test.persistence_latency_per_domain", timer.Name())
+		s.Len(timer.Values(), 4)
+	}
+}
+
+func (s *domainNameResolverSuite) TestStartDomainMetrics_DomainNotResolved() {
+	scope := tally.NewTestScope("test", nil)
+	metricsClient := metrics.NewClient(scope, metrics.History)
+	resolver := func(domainID string) (string, error) {
+		return "", nil
+	}
+
+	startDomainMetrics(metricsClient, nil, metrics.PersistenceGetTasksScope, "domain-id")(nil)
+	startDomainMetrics(metricsClient, resolver, metrics.PersistenceGetTasksScope, "domain-id")(&workflow.ServiceBusyError{})
+
+	s.Empty(scope.Snapshot().Counters())
+	s.Empty(scope.Snapshot().Timers())
+}
+
+func (s *domainNameResolverSuite) TestRateLimitedClient_PerDomainLimit() {
+	resolver := func(domainID string) (string, error) {
+		return domainID + "-name", nil
+	}
+	rateLimiter := quotas.NewStrictMultiStageRateLimiter(
+		func() float64 { return 100 },
+		func(domain string) float64 {
+			if domain == "limited-name" {
+				return 1
+			}
+			return 100
+		},
+	)
+	taskManager := &testTaskManager{}
+	client := NewTaskPersistenceRateLimitedClient(taskManager, rateLimiter, resolver, loggerimpl.NewNopLogger())
+
+	_, err := client.GetTasks(&GetTasksRequest{DomainID: "limited"})
+	s.NoError(err)
+	_, err = client.GetTasks(&GetTasksRequest{DomainID: "limited"})
+	s.Equal(ErrPersistenceLimitExceeded, err)
+
+	// other domains are not affected by the limit of the throttled domain
+	for i := 0; i < 10; i++ {
+		_, err = client.GetTasks(&GetTasksRequest{DomainID: "other"})
+		s.NoError(err)
+	}
+	s.Equal(11, taskManager.getTasksCount)
+}
+
+func (m *testTaskManager) GetTasks(request *GetTasksRequest) (*GetTasksResponse, error) {
+	m.getTasksCount++
+	return &GetTasksResponse{}, nil
+}
@@ -42,9 +42,13 @@ func NewESVisibilityManager(indexName string, esClient es.Client, config *config
 	if config != nil {
 		// wrap with rate limiter
 		if config.MaxQPS() != 0 {
-			esRateLimiter := quotas.NewDynamicRateLimiter(
-				func() float64 {
-					return float64(config.MaxQPS())
+			esRPS := func() float64 {
+				return float64(config.MaxQPS())
+			}
+			esRateLimiter := quotas.NewMultiStageRateLimiter(
+				esRPS,
+				func(string) float64 {
+					return esRPS()
 				},
 			)
 			visibilityFromES = p.NewVisibilityPersistenceRateLimitedClient(visibilityFromES, esRateLimiter, log)
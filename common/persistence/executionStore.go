@@ -41,11 +41,12 @@ var _ ExecutionManager = (*executionManagerImpl)(nil)
 // NewExecutionManagerImpl returns new ExecutionManager
 func NewExecutionManagerImpl(
 	persistence ExecutionStore,
+	serializer PayloadSerializer,
 	logger log.Logger,
 ) ExecutionManager {
 
 	return &executionManagerImpl{
-		serializer:    NewPayloadSerializer(),
+		serializer:    serializer,
 		persistence:   persistence,
 		statsComputer: statsComputer{},
 		logger:        logger,
@@ -75,6 +76,8 @@ func (m *executionManagerImpl) GetWorkflowExecution(
 			RequestCancelInfos: response.State.RequestCancelInfos,
 			SignalInfos:        response.State.SignalInfos,
 			SignalRequestedIDs: response.State.SignalRequestedIDs,
+			DelayedSignalInfos: response.State.DelayedSignalInfos,
+			UpdateRequestedIDs: response.State.UpdateRequestedIDs,
 			ReplicationState:   response.State.ReplicationState,
 		},
 	}
@@ -150,6 +153,7 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		DecisionScheduledTimestamp:   info.DecisionScheduledTimestamp,
 		CancelRequested:              info.CancelRequested,
 		CancelRequestID:              info.CancelRequestID,
+		Paused:                       info.Paused,
 		StickyTaskList:               info.StickyTaskList,
 		StickyScheduleToStartTimeout: info.StickyScheduleToStartTimeout,
 		ClientLibraryVersion:         info.ClientLibraryVersion,
@@ -166,6 +170,9 @@ func (m *executionManagerImpl) DeserializeExecutionInfo(
 		EventStoreVersion:            info.EventStoreVersion,
 		BranchToken:                  info.BranchToken,
 		CronSchedule:                 info.CronSchedule,
+		CronMaxJitterSeconds:         info.CronMaxJitterSeconds,
+		TaskPriority:                 info.TaskPriority,
+		BuildID:                      info.BuildID,
 		ExpirationSeconds:            info.ExpirationSeconds,
 		AutoResetPoints:              autoResetPoints,
 		SearchAttributes:             info.SearchAttributes,
@@ -251,6 +258,10 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 		if err != nil {
 			return nil, err
 		}
+		details, err := m.serializer.DecryptPayload(v.Details)
+		if err != nil {
+			return nil, err
+		}
 		a := &ActivityInfo{
 			ScheduledEvent: scheduledEvent,
 			StartedEvent:   startedEvent,
@@ -263,7 +274,7 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 			StartedTime:                    v.StartedTime,
 			ActivityID:                     v.ActivityID,
 			RequestID:                      v.RequestID,
-			Details:                        v.Details,
+			Details:                        details,
 			ScheduleToStartTimeout:         v.ScheduleToStartTimeout,
 			ScheduleToCloseTimeout:         v.ScheduleToCloseTimeout,
 			StartToCloseTimeout:            v.StartToCloseTimeout,
@@ -285,6 +296,7 @@ func (m *executionManagerImpl) DeserializeActivityInfos(
 			NonRetriableErrors:             v.NonRetriableErrors,
 			LastFailureReason:              v.LastFailureReason,
 			LastWorkerIdentity:             v.LastWorkerIdentity,
+			TaskPriority:                   v.TaskPriority,
 			LastHeartbeatTimeoutVisibility: v.LastHeartbeatTimeoutVisibility,
 		}
 		newInfos[k] = a
@@ -367,6 +379,10 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 		if err != nil {
 			return nil, err
 		}
+		details, err := m.serializer.EncryptPayload(v.Details)
+		if err != nil {
+			return nil, err
+		}
 		i := &InternalActivityInfo{
 			Version:                        v.Version,
 			ScheduleID:                     v.ScheduleID,
@@ -378,7 +394,7 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 			StartedTime:                    v.StartedTime,
 			ActivityID:                     v.ActivityID,
 			RequestID:                      v.RequestID,
-			Details:                        v.Details,
+			Details:                        details,
 			ScheduleToStartTimeout:         v.ScheduleToStartTimeout,
 			ScheduleToCloseTimeout:         v.ScheduleToCloseTimeout,
 			StartToCloseTimeout:            v.StartToCloseTimeout,
@@ -400,6 +416,7 @@ func (m *executionManagerImpl) SerializeUpsertActivityInfos(
 			NonRetriableErrors:             v.NonRetriableErrors,
 			LastFailureReason:              v.LastFailureReason,
 			LastWorkerIdentity:             v.LastWorkerIdentity,
+			TaskPriority:                   v.TaskPriority,
 			LastHeartbeatTimeoutVisibility: v.LastHeartbeatTimeoutVisibility,
 		}
 		newInfos = append(newInfos, i)
@@ -461,6 +478,7 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		DecisionScheduledTimestamp:   info.DecisionScheduledTimestamp,
 		CancelRequested:              info.CancelRequested,
 		CancelRequestID:              info.CancelRequestID,
+		Paused:                       info.Paused,
 		StickyTaskList:               info.StickyTaskList,
 		StickyScheduleToStartTimeout: info.StickyScheduleToStartTimeout,
 		ClientLibraryVersion:         info.ClientLibraryVersion,
@@ -478,6 +496,9 @@ func (m *executionManagerImpl) SerializeExecutionInfo(
 		EventStoreVersion:            info.EventStoreVersion,
 		BranchToken:                  info.BranchToken,
 		CronSchedule:                 info.CronSchedule,
+		CronMaxJitterSeconds:         info.CronMaxJitterSeconds,
+		TaskPriority:                 info.TaskPriority,
+		BuildID:                      info.BuildID,
 		ExpirationSeconds:            info.ExpirationSeconds,
 		Memo:                         info.Memo,
 		SearchAttributes:             info.SearchAttributes,
@@ -553,9 +574,7 @@ func (m *executionManagerImpl) CreateWorkflowExecution(
 	request *CreateWorkflowExecutionRequest,
 ) (*CreateWorkflowExecutionResponse, error) {
 
-	encoding := common.EncodingTypeThriftRW
-
-	serializedNewWorkflowSnapshot, err := m.SerializeWorkflowSnapshot(&request.NewWorkflowSnapshot, encoding)
+	serializedNewWorkflowSnapshot, err := m.SerializeWorkflowSnapshot(&request.NewWorkflowSnapshot, request.Encoding)
 	if err != nil {
 		return nil, err
 	}
@@ -618,6 +637,10 @@ func (m *executionManagerImpl) SerializeWorkflowMutation(
 		DeleteSignalInfo:          input.DeleteSignalInfo,
 		UpsertSignalRequestedIDs:  input.UpsertSignalRequestedIDs,
 		DeleteSignalRequestedID:   input.DeleteSignalRequestedID,
+		UpsertDelayedSignalInfos:  input.UpsertDelayedSignalInfos,
+		DeleteDelayedSignalInfos:  input.DeleteDelayedSignalInfos,
+		UpsertUpdateRequestedIDs:  input.UpsertUpdateRequestedIDs,
+		DeleteUpdateRequestedIDs:  input.DeleteUpdateRequestedIDs,
 		NewBufferedEvents:         serializedNewBufferedEvents,
 		ClearBufferedEvents:       input.ClearBufferedEvents,
 
@@ -661,6 +684,8 @@ func (m *executionManagerImpl) SerializeWorkflowSnapshot(
 		RequestCancelInfos:  input.RequestCancelInfos,
 		SignalInfos:         input.SignalInfos,
 		SignalRequestedIDs:  input.SignalRequestedIDs,
+		DelayedSignalInfos:  input.DelayedSignalInfos,
+		UpdateRequestedIDs:  input.UpdateRequestedIDs,
 
 		TransferTasks:    input.TransferTasks,
 		ReplicationTasks: input.ReplicationTasks,
@@ -51,9 +51,9 @@ type (
 var _ HistoryManager = (*historyManagerImpl)(nil)
 
 //NewHistoryManagerImpl returns new HistoryManager
-func NewHistoryManagerImpl(persistence HistoryStore, logger log.Logger, transactionSizeLimit dynamicconfig.IntPropertyFn) HistoryManager {
+func NewHistoryManagerImpl(persistence HistoryStore, serializer PayloadSerializer, logger log.Logger, transactionSizeLimit dynamicconfig.IntPropertyFn) HistoryManager {
 	return &historyManagerImpl{
-		serializer:           NewPayloadSerializer(),
+		serializer:           serializer,
 		persistence:          persistence,
 		logger:               logger,
 		transactionSizeLimit: transactionSizeLimit,
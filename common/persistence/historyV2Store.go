@@ -48,9 +48,9 @@ type (
 var _ HistoryV2Manager = (*historyV2ManagerImpl)(nil)
 
 //NewHistoryV2ManagerImpl returns new HistoryManager
-func NewHistoryV2ManagerImpl(persistence HistoryV2Store, logger log.Logger, transactionSizeLimit dynamicconfig.IntPropertyFn) HistoryV2Manager {
+func NewHistoryV2ManagerImpl(persistence HistoryV2Store, serializer PayloadSerializer, logger log.Logger, transactionSizeLimit dynamicconfig.IntPropertyFn) HistoryV2Manager {
 	return &historyV2ManagerImpl{
-		historySerializer:     NewPayloadSerializer(),
+		historySerializer:     serializer,
 		persistence:           persistence,
 		logger:                logger,
 		thriftEncoder:         codec.NewThriftRWEncoder(),
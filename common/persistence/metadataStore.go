@@ -155,6 +155,10 @@ func (m *metadataManagerImpl) serializeDomainConfig(c *DomainConfig) (InternalDo
 	if err != nil {
 		return InternalDomainConfig{}, err
 	}
+	workerVersioning, err := m.serializer.SerializeWorkerVersioning(&c.WorkerVersioning, common.EncodingTypeThriftRW)
+	if err != nil {
+		return InternalDomainConfig{}, err
+	}
 	return InternalDomainConfig{
 		Retention:                c.Retention,
 		EmitMetric:               c.EmitMetric,
@@ -163,6 +167,7 @@ func (m *metadataManagerImpl) serializeDomainConfig(c *DomainConfig) (InternalDo
 		VisibilityArchivalStatus: c.VisibilityArchivalStatus,
 		VisibilityArchivalURI:    c.VisibilityArchivalURI,
 		BadBinaries:              badBinaries,
+		WorkerVersioning:         workerVersioning,
 	}, nil
 }
 
@@ -177,6 +182,10 @@ func (m *metadataManagerImpl) deserializeDomainConfig(ic *InternalDomainConfig)
 	if badBinaries.Binaries == nil {
 		badBinaries.Binaries = map[string]*shared.BadBinaryInfo{}
 	}
+	workerVersioning, err := m.serializer.DeserializeWorkerVersioning(ic.WorkerVersioning)
+	if err != nil {
+		return DomainConfig{}, err
+	}
 	return DomainConfig{
 		Retention:                ic.Retention,
 		EmitMetric:               ic.EmitMetric,
@@ -185,6 +194,7 @@ func (m *metadataManagerImpl) deserializeDomainConfig(ic *InternalDomainConfig)
 		VisibilityArchivalStatus: ic.VisibilityArchivalStatus,
 		VisibilityArchivalURI:    ic.VisibilityArchivalURI,
 		BadBinaries:              *badBinaries,
+		WorkerVersioning:         *workerVersioning,
 	}, nil
 }
 
@@ -22,15 +22,18 @@ package persistence
 
 import (
 	"sync"
+	"sync/atomic"
 
 	"github.com/uber/cadence/common/quotas"
 
+	"github.com/uber/cadence/common/encryption"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/metrics"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/persistence/cassandra"
 	"github.com/uber/cadence/common/persistence/sql"
 	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type (
@@ -55,6 +58,9 @@ type (
 		NewExecutionManager(shardID int) (p.ExecutionManager, error)
 		// NewVisibilityManager returns a new visibility manager
 		NewVisibilityManager() (p.VisibilityManager, error)
+		// SetDomainNameResolver sets the resolver used to attribute per-domain metrics
+		// and rate limits, managers vended before the call pick it up as well
+		SetDomainNameResolver(resolver p.DomainNameResolver)
 	}
 	// DataStoreFactory is a low level interface to be implemented by a datastore
 	// Examples of datastores are cassandra, mysql etc
@@ -83,14 +89,16 @@ type (
 	// Datastore represents a datastore
 	Datastore struct {
 		factory   DataStoreFactory
-		ratelimit quotas.Limiter
+		ratelimit quotas.Policy
 	}
 	factoryImpl struct {
 		sync.RWMutex
-		config        *config.Persistence
-		metricsClient metrics.Client
-		logger        log.Logger
-		datastores    map[storeType]Datastore
+		config             *config.Persistence
+		metricsClient      metrics.Client
+		logger             log.Logger
+		serializer         p.PayloadSerializer
+		datastores         map[storeType]Datastore
+		domainNameResolver atomic.Value
 	}
 
 	storeType int
@@ -126,16 +134,21 @@ var storeTypes = []storeType{
 // also contains config for individual datastores themselves.
 //
 // The objects returned by this factory enforce ratelimit and maxconns according to
-// given configuration. In addition, all objects will emit metrics automatically
+// given configuration. In addition, all objects will emit metrics automatically, and
+// inject faults into persistence calls if fault injection is enabled for testing.
+// History events and heartbeat details are encrypted at rest with the given encryptor,
+// they are stored in plaintext if it is nil.
 func New(
 	cfg *config.Persistence,
 	clusterName string,
 	metricsClient metrics.Client,
+	encryptor encryption.Encryptor,
 	logger log.Logger) Factory {
 	factory := &factoryImpl{
 		config:        cfg,
 		metricsClient: metricsClient,
 		logger:        logger,
+		serializer:    p.NewPayloadSerializerWithEncryptor(encryptor),
 	}
 	limiters := buildRatelimiters(cfg)
 	factory.init(clusterName, limiters)
@@ -149,11 +162,14 @@ func (f *factoryImpl) NewTaskManager() (p.TaskManager, error) {
 	if err != nil {
 		return nil, err
 	}
+	if f.isFaultInjectionEnabled() {
+		result = p.NewTaskPersistenceFaultInjectionClient(result, f.config.FaultInjectionConfig, f.logger)
+	}
 	if ds.ratelimit != nil {
-		result = p.NewTaskPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
+		result = p.NewTaskPersistenceRateLimitedClient(result, ds.ratelimit, f.resolveDomainName, f.logger)
 	}
 	if f.metricsClient != nil {
-		result = p.NewTaskPersistenceMetricsClient(result, f.metricsClient, f.logger)
+		result = p.NewTaskPersistenceMetricsClient(result, f.metricsClient, f.resolveMetricsDomainName, f.logger)
 	}
 	return result, nil
 }
@@ -165,6 +181,9 @@ func (f *factoryImpl) NewShardManager() (p.ShardManager, error) {
 	if err != nil {
 		return nil, err
 	}
+	if f.isFaultInjectionEnabled() {
+		result = p.NewShardPersistenceFaultInjectionClient(result, f.config.FaultInjectionConfig, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewShardPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -182,12 +201,15 @@ func (f *factoryImpl) NewHistoryManager() (p.HistoryManager, error) {
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewHistoryManagerImpl(store, f.logger, f.config.TransactionSizeLimit)
+	result := p.NewHistoryManagerImpl(store, f.serializer, f.logger, f.config.TransactionSizeLimit)
+	if f.isFaultInjectionEnabled() {
+		result = p.NewHistoryPersistenceFaultInjectionClient(result, f.config.FaultInjectionConfig, f.logger)
+	}
 	if ds.ratelimit != nil {
-		result = p.NewHistoryPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
+		result = p.NewHistoryPersistenceRateLimitedClient(result, ds.ratelimit, f.resolveDomainName, f.logger)
 	}
 	if f.metricsClient != nil {
-		result = p.NewHistoryPersistenceMetricsClient(result, f.metricsClient, f.logger)
+		result = p.NewHistoryPersistenceMetricsClient(result, f.metricsClient, f.resolveMetricsDomainName, f.logger)
 	}
 	return result, nil
 }
@@ -199,7 +221,10 @@ func (f *factoryImpl) NewHistoryV2Manager() (p.HistoryV2Manager, error) {
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewHistoryV2ManagerImpl(store, f.logger, f.config.TransactionSizeLimit)
+	result := p.NewHistoryV2ManagerImpl(store, f.serializer, f.logger, f.config.TransactionSizeLimit)
+	if f.isFaultInjectionEnabled() {
+		result = p.NewHistoryV2PersistenceFaultInjectionClient(result, f.config.FaultInjectionConfig, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewHistoryV2PersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -228,6 +253,9 @@ func (f *factoryImpl) NewMetadataManager(version MetadataVersion) (p.MetadataMan
 	}
 
 	result := p.NewMetadataManagerImpl(store, f.logger)
+	if f.isFaultInjectionEnabled() {
+		result = p.NewMetadataPersistenceFaultInjectionClient(result, f.config.FaultInjectionConfig, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewMetadataPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -244,12 +272,15 @@ func (f *factoryImpl) NewExecutionManager(shardID int) (p.ExecutionManager, erro
 	if err != nil {
 		return nil, err
 	}
-	result := p.NewExecutionManagerImpl(store, f.logger)
+	result := p.NewExecutionManagerImpl(store, f.serializer, f.logger)
+	if f.isFaultInjectionEnabled() {
+		result = p.NewWorkflowExecutionPersistenceFaultInjectionClient(result, f.config.FaultInjectionConfig, f.logger)
+	}
 	if ds.ratelimit != nil {
-		result = p.NewWorkflowExecutionPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
+		result = p.NewWorkflowExecutionPersistenceRateLimitedClient(result, ds.ratelimit, f.resolveDomainName, f.logger)
 	}
 	if f.metricsClient != nil {
-		result = p.NewWorkflowExecutionPersistenceMetricsClient(result, f.metricsClient, f.logger)
+		result = p.NewWorkflowExecutionPersistenceMetricsClient(result, f.metricsClient, f.resolveMetricsDomainName, f.logger)
 	}
 	return result, nil
 }
@@ -267,6 +298,9 @@ func (f *factoryImpl) NewVisibilityManager() (p.VisibilityManager, error) {
 	}
 
 	result := p.NewVisibilityManagerImpl(store, f.logger)
+	if f.isFaultInjectionEnabled() {
+		result = p.NewVisibilityPersistenceFaultInjectionClient(result, f.config.FaultInjectionConfig, f.logger)
+	}
 	if ds.ratelimit != nil {
 		result = p.NewVisibilityPersistenceRateLimitedClient(result, ds.ratelimit, f.logger)
 	}
@@ -280,12 +314,40 @@ func (f *factoryImpl) NewVisibilityManager() (p.VisibilityManager, error) {
 	return result, nil
 }
 
+// SetDomainNameResolver sets the resolver used to attribute per-domain metrics and rate limits
+func (f *factoryImpl) SetDomainNameResolver(resolver p.DomainNameResolver) {
+	f.domainNameResolver.Store(resolver)
+}
+
 // Close closes this factory
 func (f *factoryImpl) Close() {
 	ds := f.datastores[storeTypeExecution]
 	ds.factory.Close()
 }
 
+func (f *factoryImpl) resolveDomainName(domainID string) (string, error) {
+	resolver, ok := f.domainNameResolver.Load().(p.DomainNameResolver)
+	if !ok || resolver == nil {
+		return "", nil
+	}
+	return resolver(domainID)
+}
+
+// resolveMetricsDomainName only resolves the domains with per-domain metrics enabled, the
+// metrics clients skip the per-domain metrics of the calls whose domain is not resolved
+func (f *factoryImpl) resolveMetricsDomainName(domainID string) (string, error) {
+	domainName, err := f.resolveDomainName(domainID)
+	if err != nil || f.config.EnableDomainMetrics == nil || !f.config.EnableDomainMetrics(domainName) {
+		return "", err
+	}
+	return domainName, nil
+}
+
+func (f *factoryImpl) isFaultInjectionEnabled() bool {
+	faultInjectionConfig := f.config.FaultInjectionConfig
+	return faultInjectionConfig != nil && faultInjectionConfig.Enabled()
+}
+
 func (f *factoryImpl) isCassandra() bool {
 	cfg := f.config
 	return cfg.DataStores[cfg.VisibilityStore].SQL == nil
@@ -296,7 +358,7 @@ func (f *factoryImpl) getCassandraConfig() *config.Cassandra {
 	return cfg.DataStores[cfg.VisibilityStore].Cassandra
 }
 
-func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Limiter) {
+func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Policy) {
 	f.datastores = make(map[storeType]Datastore, len(storeTypes))
 	defaultCfg := f.config.DataStores[f.config.DefaultStore]
 	defaultDataStore := Datastore{ratelimit: limiters[f.config.DefaultStore]}
@@ -329,8 +391,8 @@ func (f *factoryImpl) init(clusterName string, limiters map[string]quotas.Limite
 	f.datastores[storeTypeVisibility] = visibilityDataStore
 }
 
-func buildRatelimiters(cfg *config.Persistence) map[string]quotas.Limiter {
-	result := make(map[string]quotas.Limiter, len(cfg.DataStores))
+func buildRatelimiters(cfg *config.Persistence) map[string]quotas.Policy {
+	result := make(map[string]quotas.Policy, len(cfg.DataStores))
 	for dsName, ds := range cfg.DataStores {
 		qps := 0
 		if ds.Cassandra != nil {
@@ -340,8 +402,25 @@ func buildRatelimiters(cfg *config.Persistence) map[string]quotas.Limiter {
 			qps = ds.SQL.MaxQPS
 		}
 		if qps > 0 {
-			result[dsName] = quotas.NewSimpleRateLimiter(qps)
+			result[dsName] = newDatastoreRateLimiter(qps, cfg.DomainMaxQPS)
 		}
 	}
 	return result
 }
+
+// newDatastoreRateLimiter returns a policy that bounds all requests to a datastore
+// by qps and, when configured, the requests of every single domain by domainQPS
+func newDatastoreRateLimiter(qps int, domainQPS dynamicconfig.IntPropertyFnWithDomainFilter) quotas.Policy {
+	rps := func() float64 {
+		return float64(qps)
+	}
+	domainRPS := func(domain string) float64 {
+		if domainQPS != nil {
+			if limit := domainQPS(domain); limit > 0 {
+				return float64(limit)
+			}
+		}
+		return float64(qps)
+	}
+	return quotas.NewStrictMultiStageRateLimiter(rps, domainRPS)
+}
@@ -0,0 +1,793 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+const (
+	// FaultInjectionErrorTypeTimeout injects TimeoutError
+	FaultInjectionErrorTypeTimeout = "timeout"
+	// FaultInjectionErrorTypeServiceBusy injects ServiceBusyError
+	FaultInjectionErrorTypeServiceBusy = "serviceBusy"
+	// FaultInjectionErrorTypeConditionFailed injects ConditionFailedError
+	FaultInjectionErrorTypeConditionFailed = "conditionFailed"
+	// FaultInjectionErrorTypeShardOwnershipLost injects ShardOwnershipLostError
+	FaultInjectionErrorTypeShardOwnershipLost = "shardOwnershipLost"
+	// FaultInjectionErrorTypeInternal injects InternalServiceError
+	FaultInjectionErrorTypeInternal = "internal"
+)
+
+type (
+	// faultInjector decides, per persistence API, the latency and the error injected into a call
+	faultInjector struct {
+		config *config.FaultInjectionConfig
+		logger log.Logger
+	}
+
+	shardFaultInjectionPersistenceClient struct {
+		faultInjector *faultInjector
+		persistence   ShardManager
+		logger        log.Logger
+	}
+
+	workflowExecutionFaultInjectionPersistenceClient struct {
+		faultInjector *faultInjector
+		persistence   ExecutionManager
+		logger        log.Logger
+	}
+
+	taskFaultInjectionPersistenceClient struct {
+		faultInjector *faultInjector
+		persistence   TaskManager
+		logger        log.Logger
+	}
+
+	historyFaultInjectionPersistenceClient struct {
+		faultInjector *faultInjector
+		persistence   HistoryManager
+		logger        log.Logger
+	}
+
+	historyV2FaultInjectionPersistenceClient struct {
+		faultInjector *faultInjector
+		persistence   HistoryV2Manager
+		logger        log.Logger
+	}
+
+	metadataFaultInjectionPersistenceClient struct {
+		faultInjector *faultInjector
+		persistence   MetadataManager
+		logger        log.Logger
+	}
+
+	visibilityFaultInjectionPersistenceClient struct {
+		faultInjector *faultInjector
+		persistence   VisibilityManager
+		logger        log.Logger
+	}
+)
+
+var _ ShardManager = (*shardFaultInjectionPersistenceClient)(nil)
+var _ ExecutionManager = (*workflowExecutionFaultInjectionPersistenceClient)(nil)
+var _ TaskManager = (*taskFaultInjectionPersistenceClient)(nil)
+var _ HistoryManager = (*historyFaultInjectionPersistenceClient)(nil)
+var _ HistoryV2Manager = (*historyV2FaultInjectionPersistenceClient)(nil)
+var _ MetadataManager = (*metadataFaultInjectionPersistenceClient)(nil)
+var _ VisibilityManager = (*visibilityFaultInjectionPersistenceClient)(nil)
+
+// NewShardPersistenceFaultInjectionClient creates a client to manage shards
+func NewShardPersistenceFaultInjectionClient(persistence ShardManager, config *config.FaultInjectionConfig, logger log.Logger) ShardManager {
+	return &shardFaultInjectionPersistenceClient{
+		persistence:   persistence,
+		faultInjector: newFaultInjector(config, logger),
+		logger:        logger,
+	}
+}
+
+// NewWorkflowExecutionPersistenceFaultInjectionClient creates a client to manage executions
+func NewWorkflowExecutionPersistenceFaultInjectionClient(persistence ExecutionManager, config *config.FaultInjectionConfig, logger log.Logger) ExecutionManager {
+	return &workflowExecutionFaultInjectionPersistenceClient{
+		persistence:   persistence,
+		faultInjector: newFaultInjector(config, logger),
+		logger:        logger,
+	}
+}
+
+// NewTaskPersistenceFaultInjectionClient creates a client to manage tasks
+func NewTaskPersistenceFaultInjectionClient(persistence TaskManager, config *config.FaultInjectionConfig, logger log.Logger) TaskManager {
+	return &taskFaultInjectionPersistenceClient{
+		persistence:   persistence,
+		faultInjector: newFaultInjector(config, logger),
+		logger:        logger,
+	}
+}
+
+// NewHistoryPersistenceFaultInjectionClient creates a HistoryManager client to manage workflow execution history
+func NewHistoryPersistenceFaultInjectionClient(persistence HistoryManager, config *config.FaultInjectionConfig, logger log.Logger) HistoryManager {
+	return &historyFaultInjectionPersistenceClient{
+		persistence:   persistence,
+		faultInjector: newFaultInjector(config, logger),
+		logger:        logger,
+	}
+}
+
+// NewHistoryV2PersistenceFaultInjectionClient creates a HistoryManager client to manage workflow execution history
+func NewHistoryV2PersistenceFaultInjectionClient(persistence HistoryV2Manager, config *config.FaultInjectionConfig, logger log.Logger) HistoryV2Manager {
+	return &historyV2FaultInjectionPersistenceClient{
+		persistence:   persistence,
+		faultInjector: newFaultInjector(config, logger),
+		logger:        logger,
+	}
+}
+
+// NewMetadataPersistenceFaultInjectionClient creates a MetadataManager client to manage metadata
+func NewMetadataPersistenceFaultInjectionClient(persistence MetadataManager, config *config.FaultInjectionConfig, logger log.Logger) MetadataManager {
+	return &metadataFaultInjectionPersistenceClient{
+		persistence:   persistence,
+		faultInjector: newFaultInjector(config, logger),
+		logger:        logger,
+	}
+}
+
+// NewVisibilityPersistenceFaultInjectionClient creates a client to manage visibility
+func NewVisibilityPersistenceFaultInjectionClient(persistence VisibilityManager, config *config.FaultInjectionConfig, logger log.Logger) VisibilityManager {
+	return &visibilityFaultInjectionPersistenceClient{
+		persistence:   persistence,
+		faultInjector: newFaultInjector(config, logger),
+		logger:        logger,
+	}
+}
+
+func newFaultInjector(config *config.FaultInjectionConfig, logger log.Logger) *faultInjector {
+	return &faultInjector{
+		config: config,
+		logger: logger,
+	}
+}
+
+// inject delays a call to the given persistence API by the configured latency, then
+// returns an error with the configured probability
+func (f *faultInjector) inject(api string) error {
+	filter := dynamicconfig.PersistenceAPIFilter(api)
+	if latency := f.config.Latency(filter); latency > 0 {
+		time.Sleep(latency)
+	}
+
+	errorRate := f.config.ErrorRate(filter)
+	if errorRate <= 0 || rand.Float64() >= errorRate {
+		return nil
+	}
+
+	err := newInjectedError(f.config.ErrorType(filter), api)
+	f.logger.Debug("Injected persistence error", tag.Value(api), tag.Error(err))
+	return err
+}
+
+func newInjectedError(errorType string, api string) error {
+	msg := fmt.Sprintf("Injected fault for persistence API %v.", api)
+	switch errorType {
+	case FaultInjectionErrorTypeTimeout:
+		return &TimeoutError{Msg: msg}
+	case FaultInjectionErrorTypeServiceBusy:
+		return &workflow.ServiceBusyError{Message: msg}
+	case FaultInjectionErrorTypeConditionFailed:
+		return &ConditionFailedError{Msg: msg}
+	case FaultInjectionErrorTypeShardOwnershipLost:
+		return &ShardOwnershipLostError{Msg: msg}
+	default:
+		return &workflow.InternalServiceError{Message: msg}
+	}
+}
+
+func (p *shardFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *shardFaultInjectionPersistenceClient) CreateShard(request *CreateShardRequest) error {
+	if err := p.faultInjector.inject("CreateShard"); err != nil {
+		return err
+	}
+
+	err := p.persistence.CreateShard(request)
+	return err
+}
+
+func (p *shardFaultInjectionPersistenceClient) GetShard(request *GetShardRequest) (*GetShardResponse, error) {
+	if err := p.faultInjector.inject("GetShard"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetShard(request)
+	return response, err
+}
+
+func (p *shardFaultInjectionPersistenceClient) UpdateShard(request *UpdateShardRequest) error {
+	if err := p.faultInjector.inject("UpdateShard"); err != nil {
+		return err
+	}
+
+	err := p.persistence.UpdateShard(request)
+	return err
+}
+
+func (p *shardFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetShardID() int {
+	return p.persistence.GetShardID()
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
+	if err := p.faultInjector.inject("CreateWorkflowExecution"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.CreateWorkflowExecution(request)
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
+	if err := p.faultInjector.inject("GetWorkflowExecution"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetWorkflowExecution(request)
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
+	if err := p.faultInjector.inject("UpdateWorkflowExecution"); err != nil {
+		return nil, err
+	}
+
+	resp, err := p.persistence.UpdateWorkflowExecution(request)
+	return resp, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) ConflictResolveWorkflowExecution(request *ConflictResolveWorkflowExecutionRequest) error {
+	if err := p.faultInjector.inject("ConflictResolveWorkflowExecution"); err != nil {
+		return err
+	}
+
+	err := p.persistence.ConflictResolveWorkflowExecution(request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) ResetWorkflowExecution(request *ResetWorkflowExecutionRequest) error {
+	if err := p.faultInjector.inject("ResetWorkflowExecution"); err != nil {
+		return err
+	}
+
+	err := p.persistence.ResetWorkflowExecution(request)
+	return err
+}
+
+// CompleteForkBranch complete forking process
+func (p *historyV2FaultInjectionPersistenceClient) CompleteForkBranch(request *CompleteForkBranchRequest) error {
+	if err := p.faultInjector.inject("CompleteForkBranch"); err != nil {
+		return err
+	}
+	err := p.persistence.CompleteForkBranch(request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
+	if err := p.faultInjector.inject("DeleteWorkflowExecution"); err != nil {
+		return err
+	}
+
+	err := p.persistence.DeleteWorkflowExecution(request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error {
+	if err := p.faultInjector.inject("DeleteCurrentWorkflowExecution"); err != nil {
+		return err
+	}
+
+	err := p.persistence.DeleteCurrentWorkflowExecution(request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
+	if err := p.faultInjector.inject("GetCurrentExecution"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetCurrentExecution(request)
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
+	if err := p.faultInjector.inject("GetTransferTasks"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetTransferTasks(request)
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetReplicationTasks(request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error) {
+	if err := p.faultInjector.inject("GetReplicationTasks"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetReplicationTasks(request)
+	return response, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
+	if err := p.faultInjector.inject("CompleteTransferTask"); err != nil {
+		return err
+	}
+
+	err := p.persistence.CompleteTransferTask(request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error {
+	if err := p.faultInjector.inject("RangeCompleteTransferTask"); err != nil {
+		return err
+	}
+
+	err := p.persistence.RangeCompleteTransferTask(request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) CompleteReplicationTask(request *CompleteReplicationTaskRequest) error {
+	if err := p.faultInjector.inject("CompleteReplicationTask"); err != nil {
+		return err
+	}
+
+	err := p.persistence.CompleteReplicationTask(request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
+	if err := p.faultInjector.inject("GetTimerIndexTasks"); err != nil {
+		return nil, err
+	}
+
+	resonse, err := p.persistence.GetTimerIndexTasks(request)
+	return resonse, err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
+	if err := p.faultInjector.inject("CompleteTimerTask"); err != nil {
+		return err
+	}
+
+	err := p.persistence.CompleteTimerTask(request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error {
+	if err := p.faultInjector.inject("RangeCompleteTimerTask"); err != nil {
+		return err
+	}
+
+	err := p.persistence.RangeCompleteTimerTask(request)
+	return err
+}
+
+func (p *workflowExecutionFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *taskFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *taskFaultInjectionPersistenceClient) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
+	if err := p.faultInjector.inject("CreateTasks"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.CreateTasks(request)
+	return response, err
+}
+
+func (p *taskFaultInjectionPersistenceClient) GetTasks(request *GetTasksRequest) (*GetTasksResponse, error) {
+	if err := p.faultInjector.inject("GetTasks"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetTasks(request)
+	return response, err
+}
+
+func (p *taskFaultInjectionPersistenceClient) CompleteTask(request *CompleteTaskRequest) error {
+	if err := p.faultInjector.inject("CompleteTask"); err != nil {
+		return err
+	}
+
+	err := p.persistence.CompleteTask(request)
+	return err
+}
+
+func (p *taskFaultInjectionPersistenceClient) CompleteTasksLessThan(request *CompleteTasksLessThanRequest) (int, error) {
+	if err := p.faultInjector.inject("CompleteTasksLessThan"); err != nil {
+		return 0, err
+	}
+	return p.persistence.CompleteTasksLessThan(request)
+}
+
+func (p *taskFaultInjectionPersistenceClient) LeaseTaskList(request *LeaseTaskListRequest) (*LeaseTaskListResponse, error) {
+	if err := p.faultInjector.inject("LeaseTaskList"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.LeaseTaskList(request)
+	return response, err
+}
+
+func (p *taskFaultInjectionPersistenceClient) UpdateTaskList(request *UpdateTaskListRequest) (*UpdateTaskListResponse, error) {
+	if err := p.faultInjector.inject("UpdateTaskList"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.UpdateTaskList(request)
+	return response, err
+}
+
+func (p *taskFaultInjectionPersistenceClient) ListTaskList(request *ListTaskListRequest) (*ListTaskListResponse, error) {
+	if err := p.faultInjector.inject("ListTaskList"); err != nil {
+		return nil, err
+	}
+	return p.persistence.ListTaskList(request)
+}
+
+func (p *taskFaultInjectionPersistenceClient) DeleteTaskList(request *DeleteTaskListRequest) error {
+	if err := p.faultInjector.inject("DeleteTaskList"); err != nil {
+		return err
+	}
+	return p.persistence.DeleteTaskList(request)
+}
+
+func (p *taskFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *historyFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *historyFaultInjectionPersistenceClient) AppendHistoryEvents(request *AppendHistoryEventsRequest) (*AppendHistoryEventsResponse, error) {
+	if err := p.faultInjector.inject("AppendHistoryEvents"); err != nil {
+		return nil, err
+	}
+
+	resp, err := p.persistence.AppendHistoryEvents(request)
+	return resp, err
+}
+
+func (p *historyFaultInjectionPersistenceClient) GetWorkflowExecutionHistory(request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse, error) {
+	if err := p.faultInjector.inject("GetWorkflowExecutionHistory"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetWorkflowExecutionHistory(request)
+	return response, err
+}
+
+func (p *historyFaultInjectionPersistenceClient) GetWorkflowExecutionHistoryByBatch(request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryByBatchResponse, error) {
+	if err := p.faultInjector.inject("GetWorkflowExecutionHistoryByBatch"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetWorkflowExecutionHistoryByBatch(request)
+	return response, err
+}
+
+func (p *historyFaultInjectionPersistenceClient) DeleteWorkflowExecutionHistory(request *DeleteWorkflowExecutionHistoryRequest) error {
+	if err := p.faultInjector.inject("DeleteWorkflowExecutionHistory"); err != nil {
+		return err
+	}
+
+	err := p.persistence.DeleteWorkflowExecutionHistory(request)
+	return err
+}
+
+func (p *historyFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *metadataFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *metadataFaultInjectionPersistenceClient) CreateDomain(request *CreateDomainRequest) (*CreateDomainResponse, error) {
+	if err := p.faultInjector.inject("CreateDomain"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.CreateDomain(request)
+	return response, err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) GetDomain(request *GetDomainRequest) (*GetDomainResponse, error) {
+	if err := p.faultInjector.inject("GetDomain"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetDomain(request)
+	return response, err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) UpdateDomain(request *UpdateDomainRequest) error {
+	if err := p.faultInjector.inject("UpdateDomain"); err != nil {
+		return err
+	}
+
+	err := p.persistence.UpdateDomain(request)
+	return err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) DeleteDomain(request *DeleteDomainRequest) error {
+	if err := p.faultInjector.inject("DeleteDomain"); err != nil {
+		return err
+	}
+
+	err := p.persistence.DeleteDomain(request)
+	return err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) DeleteDomainByName(request *DeleteDomainByNameRequest) error {
+	if err := p.faultInjector.inject("DeleteDomainByName"); err != nil {
+		return err
+	}
+
+	err := p.persistence.DeleteDomainByName(request)
+	return err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) ListDomains(request *ListDomainsRequest) (*ListDomainsResponse, error) {
+	if err := p.faultInjector.inject("ListDomains"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListDomains(request)
+	return response, err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) GetMetadata() (*GetMetadataResponse, error) {
+	if err := p.faultInjector.inject("GetMetadata"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetMetadata()
+	return response, err
+}
+
+func (p *metadataFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) RecordWorkflowExecutionStarted(request *RecordWorkflowExecutionStartedRequest) error {
+	if err := p.faultInjector.inject("RecordWorkflowExecutionStarted"); err != nil {
+		return err
+	}
+
+	err := p.persistence.RecordWorkflowExecutionStarted(request)
+	return err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) RecordWorkflowExecutionClosed(request *RecordWorkflowExecutionClosedRequest) error {
+	if err := p.faultInjector.inject("RecordWorkflowExecutionClosed"); err != nil {
+		return err
+	}
+
+	err := p.persistence.RecordWorkflowExecutionClosed(request)
+	return err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error {
+	if err := p.faultInjector.inject("UpsertWorkflowExecution"); err != nil {
+		return err
+	}
+
+	err := p.persistence.UpsertWorkflowExecution(request)
+	return err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.inject("ListOpenWorkflowExecutions"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListOpenWorkflowExecutions(request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListClosedWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.inject("ListClosedWorkflowExecutions"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListClosedWorkflowExecutions(request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListOpenWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.inject("ListOpenWorkflowExecutionsByType"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListOpenWorkflowExecutionsByType(request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListClosedWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.inject("ListClosedWorkflowExecutionsByType"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListClosedWorkflowExecutionsByType(request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.inject("ListOpenWorkflowExecutionsByWorkflowID"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListOpenWorkflowExecutionsByWorkflowID(request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.inject("ListClosedWorkflowExecutionsByWorkflowID"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListClosedWorkflowExecutionsByWorkflowID(request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.inject("ListClosedWorkflowExecutionsByStatus"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.ListClosedWorkflowExecutionsByStatus(request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
+	if err := p.faultInjector.inject("GetClosedWorkflowExecution"); err != nil {
+		return nil, err
+	}
+
+	response, err := p.persistence.GetClosedWorkflowExecution(request)
+	return response, err
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error {
+	if err := p.faultInjector.inject("DeleteWorkflowExecution"); err != nil {
+		return err
+	}
+	return p.persistence.DeleteWorkflowExecution(request)
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ListWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.inject("ListWorkflowExecutions"); err != nil {
+		return nil, err
+	}
+	return p.persistence.ListWorkflowExecutions(request)
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) ScanWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.inject("ScanWorkflowExecutions"); err != nil {
+		return nil, err
+	}
+	return p.persistence.ScanWorkflowExecutions(request)
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) CountWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
+	if err := p.faultInjector.inject("CountWorkflowExecutions"); err != nil {
+		return nil, err
+	}
+	return p.persistence.CountWorkflowExecutions(request)
+}
+
+func (p *visibilityFaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+func (p *historyV2FaultInjectionPersistenceClient) GetName() string {
+	return p.persistence.GetName()
+}
+
+func (p *historyV2FaultInjectionPersistenceClient) Close() {
+	p.persistence.Close()
+}
+
+// AppendHistoryNodes add(or override) a node to a history branch
+func (p *historyV2FaultInjectionPersistenceClient) AppendHistoryNodes(request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
+	if err := p.faultInjector.inject("AppendHistoryNodes"); err != nil {
+		return nil, err
+	}
+	return p.persistence.AppendHistoryNodes(request)
+}
+
+// ReadHistoryBranch returns history node data for a branch
+func (p *historyV2FaultInjectionPersistenceClient) ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
+	if err := p.faultInjector.inject("ReadHistoryBranch"); err != nil {
+		return nil, err
+	}
+	response, err := p.persistence.ReadHistoryBranch(request)
+	return response, err
+}
+
+// ReadHistoryBranchByBatch returns history node data for a branch
+func (p *historyV2FaultInjectionPersistenceClient) ReadHistoryBranchByBatch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error) {
+	if err := p.faultInjector.inject("ReadHistoryBranchByBatch"); err != nil {
+		return nil, err
+	}
+	response, err := p.persistence.ReadHistoryBranchByBatch(request)
+	return response, err
+}
+
+// ForkHistoryBranch forks a new branch from a old branch
+func (p *historyV2FaultInjectionPersistenceClient) ForkHistoryBranch(request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
+	if err := p.faultInjector.inject("ForkHistoryBranch"); err != nil {
+		return nil, err
+	}
+	response, err := p.persistence.ForkHistoryBranch(request)
+	return response, err
+}
+
+// DeleteHistoryBranch removes a branch
+func (p *historyV2FaultInjectionPersistenceClient) DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error {
+	if err := p.faultInjector.inject("DeleteHistoryBranch"); err != nil {
+		return err
+	}
+	err := p.persistence.DeleteHistoryBranch(request)
+	return err
+}
+
+// GetHistoryTree returns all branch information of a tree
+func (p *historyV2FaultInjectionPersistenceClient) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
+	if err := p.faultInjector.inject("GetHistoryTree"); err != nil {
+		return nil, err
+	}
+	response, err := p.persistence.GetHistoryTree(request)
+	return response, err
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/service/config"
+	"github.com/uber/cadence/common/service/dynamicconfig"
+)
+
+type (
+	faultInjectionClientsSuite struct {
+		suite.Suite
+		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
+		// not merely log an error
+		*require.Assertions
+	}
+)
+
+func TestFaultInjectionClientsSuite(t *testing.T) {
+	s := new(faultInjectionClientsSuite)
+	suite.Run(t, s)
+}
+
+func (s *faultInjectionClientsSuite) SetupTest() {
+	// Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+	s.Assertions = require.New(s.T())
+}
+
+func (s *faultInjectionClientsSuite) TestInject_NoFault() {
+	injector := newFaultInjector(s.newConfig(0, FaultInjectionErrorTypeTimeout, 0), loggerimpl.NewNopLogger())
+	for i := 0; i < 100; i++ {
+		s.NoError(injector.inject("GetShard"))
+	}
+}
+
+func (s *faultInjectionClientsSuite) TestInject_ErrorTypes() {
+	testCases := []struct {
+		errorType string
+		validate  func(err error)
+	}{
+		{FaultInjectionErrorTypeTimeout, func(err error) { s.IsType(&TimeoutError{}, err) }},
+		{FaultInjectionErrorTypeServiceBusy, func(err error) { s.IsType(&workflow.ServiceBusyError{}, err) }},
+		{FaultInjectionErrorTypeConditionFailed, func(err error) { s.IsType(&ConditionFailedError{}, err) }},
+		{FaultInjectionErrorTypeShardOwnershipLost, func(err error) { s.IsType(&ShardOwnershipLostError{}, err) }},
+		{FaultInjectionErrorTypeInternal, func(err error) { s.IsType(&workflow.InternalServiceError{}, err) }},
+		{"unknown", func(err error) { s.IsType(&workflow.InternalServiceError{}, err) }},
+	}
+
+	for _, tc := range testCases {
+		injector := newFaultInjector(s.newConfig(1, tc.errorType, 0), loggerimpl.NewNopLogger())
+		tc.validate(injector.inject("GetShard"))
+	}
+}
+
+func (s *faultInjectionClientsSuite) TestInject_FilteredByAPI() {
+	cfg := s.newConfig(0, FaultInjectionErrorTypeTimeout, 0)
+	cfg.ErrorRate = func(opts ...dynamicconfig.FilterOption) float64 {
+		filters := make(map[dynamicconfig.Filter]interface{})
+		for _, opt := range opts {
+			opt(filters)
+		}
+		if filters[dynamicconfig.PersistenceAPI] == "UpdateShard" {
+			return 1
+		}
+		return 0
+	}
+
+	injector := newFaultInjector(cfg, loggerimpl.NewNopLogger())
+	s.NoError(injector.inject("GetShard"))
+	s.Error(injector.inject("UpdateShard"))
+}
+
+func (s *faultInjectionClientsSuite) TestInject_Latency() {
+	injector := newFaultInjector(s.newConfig(0, FaultInjectionErrorTypeTimeout, 10*time.Millisecond), loggerimpl.NewNopLogger())
+	start := time.Now()
+	s.NoError(injector.inject("GetShard"))
+	s.True(time.Since(start) >= 10*time.Millisecond)
+}
+
+func (s *faultInjectionClientsSuite) TestClient_FaultInjected() {
+	// the wrapped manager is never called once a fault is injected
+	client := NewShardPersistenceFaultInjectionClient(nil, s.newConfig(1, FaultInjectionErrorTypeShardOwnershipLost, 0), loggerimpl.NewNopLogger())
+	response, err := client.GetShard(&GetShardRequest{ShardID: 1})
+	s.Nil(response)
+	s.IsType(&ShardOwnershipLostError{}, err)
+}
+
+func (s *faultInjectionClientsSuite) newConfig(errorRate float64, errorType string, latency time.Duration) *config.FaultInjectionConfig {
+	return &config.FaultInjectionConfig{
+		Enabled:   dynamicconfig.GetBoolPropertyFn(true),
+		ErrorRate: dynamicconfig.GetFloatPropertyFn(errorRate),
+		ErrorType: dynamicconfig.GetStringPropertyFn(errorType),
+		Latency:   dynamicconfig.GetDurationPropertyFn(latency),
+	}
+}
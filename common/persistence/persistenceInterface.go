@@ -198,6 +198,7 @@ type (
 		DecisionScheduledTimestamp   int64
 		CancelRequested              bool
 		CancelRequestID              string
+		Paused                       bool
 		StickyTaskList               string
 		StickyScheduleToStartTimeout int32
 		ClientLibraryVersion         string
@@ -214,12 +215,15 @@ type (
 		MaximumAttempts    int32
 		NonRetriableErrors []string
 		// events V2 related
-		EventStoreVersion int32
-		BranchToken       []byte
-		CronSchedule      string
-		ExpirationSeconds int32
-		Memo              map[string][]byte
-		SearchAttributes  map[string][]byte
+		EventStoreVersion    int32
+		BranchToken          []byte
+		CronSchedule         string
+		CronMaxJitterSeconds int32
+		ExpirationSeconds    int32
+		TaskPriority         int32
+		BuildID              string
+		Memo                 map[string][]byte
+		SearchAttributes     map[string][]byte
 
 		// attributes which are not related to mutable state at all
 		HistorySize int64
@@ -233,6 +237,8 @@ type (
 		RequestCancelInfos  map[int64]*RequestCancelInfo
 		SignalInfos         map[int64]*SignalInfo
 		SignalRequestedIDs  map[string]struct{}
+		DelayedSignalInfos  map[string]*DelayedSignalInfo
+		UpdateRequestedIDs  map[string]int64 // request ID -> event ID to look up the update result from
 		ExecutionInfo       *InternalWorkflowExecutionInfo
 		ReplicationState    *ReplicationState
 		BufferedEvents      []*DataBlob
@@ -273,6 +279,7 @@ type (
 		NonRetriableErrors []string
 		LastFailureReason  string
 		LastWorkerIdentity string
+		TaskPriority       int32
 		// Not written to database - This is used only for deduping heartbeat timer creation
 		LastHeartbeatTimeoutVisibility int64
 	}
@@ -353,6 +360,10 @@ type (
 		DeleteSignalInfo          *int64
 		UpsertSignalRequestedIDs  []string
 		DeleteSignalRequestedID   string
+		UpsertDelayedSignalInfos  []*DelayedSignalInfo
+		DeleteDelayedSignalInfos  []string
+		UpsertUpdateRequestedIDs  map[string]int64
+		DeleteUpdateRequestedIDs  []string
 		NewBufferedEvents         *DataBlob
 		ClearBufferedEvents       bool
 
@@ -374,6 +385,8 @@ type (
 		RequestCancelInfos  []*RequestCancelInfo
 		SignalInfos         []*SignalInfo
 		SignalRequestedIDs  []string
+		DelayedSignalInfos  []*DelayedSignalInfo
+		UpdateRequestedIDs  map[string]int64
 
 		TransferTasks    []Task
 		TimerTasks       []Task
@@ -592,6 +605,7 @@ type (
 		VisibilityArchivalStatus workflow.ArchivalStatus
 		VisibilityArchivalURI    string
 		BadBinaries              *DataBlob
+		WorkerVersioning         *DataBlob
 	}
 
 	// InternalCreateDomainRequest is used to create the domain
@@ -641,7 +655,7 @@ func NewDataBlob(data []byte, encodingType common.EncodingType) *DataBlob {
 	if data == nil || len(data) == 0 {
 		return nil
 	}
-	if encodingType != "thriftrw" && data[0] == 'Y' {
+	if encodingType != common.EncodingTypeThriftRW && encodingType != common.EncodingTypeThriftRWSnappy && data[0] == 'Y' {
 		panic(fmt.Sprintf("Invalid incoding: \"%v\"", encodingType))
 	}
 	return &DataBlob{
@@ -669,6 +683,8 @@ func (d *DataBlob) GetEncoding() common.EncodingType {
 		return common.EncodingTypeJSON
 	case common.EncodingTypeThriftRW:
 		return common.EncodingTypeThriftRW
+	case common.EncodingTypeThriftRWSnappy:
+		return common.EncodingTypeThriftRWSnappy
 	case common.EncodingTypeEmpty:
 		return common.EncodingTypeEmpty
 	default:
@@ -35,21 +35,24 @@ type (
 	}
 
 	workflowExecutionPersistenceClient struct {
-		metricClient metrics.Client
-		persistence  ExecutionManager
-		logger       log.Logger
+		metricClient       metrics.Client
+		persistence        ExecutionManager
+		domainNameResolver DomainNameResolver
+		logger             log.Logger
 	}
 
 	taskPersistenceClient struct {
-		metricClient metrics.Client
-		persistence  TaskManager
-		logger       log.Logger
+		metricClient       metrics.Client
+		persistence        TaskManager
+		domainNameResolver DomainNameResolver
+		logger             log.Logger
 	}
 
 	historyPersistenceClient struct {
-		metricClient metrics.Client
-		persistence  HistoryManager
-		logger       log.Logger
+		metricClient       metrics.Client
+		persistence        HistoryManager
+		domainNameResolver DomainNameResolver
+		logger             log.Logger
 	}
 
 	historyV2PersistenceClient struct {
@@ -89,29 +92,32 @@ func NewShardPersistenceMetricsClient(persistence ShardManager, metricClient met
 }
 
 // NewWorkflowExecutionPersistenceMetricsClient creates a client to manage executions
-func NewWorkflowExecutionPersistenceMetricsClient(persistence ExecutionManager, metricClient metrics.Client, logger log.Logger) ExecutionManager {
+func NewWorkflowExecutionPersistenceMetricsClient(persistence ExecutionManager, metricClient metrics.Client, domainNameResolver DomainNameResolver, logger log.Logger) ExecutionManager {
 	return &workflowExecutionPersistenceClient{
-		persistence:  persistence,
-		metricClient: metricClient,
-		logger:       logger,
+		persistence:        persistence,
+		metricClient:       metricClient,
+		domainNameResolver: domainNameResolver,
+		logger:             logger,
 	}
 }
 
 // NewTaskPersistenceMetricsClient creates a client to manage tasks
-func NewTaskPersistenceMetricsClient(persistence TaskManager, metricClient metrics.Client, logger log.Logger) TaskManager {
+func NewTaskPersistenceMetricsClient(persistence TaskManager, metricClient metrics.Client, domainNameResolver DomainNameResolver, logger log.Logger) TaskManager {
 	return &taskPersistenceClient{
-		persistence:  persistence,
-		metricClient: metricClient,
-		logger:       logger,
+		persistence:        persistence,
+		metricClient:       metricClient,
+		domainNameResolver: domainNameResolver,
+		logger:             logger,
 	}
 }
 
 // NewHistoryPersistenceMetricsClient creates a HistoryManager client to manage workflow execution history
-func NewHistoryPersistenceMetricsClient(persistence HistoryManager, metricClient metrics.Client, logger log.Logger) HistoryManager {
+func NewHistoryPersistenceMetricsClient(persistence HistoryManager, metricClient metrics.Client, domainNameResolver DomainNameResolver, logger log.Logger) HistoryManager {
 	return &historyPersistenceClient{
-		persistence:  persistence,
-		metricClient: metricClient,
-		logger:       logger,
+		persistence:        persistence,
+		metricClient:       metricClient,
+		domainNameResolver: domainNameResolver,
+		logger:             logger,
 	}
 }
 
@@ -221,9 +227,11 @@ func (p *workflowExecutionPersistenceClient) GetShardID() int {
 func (p *workflowExecutionPersistenceClient) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceCreateWorkflowExecutionScope, metrics.PersistenceRequests)
 
+	stopDomainMetrics := startDomainMetrics(p.metricClient, p.domainNameResolver, metrics.PersistenceCreateWorkflowExecutionScope, executionDomainID(request.NewWorkflowSnapshot.ExecutionInfo))
 	sw := p.metricClient.StartTimer(metrics.PersistenceCreateWorkflowExecutionScope, metrics.PersistenceLatency)
 	response, err := p.persistence.CreateWorkflowExecution(request)
 	sw.Stop()
+	stopDomainMetrics(err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceCreateWorkflowExecutionScope, err)
@@ -235,9 +243,11 @@ func (p *workflowExecutionPersistenceClient) CreateWorkflowExecution(request *Cr
 func (p *workflowExecutionPersistenceClient) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetWorkflowExecutionScope, metrics.PersistenceRequests)
 
+	stopDomainMetrics := startDomainMetrics(p.metricClient, p.domainNameResolver, metrics.PersistenceGetWorkflowExecutionScope, request.DomainID)
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetWorkflowExecutionScope, metrics.PersistenceLatency)
 	response, err := p.persistence.GetWorkflowExecution(request)
 	sw.Stop()
+	stopDomainMetrics(err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetWorkflowExecutionScope, err)
@@ -249,9 +259,11 @@ func (p *workflowExecutionPersistenceClient) GetWorkflowExecution(request *GetWo
 func (p *workflowExecutionPersistenceClient) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceUpdateWorkflowExecutionScope, metrics.PersistenceRequests)
 
+	stopDomainMetrics := startDomainMetrics(p.metricClient, p.domainNameResolver, metrics.PersistenceUpdateWorkflowExecutionScope, executionDomainID(request.UpdateWorkflowMutation.ExecutionInfo))
 	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateWorkflowExecutionScope, metrics.PersistenceLatency)
 	resp, err := p.persistence.UpdateWorkflowExecution(request)
 	sw.Stop()
+	stopDomainMetrics(err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceUpdateWorkflowExecutionScope, err)
@@ -263,9 +275,11 @@ func (p *workflowExecutionPersistenceClient) UpdateWorkflowExecution(request *Up
 func (p *workflowExecutionPersistenceClient) ConflictResolveWorkflowExecution(request *ConflictResolveWorkflowExecutionRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceConflictResolveWorkflowExecutionScope, metrics.PersistenceRequests)
 
+	stopDomainMetrics := startDomainMetrics(p.metricClient, p.domainNameResolver, metrics.PersistenceConflictResolveWorkflowExecutionScope, executionDomainID(request.ResetWorkflowSnapshot.ExecutionInfo))
 	sw := p.metricClient.StartTimer(metrics.PersistenceConflictResolveWorkflowExecutionScope, metrics.PersistenceLatency)
 	err := p.persistence.ConflictResolveWorkflowExecution(request)
 	sw.Stop()
+	stopDomainMetrics(err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceConflictResolveWorkflowExecutionScope, err)
@@ -277,9 +291,11 @@ func (p *workflowExecutionPersistenceClient) ConflictResolveWorkflowExecution(re
 func (p *workflowExecutionPersistenceClient) ResetWorkflowExecution(request *ResetWorkflowExecutionRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceResetWorkflowExecutionScope, metrics.PersistenceRequests)
 
+	stopDomainMetrics := startDomainMetrics(p.metricClient, p.domainNameResolver, metrics.PersistenceResetWorkflowExecutionScope, executionDomainID(request.NewWorkflowSnapshot.ExecutionInfo))
 	sw := p.metricClient.StartTimer(metrics.PersistenceResetWorkflowExecutionScope, metrics.PersistenceLatency)
 	err := p.persistence.ResetWorkflowExecution(request)
 	sw.Stop()
+	stopDomainMetrics(err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceResetWorkflowExecutionScope, err)
@@ -291,9 +307,11 @@ func (p *workflowExecutionPersistenceClient) ResetWorkflowExecution(request *Res
 func (p *workflowExecutionPersistenceClient) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteWorkflowExecutionScope, metrics.PersistenceRequests)
 
+	stopDomainMetrics := startDomainMetrics(p.metricClient, p.domainNameResolver, metrics.PersistenceDeleteWorkflowExecutionScope, request.DomainID)
 	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteWorkflowExecutionScope, metrics.PersistenceLatency)
 	err := p.persistence.DeleteWorkflowExecution(request)
 	sw.Stop()
+	stopDomainMetrics(err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceDeleteWorkflowExecutionScope, err)
@@ -305,9 +323,11 @@ func (p *workflowExecutionPersistenceClient) DeleteWorkflowExecution(request *De
 func (p *workflowExecutionPersistenceClient) DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteCurrentWorkflowExecutionScope, metrics.PersistenceRequests)
 
+	stopDomainMetrics := startDomainMetrics(p.metricClient, p.domainNameResolver, metrics.PersistenceDeleteCurrentWorkflowExecutionScope, request.DomainID)
 	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteCurrentWorkflowExecutionScope, metrics.PersistenceLatency)
 	err := p.persistence.DeleteCurrentWorkflowExecution(request)
 	sw.Stop()
+	stopDomainMetrics(err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceDeleteCurrentWorkflowExecutionScope, err)
@@ -319,9 +339,11 @@ func (p *workflowExecutionPersistenceClient) DeleteCurrentWorkflowExecution(requ
 func (p *workflowExecutionPersistenceClient) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetCurrentExecutionScope, metrics.PersistenceRequests)
 
+	stopDomainMetrics := startDomainMetrics(p.metricClient, p.domainNameResolver, metrics.PersistenceGetCurrentExecutionScope, request.DomainID)
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetCurrentExecutionScope, metrics.PersistenceLatency)
 	response, err := p.persistence.GetCurrentExecution(request)
 	sw.Stop()
+	stopDomainMetrics(err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetCurrentExecutionScope, err)
@@ -478,9 +500,11 @@ func (p *taskPersistenceClient) GetName() string {
 func (p *taskPersistenceClient) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceCreateTaskScope, metrics.PersistenceRequests)
 
+	stopDomainMetrics := startDomainMetrics(p.metricClient, p.domainNameResolver, metrics.PersistenceCreateTaskScope, taskListDomainID(request.TaskListInfo))
 	sw := p.metricClient.StartTimer(metrics.PersistenceCreateTaskScope, metrics.PersistenceLatency)
 	response, err := p.persistence.CreateTasks(request)
 	sw.Stop()
+	stopDomainMetrics(err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceCreateTaskScope, err)
@@ -492,9 +516,11 @@ func (p *taskPersistenceClient) CreateTasks(request *CreateTasksRequest) (*Creat
 func (p *taskPersistenceClient) GetTasks(request *GetTasksRequest) (*GetTasksResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetTasksScope, metrics.PersistenceRequests)
 
+	stopDomainMetrics := startDomainMetrics(p.metricClient, p.domainNameResolver, metrics.PersistenceGetTasksScope, request.DomainID)
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetTasksScope, metrics.PersistenceLatency)
 	response, err := p.persistence.GetTasks(request)
 	sw.Stop()
+	stopDomainMetrics(err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetTasksScope, err)
@@ -506,9 +532,11 @@ func (p *taskPersistenceClient) GetTasks(request *GetTasksRequest) (*GetTasksRes
 func (p *taskPersistenceClient) CompleteTask(request *CompleteTaskRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceCompleteTaskScope, metrics.PersistenceRequests)
 
+	stopDomainMetrics := startDomainMetrics(p.metricClient, p.domainNameResolver, metrics.PersistenceCompleteTaskScope, taskListDomainID(request.TaskList))
 	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteTaskScope, metrics.PersistenceLatency)
 	err := p.persistence.CompleteTask(request)
 	sw.Stop()
+	stopDomainMetrics(err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceCompleteTaskScope, err)
@@ -519,9 +547,11 @@ func (p *taskPersistenceClient) CompleteTask(request *CompleteTaskRequest) error
 
 func (p *taskPersistenceClient) CompleteTasksLessThan(request *CompleteTasksLessThanRequest) (int, error) {
 	p.metricClient.IncCounter(metrics.PersistenceCompleteTasksLessThanScope, metrics.PersistenceRequests)
+	stopDomainMetrics := startDomainMetrics(p.metricClient, p.domainNameResolver, metrics.PersistenceCompleteTasksLessThanScope, request.DomainID)
 	sw := p.metricClient.StartTimer(metrics.PersistenceCompleteTasksLessThanScope, metrics.PersistenceLatency)
 	result, err := p.persistence.CompleteTasksLessThan(request)
 	sw.Stop()
+	stopDomainMetrics(err)
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceCompleteTasksLessThanScope, err)
 	}
@@ -531,9 +561,11 @@ func (p *taskPersistenceClient) CompleteTasksLessThan(request *CompleteTasksLess
 func (p *taskPersistenceClient) LeaseTaskList(request *LeaseTaskListRequest) (*LeaseTaskListResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceLeaseTaskListScope, metrics.PersistenceRequests)
 
+	stopDomainMetrics := startDomainMetrics(p.metricClient, p.domainNameResolver, metrics.PersistenceLeaseTaskListScope, request.DomainID)
 	sw := p.metricClient.StartTimer(metrics.PersistenceLeaseTaskListScope, metrics.PersistenceLatency)
 	response, err := p.persistence.LeaseTaskList(request)
 	sw.Stop()
+	stopDomainMetrics(err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceLeaseTaskListScope, err)
@@ -555,9 +587,11 @@ func (p *taskPersistenceClient) ListTaskList(request *ListTaskListRequest) (*Lis
 
 func (p *taskPersistenceClient) DeleteTaskList(request *DeleteTaskListRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteTaskListScope, metrics.PersistenceRequests)
+	stopDomainMetrics := startDomainMetrics(p.metricClient, p.domainNameResolver, metrics.PersistenceDeleteTaskListScope, request.DomainID)
 	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteTaskListScope, metrics.PersistenceLatency)
 	err := p.persistence.DeleteTaskList(request)
 	sw.Stop()
+	stopDomainMetrics(err)
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceDeleteTaskListScope, err)
 	}
@@ -567,9 +601,11 @@ func (p *taskPersistenceClient) DeleteTaskList(request *DeleteTaskListRequest) e
 func (p *taskPersistenceClient) UpdateTaskList(request *UpdateTaskListRequest) (*UpdateTaskListResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceUpdateTaskListScope, metrics.PersistenceRequests)
 
+	stopDomainMetrics := startDomainMetrics(p.metricClient, p.domainNameResolver, metrics.PersistenceUpdateTaskListScope, taskListDomainID(request.TaskListInfo))
 	sw := p.metricClient.StartTimer(metrics.PersistenceUpdateTaskListScope, metrics.PersistenceLatency)
 	response, err := p.persistence.UpdateTaskList(request)
 	sw.Stop()
+	stopDomainMetrics(err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceUpdateTaskListScope, err)
@@ -606,9 +642,11 @@ func (p *historyPersistenceClient) GetName() string {
 func (p *historyPersistenceClient) AppendHistoryEvents(request *AppendHistoryEventsRequest) (*AppendHistoryEventsResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceAppendHistoryEventsScope, metrics.PersistenceRequests)
 
+	stopDomainMetrics := startDomainMetrics(p.metricClient, p.domainNameResolver, metrics.PersistenceAppendHistoryEventsScope, request.DomainID)
 	sw := p.metricClient.StartTimer(metrics.PersistenceAppendHistoryEventsScope, metrics.PersistenceLatency)
 	resp, err := p.persistence.AppendHistoryEvents(request)
 	sw.Stop()
+	stopDomainMetrics(err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceAppendHistoryEventsScope, err)
@@ -621,9 +659,11 @@ func (p *historyPersistenceClient) GetWorkflowExecutionHistory(
 	request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetWorkflowExecutionHistoryScope, metrics.PersistenceRequests)
 
+	stopDomainMetrics := startDomainMetrics(p.metricClient, p.domainNameResolver, metrics.PersistenceGetWorkflowExecutionHistoryScope, request.DomainID)
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetWorkflowExecutionHistoryScope, metrics.PersistenceLatency)
 	response, err := p.persistence.GetWorkflowExecutionHistory(request)
 	sw.Stop()
+	stopDomainMetrics(err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetWorkflowExecutionHistoryScope, err)
@@ -636,9 +676,11 @@ func (p *historyPersistenceClient) GetWorkflowExecutionHistoryByBatch(
 	request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryByBatchResponse, error) {
 	p.metricClient.IncCounter(metrics.PersistenceGetWorkflowExecutionHistoryScope, metrics.PersistenceRequests)
 
+	stopDomainMetrics := startDomainMetrics(p.metricClient, p.domainNameResolver, metrics.PersistenceGetWorkflowExecutionHistoryScope, request.DomainID)
 	sw := p.metricClient.StartTimer(metrics.PersistenceGetWorkflowExecutionHistoryScope, metrics.PersistenceLatency)
 	response, err := p.persistence.GetWorkflowExecutionHistoryByBatch(request)
 	sw.Stop()
+	stopDomainMetrics(err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceGetWorkflowExecutionHistoryScope, err)
@@ -651,9 +693,11 @@ func (p *historyPersistenceClient) DeleteWorkflowExecutionHistory(
 	request *DeleteWorkflowExecutionHistoryRequest) error {
 	p.metricClient.IncCounter(metrics.PersistenceDeleteWorkflowExecutionHistoryScope, metrics.PersistenceRequests)
 
+	stopDomainMetrics := startDomainMetrics(p.metricClient, p.domainNameResolver, metrics.PersistenceDeleteWorkflowExecutionHistoryScope, request.DomainID)
 	sw := p.metricClient.StartTimer(metrics.PersistenceDeleteWorkflowExecutionHistoryScope, metrics.PersistenceLatency)
 	err := p.persistence.DeleteWorkflowExecutionHistory(request)
 	sw.Stop()
+	stopDomainMetrics(err)
 
 	if err != nil {
 		p.updateErrorMetric(metrics.PersistenceDeleteWorkflowExecutionHistoryScope, err)
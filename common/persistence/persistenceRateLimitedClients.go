@@ -35,43 +35,46 @@ var (
 
 type (
 	shardRateLimitedPersistenceClient struct {
-		rateLimiter quotas.Limiter
+		rateLimiter quotas.Policy
 		persistence ShardManager
 		logger      log.Logger
 	}
 
 	workflowExecutionRateLimitedPersistenceClient struct {
-		rateLimiter quotas.Limiter
-		persistence ExecutionManager
-		logger      log.Logger
+		rateLimiter        quotas.Policy
+		persistence        ExecutionManager
+		domainNameResolver DomainNameResolver
+		logger             log.Logger
 	}
 
 	taskRateLimitedPersistenceClient struct {
-		rateLimiter quotas.Limiter
-		persistence TaskManager
-		logger      log.Logger
+		rateLimiter        quotas.Policy
+		persistence        TaskManager
+		domainNameResolver DomainNameResolver
+		logger             log.Logger
 	}
 
 	historyRateLimitedPersistenceClient struct {
-		rateLimiter quotas.Limiter
-		persistence HistoryManager
-		logger      log.Logger
+		rateLimiter        quotas.Policy
+		persistence        HistoryManager
+		domainNameResolver DomainNameResolver
+		logger             log.Logger
 	}
 
 	historyV2RateLimitedPersistenceClient struct {
-		rateLimiter quotas.Limiter
+		rateLimiter quotas.Policy
 		persistence HistoryV2Manager
 		logger      log.Logger
 	}
 
 	metadataRateLimitedPersistenceClient struct {
-		rateLimiter quotas.Limiter
+		rateLimiter quotas.Policy
 		persistence MetadataManager
 		logger      log.Logger
 	}
 
 	visibilityRateLimitedPersistenceClient struct {
-		rateLimiter quotas.Limiter
+		rateLimiter quotas.Policy
 		persistence VisibilityManager
 		logger      log.Logger
 	}
@@ -86,7 +89,7 @@ var _ MetadataManager = (*metadataRateLimitedPersistenceClient)(nil)
 var _ VisibilityManager = (*visibilityRateLimitedPersistenceClient)(nil)
 
 // NewShardPersistenceRateLimitedClient creates a client to manage shards
-func NewShardPersistenceRateLimitedClient(persistence ShardManager, rateLimiter quotas.Limiter, logger log.Logger) ShardManager {
+func NewShardPersistenceRateLimitedClient(persistence ShardManager, rateLimiter quotas.Policy, logger log.Logger) ShardManager {
 	return &shardRateLimitedPersistenceClient{
 		persistence: persistence,
 		rateLimiter: rateLimiter,
@@ -95,34 +98,37 @@ func NewShardPersistenceRateLimitedClient(persistence ShardManager, rateLimiter
 }
 
 // NewWorkflowExecutionPersistenceRateLimitedClient creates a client to manage executions
-func NewWorkflowExecutionPersistenceRateLimitedClient(persistence ExecutionManager, rateLimiter quotas.Limiter, logger log.Logger) ExecutionManager {
+func NewWorkflowExecutionPersistenceRateLimitedClient(persistence ExecutionManager, rateLimiter quotas.Policy, domainNameResolver DomainNameResolver, logger log.Logger) ExecutionManager {
 	return &workflowExecutionRateLimitedPersistenceClient{
-		persistence: persistence,
-		rateLimiter: rateLimiter,
-		logger:      logger,
+		persistence:        persistence,
+		rateLimiter:        rateLimiter,
+		domainNameResolver: domainNameResolver,
+		logger:             logger,
 	}
 }
 
 // NewTaskPersistenceRateLimitedClient creates a client to manage tasks
-func NewTaskPersistenceRateLimitedClient(persistence TaskManager, rateLimiter quotas.Limiter, logger log.Logger) TaskManager {
+func NewTaskPersistenceRateLimitedClient(persistence TaskManager, rateLimiter quotas.Policy, domainNameResolver DomainNameResolver, logger log.Logger) TaskManager {
 	return &taskRateLimitedPersistenceClient{
-		persistence: persistence,
-		rateLimiter: rateLimiter,
-		logger:      logger,
+		persistence:        persistence,
+		rateLimiter:        rateLimiter,
+		domainNameResolver: domainNameResolver,
+		logger:             logger,
 	}
 }
 
 // NewHistoryPersistenceRateLimitedClient creates a HistoryManager client to manage workflow execution history
-func NewHistoryPersistenceRateLimitedClient(persistence HistoryManager, rateLimiter quotas.Limiter, logger log.Logger) HistoryManager {
+func NewHistoryPersistenceRateLimitedClient(persistence HistoryManager, rateLimiter quotas.Policy, domainNameResolver DomainNameResolver, logger log.Logger) HistoryManager {
 	return &historyRateLimitedPersistenceClient{
-		persistence: persistence,
-		rateLimiter: rateLimiter,
-		logger:      logger,
+		persistence:        persistence,
+		rateLimiter:        rateLimiter,
+		domainNameResolver: domainNameResolver,
+		logger:             logger,
 	}
 }
 
 // NewHistoryV2PersistenceRateLimitedClient creates a HistoryManager client to manage workflow execution history
-func NewHistoryV2PersistenceRateLimitedClient(persistence HistoryV2Manager, rateLimiter quotas.Limiter, logger log.Logger) HistoryV2Manager {
+func NewHistoryV2PersistenceRateLimitedClient(persistence HistoryV2Manager, rateLimiter quotas.Policy, logger log.Logger) HistoryV2Manager {
 	return &historyV2RateLimitedPersistenceClient{
 		persistence: persistence,
 		rateLimiter: rateLimiter,
@@ -131,7 +137,7 @@ func NewHistoryV2PersistenceRateLimitedClient(persistence HistoryV2Manager, rate
 }
 
 // NewMetadataPersistenceRateLimitedClient creates a MetadataManager client to manage metadata
-func NewMetadataPersistenceRateLimitedClient(persistence MetadataManager, rateLimiter quotas.Limiter, logger log.Logger) MetadataManager {
+func NewMetadataPersistenceRateLimitedClient(persistence MetadataManager, rateLimiter quotas.Policy, logger log.Logger) MetadataManager {
 	return &metadataRateLimitedPersistenceClient{
 		persistence: persistence,
 		rateLimiter: rateLimiter,
@@ -140,7 +146,7 @@ func NewMetadataPersistenceRateLimitedClient(persistence MetadataManager, rateLi
 }
 
 // NewVisibilityPersistenceRateLimitedClient creates a client to manage visibility
-func NewVisibilityPersistenceRateLimitedClient(persistence VisibilityManager, rateLimiter quotas.Limiter, logger log.Logger) VisibilityManager {
+func NewVisibilityPersistenceRateLimitedClient(persistence VisibilityManager, rateLimiter quotas.Policy, logger log.Logger) VisibilityManager {
 	return &visibilityRateLimitedPersistenceClient{
 		persistence: persistence,
 		rateLimiter: rateLimiter,
@@ -153,7 +159,7 @@ func (p *shardRateLimitedPersistenceClient) GetName() string {
 }
 
 func (p *shardRateLimitedPersistenceClient) CreateShard(request *CreateShardRequest) error {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -162,7 +168,7 @@ func (p *shardRateLimitedPersistenceClient) CreateShard(request *CreateShardRequ
 }
 
 func (p *shardRateLimitedPersistenceClient) GetShard(request *GetShardRequest) (*GetShardResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -171,7 +177,7 @@ func (p *shardRateLimitedPersistenceClient) GetShard(request *GetShardRequest) (
 }
 
 func (p *shardRateLimitedPersistenceClient) UpdateShard(request *UpdateShardRequest) error {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -192,7 +198,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) GetShardID() int {
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) CreateWorkflowExecution(request *CreateWorkflowExecutionRequest) (*CreateWorkflowExecutionResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(domainQuotaInfo(p.domainNameResolver, executionDomainID(request.NewWorkflowSnapshot.ExecutionInfo))); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -201,7 +207,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) CreateWorkflowExecution(
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (*GetWorkflowExecutionResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(domainQuotaInfo(p.domainNameResolver, request.DomainID)); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -210,7 +216,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) GetWorkflowExecution(req
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) UpdateWorkflowExecution(request *UpdateWorkflowExecutionRequest) (*UpdateWorkflowExecutionResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(domainQuotaInfo(p.domainNameResolver, executionDomainID(request.UpdateWorkflowMutation.ExecutionInfo))); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -219,7 +225,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) UpdateWorkflowExecution(
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) ConflictResolveWorkflowExecution(request *ConflictResolveWorkflowExecutionRequest) error {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(domainQuotaInfo(p.domainNameResolver, executionDomainID(request.ResetWorkflowSnapshot.ExecutionInfo))); !ok {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -228,7 +234,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) ConflictResolveWorkflowE
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) ResetWorkflowExecution(request *ResetWorkflowExecutionRequest) error {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(domainQuotaInfo(p.domainNameResolver, executionDomainID(request.NewWorkflowSnapshot.ExecutionInfo))); !ok {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -238,7 +244,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) ResetWorkflowExecution(r
 
 // CompleteForkBranch complete forking process
 func (p *historyV2RateLimitedPersistenceClient) CompleteForkBranch(request *CompleteForkBranchRequest) error {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return ErrPersistenceLimitExceeded
 	}
 	err := p.persistence.CompleteForkBranch(request)
@@ -246,7 +252,7 @@ func (p *historyV2RateLimitedPersistenceClient) CompleteForkBranch(request *Comp
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) DeleteWorkflowExecution(request *DeleteWorkflowExecutionRequest) error {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(domainQuotaInfo(p.domainNameResolver, request.DomainID)); !ok {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -255,7 +261,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) DeleteWorkflowExecution(
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) DeleteCurrentWorkflowExecution(request *DeleteCurrentWorkflowExecutionRequest) error {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(domainQuotaInfo(p.domainNameResolver, request.DomainID)); !ok {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -264,7 +270,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) DeleteCurrentWorkflowExe
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) GetCurrentExecution(request *GetCurrentExecutionRequest) (*GetCurrentExecutionResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(domainQuotaInfo(p.domainNameResolver, request.DomainID)); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -273,7 +279,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) GetCurrentExecution(requ
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -282,7 +288,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) GetTransferTasks(request
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) GetReplicationTasks(request *GetReplicationTasksRequest) (*GetReplicationTasksResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -291,7 +297,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) GetReplicationTasks(requ
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) CompleteTransferTask(request *CompleteTransferTaskRequest) error {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -300,7 +306,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) CompleteTransferTask(req
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) RangeCompleteTransferTask(request *RangeCompleteTransferTaskRequest) error {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -309,7 +315,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) RangeCompleteTransferTas
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) CompleteReplicationTask(request *CompleteReplicationTaskRequest) error {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -318,7 +324,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) CompleteReplicationTask(
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) GetTimerIndexTasks(request *GetTimerIndexTasksRequest) (*GetTimerIndexTasksResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -327,7 +333,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) GetTimerIndexTasks(reque
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) CompleteTimerTask(request *CompleteTimerTaskRequest) error {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -336,7 +342,7 @@ func (p *workflowExecutionRateLimitedPersistenceClient) CompleteTimerTask(reques
 }
 
 func (p *workflowExecutionRateLimitedPersistenceClient) RangeCompleteTimerTask(request *RangeCompleteTimerTaskRequest) error {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -353,7 +359,7 @@ func (p *taskRateLimitedPersistenceClient) GetName() string {
 }
 
 func (p *taskRateLimitedPersistenceClient) CreateTasks(request *CreateTasksRequest) (*CreateTasksResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(domainQuotaInfo(p.domainNameResolver, taskListDomainID(request.TaskListInfo))); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -362,7 +368,7 @@ func (p *taskRateLimitedPersistenceClient) CreateTasks(request *CreateTasksReque
 }
 
 func (p *taskRateLimitedPersistenceClient) GetTasks(request *GetTasksRequest) (*GetTasksResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(domainQuotaInfo(p.domainNameResolver, request.DomainID)); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -371,7 +377,7 @@ func (p *taskRateLimitedPersistenceClient) GetTasks(request *GetTasksRequest) (*
 }
 
 func (p *taskRateLimitedPersistenceClient) CompleteTask(request *CompleteTaskRequest) error {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(domainQuotaInfo(p.domainNameResolver, taskListDomainID(request.TaskList))); !ok {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -380,14 +386,14 @@ func (p *taskRateLimitedPersistenceClient) CompleteTask(request *CompleteTaskReq
 }
 
 func (p *taskRateLimitedPersistenceClient) CompleteTasksLessThan(request *CompleteTasksLessThanRequest) (int, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(domainQuotaInfo(p.domainNameResolver, request.DomainID)); !ok {
 		return 0, ErrPersistenceLimitExceeded
 	}
 	return p.persistence.CompleteTasksLessThan(request)
 }
 
 func (p *taskRateLimitedPersistenceClient) LeaseTaskList(request *LeaseTaskListRequest) (*LeaseTaskListResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(domainQuotaInfo(p.domainNameResolver, request.DomainID)); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -396,7 +402,7 @@ func (p *taskRateLimitedPersistenceClient) LeaseTaskList(request *LeaseTaskListR
 }
 
 func (p *taskRateLimitedPersistenceClient) UpdateTaskList(request *UpdateTaskListRequest) (*UpdateTaskListResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(domainQuotaInfo(p.domainNameResolver, taskListDomainID(request.TaskListInfo))); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -405,14 +411,14 @@ func (p *taskRateLimitedPersistenceClient) UpdateTaskList(request *UpdateTaskLis
 }
 
 func (p *taskRateLimitedPersistenceClient) ListTaskList(request *ListTaskListRequest) (*ListTaskListResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 	return p.persistence.ListTaskList(request)
 }
 
 func (p *taskRateLimitedPersistenceClient) DeleteTaskList(request *DeleteTaskListRequest) error {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(domainQuotaInfo(p.domainNameResolver, request.DomainID)); !ok {
 		return ErrPersistenceLimitExceeded
 	}
 	return p.persistence.DeleteTaskList(request)
@@ -427,7 +433,7 @@ func (p *historyRateLimitedPersistenceClient) GetName() string {
 }
 
 func (p *historyRateLimitedPersistenceClient) AppendHistoryEvents(request *AppendHistoryEventsRequest) (*AppendHistoryEventsResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(domainQuotaInfo(p.domainNameResolver, request.DomainID)); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -436,7 +442,7 @@ func (p *historyRateLimitedPersistenceClient) AppendHistoryEvents(request *Appen
 }
 
 func (p *historyRateLimitedPersistenceClient) GetWorkflowExecutionHistory(request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(domainQuotaInfo(p.domainNameResolver, request.DomainID)); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -445,7 +451,7 @@ func (p *historyRateLimitedPersistenceClient) GetWorkflowExecutionHistory(reques
 }
 
 func (p *historyRateLimitedPersistenceClient) GetWorkflowExecutionHistoryByBatch(request *GetWorkflowExecutionHistoryRequest) (*GetWorkflowExecutionHistoryByBatchResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(domainQuotaInfo(p.domainNameResolver, request.DomainID)); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -454,7 +460,7 @@ func (p *historyRateLimitedPersistenceClient) GetWorkflowExecutionHistoryByBatch
 }
 
 func (p *historyRateLimitedPersistenceClient) DeleteWorkflowExecutionHistory(request *DeleteWorkflowExecutionHistoryRequest) error {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(domainQuotaInfo(p.domainNameResolver, request.DomainID)); !ok {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -471,7 +477,7 @@ func (p *metadataRateLimitedPersistenceClient) GetName() string {
 }
 
 func (p *metadataRateLimitedPersistenceClient) CreateDomain(request *CreateDomainRequest) (*CreateDomainResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -480,7 +486,7 @@ func (p *metadataRateLimitedPersistenceClient) CreateDomain(request *CreateDomai
 }
 
 func (p *metadataRateLimitedPersistenceClient) GetDomain(request *GetDomainRequest) (*GetDomainResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -489,7 +495,7 @@ func (p *metadataRateLimitedPersistenceClient) GetDomain(request *GetDomainReque
 }
 
 func (p *metadataRateLimitedPersistenceClient) UpdateDomain(request *UpdateDomainRequest) error {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -498,7 +504,7 @@ func (p *metadataRateLimitedPersistenceClient) UpdateDomain(request *UpdateDomai
 }
 
 func (p *metadataRateLimitedPersistenceClient) DeleteDomain(request *DeleteDomainRequest) error {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -507,7 +513,7 @@ func (p *metadataRateLimitedPersistenceClient) DeleteDomain(request *DeleteDomai
 }
 
 func (p *metadataRateLimitedPersistenceClient) DeleteDomainByName(request *DeleteDomainByNameRequest) error {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -516,7 +522,7 @@ func (p *metadataRateLimitedPersistenceClient) DeleteDomainByName(request *Delet
 }
 
 func (p *metadataRateLimitedPersistenceClient) ListDomains(request *ListDomainsRequest) (*ListDomainsResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -525,7 +531,7 @@ func (p *metadataRateLimitedPersistenceClient) ListDomains(request *ListDomainsR
 }
 
 func (p *metadataRateLimitedPersistenceClient) GetMetadata() (*GetMetadataResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -542,7 +548,7 @@ func (p *visibilityRateLimitedPersistenceClient) GetName() string {
 }
 
 func (p *visibilityRateLimitedPersistenceClient) RecordWorkflowExecutionStarted(request *RecordWorkflowExecutionStartedRequest) error {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -551,7 +557,7 @@ func (p *visibilityRateLimitedPersistenceClient) RecordWorkflowExecutionStarted(
 }
 
 func (p *visibilityRateLimitedPersistenceClient) RecordWorkflowExecutionClosed(request *RecordWorkflowExecutionClosedRequest) error {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -560,7 +566,7 @@ func (p *visibilityRateLimitedPersistenceClient) RecordWorkflowExecutionClosed(r
 }
 
 func (p *visibilityRateLimitedPersistenceClient) UpsertWorkflowExecution(request *UpsertWorkflowExecutionRequest) error {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return ErrPersistenceLimitExceeded
 	}
 
@@ -569,7 +575,7 @@ func (p *visibilityRateLimitedPersistenceClient) UpsertWorkflowExecution(request
 }
 
 func (p *visibilityRateLimitedPersistenceClient) ListOpenWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -578,7 +584,7 @@ func (p *visibilityRateLimitedPersistenceClient) ListOpenWorkflowExecutions(requ
 }
 
 func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutions(request *ListWorkflowExecutionsRequest) (*ListWorkflowExecutionsResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -587,7 +593,7 @@ func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutions(re
 }
 
 func (p *visibilityRateLimitedPersistenceClient) ListOpenWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -596,7 +602,7 @@ func (p *visibilityRateLimitedPersistenceClient) ListOpenWorkflowExecutionsByTyp
 }
 
 func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutionsByType(request *ListWorkflowExecutionsByTypeRequest) (*ListWorkflowExecutionsResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -605,7 +611,7 @@ func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutionsByT
 }
 
 func (p *visibilityRateLimitedPersistenceClient) ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -614,7 +620,7 @@ func (p *visibilityRateLimitedPersistenceClient) ListOpenWorkflowExecutionsByWor
 }
 
 func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -623,7 +629,7 @@ func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutionsByW
 }
 
 func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -632,7 +638,7 @@ func (p *visibilityRateLimitedPersistenceClient) ListClosedWorkflowExecutionsByS
 }
 
 func (p *visibilityRateLimitedPersistenceClient) GetClosedWorkflowExecution(request *GetClosedWorkflowExecutionRequest) (*GetClosedWorkflowExecutionResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 
@@ -641,28 +647,28 @@ func (p *visibilityRateLimitedPersistenceClient) GetClosedWorkflowExecution(requ
 }
 
 func (p *visibilityRateLimitedPersistenceClient) DeleteWorkflowExecution(request *VisibilityDeleteWorkflowExecutionRequest) error {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return ErrPersistenceLimitExceeded
 	}
 	return p.persistence.DeleteWorkflowExecution(request)
 }
 
 func (p *visibilityRateLimitedPersistenceClient) ListWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 	return p.persistence.ListWorkflowExecutions(request)
 }
 
 func (p *visibilityRateLimitedPersistenceClient) ScanWorkflowExecutions(request *ListWorkflowExecutionsRequestV2) (*ListWorkflowExecutionsResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 	return p.persistence.ScanWorkflowExecutions(request)
 }
 
 func (p *visibilityRateLimitedPersistenceClient) CountWorkflowExecutions(request *CountWorkflowExecutionsRequest) (*CountWorkflowExecutionsResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 	return p.persistence.CountWorkflowExecutions(request)
@@ -682,7 +688,7 @@ func (p *historyV2RateLimitedPersistenceClient) Close() {
 
 // AppendHistoryNodes add(or override) a node to a history branch
 func (p *historyV2RateLimitedPersistenceClient) AppendHistoryNodes(request *AppendHistoryNodesRequest) (*AppendHistoryNodesResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 	return p.persistence.AppendHistoryNodes(request)
@@ -690,7 +696,7 @@ func (p *historyV2RateLimitedPersistenceClient) AppendHistoryNodes(request *Appe
 
 // ReadHistoryBranch returns history node data for a branch
 func (p *historyV2RateLimitedPersistenceClient) ReadHistoryBranch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 	response, err := p.persistence.ReadHistoryBranch(request)
@@ -699,7 +705,7 @@ func (p *historyV2RateLimitedPersistenceClient) ReadHistoryBranch(request *ReadH
 
 // ReadHistoryBranchByBatch returns history node data for a branch
 func (p *historyV2RateLimitedPersistenceClient) ReadHistoryBranchByBatch(request *ReadHistoryBranchRequest) (*ReadHistoryBranchByBatchResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 	response, err := p.persistence.ReadHistoryBranchByBatch(request)
@@ -708,7 +714,7 @@ func (p *historyV2RateLimitedPersistenceClient) ReadHistoryBranchByBatch(request
 
 // ForkHistoryBranch forks a new branch from a old branch
 func (p *historyV2RateLimitedPersistenceClient) ForkHistoryBranch(request *ForkHistoryBranchRequest) (*ForkHistoryBranchResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 	response, err := p.persistence.ForkHistoryBranch(request)
@@ -717,7 +723,7 @@ func (p *historyV2RateLimitedPersistenceClient) ForkHistoryBranch(request *ForkH
 
 // DeleteHistoryBranch removes a branch
 func (p *historyV2RateLimitedPersistenceClient) DeleteHistoryBranch(request *DeleteHistoryBranchRequest) error {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return ErrPersistenceLimitExceeded
 	}
 	err := p.persistence.DeleteHistoryBranch(request)
@@ -726,7 +732,7 @@ func (p *historyV2RateLimitedPersistenceClient) DeleteHistoryBranch(request *Del
 
 // GetHistoryTree returns all branch information of a tree
 func (p *historyV2RateLimitedPersistenceClient) GetHistoryTree(request *GetHistoryTreeRequest) (*GetHistoryTreeResponse, error) {
-	if ok := p.rateLimiter.Allow(); !ok {
+	if ok := p.rateLimiter.Allow(quotas.Info{}); !ok {
 		return nil, ErrPersistenceLimitExceeded
 	}
 	response, err := p.persistence.GetHistoryTree(request)
@@ -24,9 +24,11 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/golang/snappy"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/encryption"
 )
 
 type (
@@ -52,6 +54,14 @@ type (
 		// serialize/deserialize bad binaries
 		SerializeBadBinaries(event *workflow.BadBinaries, encodingType common.EncodingType) (*DataBlob, error)
 		DeserializeBadBinaries(data *DataBlob) (*workflow.BadBinaries, error)
+
+		// serialize/deserialize worker versioning
+		SerializeWorkerVersioning(wv *workflow.WorkerVersioning, encodingType common.EncodingType) (*DataBlob, error)
+		DeserializeWorkerVersioning(data *DataBlob) (*workflow.WorkerVersioning, error)
+
+		// encrypt/decrypt opaque payloads stored outside of a data blob, e.g. activity heartbeat details
+		EncryptPayload(data []byte) ([]byte, error)
+		DecryptPayload(data []byte) ([]byte, error)
 	}
 
 	// CadenceSerializationError is an error type for cadence serialization
@@ -71,18 +81,33 @@ type (
 
 	serializerImpl struct {
 		thriftrwEncoder codec.BinaryEncoder
+		// encryptor encrypts history events and heartbeat details, it is nil if encryption is not enabled
+		encryptor encryption.Encryptor
 	}
 )
 
 // NewPayloadSerializer returns a PayloadSerializer
 func NewPayloadSerializer() PayloadSerializer {
+	return NewPayloadSerializerWithEncryptor(nil)
+}
+
+// NewPayloadSerializerWithEncryptor returns a PayloadSerializer which encrypts history events and
+// heartbeat details with the given encryptor, payloads are written in plaintext if it is nil.
+// Encrypted payloads are decrypted transparently on read, so the encryptor has to be given to every
+// process reading them, even if it no longer writes encrypted data.
+func NewPayloadSerializerWithEncryptor(encryptor encryption.Encryptor) PayloadSerializer {
 	return &serializerImpl{
 		thriftrwEncoder: codec.NewThriftRWEncoder(),
+		encryptor:       encryptor,
 	}
 }
 
 func (t *serializerImpl) SerializeBatchEvents(events []*workflow.HistoryEvent, encodingType common.EncodingType) (*DataBlob, error) {
-	return t.serialize(events, encodingType)
+	data, err := t.serialize(events, encodingType)
+	if err != nil {
+		return nil, err
+	}
+	return t.encrypt(data)
 }
 
 func (t *serializerImpl) DeserializeBatchEvents(data *DataBlob) ([]*workflow.HistoryEvent, error) {
@@ -101,7 +126,11 @@ func (t *serializerImpl) SerializeEvent(event *workflow.HistoryEvent, encodingTy
 	if event == nil {
 		return nil, nil
 	}
-	return t.serialize(event, encodingType)
+	data, err := t.serialize(event, encodingType)
+	if err != nil {
+		return nil, err
+	}
+	return t.encrypt(data)
 }
 
 func (t *serializerImpl) DeserializeEvent(data *DataBlob) (*workflow.HistoryEvent, error) {
@@ -139,6 +168,19 @@ func (t *serializerImpl) DeserializeBadBinaries(data *DataBlob) (*workflow.BadBi
 	return &bb, err
 }
 
+func (t *serializerImpl) SerializeWorkerVersioning(wv *workflow.WorkerVersioning, encodingType common.EncodingType) (*DataBlob, error) {
+	if wv == nil {
+		wv = &workflow.WorkerVersioning{}
+	}
+	return t.serialize(wv, encodingType)
+}
+
+func (t *serializerImpl) DeserializeWorkerVersioning(data *DataBlob) (*workflow.WorkerVersioning, error) {
+	var wv workflow.WorkerVersioning
+	err := t.deserialize(data, &wv)
+	return &wv, err
+}
+
 func (t *serializerImpl) SerializeVisibilityMemo(memo *workflow.Memo, encodingType common.EncodingType) (*DataBlob, error) {
 	if memo == nil {
 		// Return nil here to be consistent with Event
@@ -165,6 +207,11 @@ func (t *serializerImpl) serialize(input interface{}, encodingType common.Encodi
 	switch encodingType {
 	case common.EncodingTypeThriftRW:
 		data, err = t.thriftrwEncode(input)
+	case common.EncodingTypeThriftRWSnappy:
+		data, err = t.thriftrwEncode(input)
+		if err == nil {
+			data = snappy.Encode(nil, data)
+		}
 	case common.EncodingTypeJSON, common.EncodingTypeUnknown, common.EncodingTypeEmpty: // For backward-compatibility
 		encodingType = common.EncodingTypeJSON
 		data, err = json.Marshal(input)
@@ -178,6 +225,44 @@ func (t *serializerImpl) serialize(input interface{}, encodingType common.Encodi
 	return NewDataBlob(data, encodingType), nil
 }
 
+// encrypt encrypts the given serialized payload if encryption is enabled, the encoding type of the
+// payload is kept since encrypted payloads are recognized by their preamble
+func (t *serializerImpl) encrypt(data *DataBlob) (*DataBlob, error) {
+	if data == nil {
+		return nil, nil
+	}
+	encrypted, err := t.EncryptPayload(data.Data)
+	if err != nil {
+		return nil, err
+	}
+	return NewDataBlob(encrypted, data.Encoding), nil
+}
+
+func (t *serializerImpl) EncryptPayload(data []byte) ([]byte, error) {
+	if t.encryptor == nil || len(data) == 0 {
+		return data, nil
+	}
+	encrypted, err := t.encryptor.Encrypt(data)
+	if err != nil {
+		return nil, NewCadenceSerializationError(fmt.Sprintf("failed to encrypt payload: %v", err))
+	}
+	return encrypted, nil
+}
+
+func (t *serializerImpl) DecryptPayload(data []byte) ([]byte, error) {
+	if !encryption.IsEncrypted(data) {
+		return data, nil
+	}
+	if t.encryptor == nil {
+		return nil, NewCadenceDeserializationError("payload is encrypted but encryption is not configured")
+	}
+	decrypted, err := t.encryptor.Decrypt(data)
+	if err != nil {
+		return nil, NewCadenceDeserializationError(fmt.Sprintf("failed to decrypt payload: %v", err))
+	}
+	return decrypted, nil
+}
+
 func (t *serializerImpl) thriftrwEncode(input interface{}) ([]byte, error) {
 	switch input.(type) {
 	case []*workflow.HistoryEvent:
@@ -190,6 +275,8 @@ func (t *serializerImpl) thriftrwEncode(input interface{}) ([]byte, error) {
 		return t.thriftrwEncoder.Encode(input.(*workflow.ResetPoints))
 	case *workflow.BadBinaries:
 		return t.thriftrwEncoder.Encode(input.(*workflow.BadBinaries))
+	case *workflow.WorkerVersioning:
+		return t.thriftrwEncoder.Encode(input.(*workflow.WorkerVersioning))
 	default:
 		return nil, nil
 	}
@@ -202,13 +289,21 @@ func (t *serializerImpl) deserialize(data *DataBlob, target interface{}) error {
 	if len(data.Data) == 0 {
 		return NewCadenceDeserializationError("DeserializeEvent empty data")
 	}
-	var err error
+	payload, err := t.DecryptPayload(data.Data)
+	if err != nil {
+		return err
+	}
 
 	switch data.GetEncoding() {
 	case common.EncodingTypeThriftRW:
-		err = t.thriftrwDecode(data.Data, target)
+		err = t.thriftrwDecode(payload, target)
+	case common.EncodingTypeThriftRWSnappy:
+		var decoded []byte
+		if decoded, err = snappy.Decode(nil, payload); err == nil {
+			err = t.thriftrwDecode(decoded, target)
+		}
 	case common.EncodingTypeJSON, common.EncodingTypeUnknown, common.EncodingTypeEmpty: // For backward-compatibility
-		err = json.Unmarshal(data.Data, target)
+		err = json.Unmarshal(payload, target)
 	default:
 		return NewUnknownEncodingTypeError(data.GetEncoding())
 	}
@@ -243,6 +338,9 @@ func (t *serializerImpl) thriftrwDecode(data []byte, target interface{}) error {
 		rp := target.(*workflow.BadBinaries)
 		t.thriftrwEncoder.Decode(data, rp)
 		return nil
+	case *workflow.WorkerVersioning:
+		wv := target.(*workflow.WorkerVersioning)
+		return t.thriftrwEncoder.Decode(data, wv)
 	default:
 		return nil
 	}
@@ -32,9 +32,15 @@ import (
 	"github.com/stretchr/testify/suite"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/encryption"
 )
 
 type (
+	testKeyProvider struct {
+		keyID string
+		key   []byte
+	}
+
 	cadenceSerializerSuite struct {
 		suite.Suite
 		// override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test,
@@ -338,3 +344,142 @@ func (s *cadenceSerializerSuite) TestSerializer() {
 	succ := common.AwaitWaitGroup(&doneWG, 10*time.Second)
 	s.True(succ, "test timed out")
 }
+
+func (s *cadenceSerializerSuite) TestSerializer_Encryption() {
+	plainSerializer := NewPayloadSerializer()
+	serializer := NewPayloadSerializerWithEncryptor(
+		encryption.NewAESEncryptor(&testKeyProvider{keyID: "key-1", key: []byte("0123456789abcdef")}),
+	)
+
+	event0 := &workflow.HistoryEvent{
+		EventId:   common.Int64Ptr(999),
+		Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+		EventType: common.EventTypePtr(workflow.EventTypeActivityTaskCompleted),
+		ActivityTaskCompletedEventAttributes: &workflow.ActivityTaskCompletedEventAttributes{
+			Result:           []byte("result-1-event-1"),
+			ScheduledEventId: common.Int64Ptr(4),
+			StartedEventId:   common.Int64Ptr(5),
+			Identity:         common.StringPtr("event-1"),
+		},
+	}
+	history0 := &workflow.History{Events: []*workflow.HistoryEvent{event0, event0}}
+
+	for _, encodingType := range []common.EncodingType{common.EncodingTypeThriftRW, common.EncodingTypeJSON} {
+		dEvent, err := serializer.SerializeEvent(event0, encodingType)
+		s.Nil(err)
+		s.Equal(encodingType, dEvent.Encoding)
+		s.True(encryption.IsEncrypted(dEvent.Data))
+
+		event1, err := serializer.DeserializeEvent(dEvent)
+		s.Nil(err)
+		s.True(event0.Equals(event1))
+
+		dEvents, err := serializer.SerializeBatchEvents(history0.Events, encodingType)
+		s.Nil(err)
+		s.True(encryption.IsEncrypted(dEvents.Data))
+
+		events, err := serializer.DeserializeBatchEvents(dEvents)
+		s.Nil(err)
+		s.True(history0.Equals(&workflow.History{Events: events}))
+
+		// encrypted payloads cannot be read without encryption configured
+		_, err = plainSerializer.DeserializeEvent(dEvent)
+		s.NotNil(err)
+		_, ok := err.(*CadenceDeserializationError)
+		s.True(ok)
+
+		// plaintext payloads are still readable with encryption configured
+		dPlain, err := plainSerializer.SerializeEvent(event0, encodingType)
+		s.Nil(err)
+		s.False(encryption.IsEncrypted(dPlain.Data))
+		event2, err := serializer.DeserializeEvent(dPlain)
+		s.Nil(err)
+		s.True(event0.Equals(event2))
+	}
+
+	details := []byte("heartbeat-details")
+	encrypted, err := serializer.EncryptPayload(details)
+	s.Nil(err)
+	s.True(encryption.IsEncrypted(encrypted))
+	decrypted, err := serializer.DecryptPayload(encrypted)
+	s.Nil(err)
+	s.Equal(details, decrypted)
+	_, err = plainSerializer.DecryptPayload(encrypted)
+	s.NotNil(err)
+
+	// plaintext details written before encryption was enabled are returned as is
+	plain, err := plainSerializer.EncryptPayload(details)
+	s.Nil(err)
+	s.Equal(details, plain)
+	decrypted, err = serializer.DecryptPayload(plain)
+	s.Nil(err)
+	s.Equal(details, decrypted)
+}
+
+func (s *cadenceSerializerSuite) TestSerializer_Snappy() {
+	serializer := NewPayloadSerializer()
+	encryptedSerializer := NewPayloadSerializerWithEncryptor(
+		encryption.NewAESEncryptor(&testKeyProvider{keyID: "key-1", key: []byte("0123456789abcdef")}),
+	)
+
+	events := []*workflow.HistoryEvent{}
+	for i := int64(1); i <= 10; i++ {
+		events = append(events, &workflow.HistoryEvent{
+			EventId:   common.Int64Ptr(i),
+			Timestamp: common.Int64Ptr(time.Now().UnixNano()),
+			EventType: common.EventTypePtr(workflow.EventTypeActivityTaskCompleted),
+			ActivityTaskCompletedEventAttributes: &workflow.ActivityTaskCompletedEventAttributes{
+				Result:           []byte("result-result-result-result-result"),
+				ScheduledEventId: common.Int64Ptr(4),
+				StartedEventId:   common.Int64Ptr(5),
+				Identity:         common.StringPtr("identity-identity-identity"),
+			},
+		})
+	}
+	history0 := &workflow.History{Events: events}
+
+	dThrift, err := serializer.SerializeBatchEvents(events, common.EncodingTypeThriftRW)
+	s.Nil(err)
+	dSnappy, err := serializer.SerializeBatchEvents(events, common.EncodingTypeThriftRWSnappy)
+	s.Nil(err)
+	s.Equal(common.EncodingTypeThriftRWSnappy, dSnappy.GetEncoding())
+	s.True(len(dSnappy.Data) < len(dThrift.Data))
+
+	decoded, err := serializer.DeserializeBatchEvents(dSnappy)
+	s.Nil(err)
+	s.True(history0.Equals(&workflow.History{Events: decoded}))
+
+	dEvent, err := serializer.SerializeEvent(events[0], common.EncodingTypeThriftRWSnappy)
+	s.Nil(err)
+	event, err := serializer.DeserializeEvent(dEvent)
+	s.Nil(err)
+	s.True(events[0].Equals(event))
+
+	dEncrypted, err := encryptedSerializer.SerializeBatchEvents(events, common.EncodingTypeThriftRWSnappy)
+	s.Nil(err)
+	s.True(encryption.IsEncrypted(dEncrypted.Data))
+	decoded, err = encryptedSerializer.DeserializeBatchEvents(dEncrypted)
+	s.Nil(err)
+	s.True(history0.Equals(&workflow.History{Events: decoded}))
+
+	// blobs written without compression are still readable
+	decoded, err = serializer.DeserializeBatchEvents(dThrift)
+	s.Nil(err)
+	s.True(history0.Equals(&workflow.History{Events: decoded}))
+
+	_, err = serializer.DeserializeBatchEvents(&DataBlob{Data: dThrift.Data, Encoding: common.EncodingTypeThriftRWSnappy})
+	s.NotNil(err)
+	_, ok := err.(*CadenceDeserializationError)
+	s.True(ok)
+}
+
+func (p *testKeyProvider) GetCurrentKey() (string, []byte, error) {
+	return p.keyID, p.key, nil
+}
+
+func (p *testKeyProvider) GetKey(keyID string) ([]byte, error) {
+	if keyID != p.keyID {
+		return nil, encryption.ErrKeyNotFound
+	}
+	return p.key, nil
+}
@@ -164,6 +164,15 @@ func timerInfoFromBlob(b []byte, proto string) (*sqlblobs.TimerInfo, error) {
 	return result, thriftRWDecode(b, proto, result)
 }
 
+func delayedSignalInfoToBlob(info *sqlblobs.DelayedSignalInfo) (p.DataBlob, error) {
+	return thriftRWEncode(info)
+}
+
+func delayedSignalInfoFromBlob(b []byte, proto string) (*sqlblobs.DelayedSignalInfo, error) {
+	result := &sqlblobs.DelayedSignalInfo{}
+	return result, thriftRWDecode(b, proto, result)
+}
+
 func taskInfoToBlob(info *sqlblobs.TaskInfo) (p.DataBlob, error) {
 	return thriftRWEncode(info)
 }
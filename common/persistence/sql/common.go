@@ -49,6 +49,35 @@ func (m *sqlStore) Close() {
 	}
 }
 
+// shardedSQLStore is a store whose data is spread over a set of databases, the first
+// database serves the requests that are not bound to a shard
+type shardedSQLStore struct {
+	sqlStore
+	shards []sqlStore
+}
+
+func newShardedSQLStore(dbs []sqldb.Interface, logger log.Logger) shardedSQLStore {
+	shards := make([]sqlStore, len(dbs))
+	for i, db := range dbs {
+		shards[i] = sqlStore{db: db, logger: logger}
+	}
+	return shardedSQLStore{
+		sqlStore: shards[0],
+		shards:   shards,
+	}
+}
+
+// shard returns the store of the database the given shard is mapped to
+func (m *shardedSQLStore) shard(shardID int) *sqlStore {
+	return &m.shards[shardID%len(m.shards)]
+}
+
+func (m *shardedSQLStore) Close() {
+	for i := range m.shards {
+		m.shards[i].Close()
+	}
+}
+
 func (m *sqlStore) txExecute(operation string, f func(tx sqldb.Tx) error) error {
 	tx, err := m.db.BeginTx()
 	if err != nil {
@@ -34,7 +34,7 @@ type (
 	// Factory vends store objects backed by MySQL
 	Factory struct {
 		cfg         config.SQL
-		dbConn      dbConn
+		dbConns     []*dbConn
 		clusterName string
 		logger      log.Logger
 	}
@@ -53,35 +53,41 @@ type (
 // NewFactory returns an instance of a factory object which can be used to create
 // datastores backed by any kind of SQL store
 func NewFactory(cfg config.SQL, clusterName string, logger log.Logger) *Factory {
+	dbConfigs := cfg.DatabaseConfigs()
+	dbConns := make([]*dbConn, len(dbConfigs))
+	for i := range dbConfigs {
+		conn := newRefCountedDBConn(&dbConfigs[i])
+		dbConns[i] = &conn
+	}
 	return &Factory{
 		cfg:         cfg,
 		clusterName: clusterName,
 		logger:      logger,
-		dbConn:      newRefCountedDBConn(&cfg),
+		dbConns:     dbConns,
 	}
 }
 
 // NewTaskStore returns a new task store
 func (f *Factory) NewTaskStore() (p.TaskStore, error) {
-	conn, err := f.dbConn.get()
+	conns, err := f.getAllDBConns()
 	if err != nil {
 		return nil, err
 	}
-	return newTaskPersistence(conn, f.cfg.NumShards, f.logger)
+	return newTaskPersistence(conns, f.cfg.NumShards, f.logger)
 }
 
 // NewShardStore returns a new shard store
 func (f *Factory) NewShardStore() (p.ShardStore, error) {
-	conn, err := f.dbConn.get()
+	conns, err := f.getAllDBConns()
 	if err != nil {
 		return nil, err
 	}
-	return newShardPersistence(conn, f.clusterName, f.logger)
+	return newShardPersistence(conns, f.clusterName, f.logger)
 }
 
 // NewHistoryStore returns a new history store
 func (f *Factory) NewHistoryStore() (p.HistoryStore, error) {
-	conn, err := f.dbConn.get()
+	conn, err := f.dbConns[0].get()
 	if err != nil {
 		return nil, err
 	}
@@ -90,16 +96,16 @@ func (f *Factory) NewHistoryStore() (p.HistoryStore, error) {
 
 // NewHistoryV2Store returns a new history store
 func (f *Factory) NewHistoryV2Store() (p.HistoryV2Store, error) {
-	conn, err := f.dbConn.get()
+	conns, err := f.getAllDBConns()
 	if err != nil {
 		return nil, err
 	}
-	return newHistoryV2Persistence(conn, f.logger)
+	return newHistoryV2Persistence(conns, f.logger)
 }
 
 // NewMetadataStore returns a new metadata store
 func (f *Factory) NewMetadataStore() (p.MetadataStore, error) {
-	conn, err := f.dbConn.get()
+	conn, err := f.dbConns[0].get()
 	if err != nil {
 		return nil, err
 	}
@@ -118,7 +124,7 @@ func (f *Factory) NewMetadataStoreV2() (p.MetadataStore, error) {
 
 // NewExecutionStore returns an ExecutionStore for a given shardID
 func (f *Factory) NewExecutionStore(shardID int) (p.ExecutionStore, error) {
-	conn, err := f.dbConn.get()
+	conn, err := f.dbConns[shardID%len(f.dbConns)].get()
 	if err != nil {
 		return nil, err
 	}
@@ -127,12 +133,31 @@ func (f *Factory) NewExecutionStore(shardID int) (p.ExecutionStore, error) {
 
 // NewVisibilityStore returns a visibility store
 func (f *Factory) NewVisibilityStore() (p.VisibilityStore, error) {
-	return NewSQLVisibilityStore(f.cfg, f.logger)
+	return NewSQLVisibilityStore(f.cfg.DatabaseConfigs()[0], f.logger)
 }
 
 // Close closes the factory
 func (f *Factory) Close() {
-	f.dbConn.forceClose()
+	for _, conn := range f.dbConns {
+		conn.forceClose()
+	}
+}
+
+// getAllDBConns returns a connection to every database of the datastore, the index of a
+// connection is the database that the shards with the same index modulo count map to
+func (f *Factory) getAllDBConns() ([]sqldb.Interface, error) {
+	conns := make([]sqldb.Interface, 0, len(f.dbConns))
+	for _, dbConn := range f.dbConns {
+		conn, err := dbConn.get()
+		if err != nil {
+			for _, c := range conns {
+				c.Close()
+			}
+			return nil, err
+		}
+		conns = append(conns, conn)
+	}
+	return conns, nil
 }
 
 // newRefCountedDBConn returns a  logical mysql connection that
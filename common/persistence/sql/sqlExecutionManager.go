@@ -240,6 +240,9 @@ func (m *sqlExecutionManager) GetWorkflowExecution(
 		SignalCount:                  int32(info.GetSignalCount()),
 		HistorySize:                  info.GetHistorySize(),
 		CronSchedule:                 info.GetCronSchedule(),
+		CronMaxJitterSeconds:         info.GetCronMaxJitterSeconds(),
+		TaskPriority:                 info.GetTaskPriority(),
+		BuildID:                      info.GetBuildId(),
 		CompletionEventBatchID:       common.EmptyEventID,
 		HasRetryPolicy:               info.GetHasRetryPolicy(),
 		Attempt:                      int32(info.GetRetryAttempt()),
@@ -255,6 +258,7 @@ func (m *sqlExecutionManager) GetWorkflowExecution(
 		NonRetriableErrors:           info.GetRetryNonRetryableErrors(),
 		SearchAttributes:             info.GetSearchAttributes(),
 		Memo:                         info.GetMemo(),
+		Paused:                       info.GetPaused(),
 	}
 
 	if info.LastWriteEventID != nil {
@@ -396,6 +400,34 @@ func (m *sqlExecutionManager) GetWorkflowExecution(
 		}
 	}
 
+	{
+		var err error
+		state.DelayedSignalInfos, err = getDelayedSignalInfoMap(m.db,
+			m.shardID,
+			domainID,
+			wfID,
+			runID)
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("GetWorkflowExecution failed. Failed to get delayed signal infos. Error: %v", err),
+			}
+		}
+	}
+
+	{
+		var err error
+		state.UpdateRequestedIDs, err = getUpdatesRequested(m.db,
+			m.shardID,
+			domainID,
+			wfID,
+			runID)
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("GetWorkflowExecution failed. Failed to get updates requested. Error: %v", err),
+			}
+		}
+	}
+
 	return &p.InternalGetWorkflowExecutionResponse{State: &state}, nil
 }
 
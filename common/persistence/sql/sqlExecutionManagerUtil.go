@@ -153,6 +153,30 @@ func applyWorkflowMutationTx(
 		}
 	}
 
+	if err := updateDelayedSignalInfos(tx,
+		workflowMutation.UpsertDelayedSignalInfos,
+		workflowMutation.DeleteDelayedSignalInfos,
+		shardID,
+		domainID,
+		workflowID,
+		runID); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Error: %v", err),
+		}
+	}
+
+	if err := updateUpdatesRequested(tx,
+		workflowMutation.UpsertUpdateRequestedIDs,
+		workflowMutation.DeleteUpdateRequestedIDs,
+		shardID,
+		domainID,
+		workflowID,
+		runID); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("UpdateWorkflowExecution operation failed. Error: %v", err),
+		}
+	}
+
 	if workflowMutation.ClearBufferedEvents {
 		if err := deleteBufferedEvents(tx,
 			shardID,
@@ -359,6 +383,50 @@ func applyWorkflowSnapshotTxAsReset(
 		}
 	}
 
+	if err := deleteDelayedSignalInfoMap(tx,
+		shardID,
+		domainID,
+		workflowID,
+		runID); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ResetMutableState operation failed. Failed to clear delayed signal info map. Error: %v", err),
+		}
+	}
+
+	if err := updateDelayedSignalInfos(tx,
+		workflowSnapshot.DelayedSignalInfos,
+		nil,
+		shardID,
+		domainID,
+		workflowID,
+		runID); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ResetMutableState operation failed. Failed to insert into delayed signal info map after clearing. Error: %v", err),
+		}
+	}
+
+	if err := deleteUpdatesRequestedSet(tx,
+		shardID,
+		domainID,
+		workflowID,
+		runID); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ResetMutableState operation failed. Failed to clear updates requested set. Error: %v", err),
+		}
+	}
+
+	if err := updateUpdatesRequested(tx,
+		workflowSnapshot.UpdateRequestedIDs,
+		nil,
+		shardID,
+		domainID,
+		workflowID,
+		runID); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ResetMutableState operation failed. Failed to insert into updates requested set after clearing. Error: %v", err),
+		}
+	}
+
 	if err := deleteBufferedEvents(tx,
 		shardID,
 		domainID,
@@ -475,6 +543,30 @@ func applyWorkflowSnapshotTxAsNew(
 		}
 	}
 
+	if err := updateDelayedSignalInfos(tx,
+		workflowSnapshot.DelayedSignalInfos,
+		nil,
+		shardID,
+		domainID,
+		workflowID,
+		runID); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ResetMutableState operation failed. Failed to insert into delayed signal info map after clearing. Error: %v", err),
+		}
+	}
+
+	if err := updateUpdatesRequested(tx,
+		workflowSnapshot.UpdateRequestedIDs,
+		nil,
+		shardID,
+		domainID,
+		workflowID,
+		runID); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ResetMutableState operation failed. Failed to insert into updates requested set after clearing. Error: %v", err),
+		}
+	}
+
 	return nil
 }
 
@@ -937,6 +1029,9 @@ func createTimerTasks(
 			case *p.WorkflowTimeoutTask:
 				// noop
 
+			case *p.DelayedSignalTimerTask:
+				// noop
+
 			case *p.DeleteHistoryEventTask:
 				// noop
 
@@ -1182,6 +1277,10 @@ func buildExecutionRow(
 		AutoResetPointsEncoding:         common.StringPtr(string(executionInfo.AutoResetPoints.GetEncoding())),
 		SearchAttributes:                executionInfo.SearchAttributes,
 		Memo:                            executionInfo.Memo,
+		Paused:                          &executionInfo.Paused,
+		CronMaxJitterSeconds:            &executionInfo.CronMaxJitterSeconds,
+		TaskPriority:                    &executionInfo.TaskPriority,
+		BuildId:                         &executionInfo.BuildID,
 	}
 
 	completionEvent := executionInfo.CompletionEvent
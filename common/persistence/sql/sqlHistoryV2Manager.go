@@ -36,17 +36,15 @@ import (
 )
 
 type sqlHistoryV2Manager struct {
-	sqlStore
+	shardedSQLStore
 	shardID int
 }
 
-// newHistoryV2Persistence creates an instance of HistoryManager
-func newHistoryV2Persistence(db sqldb.Interface, logger log.Logger) (p.HistoryV2Store, error) {
+// newHistoryV2Persistence creates an instance of HistoryManager, the history of a workflow
+// is stored in the database of its history shard
+func newHistoryV2Persistence(dbs []sqldb.Interface, logger log.Logger) (p.HistoryV2Store, error) {
 	return &sqlHistoryV2Manager{
-		sqlStore: sqlStore{
-			db:     db,
-			logger: logger,
-		},
+		shardedSQLStore: newShardedSQLStore(dbs, logger),
 	}, nil
 }
 
@@ -114,7 +112,7 @@ func (m *sqlHistoryV2Manager) AppendHistoryNodes(request *p.InternalAppendHistor
 			DataEncoding: string(blob.Encoding),
 		}
 
-		return m.txExecute("AppendHistoryNodes", func(tx sqldb.Tx) error {
+		return m.shard(request.ShardID).txExecute("AppendHistoryNodes", func(tx sqldb.Tx) error {
 			result, err := tx.InsertIntoHistoryNode(nodeRow)
 			if err != nil {
 				return err
@@ -141,7 +139,7 @@ func (m *sqlHistoryV2Manager) AppendHistoryNodes(request *p.InternalAppendHistor
 		})
 	}
 
-	_, err := m.db.InsertIntoHistoryNode(nodeRow)
+	_, err := m.shard(request.ShardID).db.InsertIntoHistoryNode(nodeRow)
 	if err != nil {
 		if sqlErr, ok := err.(*mysql.MySQLError); ok && sqlErr.Number == ErrDupEntry {
 			return &p.ConditionFailedError{Msg: fmt.Sprintf("AppendHistoryNodes: row already exist: %v", err)}
@@ -174,7 +172,7 @@ func (m *sqlHistoryV2Manager) ReadHistoryBranch(request *p.InternalReadHistoryBr
 		ShardID:   request.ShardID,
 	}
 
-	rows, err := m.db.SelectFromHistoryNode(filter)
+	rows, err := m.shard(request.ShardID).db.SelectFromHistoryNode(filter)
 	if err == sql.ErrNoRows || (err == nil && len(rows) == 0) {
 		return &p.InternalReadHistoryBranchResponse{}, nil
 	}
@@ -322,7 +320,7 @@ func (m *sqlHistoryV2Manager) ForkHistoryBranch(request *p.InternalForkHistoryBr
 		Data:         blob.Data,
 		DataEncoding: string(blob.Encoding),
 	}
-	result, err := m.db.InsertIntoHistoryTree(row)
+	result, err := m.shard(request.ShardID).db.InsertIntoHistoryTree(row)
 	if err != nil {
 		return nil, err
 	}
@@ -375,7 +373,7 @@ func (m *sqlHistoryV2Manager) DeleteHistoryBranch(request *p.InternalDeleteHisto
 		}
 	}
 
-	return m.txExecute("DeleteHistoryBranch", func(tx sqldb.Tx) error {
+	return m.shard(request.ShardID).txExecute("DeleteHistoryBranch", func(tx sqldb.Tx) error {
 		branchID := sqldb.MustParseUUID(*branch.BranchID)
 		treeFilter := &sqldb.HistoryTreeFilter{
 			TreeID:   sqldb.MustParseUUID(treeID),
@@ -431,7 +429,7 @@ func (m *sqlHistoryV2Manager) CompleteForkBranch(request *p.InternalCompleteFork
 			InProgress: false,
 			ShardID:    request.ShardID,
 		}
-		result, err := m.db.UpdateHistoryTree(row)
+		result, err := m.shard(request.ShardID).db.UpdateHistoryTree(row)
 		if err != nil {
 			return err
 		}
@@ -456,7 +454,7 @@ func (m *sqlHistoryV2Manager) CompleteForkBranch(request *p.InternalCompleteFork
 		ShardID:   request.ShardID,
 		MinNodeID: common.Int64Ptr(1),
 	}
-	return m.txExecute("CompleteForkBranch", func(tx sqldb.Tx) error {
+	return m.shard(request.ShardID).txExecute("CompleteForkBranch", func(tx sqldb.Tx) error {
 		_, err := tx.DeleteFromHistoryNode(nodeFilter)
 		if err != nil {
 			return err
@@ -487,7 +485,7 @@ func (m *sqlHistoryV2Manager) GetHistoryTree(request *p.GetHistoryTreeRequest) (
 		TreeID:  treeID,
 		ShardID: *request.ShardID,
 	}
-	rows, err := m.db.SelectFromHistoryTree(treeFilter)
+	rows, err := m.shard(*request.ShardID).db.SelectFromHistoryTree(treeFilter)
 	if err == sql.ErrNoRows || (err == nil && len(rows) == 0) {
 		return &p.GetHistoryTreeResponse{}, nil
 	}
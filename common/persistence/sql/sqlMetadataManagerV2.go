@@ -99,6 +99,12 @@ func (m *sqlMetadataManagerV2) CreateDomain(request *persistence.InternalCreateD
 		badBinaries = request.Config.BadBinaries.Data
 		badBinariesEncoding = common.StringPtr(string(request.Config.BadBinaries.GetEncoding()))
 	}
+	var workerVersioning []byte
+	var workerVersioningEncoding *string
+	if request.Config.WorkerVersioning != nil {
+		workerVersioning = request.Config.WorkerVersioning.Data
+		workerVersioningEncoding = common.StringPtr(string(request.Config.WorkerVersioning.GetEncoding()))
+	}
 	domainInfo := &sqlblobs.DomainInfo{
 		Status:                      common.Int32Ptr(int32(request.Info.Status)),
 		Description:                 &request.Info.Description,
@@ -120,6 +126,8 @@ func (m *sqlMetadataManagerV2) CreateDomain(request *persistence.InternalCreateD
 		FailoverNotificationVersion: common.Int64Ptr(persistence.InitialFailoverNotificationVersion),
 		BadBinaries:                 badBinaries,
 		BadBinariesEncoding:         badBinariesEncoding,
+		WorkerVersioning:            workerVersioning,
+		WorkerVersioningEncoding:    workerVersioningEncoding,
 	}
 
 	blob, err := domainInfoToBlob(domainInfo)
@@ -215,6 +223,10 @@ func (m *sqlMetadataManagerV2) domainRowToGetDomainResponse(row *sqldb.DomainRow
 	if domainInfo.BadBinaries != nil {
 		badBinaries = persistence.NewDataBlob(domainInfo.BadBinaries, common.EncodingType(*domainInfo.BadBinariesEncoding))
 	}
+	var workerVersioning *persistence.DataBlob
+	if domainInfo.WorkerVersioning != nil {
+		workerVersioning = persistence.NewDataBlob(domainInfo.WorkerVersioning, common.EncodingType(domainInfo.GetWorkerVersioningEncoding()))
+	}
 
 	return &persistence.InternalGetDomainResponse{
 		TableVersion: persistence.DomainTableVersionV2,
@@ -236,6 +248,7 @@ func (m *sqlMetadataManagerV2) domainRowToGetDomainResponse(row *sqldb.DomainRow
 			VisibilityArchivalStatus: workflow.ArchivalStatus(domainInfo.GetVisibilityArchivalStatus()),
 			VisibilityArchivalURI:    domainInfo.GetVisibilityArchivalURI(),
 			BadBinaries:              badBinaries,
+			WorkerVersioning:         workerVersioning,
 		},
 		ReplicationConfig: &persistence.DomainReplicationConfig{
 			ActiveClusterName: persistence.GetOrUseDefaultActiveCluster(m.activeClusterName, domainInfo.GetActiveClusterName()),
@@ -261,6 +274,12 @@ func (m *sqlMetadataManagerV2) UpdateDomain(request *persistence.InternalUpdateD
 		badBinaries = request.Config.BadBinaries.Data
 		badBinariesEncoding = common.StringPtr(string(request.Config.BadBinaries.GetEncoding()))
 	}
+	var workerVersioning []byte
+	var workerVersioningEncoding *string
+	if request.Config.WorkerVersioning != nil {
+		workerVersioning = request.Config.WorkerVersioning.Data
+		workerVersioningEncoding = common.StringPtr(string(request.Config.WorkerVersioning.GetEncoding()))
+	}
 	domainInfo := &sqlblobs.DomainInfo{
 		Status:                      common.Int32Ptr(int32(request.Info.Status)),
 		Description:                 &request.Info.Description,
@@ -282,6 +301,8 @@ func (m *sqlMetadataManagerV2) UpdateDomain(request *persistence.InternalUpdateD
 		FailoverNotificationVersion: common.Int64Ptr(request.FailoverNotificationVersion),
 		BadBinaries:                 badBinaries,
 		BadBinariesEncoding:         badBinariesEncoding,
+		WorkerVersioning:            workerVersioning,
+		WorkerVersioningEncoding:    workerVersioningEncoding,
 	}
 
 	blob, err := domainInfoToBlob(domainInfo)
@@ -34,17 +34,15 @@ import (
 )
 
 type sqlShardManager struct {
-	sqlStore
+	shardedSQLStore
 	currentClusterName string
 }
 
-// newShardPersistence creates an instance of ShardManager
-func newShardPersistence(db sqldb.Interface, currentClusterName string, log log.Logger) (persistence.ShardManager, error) {
+// newShardPersistence creates an instance of ShardManager, the record of a shard is stored
+// in the same database as the executions of the shard
+func newShardPersistence(dbs []sqldb.Interface, currentClusterName string, log log.Logger) (persistence.ShardManager, error) {
 	return &sqlShardManager{
-		sqlStore: sqlStore{
-			db:     db,
-			logger: log,
-		},
+		shardedSQLStore:    newShardedSQLStore(dbs, log),
 		currentClusterName: currentClusterName,
 	}, nil
 }
@@ -65,7 +63,7 @@ func (m *sqlShardManager) CreateShard(request *persistence.CreateShardRequest) e
 		}
 	}
 
-	if _, err := m.db.InsertIntoShards(row); err != nil {
+	if _, err := m.shard(request.ShardInfo.ShardID).db.InsertIntoShards(row); err != nil {
 		return &workflow.InternalServiceError{
 			Message: fmt.Sprintf("CreateShard operation failed. Failed to insert into shards table. Error: %v", err),
 		}
@@ -75,7 +73,7 @@ func (m *sqlShardManager) CreateShard(request *persistence.CreateShardRequest) e
 }
 
 func (m *sqlShardManager) GetShard(request *persistence.GetShardRequest) (*persistence.GetShardResponse, error) {
-	row, err := m.db.SelectFromShards(&sqldb.ShardsFilter{ShardID: int64(request.ShardID)})
+	row, err := m.shard(request.ShardID).db.SelectFromShards(&sqldb.ShardsFilter{ShardID: int64(request.ShardID)})
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, &workflow.EntityNotExistsError{
@@ -133,7 +131,7 @@ func (m *sqlShardManager) UpdateShard(request *persistence.UpdateShardRequest) e
 			Message: fmt.Sprintf("UpdateShard operation failed. Error: %v", err),
 		}
 	}
-	return m.txExecute("UpdateShard", func(tx sqldb.Tx) error {
+	return m.shard(request.ShardInfo.ShardID).txExecute("UpdateShard", func(tx sqldb.Tx) error {
 		if err := lockShard(tx, request.ShardInfo.ShardID, request.PreviousRangeID); err != nil {
 			return err
 		}
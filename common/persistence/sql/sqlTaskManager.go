@@ -36,7 +36,7 @@ import (
 )
 
 type sqlTaskManager struct {
-	sqlStore
+	shardedSQLStore
 	nShards int
 }
 
@@ -44,14 +44,15 @@ var (
 	minUUID = "00000000-0000-0000-0000-000000000000"
 )
 
-// newTaskPersistence creates a new instance of TaskManager
-func newTaskPersistence(db sqldb.Interface, nShards int, log log.Logger) (persistence.TaskManager, error) {
+// newTaskPersistence creates a new instance of TaskManager, task lists are spread over the
+// given databases by their storage shard
+func newTaskPersistence(dbs []sqldb.Interface, nShards int, log log.Logger) (persistence.TaskManager, error) {
+	if nShards < len(dbs) {
+		return nil, fmt.Errorf("number of task list shards %v is less than the number of databases %v", nShards, len(dbs))
+	}
 	return &sqlTaskManager{
-		sqlStore: sqlStore{
-			db:     db,
-			logger: log,
-		},
-		nShards: nShards,
+		shardedSQLStore: newShardedSQLStore(dbs, log),
+		nShards:         nShards,
 	}, nil
 }
 
@@ -60,7 +61,7 @@ func (m *sqlTaskManager) LeaseTaskList(request *persistence.LeaseTaskListRequest
 	var ackLevel int64
 	shardID := m.shardID(request.DomainID, request.TaskList)
 	domainID := sqldb.MustParseUUID(request.DomainID)
-	rows, err := m.db.SelectFromTaskLists(&sqldb.TaskListsFilter{
+	rows, err := m.shard(shardID).db.SelectFromTaskLists(&sqldb.TaskListsFilter{
 		ShardID:  shardID,
 		DomainID: &domainID,
 		Name:     &request.TaskList,
@@ -86,7 +87,7 @@ func (m *sqlTaskManager) LeaseTaskList(request *persistence.LeaseTaskListRequest
 				DataEncoding: string(blob.Encoding),
 			}
 			rows = []sqldb.TaskListsRow{row}
-			if _, err := m.db.InsertIntoTaskLists(&row); err != nil {
+			if _, err := m.shard(shardID).db.InsertIntoTaskLists(&row); err != nil {
 				return nil, &workflow.InternalServiceError{
 					Message: fmt.Sprintf("LeaseTaskList operation failed. Failed to make task list %v of type %v. Error: %v", request.TaskList, request.TaskType, err),
 				}
@@ -112,7 +113,7 @@ func (m *sqlTaskManager) LeaseTaskList(request *persistence.LeaseTaskListRequest
 	}
 
 	var resp *persistence.LeaseTaskListResponse
-	err = m.txExecute("LeaseTaskList", func(tx sqldb.Tx) error {
+	err = m.shard(shardID).txExecute("LeaseTaskList", func(tx sqldb.Tx) error {
 		rangeID = row.RangeID
 		ackLevel = tlInfo.GetAckLevel()
 		// We need to separately check the condition and do the
@@ -176,7 +177,7 @@ func (m *sqlTaskManager) UpdateTaskList(request *persistence.UpdateTaskListReque
 		if err != nil {
 			return nil, err
 		}
-		if _, err := m.db.ReplaceIntoTaskLists(&sqldb.TaskListsRow{
+		if _, err := m.shard(shardID).db.ReplaceIntoTaskLists(&sqldb.TaskListsRow{
 			ShardID:      shardID,
 			DomainID:     domainID,
 			RangeID:      request.TaskListInfo.RangeID,
@@ -195,7 +196,7 @@ func (m *sqlTaskManager) UpdateTaskList(request *persistence.UpdateTaskListReque
 	if err != nil {
 		return nil, err
 	}
-	err = m.txExecute("UpdateTaskList", func(tx sqldb.Tx) error {
+	err = m.shard(shardID).txExecute("UpdateTaskList", func(tx sqldb.Tx) error {
 		err1 := lockTaskList(
 			tx, shardID, domainID, request.TaskListInfo.Name, request.TaskListInfo.TaskType, request.TaskListInfo.RangeID)
 		if err1 != nil {
@@ -244,7 +245,7 @@ func (m *sqlTaskManager) ListTaskList(request *persistence.ListTaskListRequest)
 	var rows []sqldb.TaskListsRow
 	domainID := sqldb.MustParseUUID(pageToken.DomainID)
 	for pageToken.ShardID < m.nShards {
-		rows, err = m.db.SelectFromTaskLists(&sqldb.TaskListsFilter{
+		rows, err = m.shard(pageToken.ShardID).db.SelectFromTaskLists(&sqldb.TaskListsFilter{
 			ShardID:             pageToken.ShardID,
 			DomainIDGreaterThan: &domainID,
 			NameGreaterThan:     &pageToken.Name,
@@ -302,9 +303,10 @@ func (m *sqlTaskManager) ListTaskList(request *persistence.ListTaskListRequest)
 }
 
 func (m *sqlTaskManager) DeleteTaskList(request *persistence.DeleteTaskListRequest) error {
+	shardID := m.shardID(request.DomainID, request.TaskListName)
 	domainID := sqldb.MustParseUUID(request.DomainID)
-	result, err := m.db.DeleteFromTaskLists(&sqldb.TaskListsFilter{
-		ShardID:  m.shardID(request.DomainID, request.TaskListName),
+	result, err := m.shard(shardID).db.DeleteFromTaskLists(&sqldb.TaskListsFilter{
+		ShardID:  shardID,
 		DomainID: &domainID,
 		Name:     &request.TaskListName,
 		TaskType: common.Int64Ptr(int64(request.TaskListType)),
@@ -336,6 +338,7 @@ func (m *sqlTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (*
 			ScheduleID:       &v.Data.ScheduleID,
 			ExpiryTimeNanos:  common.Int64Ptr(expiryTime.UnixNano()),
 			CreatedTimeNanos: common.Int64Ptr(time.Now().UnixNano()),
+			Priority:         &v.Data.Priority,
 		})
 		if err != nil {
 			return nil, err
@@ -350,13 +353,14 @@ func (m *sqlTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (*
 		}
 	}
 	var resp *persistence.CreateTasksResponse
-	err := m.txExecute("CreateTasks", func(tx sqldb.Tx) error {
+	shardID := m.shardID(request.TaskListInfo.DomainID, request.TaskListInfo.Name)
+	err := m.shard(shardID).txExecute("CreateTasks", func(tx sqldb.Tx) error {
 		if _, err1 := tx.InsertIntoTasks(tasksRows); err1 != nil {
 			return err1
 		}
 		// Lock task list before committing.
 		err1 := lockTaskList(tx,
-			m.shardID(request.TaskListInfo.DomainID, request.TaskListInfo.Name),
+			shardID,
 			sqldb.MustParseUUID(request.TaskListInfo.DomainID),
 			request.TaskListInfo.Name,
 			request.TaskListInfo.TaskType, request.TaskListInfo.RangeID)
@@ -370,7 +374,8 @@ func (m *sqlTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (*
 }
 
 func (m *sqlTaskManager) GetTasks(request *persistence.GetTasksRequest) (*persistence.GetTasksResponse, error) {
-	rows, err := m.db.SelectFromTasks(&sqldb.TasksFilter{
+	shardID := m.shardID(request.DomainID, request.TaskList)
+	rows, err := m.shard(shardID).db.SelectFromTasks(&sqldb.TasksFilter{
 		DomainID:     sqldb.MustParseUUID(request.DomainID),
 		TaskListName: request.TaskList,
 		TaskType:     int64(request.TaskType),
@@ -398,6 +403,7 @@ func (m *sqlTaskManager) GetTasks(request *persistence.GetTasksRequest) (*persis
 			ScheduleID:  info.GetScheduleID(),
 			Expiry:      time.Unix(0, info.GetExpiryTimeNanos()),
 			CreatedTime: time.Unix(0, info.GetCreatedTimeNanos()),
+			Priority:    info.GetPriority(),
 		}
 	}
 
@@ -407,7 +413,8 @@ func (m *sqlTaskManager) GetTasks(request *persistence.GetTasksRequest) (*persis
 func (m *sqlTaskManager) CompleteTask(request *persistence.CompleteTaskRequest) error {
 	taskID := request.TaskID
 	taskList := request.TaskList
-	_, err := m.db.DeleteFromTasks(&sqldb.TasksFilter{
+	shardID := m.shardID(taskList.DomainID, taskList.Name)
+	_, err := m.shard(shardID).db.DeleteFromTasks(&sqldb.TasksFilter{
 		DomainID:     sqldb.MustParseUUID(taskList.DomainID),
 		TaskListName: taskList.Name,
 		TaskType:     int64(taskList.TaskType),
@@ -419,7 +426,8 @@ func (m *sqlTaskManager) CompleteTask(request *persistence.CompleteTaskRequest)
 }
 
 func (m *sqlTaskManager) CompleteTasksLessThan(request *persistence.CompleteTasksLessThanRequest) (int, error) {
-	result, err := m.db.DeleteFromTasks(&sqldb.TasksFilter{
+	shardID := m.shardID(request.DomainID, request.TaskListName)
+	result, err := m.shard(shardID).db.DeleteFromTasks(&sqldb.TasksFilter{
 		DomainID:             sqldb.MustParseUUID(request.DomainID),
 		TaskListName:         request.TaskListName,
 		TaskType:             int64(request.TaskType),
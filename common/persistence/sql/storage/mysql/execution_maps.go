@@ -362,3 +362,97 @@ func (mdb *DB) DeleteFromSignalsRequestedSets(filter *sqldb.SignalsRequestedSets
 	}
 	return mdb.conn.Exec(deleteAllSignalsRequestedSetQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
 }
+
+const (
+	deleteAllUpdatesRequestedSetQry = `DELETE FROM updates_requested_sets
+WHERE
+shard_id = ? AND
+domain_id = ? AND
+workflow_id = ? AND
+run_id = ?
+`
+
+	createUpdatesRequestedSetQry = `INSERT IGNORE INTO updates_requested_sets
+(shard_id, domain_id, workflow_id, run_id, request_id, event_id) VALUES
+(:shard_id, :domain_id, :workflow_id, :run_id, :request_id, :event_id)`
+
+	deleteUpdatesRequestedSetQry = `DELETE FROM updates_requested_sets
+WHERE
+shard_id = ? AND
+domain_id = ? AND
+workflow_id = ? AND
+run_id = ? AND
+request_id = ?`
+
+	getUpdatesRequestedSetQry = `SELECT request_id, event_id FROM updates_requested_sets WHERE
+shard_id = ? AND
+domain_id = ? AND
+workflow_id = ? AND
+run_id = ?`
+)
+
+// InsertIntoUpdatesRequestedSets inserts one or more rows into updates_requested_sets table
+func (mdb *DB) InsertIntoUpdatesRequestedSets(rows []sqldb.UpdatesRequestedSetsRow) (sql.Result, error) {
+	return mdb.conn.NamedExec(createUpdatesRequestedSetQry, rows)
+}
+
+// SelectFromUpdatesRequestedSets reads one or more rows from updates_requested_sets table
+func (mdb *DB) SelectFromUpdatesRequestedSets(filter *sqldb.UpdatesRequestedSetsFilter) ([]sqldb.UpdatesRequestedSetsRow, error) {
+	var rows []sqldb.UpdatesRequestedSetsRow
+	err := mdb.conn.Select(&rows, getUpdatesRequestedSetQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+	for i := 0; i < len(rows); i++ {
+		rows[i].ShardID = int64(filter.ShardID)
+		rows[i].DomainID = filter.DomainID
+		rows[i].WorkflowID = filter.WorkflowID
+		rows[i].RunID = filter.RunID
+	}
+	return rows, err
+}
+
+// DeleteFromUpdatesRequestedSets deletes one or more rows from updates_requested_sets table
+func (mdb *DB) DeleteFromUpdatesRequestedSets(filter *sqldb.UpdatesRequestedSetsFilter) (sql.Result, error) {
+	if filter.RequestID != nil {
+		return mdb.conn.Exec(deleteUpdatesRequestedSetQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID, *filter.RequestID)
+	}
+	return mdb.conn.Exec(deleteAllUpdatesRequestedSetQry, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+}
+
+var (
+	delayedSignalInfoColumns = []string{
+		"data",
+		"data_encoding",
+	}
+	delayedSignalInfoTableName = "delayed_signal_info_maps"
+	delayedSignalInfoKey       = "request_id"
+
+	deleteDelayedSignalInfoMapSQLQuery      = makeDeleteMapQry(delayedSignalInfoTableName)
+	setKeyInDelayedSignalInfoMapSQLQuery    = makeSetKeyInMapQry(delayedSignalInfoTableName, delayedSignalInfoColumns, delayedSignalInfoKey)
+	deleteKeyInDelayedSignalInfoMapSQLQuery = makeDeleteKeyInMapQry(delayedSignalInfoTableName, delayedSignalInfoKey)
+	getDelayedSignalInfoMapSQLQuery         = makeGetMapQryTemplate(delayedSignalInfoTableName, delayedSignalInfoColumns, delayedSignalInfoKey)
+)
+
+// ReplaceIntoDelayedSignalInfoMaps replaces one or more rows in delayed_signal_info_maps table
+func (mdb *DB) ReplaceIntoDelayedSignalInfoMaps(rows []sqldb.DelayedSignalInfoMapsRow) (sql.Result, error) {
+	return mdb.conn.NamedExec(setKeyInDelayedSignalInfoMapSQLQuery, rows)
+}
+
+// SelectFromDelayedSignalInfoMaps reads one or more rows from delayed_signal_info_maps table
+func (mdb *DB) SelectFromDelayedSignalInfoMaps(filter *sqldb.DelayedSignalInfoMapsFilter) ([]sqldb.DelayedSignalInfoMapsRow, error) {
+	var rows []sqldb.DelayedSignalInfoMapsRow
+	err := mdb.conn.Select(&rows, getDelayedSignalInfoMapSQLQuery, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+	for i := 0; i < len(rows); i++ {
+		rows[i].ShardID = int64(filter.ShardID)
+		rows[i].DomainID = filter.DomainID
+		rows[i].WorkflowID = filter.WorkflowID
+		rows[i].RunID = filter.RunID
+	}
+	return rows, err
+}
+
+// DeleteFromDelayedSignalInfoMaps deletes one or more rows from delayed_signal_info_maps table
+func (mdb *DB) DeleteFromDelayedSignalInfoMaps(filter *sqldb.DelayedSignalInfoMapsFilter) (sql.Result, error) {
+	if filter.RequestID != nil {
+		return mdb.conn.Exec(deleteKeyInDelayedSignalInfoMapSQLQuery, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID, *filter.RequestID)
+	}
+	return mdb.conn.Exec(deleteDelayedSignalInfoMapSQLQuery, filter.ShardID, filter.DomainID, filter.WorkflowID, filter.RunID)
+}
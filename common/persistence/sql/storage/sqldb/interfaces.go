@@ -425,6 +425,47 @@ type (
 		SignalID   *string
 	}
 
+	// UpdatesRequestedSetsRow represents a row in updates_requested_sets table
+	UpdatesRequestedSetsRow struct {
+		ShardID    int64
+		DomainID   UUID
+		WorkflowID string
+		RunID      UUID
+		RequestID  string
+		EventID    int64
+	}
+
+	// UpdatesRequestedSetsFilter contains the column names within updates_requested_sets table that
+	// can be used to filter results through a WHERE clause
+	UpdatesRequestedSetsFilter struct {
+		ShardID    int64
+		DomainID   UUID
+		WorkflowID string
+		RunID      UUID
+		RequestID  *string
+	}
+
+	// DelayedSignalInfoMapsRow represents a row in delayed_signal_info_maps table
+	DelayedSignalInfoMapsRow struct {
+		ShardID      int64
+		DomainID     UUID
+		WorkflowID   string
+		RunID        UUID
+		RequestID    string
+		Data         []byte
+		DataEncoding string
+	}
+
+	// DelayedSignalInfoMapsFilter contains the column names within delayed_signal_info_maps table that
+	// can be used to filter results through a WHERE clause
+	DelayedSignalInfoMapsFilter struct {
+		ShardID    int64
+		DomainID   UUID
+		WorkflowID string
+		RunID      UUID
+		RequestID  *string
+	}
+
 	// VisibilityRow represents a row in executions_visibility table
 	VisibilityRow struct {
 		DomainID         string
@@ -628,6 +669,26 @@ type (
 		// - multiple rows - {shardID, domainID, workflowID, runID}
 		DeleteFromSignalsRequestedSets(filter *SignalsRequestedSetsFilter) (sql.Result, error)
 
+		InsertIntoUpdatesRequestedSets(rows []UpdatesRequestedSetsRow) (sql.Result, error)
+		// SelectFromUpdatesRequestedSets returns one or more rows form updates_requested_sets table
+		// Required filter params - {shardID, domainID, workflowID, runID}
+		SelectFromUpdatesRequestedSets(filter *UpdatesRequestedSetsFilter) ([]UpdatesRequestedSetsRow, error)
+		// DeleteFromUpdatesRequestedSets deletes one or more rows from updates_requested_sets
+		// Required filter params
+		// - single row - {shardID, domainID, workflowID, runID, requestID}
+		// - multiple rows - {shardID, domainID, workflowID, runID}
+		DeleteFromUpdatesRequestedSets(filter *UpdatesRequestedSetsFilter) (sql.Result, error)
+
+		ReplaceIntoDelayedSignalInfoMaps(rows []DelayedSignalInfoMapsRow) (sql.Result, error)
+		// SelectFromDelayedSignalInfoMaps returns one or more rows form delayed_signal_info_maps table
+		// Required filter params - {shardID, domainID, workflowID, runID}
+		SelectFromDelayedSignalInfoMaps(filter *DelayedSignalInfoMapsFilter) ([]DelayedSignalInfoMapsRow, error)
+		// DeleteFromDelayedSignalInfoMaps deletes one or more rows from delayed_signal_info_maps table
+		// Required filter params
+		// - single row - {shardID, domainID, workflowID, runID, requestID}
+		// - multiple rows - {shardID, domainID, workflowID, runID}
+		DeleteFromDelayedSignalInfoMaps(filter *DelayedSignalInfoMapsFilter) (sql.Result, error)
+
 		// InsertIntoVisibility inserts a row into visibility table. If a row already exist,
 		// no changes will be made by this API
 		InsertIntoVisibility(row *VisibilityRow) (sql.Result, error)
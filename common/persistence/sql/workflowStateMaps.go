@@ -79,6 +79,7 @@ func updateActivityInfos(
 				RetryNonRetryableErrors:       v.NonRetriableErrors,
 				RetryLastFailureReason:        &v.LastFailureReason,
 				RetryLastWorkerIdentity:       &v.LastWorkerIdentity,
+				TaskPriority:                  &v.TaskPriority,
 			}
 			blob, err := activityInfoToBlob(info)
 			if err != nil {
@@ -193,6 +194,7 @@ func getActivityInfoMap(
 			NonRetriableErrors:       decoded.GetRetryNonRetryableErrors(),
 			LastFailureReason:        decoded.GetRetryLastFailureReason(),
 			LastWorkerIdentity:       decoded.GetRetryLastWorkerIdentity(),
+			TaskPriority:             decoded.GetTaskPriority(),
 		}
 		if decoded.StartedEvent != nil {
 			info.StartedEvent = persistence.NewDataBlob(decoded.StartedEvent, common.EncodingType(decoded.GetStartedEventEncoding()))
@@ -738,3 +740,133 @@ func deleteSignalInfoMap(
 	}
 	return nil
 }
+
+func updateDelayedSignalInfos(
+	tx sqldb.Tx,
+	delayedSignalInfos []*persistence.DelayedSignalInfo,
+	deleteInfos []string,
+	shardID int,
+	domainID sqldb.UUID,
+	workflowID string,
+	runID sqldb.UUID,
+) error {
+
+	if len(delayedSignalInfos) > 0 {
+		rows := make([]sqldb.DelayedSignalInfoMapsRow, len(delayedSignalInfos))
+		for i, v := range delayedSignalInfos {
+			blob, err := delayedSignalInfoToBlob(&sqlblobs.DelayedSignalInfo{
+				Version:       &v.Version,
+				Name:          &v.SignalName,
+				Input:         v.Input,
+				Control:       v.Control,
+				Identity:      &v.Identity,
+				FireTimeNanos: common.Int64Ptr(v.FireTime.UnixNano()),
+			})
+			if err != nil {
+				return err
+			}
+			rows[i] = sqldb.DelayedSignalInfoMapsRow{
+				ShardID:      int64(shardID),
+				DomainID:     domainID,
+				WorkflowID:   workflowID,
+				RunID:        runID,
+				RequestID:    v.RequestID,
+				Data:         blob.Data,
+				DataEncoding: string(blob.Encoding),
+			}
+		}
+		if _, err := tx.ReplaceIntoDelayedSignalInfoMaps(rows); err != nil {
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("Failed to update delayed signal info. Failed to execute update query. Error: %v", err),
+			}
+		}
+	}
+	if len(deleteInfos) > 0 {
+		for _, v := range deleteInfos {
+			result, err := tx.DeleteFromDelayedSignalInfoMaps(&sqldb.DelayedSignalInfoMapsFilter{
+				ShardID:    int64(shardID),
+				DomainID:   domainID,
+				WorkflowID: workflowID,
+				RunID:      runID,
+				RequestID:  &v,
+			})
+			if err != nil {
+				return &workflow.InternalServiceError{
+					Message: fmt.Sprintf("Failed to update delayed signal info. Failed to execute delete query. Error: %v", err),
+				}
+			}
+			rowsAffected, err := result.RowsAffected()
+			if err != nil {
+				return &workflow.InternalServiceError{
+					Message: fmt.Sprintf("Failed to update delayed signal info. Failed to verify number of rows deleted. Error: %v", err),
+				}
+			}
+			if int(rowsAffected) != 1 {
+				return &workflow.InternalServiceError{
+					Message: fmt.Sprintf("Failed to update delayed signal info. Deleted %v rows instead of 1", rowsAffected),
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func getDelayedSignalInfoMap(
+	db sqldb.Interface,
+	shardID int,
+	domainID sqldb.UUID,
+	workflowID string,
+	runID sqldb.UUID,
+) (map[string]*persistence.DelayedSignalInfo, error) {
+
+	rows, err := db.SelectFromDelayedSignalInfoMaps(&sqldb.DelayedSignalInfoMapsFilter{
+		ShardID:    int64(shardID),
+		DomainID:   domainID,
+		WorkflowID: workflowID,
+		RunID:      runID,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Failed to get delayed signal info. Error: %v", err),
+		}
+	}
+	ret := make(map[string]*persistence.DelayedSignalInfo)
+	for _, v := range rows {
+		info, err := delayedSignalInfoFromBlob(v.Data, v.DataEncoding)
+		if err != nil {
+			return nil, err
+		}
+		ret[v.RequestID] = &persistence.DelayedSignalInfo{
+			RequestID:  v.RequestID,
+			Version:    info.GetVersion(),
+			SignalName: info.GetName(),
+			Input:      info.GetInput(),
+			Control:    info.GetControl(),
+			Identity:   info.GetIdentity(),
+			FireTime:   time.Unix(0, info.GetFireTimeNanos()),
+		}
+	}
+
+	return ret, nil
+}
+
+func deleteDelayedSignalInfoMap(
+	tx sqldb.Tx,
+	shardID int,
+	domainID sqldb.UUID,
+	workflowID string,
+	runID sqldb.UUID,
+) error {
+
+	if _, err := tx.DeleteFromDelayedSignalInfoMaps(&sqldb.DelayedSignalInfoMapsFilter{
+		ShardID:    int64(shardID),
+		DomainID:   domainID,
+		WorkflowID: workflowID,
+		RunID:      runID,
+	}); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Failed to delete delayed signal info map. Error: %v", err),
+		}
+	}
+	return nil
+}
@@ -198,3 +198,97 @@ func deleteBufferedEvents(
 	}
 	return nil
 }
+
+func updateUpdatesRequested(
+	tx sqldb.Tx,
+	updateRequestedIDs map[string]int64,
+	deleteUpdateRequestIDs []string,
+	shardID int,
+	domainID sqldb.UUID,
+	workflowID string,
+	runID sqldb.UUID,
+) error {
+
+	if len(updateRequestedIDs) > 0 {
+		rows := make([]sqldb.UpdatesRequestedSetsRow, 0, len(updateRequestedIDs))
+		for requestID, eventID := range updateRequestedIDs {
+			rows = append(rows, sqldb.UpdatesRequestedSetsRow{
+				ShardID:    int64(shardID),
+				DomainID:   domainID,
+				WorkflowID: workflowID,
+				RunID:      runID,
+				RequestID:  requestID,
+				EventID:    eventID,
+			})
+		}
+		if _, err := tx.InsertIntoUpdatesRequestedSets(rows); err != nil {
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("Failed to update updates requested. Failed to execute update query. Error: %v", err),
+			}
+		}
+	}
+
+	for _, deleteUpdateRequestID := range deleteUpdateRequestIDs {
+		requestID := deleteUpdateRequestID
+		if _, err := tx.DeleteFromUpdatesRequestedSets(&sqldb.UpdatesRequestedSetsFilter{
+			ShardID:    int64(shardID),
+			DomainID:   domainID,
+			WorkflowID: workflowID,
+			RunID:      runID,
+			RequestID:  &requestID,
+		}); err != nil {
+			return &workflow.InternalServiceError{
+				Message: fmt.Sprintf("Failed to update updates requested. Failed to execute delete query. Error: %v", err),
+			}
+		}
+	}
+
+	return nil
+}
+
+func getUpdatesRequested(
+	db sqldb.Interface,
+	shardID int,
+	domainID sqldb.UUID,
+	workflowID string,
+	runID sqldb.UUID,
+) (map[string]int64, error) {
+
+	rows, err := db.SelectFromUpdatesRequestedSets(&sqldb.UpdatesRequestedSetsFilter{
+		ShardID:    int64(shardID),
+		DomainID:   domainID,
+		WorkflowID: workflowID,
+		RunID:      runID,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Failed to get updates requested. Error: %v", err),
+		}
+	}
+	var ret = make(map[string]int64)
+	for _, s := range rows {
+		ret[s.RequestID] = s.EventID
+	}
+	return ret, nil
+}
+
+func deleteUpdatesRequestedSet(
+	tx sqldb.Tx,
+	shardID int,
+	domainID sqldb.UUID,
+	workflowID string,
+	runID sqldb.UUID,
+) error {
+
+	if _, err := tx.DeleteFromUpdatesRequestedSets(&sqldb.UpdatesRequestedSetsFilter{
+		ShardID:    int64(shardID),
+		DomainID:   domainID,
+		WorkflowID: workflowID,
+		RunID:      runID,
+	}); err != nil {
+		return &workflow.InternalServiceError{
+			Message: fmt.Sprintf("Failed to delete updates requested set. Error: %v", err),
+		}
+	}
+	return nil
+}
@@ -156,11 +156,15 @@ type (
 	}
 
 	// VisibilityDeleteWorkflowExecutionRequest contains the request params for DeleteWorkflowExecution call
+	// StartTimestamp and CloseTimestamp locate the records in stores which otherwise rely on TTL based deletion,
+	// leave them zero to let those records expire
 	VisibilityDeleteWorkflowExecutionRequest struct {
-		DomainID   string
-		RunID      string
-		WorkflowID string
-		TaskID     int64
+		DomainID       string
+		RunID          string
+		WorkflowID     string
+		TaskID         int64
+		StartTimestamp int64
+		CloseTimestamp int64
 	}
 
 	// VisibilityManager is used to manage the visibility store
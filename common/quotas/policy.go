@@ -31,6 +31,7 @@ type MultiStageRateLimiter struct {
 	domainRPS      RPSKeyFunc
 	domainLimiters map[string]*DynamicRateLimiter
 	globalLimiter  *DynamicRateLimiter
+	strict         bool
 }
 
 // NewMultiStageRateLimiter returns a new domain quota rate limiter. This is about
@@ -45,6 +46,15 @@ func NewMultiStageRateLimiter(rps RPSFunc, domainRps RPSKeyFunc) *MultiStageRate
 	return rl
 }
 
+// NewStrictMultiStageRateLimiter returns a new domain quota rate limiter that rejects
+// the requests of a domain as soon as the domain exhausts its own quota, instead of
+// letting them through for as long as the global quota is not exhausted
+func NewStrictMultiStageRateLimiter(rps RPSFunc, domainRps RPSKeyFunc) *MultiStageRateLimiter {
+	rl := NewMultiStageRateLimiter(rps, domainRps)
+	rl.strict = true
+	return rl
+}
+
 // Allow attempts to allow a request to go through. The method returns
 // immediately with a true or false indicating if the request can make
 // progress
@@ -83,6 +93,11 @@ func (d *MultiStageRateLimiter) Allow(info Info) bool {
 	if !rsv.OK() {
 		return false
 	}
+	// a reservation that has to wait for a token means the domain has exhausted its own quota
+	if d.strict && rsv.Delay() > 0 {
+		rsv.Cancel()
+		return false
+	}
 
 	// ensure that the reservation does not break the global rate limit, if it
 	// does, cancel the reservation and do not allow to proceed.
@@ -0,0 +1,58 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package quotas
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiStageRateLimiter_DomainLimit(t *testing.T) {
+	policy := NewMultiStageRateLimiter(func() float64 { return 100 }, getDomainRPS)
+	// a domain over its own quota keeps borrowing from the global quota
+	assert.True(t, policy.Allow(Info{Domain: defaultDomain}))
+	assert.True(t, policy.Allow(Info{Domain: defaultDomain}))
+	assert.True(t, policy.Allow(Info{Domain: "other"}))
+	assert.True(t, policy.Allow(Info{}))
+}
+
+func TestStrictMultiStageRateLimiter_DomainLimit(t *testing.T) {
+	policy := NewStrictMultiStageRateLimiter(func() float64 { return 100 }, getDomainRPS)
+	assert.True(t, policy.Allow(Info{Domain: defaultDomain}))
+	assert.False(t, policy.Allow(Info{Domain: defaultDomain}))
+	// other domains and requests without a domain only share the global quota
+	assert.True(t, policy.Allow(Info{Domain: "other"}))
+	assert.True(t, policy.Allow(Info{}))
+}
+
+func TestStrictMultiStageRateLimiter_GlobalLimit(t *testing.T) {
+	policy := NewStrictMultiStageRateLimiter(func() float64 { return 1 }, func(string) float64 { return 100 })
+	assert.True(t, policy.Allow(Info{Domain: defaultDomain}))
+	assert.False(t, policy.Allow(Info{Domain: "other"}))
+}
+
+func getDomainRPS(domain string) float64 {
+	if domain == defaultDomain {
+		return 1
+	}
+	return 100
+}
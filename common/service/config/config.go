@@ -70,6 +70,8 @@ type (
 		Metrics Metrics `yaml:"metrics"`
 		// PProf is the PProf configuration
 		PProf PProf `yaml:"pprof"`
+		// HealthCheck is the configuration of the health check endpoints
+		HealthCheck HealthCheck `yaml:"healthCheck"`
 	}
 
 	// PProf contains the rpc config items
@@ -78,6 +80,12 @@ type (
 		Port int `yaml:"port"`
 	}
 
+	// HealthCheck contains the health check config items
+	HealthCheck struct {
+		// Port is the port on which the liveness and readiness endpoints will bind to
+		Port int `yaml:"port"`
+	}
+
 	// RPC contains the rpc config items
 	RPC struct {
 		// Port is the port  on which the channel will bind to
@@ -100,12 +108,17 @@ type (
 		Name string `yaml:"name" validate:"nonzero"`
 		// BootstrapMode is a enum that defines the ringpop bootstrap method
 		BootstrapMode BootstrapMode `yaml:"bootstrapMode"`
-		// BootstrapHosts is a list of seed hosts to be used for ringpop bootstrap
+		// BootstrapHosts is a list of seed hosts to be used for ringpop bootstrap. In dns mode they are
+		// host:port pairs to be resolved, e.g. a kubernetes headless service, and in dns-srv mode they
+		// are names of SRV records, e.g. _tchannel._tcp.cadence-history.default.svc.cluster.local
 		BootstrapHosts []string `yaml:"bootstrapHosts"`
 		// BootstrapFile is the file path to be used for ringpop bootstrap
 		BootstrapFile string `yaml:"bootstrapFile"`
 		// MaxJoinDuration is the max wait time to join the ring
 		MaxJoinDuration time.Duration `yaml:"maxJoinDuration"`
+		// BootstrapRefreshInterval is the minimum interval between two DNS lookups of a bootstrap host
+		// in dns and dns-srv modes
+		BootstrapRefreshInterval time.Duration `yaml:"bootstrapRefreshInterval"`
 		// Custom discovery provider, cannot be specified through yaml
 		DiscoveryProvider discovery.DiscoverProvider `yaml:"-"`
 	}
@@ -126,8 +139,31 @@ type (
 		DataStores map[string]DataStore `yaml:"datastores"`
 		// VisibilityConfig is config for visibility sampling
 		VisibilityConfig *VisibilityConfig
+		// FaultInjectionConfig is config for injecting faults into persistence calls
+		FaultInjectionConfig *FaultInjectionConfig
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicconfig.IntPropertyFn
+		// DomainMaxQPS is the max qps a single domain can issue against a datastore, a value
+		// of zero or a nil func means requests are only bounded by the datastore MaxQPS
+		DomainMaxQPS dynamicconfig.IntPropertyFnWithDomainFilter
+		// EnableDomainMetrics reports whether the persistence metrics tagged with the domain are
+		// emitted for a domain, a nil func means they are not emitted for any domain
+		EnableDomainMetrics dynamicconfig.BoolPropertyFnWithDomainFilter
+		// Encryption is the config for encrypting history events and heartbeat details at rest, they are
+		// stored in plaintext if it is not specified
+		Encryption *Encryption `yaml:"encryption"`
+	}
+
+	// Encryption contains the config for encrypting history events and heartbeat details at rest
+	Encryption struct {
+		// FileKeyProvider contains the config for loading the encryption keys from a local file
+		FileKeyProvider *FileKeyProvider `yaml:"fileKeyProvider"`
+	}
+
+	// FileKeyProvider contains the config for the file based encryption key provider
+	FileKeyProvider struct {
+		// KeyFile is the path of the yaml file holding the keys
+		KeyFile string `yaml:"keyFile" validate:"nonzero"`
 	}
 
 	// DataStore is the configuration for a single datastore
@@ -158,6 +194,19 @@ type (
 		ValidSearchAttributes dynamicconfig.MapPropertyFn
 	}
 
+	// FaultInjectionConfig is config for injecting faults into persistence calls, it is only
+	// meant for resilience testing. All properties except Enabled can be filtered by persistence API
+	FaultInjectionConfig struct {
+		// Enabled decides whether the persistence clients are wrapped with fault injection
+		Enabled dynamicconfig.BoolPropertyFn
+		// ErrorRate is the probability in [0, 1] of failing a persistence call with an injected error
+		ErrorRate dynamicconfig.FloatPropertyFn
+		// ErrorType is the type of the injected errors
+		ErrorType dynamicconfig.StringPropertyFn
+		// Latency is the latency injected before each persistence call
+		Latency dynamicconfig.DurationPropertyFn
+	}
+
 	// Cassandra contains configuration to connect to Cassandra cluster
 	Cassandra struct {
 		// Hosts is a csv of cassandra endpoints
@@ -207,6 +256,29 @@ type (
 		// NumShards is the number of storage shards to use for tables
 		// in a sharded sql database. The default value for this param is 1
 		NumShards int `yaml:"nShards"`
+		// Databases is the list of physical databases that history shards and task lists
+		// are spread over. The first database also holds the data that is not sharded,
+		// i.e. domains, visibility records and v1 history. If empty, the single database
+		// described by the fields above is used for everything. Shards are mapped to the
+		// databases by their position in the list, so the list must not be changed once the
+		// databases hold data. Both nShards and numHistoryShards must be at least the number
+		// of databases
+		Databases []SQLDatabase `yaml:"databases"`
+	}
+
+	// SQLDatabase describes one physical database of a multi database SQL datastore. The
+	// connection fields left empty are inherited from the enclosing SQL config
+	SQLDatabase struct {
+		// User is the username to be used for the conn
+		User string `yaml:"user"`
+		// Password is the password corresponding to the user name
+		Password string `yaml:"password"`
+		// DatabaseName is the name of SQL database to connect to
+		DatabaseName string `yaml:"databaseName"`
+		// ConnectAddr is the remote addr of the database
+		ConnectAddr string `yaml:"connectAddr"`
+		// ConnectProtocol is the protocol that goes with the ConnectAddr ex - tcp, unix
+		ConnectProtocol string `yaml:"connectProtocol"`
 	}
 
 	// Replicator describes the configuration of replicator
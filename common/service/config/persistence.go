@@ -50,6 +50,34 @@ func (c *Persistence) DefaultStoreType() string {
 	return StoreTypeCassandra
 }
 
+// DatabaseConfigs returns the connection config of every physical database of the datastore,
+// in the order used to map shards to databases
+func (c *SQL) DatabaseConfigs() []SQL {
+	if len(c.Databases) == 0 {
+		return []SQL{*c}
+	}
+	result := make([]SQL, len(c.Databases))
+	for i, db := range c.Databases {
+		cfg := *c
+		cfg.Databases = nil
+		cfg.DatabaseName = db.DatabaseName
+		if db.User != "" {
+			cfg.User = db.User
+		}
+		if db.Password != "" {
+			cfg.Password = db.Password
+		}
+		if db.ConnectAddr != "" {
+			cfg.ConnectAddr = db.ConnectAddr
+		}
+		if db.ConnectProtocol != "" {
+			cfg.ConnectProtocol = db.ConnectProtocol
+		}
+		result[i] = cfg
+	}
+	return result
+}
+
 // Validate validates the persistence config
 func (c *Persistence) Validate() error {
 	stores := []string{c.DefaultStore, c.VisibilityStore}
@@ -67,6 +95,24 @@ func (c *Persistence) Validate() error {
 		if ds.SQL != nil && ds.SQL.NumShards == 0 {
 			ds.SQL.NumShards = 1
 		}
+		if ds.SQL != nil {
+			for i, db := range ds.SQL.Databases {
+				if db.DatabaseName == "" {
+					return fmt.Errorf("persistence config: datastore %v: missing databaseName for database %v", st, i)
+				}
+			}
+			// shards are mapped to the databases by shardID % numDatabases, with fewer shards than
+			// databases some databases would never be used
+			numDatabases := len(ds.SQL.DatabaseConfigs())
+			if numDatabases > 1 && ds.SQL.NumShards < numDatabases {
+				return fmt.Errorf("persistence config: datastore %v: nShards %v is less than the number of databases %v",
+					st, ds.SQL.NumShards, numDatabases)
+			}
+			if numDatabases > 1 && st == c.DefaultStore && c.NumHistoryShards < numDatabases {
+				return fmt.Errorf("persistence config: datastore %v: numHistoryShards %v is less than the number of databases %v",
+					st, c.NumHistoryShards, numDatabases)
+			}
+		}
 	}
 	return nil
 }
@@ -0,0 +1,118 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type PersistenceSuite struct {
+	*require.Assertions
+	suite.Suite
+}
+
+func TestPersistenceSuite(t *testing.T) {
+	suite.Run(t, new(PersistenceSuite))
+}
+
+func (s *PersistenceSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *PersistenceSuite) TestSQLDatabaseConfigs_SingleDatabase() {
+	cfg := &SQL{DatabaseName: "cadence", ConnectAddr: "127.0.0.1:3306", MaxConns: 10}
+	configs := cfg.DatabaseConfigs()
+	s.Equal([]SQL{*cfg}, configs)
+}
+
+func (s *PersistenceSuite) TestSQLDatabaseConfigs_MultipleDatabases() {
+	cfg := &SQL{
+		User:            "uber",
+		Password:        "uber",
+		DriverName:      "mysql",
+		DatabaseName:    "cadence",
+		ConnectAddr:     "127.0.0.1:3306",
+		ConnectProtocol: "tcp",
+		MaxConns:        10,
+		Databases: []SQLDatabase{
+			{DatabaseName: "cadence"},
+			{DatabaseName: "cadence1", ConnectAddr: "127.0.0.2:3306", User: "admin", Password: "secret"},
+		},
+	}
+	configs := cfg.DatabaseConfigs()
+	s.Len(configs, 2)
+
+	s.Equal("cadence", configs[0].DatabaseName)
+	s.Equal("127.0.0.1:3306", configs[0].ConnectAddr)
+	s.Equal("uber", configs[0].User)
+	s.Nil(configs[0].Databases)
+
+	s.Equal("cadence1", configs[1].DatabaseName)
+	s.Equal("127.0.0.2:3306", configs[1].ConnectAddr)
+	s.Equal("tcp", configs[1].ConnectProtocol)
+	s.Equal("admin", configs[1].User)
+	s.Equal("secret", configs[1].Password)
+	s.Equal("mysql", configs[1].DriverName)
+	s.Equal(10, configs[1].MaxConns)
+	s.Nil(configs[1].Databases)
+}
+
+func (s *PersistenceSuite) TestValidate_SQLDatabases() {
+	cfg := &Persistence{
+		DefaultStore:     "default",
+		VisibilityStore:  "default",
+		NumHistoryShards: 4,
+		DataStores: map[string]DataStore{
+			"default": {SQL: &SQL{
+				DatabaseName: "cadence",
+				NumShards:    2,
+				Databases:    []SQLDatabase{{DatabaseName: "cadence"}, {ConnectAddr: "127.0.0.2:3306"}},
+			}},
+		},
+	}
+	s.Error(cfg.Validate())
+
+	cfg.DataStores["default"].SQL.Databases[1].DatabaseName = "cadence1"
+	s.NoError(cfg.Validate())
+
+	// every database must own some of the shards
+	cfg.NumHistoryShards = 1
+	s.Error(cfg.Validate())
+	cfg.NumHistoryShards = 4
+	cfg.DataStores["default"].SQL.NumShards = 0
+	s.Error(cfg.Validate())
+}
+
+func (s *PersistenceSuite) TestValidate_SQLDefaultNumShards() {
+	cfg := &Persistence{
+		DefaultStore:     "default",
+		VisibilityStore:  "default",
+		NumHistoryShards: 4,
+		DataStores: map[string]DataStore{
+			"default": {SQL: &SQL{DatabaseName: "cadence"}},
+		},
+	}
+	s.NoError(cfg.Validate())
+	s.Equal(1, cfg.DataStores["default"].SQL.NumShards)
+}
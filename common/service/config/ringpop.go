@@ -26,7 +26,9 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/uber/cadence/common"
@@ -55,10 +57,14 @@ const (
 	// BootstrapModeDNS represents a list of hosts passed in the configuration
 	// to be resolved, and the resulting addresses are used for bootstrap
 	BootstrapModeDNS
+	// BootstrapModeDNSSRV represents a list of DNS SRV records passed in the configuration
+	// to be resolved, and the resulting addresses and ports are used for bootstrap
+	BootstrapModeDNSSRV
 )
 
 const (
-	defaultMaxJoinDuration = 10 * time.Second
+	defaultMaxJoinDuration          = 10 * time.Second
+	defaultBootstrapRefreshInterval = 10 * time.Second
 )
 
 // CadenceServices indicate the list of cadence services
@@ -112,6 +118,8 @@ func parseBootstrapMode(s string) (BootstrapMode, error) {
 		return BootstrapModeCustom, nil
 	case "dns":
 		return BootstrapModeDNS, nil
+	case "dns-srv":
+		return BootstrapModeDNSSRV, nil
 	}
 	return BootstrapModeNone, errors.New("invalid or no ringpop bootstrap mode")
 }
@@ -122,7 +130,7 @@ func validateBootstrapMode(rpConfig *Ringpop) error {
 		if len(rpConfig.BootstrapFile) == 0 {
 			return fmt.Errorf("ringpop config missing bootstrap file param")
 		}
-	case BootstrapModeHosts, BootstrapModeDNS:
+	case BootstrapModeHosts, BootstrapModeDNS, BootstrapModeDNSSRV:
 		if len(rpConfig.BootstrapHosts) == 0 {
 			return fmt.Errorf("ringpop config missing boostrap hosts param")
 		}
@@ -143,6 +151,9 @@ func newRingpopFactory(rpConfig *Ringpop, logger log.Logger, serviceName string)
 	if rpConfig.MaxJoinDuration == 0 {
 		rpConfig.MaxJoinDuration = defaultMaxJoinDuration
 	}
+	if rpConfig.BootstrapRefreshInterval == 0 {
+		rpConfig.BootstrapRefreshInterval = defaultBootstrapRefreshInterval
+	}
 	return &RingpopFactory{config: rpConfig, logger: logger, serviceName: serviceName}, nil
 }
 
@@ -212,12 +223,27 @@ func (factory *RingpopFactory) getChannel(dispatcher *yarpc.Dispatcher) (*tcg.Ch
 
 type dnsHostResolver interface {
 	LookupHost(ctx context.Context, host string) (addrs []string, err error)
+	LookupSRV(ctx context.Context, service, proto, name string) (cname string, addrs []*net.SRV, err error)
 }
 
+// dnsProvider resolves the bootstrap hosts through DNS every time ringpop asks for them, i.e. on
+// bootstrap and when healing partitions. A host is looked up at most once per RefreshInterval,
+// and its last resolved addresses are used whenever a lookup fails.
 type dnsProvider struct {
 	UnresolvedHosts []string
 	Resolver        dnsHostResolver
 	Logger          log.Logger
+	// SRV indicates the unresolved hosts are names of SRV records rather than host:port pairs
+	SRV             bool
+	RefreshInterval time.Duration
+
+	sync.Mutex
+	resolved map[string]dnsResolution
+}
+
+type dnsResolution struct {
+	hostports  []string
+	resolvedAt time.Time
 }
 
 func newDNSProvider(hosts []string, resolver dnsHostResolver, logger log.Logger) *dnsProvider {
@@ -234,34 +260,93 @@ func newDNSProvider(hosts []string, resolver dnsHostResolver, logger log.Logger)
 		UnresolvedHosts: keys,
 		Resolver:        resolver,
 		Logger:          logger,
+		resolved:        make(map[string]dnsResolution),
 	}
 }
 
+func newDNSSRVProvider(names []string, resolver dnsHostResolver, logger log.Logger) *dnsProvider {
+	provider := newDNSProvider(names, resolver, logger)
+	provider.SRV = true
+	return provider
+}
+
 func (provider *dnsProvider) Hosts() ([]string, error) {
+	provider.Lock()
+	defer provider.Unlock()
+
 	results := []string{}
-	resolvedHosts := map[string][]string{}
-	for _, hostport := range provider.UnresolvedHosts {
-		host, port, err := net.SplitHostPort(hostport)
-		if err != nil {
-			provider.Logger.Warn("Could not split host and port", tag.Address(hostport), tag.Error(err))
-			continue
+	for _, unresolved := range provider.UnresolvedHosts {
+		results = append(results, provider.resolveLocked(unresolved)...)
+	}
+	if len(results) == 0 {
+		return nil, errors.New("No hosts found, and bootstrap requires at least one")
+	}
+	return results, nil
+}
+
+func (provider *dnsProvider) resolveLocked(unresolved string) []string {
+	now := time.Now()
+	last, ok := provider.resolved[unresolved]
+	if ok && now.Sub(last.resolvedAt) < provider.RefreshInterval {
+		return last.hostports
+	}
+
+	var hostports []string
+	var err error
+	if provider.SRV {
+		hostports, err = provider.lookupSRV(unresolved)
+	} else {
+		hostports, err = provider.lookupHostPort(unresolved)
+	}
+	if err != nil {
+		if ok {
+			provider.Logger.Warn("Could not resolve host, using last resolved addresses", tag.Address(unresolved), tag.Error(err))
+			return last.hostports
 		}
+		provider.Logger.Warn("Could not resolve host", tag.Address(unresolved), tag.Error(err))
+		return nil
+	}
 
-		resolved, exists := resolvedHosts[host]
-		if !exists {
-			resolved, err = provider.Resolver.LookupHost(context.Background(), host)
-			if err != nil {
-				provider.Logger.Warn("Could not resolve host", tag.Address(host), tag.Error(err))
-				continue
-			}
-			resolvedHosts[host] = resolved
+	provider.resolved[unresolved] = dnsResolution{hostports: hostports, resolvedAt: now}
+	return hostports
+}
+
+func (provider *dnsProvider) lookupHostPort(hostport string) ([]string, error) {
+	host, port, err := net.SplitHostPort(hostport)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved, err := provider.Resolver.LookupHost(context.Background(), host)
+	if err != nil {
+		return nil, err
+	}
+	results := []string{}
+	for _, r := range resolved {
+		results = append(results, net.JoinHostPort(r, port))
+	}
+	return results, nil
+}
+
+func (provider *dnsProvider) lookupSRV(name string) ([]string, error) {
+	_, records, err := provider.Resolver.LookupSRV(context.Background(), "", "", name)
+	if err != nil {
+		return nil, err
+	}
+
+	results := []string{}
+	for _, record := range records {
+		resolved, err := provider.Resolver.LookupHost(context.Background(), record.Target)
+		if err != nil {
+			provider.Logger.Warn("Could not resolve SRV target", tag.Address(record.Target), tag.Error(err))
+			continue
 		}
 		for _, r := range resolved {
-			results = append(results, net.JoinHostPort(r, port))
+			results = append(results, net.JoinHostPort(r, strconv.Itoa(int(record.Port))))
 		}
 	}
 	if len(results) == 0 {
-		return nil, errors.New("No hosts found, and bootstrap requires at least one")
+		return nil, fmt.Errorf("no SRV target of %v could be resolved", name)
 	}
 	return results, nil
 }
@@ -279,7 +364,13 @@ func newDiscoveryProvider(cfg *Ringpop, logger log.Logger) (discovery.DiscoverPr
 	case BootstrapModeFile:
 		return jsonfile.New(cfg.BootstrapFile), nil
 	case BootstrapModeDNS:
-		return newDNSProvider(cfg.BootstrapHosts, net.DefaultResolver, logger), nil
+		provider := newDNSProvider(cfg.BootstrapHosts, net.DefaultResolver, logger)
+		provider.RefreshInterval = cfg.BootstrapRefreshInterval
+		return provider, nil
+	case BootstrapModeDNSSRV:
+		provider := newDNSSRVProvider(cfg.BootstrapHosts, net.DefaultResolver, logger)
+		provider.RefreshInterval = cfg.BootstrapRefreshInterval
+		return provider, nil
 	}
 	return nil, fmt.Errorf("unknown bootstrap mode")
 }
@@ -23,6 +23,7 @@ package config
 import (
 	"context"
 	"fmt"
+	"net"
 	"testing"
 	"time"
 
@@ -92,6 +93,7 @@ func (s *RingpopSuite) TestCustomMode() {
 
 type mockResolver struct {
 	Hosts map[string][]string
+	SRVs  map[string][]*net.SRV
 }
 
 func (resolver *mockResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
@@ -102,6 +104,14 @@ func (resolver *mockResolver) LookupHost(ctx context.Context, host string) ([]st
 	return addrs, nil
 }
 
+func (resolver *mockResolver) LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error) {
+	addrs, ok := resolver.SRVs[name]
+	if !ok {
+		return "", nil, fmt.Errorf("SRV record was not resolved: %s", name)
+	}
+	return name, addrs, nil
+}
+
 func (s *RingpopSuite) TestDNSMode() {
 	var cfg Ringpop
 	err := yaml.Unmarshal([]byte(getDNSConfig()), &cfg)
@@ -164,6 +174,75 @@ func (s *RingpopSuite) TestDNSMode() {
 	s.NotNil(err, "error should be returned when no hosts")
 }
 
+func (s *RingpopSuite) TestDNSSRVMode() {
+	var cfg Ringpop
+	err := yaml.Unmarshal([]byte(getDNSSRVConfig()), &cfg)
+	s.Nil(err)
+	s.Equal("test", cfg.Name)
+	s.Equal(BootstrapModeDNSSRV, cfg.BootstrapMode)
+	s.Nil(cfg.validate())
+	logger := loggerimpl.NewNopLogger()
+	f, err := cfg.NewFactory(logger, "test")
+	s.Nil(err)
+	s.NotNil(f)
+	s.Equal(defaultBootstrapRefreshInterval, cfg.BootstrapRefreshInterval)
+
+	provider := newDNSSRVProvider(
+		cfg.BootstrapHosts,
+		&mockResolver{
+			Hosts: map[string][]string{
+				"cadence-0.example.net.": []string{"10.0.0.0"},
+				"cadence-1.example.net.": []string{"10.0.0.1"},
+			},
+			SRVs: map[string][]*net.SRV{
+				"_tchannel._tcp.example.net": []*net.SRV{
+					{Target: "cadence-0.example.net.", Port: 7933},
+					{Target: "cadence-1.example.net.", Port: 7934},
+					{Target: "unknown.example.net.", Port: 7935},
+				},
+			},
+		},
+		logger,
+	)
+	hostports, err := provider.Hosts()
+	s.Nil(err)
+	s.ElementsMatch([]string{"10.0.0.0:7933", "10.0.0.1:7934"}, hostports)
+
+	provider = newDNSSRVProvider(cfg.BootstrapHosts, &mockResolver{}, logger)
+	hostports, err = provider.Hosts()
+	s.Nil(hostports)
+	s.NotNil(err, "error should be returned when no hosts")
+}
+
+func (s *RingpopSuite) TestDNSRefresh() {
+	resolver := &mockResolver{
+		Hosts: map[string][]string{"example.net": []string{"10.0.0.0"}},
+	}
+	provider := newDNSProvider([]string{"example.net:1111"}, resolver, loggerimpl.NewNopLogger())
+	provider.RefreshInterval = time.Hour
+
+	hostports, err := provider.Hosts()
+	s.Nil(err)
+	s.Equal([]string{"10.0.0.0:1111"}, hostports)
+
+	// the host is not looked up again within the refresh interval
+	resolver.Hosts["example.net"] = []string{"10.0.0.1"}
+	hostports, err = provider.Hosts()
+	s.Nil(err)
+	s.Equal([]string{"10.0.0.0:1111"}, hostports)
+
+	provider.RefreshInterval = 0
+	hostports, err = provider.Hosts()
+	s.Nil(err)
+	s.Equal([]string{"10.0.0.1:1111"}, hostports)
+
+	// the last resolved addresses are used when a lookup fails
+	delete(resolver.Hosts, "example.net")
+	hostports, err = provider.Hosts()
+	s.Nil(err)
+	s.Equal([]string{"10.0.0.1:1111"}, hostports)
+}
+
 func (s *RingpopSuite) TestInvalidConfig() {
 	var cfg Ringpop
 	s.NotNil(cfg.validate())
@@ -206,3 +285,11 @@ bootstrapHosts:
 - badhostport
 maxJoinDuration: 30s`
 }
+
+func getDNSSRVConfig() string {
+	return `name: "test"
+bootstrapMode: "dns-srv"
+bootstrapHosts:
+- _tchannel._tcp.example.net
+maxJoinDuration: 30s`
+}
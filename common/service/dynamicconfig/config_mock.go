@@ -59,6 +59,11 @@ func GetDurationPropertyFn(value time.Duration) func(opts ...FilterOption) time.
 	return func(...FilterOption) time.Duration { return value }
 }
 
+// GetDurationPropertyFnFilteredByDomain returns value as DurationPropertyFnFilteredByDomain
+func GetDurationPropertyFnFilteredByDomain(value time.Duration) func(domain string) time.Duration {
+	return func(domain string) time.Duration { return value }
+}
+
 // GetDurationPropertyFnFilteredByTaskListInfo returns value as DurationPropertyFnWithTaskListInfoFilters
 func GetDurationPropertyFnFilteredByTaskListInfo(value time.Duration) func(domain string, taskList string, taskType int) time.Duration {
 	return func(domain string, taskList string, taskType int) time.Duration { return value }
@@ -64,7 +64,13 @@ var keys = map[Key]string{
 	TransactionSizeLimit:                "system.transactionSizeLimit",
 	MinRetentionDays:                    "system.minRetentionDays",
 	MaxDecisionStartToCloseSeconds:      "system.maxDecisionStartToCloseSeconds",
+	EnablePersistenceFaultInjection:     "system.enablePersistenceFaultInjection",
+	PersistenceFaultInjectionErrorRate:  "system.persistenceFaultInjectionErrorRate",
+	PersistenceFaultInjectionErrorType:  "system.persistenceFaultInjectionErrorType",
+	PersistenceFaultInjectionLatency:    "system.persistenceFaultInjectionLatency",
+	EnablePersistenceDomainMetrics:      "system.enablePersistenceDomainMetrics",
 	EnableBatcher:                       "worker.enableBatcher",
+	EnableScheduler:                     "worker.enableScheduler",
 
 	// size limit
 	BlobSizeLimitError:     "limit.blobSize.error",
@@ -77,6 +83,7 @@ var keys = map[Key]string{
 
 	// frontend settings
 	FrontendPersistenceMaxQPS:         "frontend.persistenceMaxQPS",
+	FrontendPersistenceDomainMaxQPS:   "frontend.persistenceDomainMaxQPS",
 	FrontendVisibilityMaxPageSize:     "frontend.visibilityMaxPageSize",
 	FrontendVisibilityListMaxQPS:      "frontend.visibilityListMaxQPS",
 	FrontendESVisibilityListMaxQPS:    "frontend.esVisibilityListMaxQPS",
@@ -97,6 +104,7 @@ var keys = map[Key]string{
 	// matching settings
 	MatchingRPS:                             "matching.rps",
 	MatchingPersistenceMaxQPS:               "matching.persistenceMaxQPS",
+	MatchingPersistenceDomainMaxQPS:         "matching.persistenceDomainMaxQPS",
 	MatchingMinTaskThrottlingBurstSize:      "matching.minTaskThrottlingBurstSize",
 	MatchingGetTasksBatchSize:               "matching.getTasksBatchSize",
 	MatchingLongPollExpirationInterval:      "matching.longPollExpirationInterval",
@@ -114,10 +122,12 @@ var keys = map[Key]string{
 	MatchingForwarderMaxOutstandingTasks:    "matching.forwarderMaxOutstandingTasks",
 	MatchingForwarderMaxRatePerSecond:       "matching.forwarderMaxRatePerSecond",
 	MatchingForwarderMaxChildrenPerNode:     "matching.forwarderMaxChildrenPerNode",
+	MatchingPriorityStarvationInterval:      "matching.priorityStarvationInterval",
 
 	// history settings
 	HistoryRPS:                                            "history.rps",
 	HistoryPersistenceMaxQPS:                              "history.persistenceMaxQPS",
+	HistoryPersistenceDomainMaxQPS:                        "history.persistenceDomainMaxQPS",
 	HistoryVisibilityOpenMaxQPS:                           "history.historyVisibilityOpenMaxQPS",
 	HistoryVisibilityClosedMaxQPS:                         "history.historyVisibilityClosedMaxQPS",
 	HistoryLongPollExpirationInterval:                     "history.longPollExpirationInterval",
@@ -187,6 +197,13 @@ var keys = map[Key]string{
 	EmitShardDiffLog:                                      "history.emitShardDiffLog",
 	HistoryThrottledLogRPS:                                "history.throttledLogRPS",
 	StickyTTL:                                             "history.stickyTTL",
+	HistoryESProcessorNumOfWorkers:                        "history.ESProcessorNumOfWorkers",
+	HistoryESProcessorBulkActions:                         "history.ESProcessorBulkActions",
+	HistoryESProcessorBulkSize:                            "history.ESProcessorBulkSize",
+	HistoryESProcessorFlushInterval:                       "history.ESProcessorFlushInterval",
+	HistoryESProcessorAckTimeout:                          "history.ESProcessorAckTimeout",
+	EnableActivityLocalDispatchByDomain:                   "history.enableActivityLocalDispatchByDomain",
+	EnableParentClosePolicy:                               "history.enableParentClosePolicy",
 
 	WorkerPersistenceMaxQPS:                         "worker.persistenceMaxQPS",
 	WorkerReplicatorMetaTaskConcurrency:             "worker.replicatorMetaTaskConcurrency",
@@ -263,6 +280,21 @@ const (
 	MinRetentionDays
 	// MaxDecisionStartToCloseSeconds is the minimal allowed decision start to close timeout in seconds
 	MaxDecisionStartToCloseSeconds
+	// EnablePersistenceFaultInjection is key for wrapping the persistence clients with fault injection,
+	// it is only read when a service starts and must never be enabled in production
+	EnablePersistenceFaultInjection
+	// PersistenceFaultInjectionErrorRate is the probability of failing a persistence call with an injected error,
+	// it can be filtered by persistence API
+	PersistenceFaultInjectionErrorRate
+	// PersistenceFaultInjectionErrorType is the type of the injected persistence errors, one of timeout,
+	// serviceBusy, conditionFailed, shardOwnershipLost or internal, it can be filtered by persistence API
+	PersistenceFaultInjectionErrorType
+	// PersistenceFaultInjectionLatency is the latency injected before each persistence call,
+	// it can be filtered by persistence API
+	PersistenceFaultInjectionLatency
+	// EnablePersistenceDomainMetrics is key for emitting the persistence request, latency and error
+	// metrics tagged with the domain of the request, it is filtered by domain
+	EnablePersistenceDomainMetrics
 
 	// BlobSizeLimitError is the per event blob size limit
 	BlobSizeLimitError
@@ -285,6 +317,8 @@ const (
 
 	// FrontendPersistenceMaxQPS is the max qps frontend host can query DB
 	FrontendPersistenceMaxQPS
+	// FrontendPersistenceDomainMaxQPS is the max qps a single domain can query DB on a frontend host
+	FrontendPersistenceDomainMaxQPS
 	// FrontendVisibilityMaxPageSize is default max size for ListWorkflowExecutions in one page
 	FrontendVisibilityMaxPageSize
 	// FrontendVisibilityListMaxQPS is max qps frontend can list open/close workflows
@@ -322,6 +356,8 @@ const (
 	MatchingRPS
 	// MatchingPersistenceMaxQPS is the max qps matching host can query DB
 	MatchingPersistenceMaxQPS
+	// MatchingPersistenceDomainMaxQPS is the max qps a single domain can query DB on a matching host
+	MatchingPersistenceDomainMaxQPS
 	// MatchingMinTaskThrottlingBurstSize is the minimum burst size for task list throttling
 	MatchingMinTaskThrottlingBurstSize
 	// MatchingGetTasksBatchSize is the maximum batch size to fetch from the task buffer
@@ -356,6 +392,9 @@ const (
 	MatchingForwarderMaxRatePerSecond
 	// MatchingForwarderMaxChildrenPerNode is the max number of children per node in the task list partition tree
 	MatchingForwarderMaxChildrenPerNode
+	// MatchingPriorityStarvationInterval is the number of buffered task dispatches after which the oldest
+	// buffered task is dispatched regardless of its priority
+	MatchingPriorityStarvationInterval
 
 	// key for history
 
@@ -363,6 +402,8 @@ const (
 	HistoryRPS
 	// HistoryPersistenceMaxQPS is the max qps history host can query DB
 	HistoryPersistenceMaxQPS
+	// HistoryPersistenceDomainMaxQPS is the max qps a single domain can query DB on a history host
+	HistoryPersistenceDomainMaxQPS
 	// HistoryVisibilityOpenMaxQPS is max qps one history host can write visibility open_executions
 	HistoryVisibilityOpenMaxQPS
 	// HistoryVisibilityClosedMaxQPS is max qps one history host can write visibility closed_executions
@@ -481,7 +522,8 @@ const (
 	ShardUpdateMinInterval
 	// ShardSyncMinInterval is the minimal time interval which the shard info should be sync to remote
 	ShardSyncMinInterval
-	// DefaultEventEncoding is the encoding type for history events
+	// DefaultEventEncoding is the encoding type for history events and mutable state blobs,
+	// thriftrw-snappy compresses them with snappy
 	DefaultEventEncoding
 	// NumArchiveSystemWorkflows is key for number of archive system workflows running in total
 	NumArchiveSystemWorkflows
@@ -501,6 +543,22 @@ const (
 	HistoryThrottledLogRPS
 	// StickyTTL is to expire a sticky tasklist if no update more than this duration
 	StickyTTL
+	// HistoryESProcessorNumOfWorkers is num of workers for esProcessor when history writes to ES directly
+	HistoryESProcessorNumOfWorkers
+	// HistoryESProcessorBulkActions is max number of requests in bulk for esProcessor when history writes to ES directly
+	HistoryESProcessorBulkActions
+	// HistoryESProcessorBulkSize is max total size of bulk in bytes for esProcessor when history writes to ES directly
+	HistoryESProcessorBulkSize
+	// HistoryESProcessorFlushInterval is flush interval for esProcessor when history writes to ES directly
+	HistoryESProcessorFlushInterval
+	// HistoryESProcessorAckTimeout is the max time to wait for ES to ack a visibility record written by history
+	HistoryESProcessorAckTimeout
+	// EnableActivityLocalDispatchByDomain is whether activities requesting local dispatch are started and returned
+	// in the decision completion response instead of being dispatched through matching
+	EnableActivityLocalDispatchByDomain
+	// EnableParentClosePolicy is whether the child policy of the pending children is applied when the
+	// parent workflow closes, children are abandoned when it is disabled
+	EnableParentClosePolicy
 
 	// key for worker
 
@@ -552,6 +610,8 @@ const (
 	ScannerPersistenceMaxQPS
 	// EnableBatcher decides whether start batcher in our worker
 	EnableBatcher
+	// EnableScheduler decides whether start scheduler in our worker
+	EnableScheduler
 
 	// lastKeyForTest must be the last one in this const group for testing purpose
 	lastKeyForTest
@@ -561,7 +621,7 @@ const (
 type Filter int
 
 func (f Filter) String() string {
-	if f <= unknownFilter || f > TaskType {
+	if f <= unknownFilter || f > PersistenceAPI {
 		return filters[unknownFilter]
 	}
 	return filters[f]
@@ -572,6 +632,7 @@ var filters = []string{
 	"domainName",
 	"taskListName",
 	"taskType",
+	"persistenceAPI",
 }
 
 const (
@@ -582,6 +643,8 @@ const (
 	TaskListName
 	// TaskType is the task type (0:Decision, 1:Activity)
 	TaskType
+	// PersistenceAPI is the name of the persistence API, e.g. UpdateWorkflowExecution
+	PersistenceAPI
 
 	// lastFilterTypeForTest must be the last one in this const group for testing purpose
 	lastFilterTypeForTest
@@ -610,3 +673,10 @@ func TaskTypeFilter(taskType int) FilterOption {
 		filterMap[TaskType] = taskType
 	}
 }
+
+// PersistenceAPIFilter filters by persistence API name
+func PersistenceAPIFilter(api string) FilterOption {
+	return func(filterMap map[Filter]interface{}) {
+		filterMap[PersistenceAPI] = api
+	}
+}
@@ -21,6 +21,7 @@
 package service
 
 import (
+	"fmt"
 	"math/rand"
 	"os"
 	"sync/atomic"
@@ -34,6 +35,8 @@ import (
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
 	es "github.com/uber/cadence/common/elasticsearch"
+	"github.com/uber/cadence/common/encryption"
+	"github.com/uber/cadence/common/healthcheck"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/membership"
@@ -65,6 +68,7 @@ type (
 		MembershipFactory   MembershipMonitorFactory
 		RPCFactory          common.RPCFactory
 		PProfInitializer    common.PProfInitializer
+		HealthCheckServer   healthcheck.Server
 		PersistenceConfig   config.Persistence
 		ClusterMetadata     cluster.Metadata
 		ReplicatorConfig    config.Replicator
@@ -78,6 +82,8 @@ type (
 		PublicClient        workflowserviceclient.Interface
 		ArchivalMetadata    archiver.ArchivalMetadata
 		ArchiverProvider    provider.ArchiverProvider
+		// PayloadEncryptor encrypts history events and heartbeat details at rest, it is nil if encryption is not enabled
+		PayloadEncryptor encryption.Encryptor
 	}
 
 	// MembershipMonitorFactory provides a bootstrapped membership monitor
@@ -97,6 +103,7 @@ type (
 		membershipMonitor     membership.Monitor
 		rpcFactory            common.RPCFactory
 		pprofInitializer      common.PProfInitializer
+		healthCheckServer     healthcheck.Server
 		clientBean            client.Bean
 		timeSource            clock.TimeSource
 		numberOfHistoryShards int
@@ -129,6 +136,7 @@ func New(params *BootstrapParams) Service {
 		rpcFactory:            params.RPCFactory,
 		membershipFactory:     params.MembershipFactory,
 		pprofInitializer:      params.PProfInitializer,
+		healthCheckServer:     params.HealthCheckServer,
 		timeSource:            clock.NewRealTimeSource(),
 		metricsScope:          params.MetricScope,
 		numberOfHistoryShards: params.PersistenceConfig.NumHistoryShards,
@@ -141,6 +149,11 @@ func New(params *BootstrapParams) Service {
 		archiverProvider:      params.ArchiverProvider,
 	}
 
+	if sVice.healthCheckServer == nil {
+		// health check endpoints are not served without a port
+		sVice.healthCheckServer = healthcheck.NewServer(0, params.Logger)
+	}
+
 	sVice.runtimeMetricsReporter = metrics.NewRuntimeMetricsReporter(params.MetricScope, time.Minute, sVice.GetLogger(), params.InstanceID)
 	sVice.dispatcher = sVice.rpcFactory.CreateDispatcher()
 	if sVice.dispatcher == nil {
@@ -182,6 +195,8 @@ func (h *serviceImpl) Start() {
 		h.logger.WithTags(tag.Error(err)).Fatal("Failed to start pprof")
 	}
 
+	h.healthCheckServer.Start()
+
 	if err := h.dispatcher.Start(); err != nil {
 		h.logger.WithTags(tag.Error(err)).Fatal("Failed to start yarpc dispatcher")
 	}
@@ -211,6 +226,8 @@ func (h *serviceImpl) Start() {
 		h.logger.WithTags(tag.Error(err)).Fatal("fail to initialize client bean")
 	}
 
+	h.healthCheckServer.AddReadinessCheck("membership", h.checkMembership)
+
 	// The service is now started up
 	h.logger.Info("service started")
 	// seed the random generator once for this service
@@ -223,6 +240,9 @@ func (h *serviceImpl) Stop() {
 		return
 	}
 
+	// fail the readiness check first so that load balancers stop routing to this host
+	h.healthCheckServer.SetServing(false)
+
 	if h.membershipMonitor != nil {
 		h.membershipMonitor.Stop()
 	}
@@ -232,6 +252,21 @@ func (h *serviceImpl) Stop() {
 	}
 
 	h.runtimeMetricsReporter.Stop()
+	h.healthCheckServer.Stop()
+}
+
+// checkMembership verifies that this host has joined the membership ring of its service
+func (h *serviceImpl) checkMembership() error {
+	resolver, err := h.membershipMonitor.GetResolver(h.sName)
+	if err != nil {
+		return err
+	}
+	for _, member := range resolver.Members() {
+		if member.Identity() == h.hostInfo.Identity() {
+			return nil
+		}
+	}
+	return fmt.Errorf("host %v has not joined the %v ring", h.hostInfo.Identity(), h.sName)
 }
 
 func (h *serviceImpl) GetLogger() log.Logger {
@@ -276,6 +311,11 @@ func (h *serviceImpl) GetMessagingClient() messaging.Client {
 	return h.messagingClient
 }
 
+// GetHealthCheckServer returns the server of the health check endpoints
+func (h *serviceImpl) GetHealthCheckServer() healthcheck.Server {
+	return h.healthCheckServer
+}
+
 func (h *serviceImpl) GetArchivalMetadata() archiver.ArchivalMetadata {
 	return h.archivalMetadata
 }
@@ -26,6 +26,7 @@ import (
 	"github.com/uber/cadence/common/archiver/provider"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/healthcheck"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/membership"
@@ -47,6 +48,7 @@ type (
 		membershipMonitor membership.Monitor
 		archivalMetadata  archiver.ArchivalMetadata
 		archiverProvider  provider.ArchiverProvider
+		healthCheckServer healthcheck.Server
 
 		metrics metrics.Client
 		logger  log.Logger
@@ -80,15 +82,16 @@ func NewTestService(
 	logger := loggerimpl.NewLogger(zapLogger)
 
 	return &serviceTestBase{
-		hostInfo:         testHostInfo,
-		clusterMetadata:  clusterMetadata,
-		messagingClient:  messagingClient,
-		metrics:          metrics,
-		clientBean:       clientBean,
-		timeSource:       clock.NewRealTimeSource(),
-		logger:           logger,
-		archivalMetadata: archivalMetadata,
-		archiverProvider: archiverProvider,
+		hostInfo:          testHostInfo,
+		clusterMetadata:   clusterMetadata,
+		messagingClient:   messagingClient,
+		metrics:           metrics,
+		clientBean:        clientBean,
+		timeSource:        clock.NewRealTimeSource(),
+		logger:            logger,
+		archivalMetadata:  archivalMetadata,
+		archiverProvider:  archiverProvider,
+		healthCheckServer: healthcheck.NewServer(0, logger),
 	}
 }
 
@@ -162,3 +165,8 @@ func (s *serviceTestBase) GetArchivalMetadata() archiver.ArchivalMetadata {
 func (s *serviceTestBase) GetArchiverProvider() provider.ArchiverProvider {
 	return s.archiverProvider
 }
+
+// GetHealthCheckServer returns the server of the health check endpoints
+func (s *serviceTestBase) GetHealthCheckServer() healthcheck.Server {
+	return s.healthCheckServer
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/uber/cadence/common/archiver/provider"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
+	"github.com/uber/cadence/common/healthcheck"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/membership"
 	"github.com/uber/cadence/common/messaging"
@@ -70,5 +71,8 @@ type (
 		GetArchivalMetadata() archiver.ArchivalMetadata
 
 		GetArchiverProvider() provider.ArchiverProvider
+
+		// GetHealthCheckServer returns the server of the liveness and readiness endpoints
+		GetHealthCheckServer() healthcheck.Server
 	}
 )
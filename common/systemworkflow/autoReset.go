@@ -0,0 +1,56 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package systemworkflow
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// BatcherTaskListName is the tasklist of the batch and auto reset workflows
+	BatcherTaskListName = "cadence-sys-batcher-tasklist"
+	// AutoResetWFTypeName is the workflow type of auto reset workflow
+	AutoResetWFTypeName = "cadence-sys-auto-reset-workflow"
+	// AutoResetWorkflowIDPrefix is the workflowID prefix of auto reset workflow
+	AutoResetWorkflowIDPrefix = "cadence-sys-auto-reset-"
+)
+
+// AutoResetParams is the parameters for resetting open workflows affected by a bad binary
+type AutoResetParams struct {
+	// Domain of the bad binary
+	DomainName string
+	// Checksum of the bad binary
+	BinaryChecksum string
+	// Reason for the reset
+	Reason string
+
+	// Below are all optional
+	// RPS of processing. Default to the RPS of batch operations
+	RPS int
+	// timeout for activity heartbeat
+	ActivityHeartBeatTimeout time.Duration
+}
+
+// GetAutoResetWorkflowID returns the workflowID of auto reset workflow for the bad binary
+func GetAutoResetWorkflowID(domainName, binaryChecksum string) string {
+	return fmt.Sprintf("%v%v-%v", AutoResetWorkflowIDPrefix, domainName, binaryChecksum)
+}
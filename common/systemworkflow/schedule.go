@@ -0,0 +1,84 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package systemworkflow
+
+import (
+	"fmt"
+	"time"
+
+	gen "github.com/uber/cadence/.gen/go/shared"
+)
+
+const (
+	// SchedulerTaskListName is the tasklist of the schedule workflows
+	SchedulerTaskListName = "cadence-sys-scheduler-tasklist"
+	// ScheduleWFTypeName is the workflow type of the schedule workflow
+	ScheduleWFTypeName = "cadence-sys-schedule-workflow"
+	// ScheduleWorkflowIDPrefix is the prefix of the workflow ID of every schedule workflow
+	ScheduleWorkflowIDPrefix = "cadence-sys-schedule-"
+)
+
+const (
+	// SignalNameUpdate is the signal sent to a schedule workflow to update the schedule
+	SignalNameUpdate = "update"
+	// SignalNamePause is the signal sent to a schedule workflow to pause or unpause the schedule
+	SignalNamePause = "pause"
+	// SignalNameBackfill is the signal sent to a schedule workflow to backfill the schedule
+	SignalNameBackfill = "backfill"
+	// SignalNameDelete is the signal sent to a schedule workflow to delete the schedule
+	SignalNameDelete = "delete"
+	// QueryTypeDescribe is the query type returning the DescribeScheduleResponse of a schedule
+	QueryTypeDescribe = "describe"
+)
+
+// ScheduleState is the input of the schedule workflow, it carries the whole state of a schedule across
+// continue as new. The frontend only fills the definition of the schedule when creating it.
+type ScheduleState struct {
+	DomainName  string
+	ScheduleID  string
+	Spec        *gen.ScheduleSpec
+	Action      *gen.ScheduleAction
+	Policies    *gen.SchedulePolicies
+	Paused      bool
+	PauseReason string
+
+	CreateTime        time.Time
+	UpdateTime        time.Time
+	NumActions        int64
+	NumSkippedActions int64
+	// LastFireTime is the nominal time the schedule was last evaluated at, fire times between
+	// LastFireTime and now which did not lead to an action are recorded as missed
+	LastFireTime time.Time
+	// LastRun is the last workflow started by the schedule
+	LastRun *gen.WorkflowExecution
+	// BufferedTime is the nominal time of the action buffered until LastRun closes
+	BufferedTime       *time.Time
+	RecentActions      []*gen.ScheduleActionResult
+	RecentSkippedTimes []time.Time
+	// PendingBackfills are the backfills not done yet, the start time of the first one
+	// is moved forward as its actions are taken
+	PendingBackfills []*gen.BackfillScheduleRequest
+}
+
+// GetScheduleWorkflowID returns the ID of the system workflow backing the given schedule
+func GetScheduleWorkflowID(domainName, scheduleID string) string {
+	return fmt.Sprintf("%v%v/%v", ScheduleWorkflowIDPrefix, domainName, scheduleID)
+}
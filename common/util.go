@@ -399,7 +399,12 @@ func CreateHistoryStartWorkflowRequest(
 		deadline := now.Add(time.Second * time.Duration(expirationInSeconds))
 		histRequest.ExpirationTimestamp = Int64Ptr(deadline.Round(time.Millisecond).UnixNano())
 	}
-	histRequest.FirstDecisionTaskBackoffSeconds = Int32Ptr(backoff.GetBackoffForNextScheduleInSeconds(startRequest.GetCronSchedule(), now, now))
+	// the first cron run is scheduled relative to the delayed start, if any
+	delayStartSeconds := startRequest.GetDelayStartSeconds()
+	delayedStartTime := now.Add(time.Second * time.Duration(delayStartSeconds))
+	cronBackoffSeconds := backoff.GetBackoffForNextScheduleInSeconds(
+		startRequest.GetCronSchedule(), delayedStartTime, delayedStartTime, startRequest.GetCronMaxJitterSeconds())
+	histRequest.FirstDecisionTaskBackoffSeconds = Int32Ptr(delayStartSeconds + cronBackoffSeconds)
 	return histRequest
 }
 
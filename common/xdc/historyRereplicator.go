@@ -477,6 +477,14 @@ func (c *historyRereplicationContext) deserializeBlob(blob *shared.DataBlob) ([]
 		if err != nil {
 			return nil, err
 		}
+	case shared.EncodingTypeThriftRWSnappy:
+		historyEvents, err = c.rereplicator.serializer.DeserializeBatchEvents(&persistence.DataBlob{
+			Encoding: common.EncodingTypeThriftRWSnappy,
+			Data:     blob.Data,
+		})
+		if err != nil {
+			return nil, err
+		}
 	default:
 		return nil, ErrUnknownEncodingType
 	}
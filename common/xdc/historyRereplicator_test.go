@@ -775,6 +775,25 @@ func (s *historyRereplicatorSuite) TestEventIDRange() {
 	s.Equal(endingEventID, nextEventID)
 }
 
+func (s *historyRereplicatorSuite) TestDeserializeBlob_Snappy() {
+	events := []*shared.HistoryEvent{
+		{
+			EventId:   common.Int64Ptr(1),
+			Version:   common.Int64Ptr(123),
+			EventType: shared.EventTypeWorkflowExecutionStarted.Ptr(),
+		},
+	}
+	blob, err := s.serializer.SerializeBatchEvents(events, common.EncodingTypeThriftRWSnappy)
+	s.Nil(err)
+
+	deserialized, err := s.getDummyRereplicationContext().deserializeBlob(&shared.DataBlob{
+		EncodingType: shared.EncodingTypeThriftRWSnappy.Ptr(),
+		Data:         blob.Data,
+	})
+	s.Nil(err)
+	s.Equal(events, deserialized)
+}
+
 func (s *historyRereplicatorSuite) TestCreateReplicationRawRequest() {
 	workflowID := "some random workflow ID"
 	runID := uuid.New()
@@ -29,6 +29,7 @@ SERVICES="${SERVICES:-history,matching,frontend,worker}"
 RF=${RF:-1}
 export LOG_LEVEL="${LOG_LEVEL:-info}"
 export NUM_HISTORY_SHARDS=${NUM_HISTORY_SHARDS:-4}
+export RINGPOP_BOOTSTRAP_MODE="${RINGPOP_BOOTSTRAP_MODE:-hosts}"
 
 # cassandra env
 export KEYSPACE="${KEYSPACE:-cadence}"
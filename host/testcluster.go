@@ -23,6 +23,7 @@ package host
 import (
 	"io/ioutil"
 	"os"
+	"time"
 
 	"github.com/uber-go/tally"
 	"github.com/uber/cadence/client"
@@ -74,6 +75,21 @@ type (
 		HistoryConfig         *HistoryConfig
 		ESConfig              elasticsearch.Config
 		WorkerConfig          *WorkerConfig
+		// PersistenceFaultInjection injects faults into the persistence managers of the cadence hosts
+		PersistenceFaultInjection *PersistenceFaultInjectionConfig
+	}
+
+	// PersistenceFaultInjectionConfig is the config for injecting faults into persistence calls
+	PersistenceFaultInjectionConfig struct {
+		ErrorRate float64
+		ErrorType string
+		Latency   time.Duration
+	}
+
+	faultInjectionExecutionMgrFactory struct {
+		factory persistence.ExecutionManagerFactory
+		config  *config.FaultInjectionConfig
+		logger  log.Logger
 	}
 
 	// MessagingClientConfig is the config for messaging config
@@ -167,6 +183,9 @@ func NewCluster(options *TestClusterConfig, logger log.Logger) (*TestCluster, er
 		HistoryConfig:       options.HistoryConfig,
 		WorkerConfig:        options.WorkerConfig,
 	}
+	if options.PersistenceFaultInjection != nil {
+		injectPersistenceFaults(cadenceParams, options.PersistenceFaultInjection.newFaultInjectionConfig())
+	}
 	cluster := NewCadence(cadenceParams)
 	if err := cluster.Start(); err != nil {
 		return nil, err
@@ -175,6 +194,50 @@ func NewCluster(options *TestClusterConfig, logger log.Logger) (*TestCluster, er
 	return &TestCluster{testBase: testBase, archiverBase: archiverBase, host: cluster}, nil
 }
 
+// injectPersistenceFaults wraps the persistence managers used by the cadence hosts with fault injection,
+// the managers used by the tests to verify the persisted state are not affected
+func injectPersistenceFaults(params *CadenceParams, cfg *config.FaultInjectionConfig) {
+	logger := params.Logger
+	params.PersistenceConfig.FaultInjectionConfig = cfg
+	params.MetadataMgr = persistence.NewMetadataPersistenceFaultInjectionClient(params.MetadataMgr, cfg, logger)
+	params.MetadataMgrV2 = persistence.NewMetadataPersistenceFaultInjectionClient(params.MetadataMgrV2, cfg, logger)
+	params.ShardMgr = persistence.NewShardPersistenceFaultInjectionClient(params.ShardMgr, cfg, logger)
+	params.HistoryMgr = persistence.NewHistoryPersistenceFaultInjectionClient(params.HistoryMgr, cfg, logger)
+	params.HistoryV2Mgr = persistence.NewHistoryV2PersistenceFaultInjectionClient(params.HistoryV2Mgr, cfg, logger)
+	params.TaskMgr = persistence.NewTaskPersistenceFaultInjectionClient(params.TaskMgr, cfg, logger)
+	params.VisibilityMgr = persistence.NewVisibilityPersistenceFaultInjectionClient(params.VisibilityMgr, cfg, logger)
+	params.ExecutionMgrFactory = &faultInjectionExecutionMgrFactory{
+		factory: params.ExecutionMgrFactory,
+		config:  cfg,
+		logger:  logger,
+	}
+}
+
+func (c *PersistenceFaultInjectionConfig) newFaultInjectionConfig() *config.FaultInjectionConfig {
+	errorType := c.ErrorType
+	if errorType == "" {
+		errorType = persistence.FaultInjectionErrorTypeTimeout
+	}
+	return &config.FaultInjectionConfig{
+		Enabled:   dynamicconfig.GetBoolPropertyFn(true),
+		ErrorRate: dynamicconfig.GetFloatPropertyFn(c.ErrorRate),
+		ErrorType: dynamicconfig.GetStringPropertyFn(errorType),
+		Latency:   dynamicconfig.GetDurationPropertyFn(c.Latency),
+	}
+}
+
+func (f *faultInjectionExecutionMgrFactory) NewExecutionManager(shardID int) (persistence.ExecutionManager, error) {
+	executionMgr, err := f.factory.NewExecutionManager(shardID)
+	if err != nil {
+		return nil, err
+	}
+	return persistence.NewWorkflowExecutionPersistenceFaultInjectionClient(executionMgr, f.config, f.logger), nil
+}
+
+func (f *faultInjectionExecutionMgrFactory) Close() {
+	f.factory.Close()
+}
+
 func setupShards(testBase persistencetests.TestBase, numHistoryShards int, logger log.Logger) {
 	// shard 0 is always created, we create additional shards if needed
 	for shardID := 1; shardID < numHistoryShards; shardID++ {
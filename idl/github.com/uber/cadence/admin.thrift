@@ -47,6 +47,78 @@ service AdminService {
       3: shared.AccessDeniedError     accessDeniedError,
     )
 
+  /**
+  * DescribeShardQueues returns the ack levels, read levels and outstanding task counts of the queue processors of a shard
+  **/
+  shared.DescribeShardQueuesResponse DescribeShardQueues(1: shared.DescribeShardQueuesRequest request)
+    throws (
+      1: shared.BadRequestError       badRequestError,
+      2: shared.InternalServiceError  internalServiceError,
+      3: shared.AccessDeniedError     accessDeniedError,
+    )
+
+  /**
+  * ListShardTasks returns the transfer, timer or replication tasks of a shard
+  **/
+  shared.ListShardTasksResponse ListShardTasks(1: shared.ListShardTasksRequest request)
+    throws (
+      1: shared.BadRequestError       badRequestError,
+      2: shared.InternalServiceError  internalServiceError,
+      3: shared.AccessDeniedError     accessDeniedError,
+    )
+
+  /**
+  * RemoveShardTask removes a transfer, timer or replication task of a shard and reloads the shard
+  **/
+  void RemoveShardTask(1: shared.RemoveShardTaskRequest request)
+    throws (
+      1: shared.BadRequestError       badRequestError,
+      2: shared.InternalServiceError  internalServiceError,
+      3: shared.AccessDeniedError     accessDeniedError,
+    )
+
+  /**
+  * CloseShard unloads a history shard from its current host, so that it is acquired by the host the membership ring assigns it to
+  **/
+  void CloseShard(1: shared.CloseShardRequest request)
+    throws (
+      1: shared.BadRequestError       badRequestError,
+      2: shared.InternalServiceError  internalServiceError,
+      3: shared.AccessDeniedError     accessDeniedError,
+    )
+
+  /**
+  * DescribeCluster returns the members of each service ring, and the host and status of each history shard
+  **/
+  DescribeClusterResponse DescribeCluster()
+    throws (
+      1: shared.InternalServiceError  internalServiceError,
+      2: shared.AccessDeniedError     accessDeniedError,
+    )
+
+  /**
+  * RefreshWorkflowTasks regenerates the transfer and timer tasks implied by the mutable state of a workflow execution
+  **/
+  void RefreshWorkflowTasks(1: RefreshWorkflowTasksRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.EntityNotExistsError    entityNotExistError,
+      4: shared.AccessDeniedError       accessDeniedError,
+    )
+
+  /**
+  * DeleteWorkflowExecution permanently removes the mutable state, history and visibility records of a workflow execution.
+  * It fails with 'BadRequestError' if the workflow execution is still running, it has to be terminated first.
+  **/
+  void DeleteWorkflowExecution(1: DeleteWorkflowExecutionRequest request)
+    throws (
+      1: shared.BadRequestError         badRequestError,
+      2: shared.InternalServiceError    internalServiceError,
+      3: shared.EntityNotExistsError    entityNotExistError,
+      4: shared.AccessDeniedError       accessDeniedError,
+    )
+
   /**
   * Returns the raw history of specified workflow execution.  It fails with 'EntityNotExistError' if speficied workflow
   * execution in unknown to the service.
@@ -82,6 +154,16 @@ struct DescribeWorkflowExecutionResponse {
   50: optional string mutableStateInDatabase
 }
 
+struct RefreshWorkflowTasksRequest {
+  10: optional string                       domain
+  20: optional shared.WorkflowExecution     execution
+}
+
+struct DeleteWorkflowExecutionRequest {
+  10: optional string                       domain
+  20: optional shared.WorkflowExecution     execution
+}
+
 struct GetWorkflowExecutionRawHistoryRequest {
   10: optional string domain
   20: optional shared.WorkflowExecution execution
@@ -101,3 +183,22 @@ struct GetWorkflowExecutionRawHistoryResponse {
 struct AddSearchAttributeRequest {
   10: optional map<string, shared.IndexedValueType> searchAttribute
 }
+
+struct RingInfo {
+  10: optional string role
+  20: optional list<string> members
+}
+
+// ringOwner is the host the membership ring assigns the shard to,
+// hostAddress is the host which currently has the shard loaded, if any
+struct HistoryShardInfo {
+  10: optional i32 shardID
+  20: optional string ringOwner
+  30: optional string hostAddress
+  40: optional string status
+}
+
+struct DescribeClusterResponse {
+  10: optional list<RingInfo> rings
+  20: optional list<HistoryShardInfo> historyShards
+}
@@ -347,6 +347,41 @@ service WorkflowService {
       7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
     )
 
+  /**
+  * PauseWorkflowExecution pauses an existing workflow execution. While paused, no decision or activity tasks
+  * are dispatched for the workflow execution, but signals are still accepted and timers still fire, and the
+  * resulting events are recorded in the history. The schedule to start timeouts of the held back activities
+  * do not fire while paused, the activities are scheduled again once the workflow execution is unpaused.
+  * This results in WorkflowExecutionPaused event recorded in the history. It fails with 'EntityNotExistsError'
+  * if the workflow is not valid anymore due to completion or doesn't exist.
+  **/
+  void PauseWorkflowExecution(1: shared.PauseWorkflowExecutionRequest pauseRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
+  /**
+  * UnpauseWorkflowExecution resumes a paused workflow execution. The decision and activity tasks which
+  * were held back while the workflow execution was paused are dispatched again.
+  * This results in WorkflowExecutionUnpaused event recorded in the history.
+  **/
+  void UnpauseWorkflowExecution(1: shared.UnpauseWorkflowExecutionRequest unpauseRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
   /**
   * RequestCancelWorkflowExecution is called by application worker when it wants to request cancellation of a workflow instance.
   * It will result in a new 'WorkflowExecutionCancelRequested' event being written to the workflow history and a new DecisionTask
@@ -368,6 +403,9 @@ service WorkflowService {
   /**
   * SignalWorkflowExecution is used to send a signal event to running workflow execution.  This results in
   * WorkflowExecutionSignaled event recorded in the history and a decision task being created for the execution.
+  * A signal sent with delaySeconds results in WorkflowExecutionDelayedSignalRequested event instead, and it is
+  * delivered as WorkflowExecutionSignaled event once the delay expires. Pending delayed signals are carried over
+  * to the new run when the workflow execution continues as new or is reset.
   **/
   void SignalWorkflowExecution(1: shared.SignalWorkflowExecutionRequest signalRequest)
     throws (
@@ -380,6 +418,41 @@ service WorkflowService {
       7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
     )
 
+  /**
+  * CancelDelayedSignal is used to cancel a signal which was sent with a delay and has not been delivered yet to
+  * the workflow execution.  The delayed signal is identified by the requestId of the original signal request.
+  * This results in WorkflowExecutionDelayedSignalCanceled event recorded in the history.
+  **/
+  void CancelDelayedSignal(1: shared.CancelDelayedSignalRequest cancelRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
+  /**
+  * UpdateWorkflowExecution is used to send an update request to running workflow execution and wait for its result.
+  * This results in WorkflowExecutionUpdateRequested event recorded in the history and a decision task being created
+  * for the execution. The call returns once the workflow responds with a CompleteWorkflowExecutionUpdate decision,
+  * which results in WorkflowExecutionUpdateCompleted event recorded in the history, or once the wait times out
+  * shortly before the deadline of the request. Requests with the same requestId are recorded only once, a retried
+  * request waits for the result of the update already recorded.
+  **/
+  shared.UpdateWorkflowExecutionResponse UpdateWorkflowExecution(1: shared.UpdateWorkflowExecutionRequest updateRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
   /**
   * SignalWithStartWorkflowExecution is used to ensure sending signal to a workflow.
   * If the workflow is running, this results in WorkflowExecutionSignaled event being recorded in the history
@@ -577,4 +650,89 @@ service WorkflowService {
       6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
     )
 
+
+  /**
+  * CreateSchedule creates a schedule which starts a workflow execution in the domain each time its spec fires.
+  * The schedule is run by a system workflow, it keeps running until the schedule is deleted.
+  **/
+  void CreateSchedule(1: shared.CreateScheduleRequest createRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
+  /**
+  * DescribeSchedule returns the configuration and the current state of a schedule.
+  **/
+  shared.DescribeScheduleResponse DescribeSchedule(1: shared.DescribeScheduleRequest describeRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.LimitExceededError limitExceededError,
+      5: shared.ServiceBusyError serviceBusyError,
+      6: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
+  /**
+  * UpdateSchedule replaces the spec, action and policies of a schedule.  Fields not set on the request are kept.
+  **/
+  void UpdateSchedule(1: shared.UpdateScheduleRequest updateRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
+  /**
+  * PauseSchedule pauses or unpauses a schedule.  A paused schedule takes no action until it is unpaused.
+  **/
+  void PauseSchedule(1: shared.PauseScheduleRequest pauseRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
+  /**
+  * BackfillSchedule takes the action of a schedule for every time its spec would have fired in the given time range.
+  **/
+  void BackfillSchedule(1: shared.BackfillScheduleRequest backfillRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
+  /**
+  * DeleteSchedule deletes a schedule.  Workflow executions already started by the schedule are not affected.
+  **/
+  void DeleteSchedule(1: shared.DeleteScheduleRequest deleteRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ClientVersionNotSupportedError clientVersionNotSupportedError,
+    )
+
 }
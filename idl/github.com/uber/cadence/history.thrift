@@ -61,6 +61,16 @@ struct DescribeMutableStateResponse{
   40: optional string mutableStateInDatabase
 }
 
+struct RefreshWorkflowTasksRequest{
+  10: optional string domainUUID
+  20: optional shared.WorkflowExecution execution
+}
+
+struct DeleteWorkflowExecutionRequest{
+  10: optional string domainUUID
+  20: optional shared.WorkflowExecution execution
+}
+
 struct GetMutableStateRequest {
   10: optional string domainUUID
   20: optional shared.WorkflowExecution execution
@@ -83,6 +93,8 @@ struct GetMutableStateResponse {
   120: optional i32 eventStoreVersion
   130: optional binary branchToken
   140: optional map<string, shared.ReplicationInfo> replicationInfo
+  // build ID used to route the decision tasks of the workflow, not set if no decision task has been completed yet
+  150: optional string buildId
 }
 
 struct ResetStickyTaskListRequest {
@@ -102,6 +114,7 @@ struct RespondDecisionTaskCompletedRequest {
 
 struct RespondDecisionTaskCompletedResponse {
   10: optional RecordDecisionTaskStartedResponse startedResponse
+  20: optional list<shared.PollForActivityTaskResponse> activitiesToDispatchLocally
 }
 
 struct RespondDecisionTaskFailedRequest {
@@ -180,6 +193,16 @@ struct SignalWorkflowExecutionRequest {
   40: optional bool childWorkflowOnly
 }
 
+struct CancelDelayedSignalRequest {
+  10: optional string domainUUID
+  20: optional shared.CancelDelayedSignalRequest cancelRequest
+}
+
+struct UpdateWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.UpdateWorkflowExecutionRequest updateRequest
+}
+
 struct SignalWithStartWorkflowExecutionRequest {
   10: optional string domainUUID
   20: optional shared.SignalWithStartWorkflowExecutionRequest signalWithStartRequest
@@ -201,6 +224,16 @@ struct ResetWorkflowExecutionRequest {
   20: optional shared.ResetWorkflowExecutionRequest resetRequest
 }
 
+struct PauseWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.PauseWorkflowExecutionRequest pauseRequest
+}
+
+struct UnpauseWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.UnpauseWorkflowExecutionRequest unpauseRequest
+}
+
 struct RequestCancelWorkflowExecutionRequest {
   10: optional string domainUUID
   20: optional shared.RequestCancelWorkflowExecutionRequest cancelRequest
@@ -493,6 +526,39 @@ service HistoryService {
       7: shared.LimitExceededError limitExceededError,
     )
 
+  /**
+  * CancelDelayedSignal is used to cancel a signal which was sent with a delay and has not been delivered yet to
+  * the workflow execution.
+  **/
+  void CancelDelayedSignal(1: CancelDelayedSignalRequest cancelRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.ServiceBusyError serviceBusyError,
+      7: shared.LimitExceededError limitExceededError,
+    )
+
+  /**
+  * UpdateWorkflowExecution is used to send an update request to running workflow execution and wait for its result.
+  * This results in WorkflowExecutionUpdateRequested event recorded in the history and a decision task being created
+  * for the execution. The call returns once WorkflowExecutionUpdateCompleted event is recorded in the history for
+  * the request, or once the wait times out shortly before the deadline of the request. Requests with the same
+  * requestId are recorded only once, a retried request waits for the result of the update already recorded.
+  **/
+  shared.UpdateWorkflowExecutionResponse UpdateWorkflowExecution(1: UpdateWorkflowExecutionRequest updateRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.ServiceBusyError serviceBusyError,
+      7: shared.LimitExceededError limitExceededError,
+    )
+
   /**
   * SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.
   * If workflow is running, this results in WorkflowExecutionSignaled event recorded in the history
@@ -558,6 +624,36 @@ service HistoryService {
       7: shared.ServiceBusyError serviceBusyError,
     )
 
+  /**
+  * PauseWorkflowExecution pauses an existing workflow execution. While paused, no decision or activity tasks
+  * are dispatched for the workflow execution, but signals are still accepted and timers, including activity
+  * timeouts, still fire. Sticky decision schedule to start timeouts are skipped while paused.
+  **/
+  void PauseWorkflowExecution(1: PauseWorkflowExecutionRequest pauseRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * UnpauseWorkflowExecution resumes a paused workflow execution and dispatches the held back decision and activity tasks.
+  **/
+  void UnpauseWorkflowExecution(1: UnpauseWorkflowExecutionRequest unpauseRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.DomainNotActiveError domainNotActiveError,
+      6: shared.LimitExceededError limitExceededError,
+      7: shared.ServiceBusyError serviceBusyError,
+    )
+
   /**
   * RequestCancelWorkflowExecution is called by application worker when it wants to request cancellation of a workflow instance.
   * It will result in a new 'WorkflowExecutionCancelRequested' event being written to the workflow history and a new DecisionTask
@@ -681,6 +777,33 @@ service HistoryService {
       6: shared.LimitExceededError limitExceededError,
     )
 
+  /**
+  * RefreshWorkflowTasks regenerates the transfer and timer tasks implied by the mutable state of a workflow execution
+  **/
+  void RefreshWorkflowTasks(1: RefreshWorkflowTasksRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.AccessDeniedError accessDeniedError,
+      5: ShardOwnershipLostError shardOwnershipLostError,
+      6: shared.LimitExceededError limitExceededError,
+    )
+
+  /**
+  * DeleteWorkflowExecution permanently removes the mutable state, history and visibility records of a workflow execution.
+  * It fails with 'BadRequestError' if the workflow execution is still running, it has to be terminated first.
+  **/
+  void DeleteWorkflowExecution(1: DeleteWorkflowExecutionRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.AccessDeniedError accessDeniedError,
+      5: ShardOwnershipLostError shardOwnershipLostError,
+      6: shared.LimitExceededError limitExceededError,
+    )
+
   /**
   * DescribeHistoryHost returns information about the internal states of a history host
   **/
@@ -690,4 +813,48 @@ service HistoryService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.AccessDeniedError accessDeniedError,
     )
+
+  /**
+  * DescribeShardQueues returns the ack levels, read levels and outstanding task counts of the queue processors of a shard
+  **/
+  shared.DescribeShardQueuesResponse DescribeShardQueues(1: shared.DescribeShardQueuesRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.AccessDeniedError accessDeniedError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * ListShardTasks returns the transfer, timer or replication tasks of a shard
+  **/
+  shared.ListShardTasksResponse ListShardTasks(1: shared.ListShardTasksRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.AccessDeniedError accessDeniedError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * RemoveShardTask removes a transfer, timer or replication task of a shard and reloads the shard
+  **/
+  void RemoveShardTask(1: shared.RemoveShardTaskRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.AccessDeniedError accessDeniedError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * CloseShard stops the queue processors of a shard, persists its shard info and unloads it from this host
+  **/
+  void CloseShard(1: shared.CloseShardRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.AccessDeniedError accessDeniedError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+    )
 }
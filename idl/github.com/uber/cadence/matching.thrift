@@ -62,6 +62,9 @@ struct AddDecisionTaskRequest {
   40: optional i64 (js.type = "Long") scheduleId
   50: optional i32 scheduleToStartTimeoutSeconds
   60: optional string forwardedFrom
+  70: optional i32 priority
+  // build ID of the workflow, not set if no decision task has been completed yet
+  80: optional string buildId
 }
 
 struct AddActivityTaskRequest {
@@ -72,6 +75,7 @@ struct AddActivityTaskRequest {
   50: optional i64 (js.type = "Long") scheduleId
   60: optional i32 scheduleToStartTimeoutSeconds
   70: optional string forwardedFrom
+  80: optional i32 priority
 }
 
 struct QueryWorkflowRequest {
@@ -79,6 +83,7 @@ struct QueryWorkflowRequest {
   20: optional shared.TaskList taskList
   30: optional shared.QueryWorkflowRequest queryRequest
   40: optional string forwardedFrom
+  50: optional string buildId
 }
 
 struct RespondQueryTaskCompletedRequest {
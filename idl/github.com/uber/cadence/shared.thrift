@@ -99,6 +99,12 @@ enum WorkflowIdReusePolicy {
    * do not allow start a workflow execution using the same workflow ID at all
    */
   RejectDuplicate,
+  /*
+   * if a workflow execution with the same workflow ID is running, terminate it
+   * and start the new workflow execution in the same transaction, otherwise
+   * behave like AllowDuplicate
+   */
+  TerminateIfRunning,
 }
 
 enum DomainStatus {
@@ -132,6 +138,7 @@ enum DecisionType {
   StartChildWorkflowExecution,
   SignalExternalWorkflowExecution,
   UpsertWorkflowSearchAttributes,
+  CompleteWorkflowExecutionUpdate,
 }
 
 enum EventType {
@@ -177,6 +184,12 @@ enum EventType {
   SignalExternalWorkflowExecutionFailed,
   ExternalWorkflowExecutionSignaled,
   UpsertWorkflowSearchAttributes,
+  WorkflowExecutionUpdateRequested,
+  WorkflowExecutionUpdateCompleted,
+  WorkflowExecutionPaused,
+  WorkflowExecutionUnpaused,
+  WorkflowExecutionDelayedSignalRequested,
+  WorkflowExecutionDelayedSignalCanceled,
 }
 
 enum DecisionTaskFailedCause {
@@ -203,6 +216,7 @@ enum DecisionTaskFailedCause {
   BAD_BINARY,
   SCHEDULE_ACTIVITY_DUPLICATE_ID,
   BAD_SEARCH_ATTRIBUTES,
+  BAD_COMPLETE_WORKFLOW_EXECUTION_UPDATE_ATTRIBUTES,
 }
 
 enum CancelExternalWorkflowExecutionFailedCause {
@@ -267,6 +281,28 @@ enum IndexedValueType {
   DATETIME,
 }
 
+// ResetReapplyPolicy controls which events after the reset point are reapplied to the new run
+enum ResetReapplyPolicy {
+  // reapply signals only, default if not specified
+  SIGNALS,
+  // reapply nothing
+  NONE,
+  // reapply all events which are not generated by decisions, i.e. signals and cancellation requests
+  ALL_EXTERNAL_EVENTS,
+}
+
+// ScheduleOverlapPolicy controls what happens when a schedule fires while the workflow it started last time is still running
+enum ScheduleOverlapPolicy {
+  // skip the new run, default if not specified
+  SKIP_NEW,
+  // buffer one run and start it when the running one closes, further runs are skipped
+  BUFFER_ONE,
+  // request cancellation of the running workflow and start the new run
+  CANCEL_OTHER,
+  // start the new run regardless of the running one
+  ALLOW_ALL,
+}
+
 struct Header {
     10: optional map<string, binary> fields
 }
@@ -286,6 +322,8 @@ struct TaskList {
 
 enum EncodingType {
   ThriftRW,
+  // thriftrw compressed with snappy
+  ThriftRWSnappy,
 }
 
 struct DataBlob {
@@ -328,6 +366,7 @@ struct WorkflowExecutionInfo {
   100: optional Memo memo
   101: optional SearchAttributes searchAttributes
   110: optional ResetPoints autoResetPoints
+  120: optional bool paused
 }
 
 struct WorkflowExecutionConfiguration {
@@ -354,6 +393,9 @@ struct ScheduleActivityTaskDecisionAttributes {
   60: optional i32 heartbeatTimeoutSeconds
   70: optional RetryPolicy retryPolicy
   80: optional Header header
+  90: optional bool requestLocalDispatch
+  // priority of the activity task, see StartWorkflowExecutionRequest.taskPriority
+  100: optional i32 taskPriority
 }
 
 struct RequestCancelActivityTaskDecisionAttributes {
@@ -403,6 +445,11 @@ struct UpsertWorkflowSearchAttributesDecisionAttributes {
   10: optional SearchAttributes searchAttributes
 }
 
+struct CompleteWorkflowExecutionUpdateDecisionAttributes {
+  10: optional string requestId
+  20: optional binary result
+}
+
 struct RecordMarkerDecisionAttributes {
   10: optional string markerName
   20: optional binary details
@@ -425,6 +472,7 @@ struct ContinueAsNewWorkflowExecutionDecisionAttributes {
   130: optional Header header
   140: optional Memo memo
   150: optional SearchAttributes searchAttributes
+  160: optional i32 cronMaxJitterSeconds
 }
 
 struct StartChildWorkflowExecutionDecisionAttributes {
@@ -443,6 +491,9 @@ struct StartChildWorkflowExecutionDecisionAttributes {
   130: optional Header header
   140: optional Memo memo
   150: optional SearchAttributes searchAttributes
+  160: optional i32 cronMaxJitterSeconds
+  // priority of the decision tasks of the child, see StartWorkflowExecutionRequest.taskPriority
+  170: optional i32 taskPriority
 }
 
 struct Decision {
@@ -460,6 +511,7 @@ struct Decision {
   100: optional StartChildWorkflowExecutionDecisionAttributes startChildWorkflowExecutionDecisionAttributes
   110: optional SignalExternalWorkflowExecutionDecisionAttributes signalExternalWorkflowExecutionDecisionAttributes
   120: optional UpsertWorkflowSearchAttributesDecisionAttributes upsertWorkflowSearchAttributesDecisionAttributes
+  130: optional CompleteWorkflowExecutionUpdateDecisionAttributes completeWorkflowExecutionUpdateDecisionAttributes
 }
 
 struct WorkflowExecutionStartedEventAttributes {
@@ -489,6 +541,8 @@ struct WorkflowExecutionStartedEventAttributes {
   121: optional SearchAttributes searchAttributes
   130: optional ResetPoints prevAutoResetPoints
   140: optional Header header
+  150: optional i32 cronMaxJitterSeconds
+  160: optional i32 taskPriority
 }
 
 struct ResetPoints{
@@ -553,6 +607,7 @@ struct DecisionTaskStartedEventAttributes {
   10: optional i64 (js.type = "Long") scheduledEventId
   20: optional string identity
   30: optional string requestId
+  40: optional string buildId
 }
 
 struct DecisionTaskCompletedEventAttributes {
@@ -595,6 +650,7 @@ struct ActivityTaskScheduledEventAttributes {
   90: optional i64 (js.type = "Long") decisionTaskCompletedEventId
   110: optional RetryPolicy retryPolicy
   120: optional Header header
+  130: optional i32 taskPriority
 }
 
 struct ActivityTaskStartedEventAttributes {
@@ -693,6 +749,44 @@ struct WorkflowExecutionSignaledEventAttributes {
   10: optional string signalName
   20: optional binary input
   30: optional string identity
+  40: optional string delayedSignalRequestId
+}
+
+struct WorkflowExecutionDelayedSignalRequestedEventAttributes {
+  10: optional string requestId
+  20: optional string signalName
+  30: optional binary input
+  40: optional binary control
+  50: optional string identity
+  60: optional i64 (js.type = "Long") fireTimestamp
+}
+
+struct WorkflowExecutionDelayedSignalCanceledEventAttributes {
+  10: optional string requestId
+  20: optional string identity
+}
+
+struct WorkflowExecutionUpdateRequestedEventAttributes {
+  10: optional string updateName
+  20: optional binary input
+  30: optional string identity
+  40: optional string requestId
+}
+
+struct WorkflowExecutionUpdateCompletedEventAttributes {
+  10: optional string requestId
+  20: optional binary result
+  30: optional i64 (js.type = "Long") decisionTaskCompletedEventId
+}
+
+struct WorkflowExecutionPausedEventAttributes {
+  10: optional string reason
+  20: optional string identity
+}
+
+struct WorkflowExecutionUnpausedEventAttributes {
+  10: optional string reason
+  20: optional string identity
 }
 
 struct WorkflowExecutionTerminatedEventAttributes {
@@ -772,6 +866,8 @@ struct StartChildWorkflowExecutionInitiatedEventAttributes {
   140: optional Header header
   150: optional Memo memo
   160: optional SearchAttributes searchAttributes
+  170: optional i32 cronMaxJitterSeconds
+  180: optional i32 taskPriority
 }
 
 struct StartChildWorkflowExecutionFailedEventAttributes {
@@ -885,6 +981,12 @@ struct HistoryEvent {
   430: optional SignalExternalWorkflowExecutionFailedEventAttributes signalExternalWorkflowExecutionFailedEventAttributes
   440: optional ExternalWorkflowExecutionSignaledEventAttributes externalWorkflowExecutionSignaledEventAttributes
   450: optional UpsertWorkflowSearchAttributesEventAttributes upsertWorkflowSearchAttributesEventAttributes
+  460: optional WorkflowExecutionUpdateRequestedEventAttributes workflowExecutionUpdateRequestedEventAttributes
+  470: optional WorkflowExecutionUpdateCompletedEventAttributes workflowExecutionUpdateCompletedEventAttributes
+  480: optional WorkflowExecutionPausedEventAttributes workflowExecutionPausedEventAttributes
+  490: optional WorkflowExecutionUnpausedEventAttributes workflowExecutionUnpausedEventAttributes
+  500: optional WorkflowExecutionDelayedSignalRequestedEventAttributes workflowExecutionDelayedSignalRequestedEventAttributes
+  510: optional WorkflowExecutionDelayedSignalCanceledEventAttributes workflowExecutionDelayedSignalCanceledEventAttributes
 }
 
 struct History {
@@ -922,6 +1024,7 @@ struct DomainConfiguration {
   90: optional string historyArchivalURI
   100: optional ArchivalStatus visibilityArchivalStatus
   110: optional string visibilityArchivalURI
+  120: optional WorkerVersioning workerVersioning
 }
 
 struct BadBinaries{
@@ -932,6 +1035,26 @@ struct BadBinaryInfo{
   10: optional string reason
   20: optional string operator
   30: optional i64 (js.type = "Long") createdTimeNano
+  // the system workflow resetting the open workflows affected by this bad binary, set by server
+  40: optional WorkflowExecution autoResetExecution
+}
+
+// Decision tasks of a workflow are only dispatched to pollers whose build is in the
+// same compatible set as the build that first processed the workflow
+struct CompatibleBuildSet {
+  10: optional list<string> buildIds
+}
+
+struct BuildIdCompatibility {
+  // the last set is the default one, workflows not yet processed by any build
+  // are dispatched to pollers of a build in that set
+  10: optional list<CompatibleBuildSet> compatibleBuildSets
+}
+
+struct WorkerVersioning {
+  // applies to all decision task lists of the domain without an entry in taskLists
+  10: optional BuildIdCompatibility defaultCompatibility
+  20: optional map<string, BuildIdCompatibility> taskLists
 }
 
 struct UpdateDomainInfo {
@@ -1030,6 +1153,10 @@ struct StartWorkflowExecutionRequest {
   140: optional Memo memo
   141: optional SearchAttributes searchAttributes
   150: optional Header header
+  160: optional i32 delayStartSeconds
+  170: optional i32 cronMaxJitterSeconds
+  // higher values are dispatched first, tasks with a positive priority are persisted in a separate backlog that is read independently of the default one
+  180: optional i32 taskPriority
 }
 
 struct StartWorkflowExecutionResponse {
@@ -1041,6 +1168,7 @@ struct PollForDecisionTaskRequest {
   20: optional TaskList taskList
   30: optional string identity
   40: optional string binaryChecksum
+  50: optional string buildId
 }
 
 struct PollForDecisionTaskResponse {
@@ -1077,6 +1205,7 @@ struct RespondDecisionTaskCompletedRequest {
 
 struct RespondDecisionTaskCompletedResponse {
   10: optional PollForDecisionTaskResponse decisionTask
+  20: optional list<PollForActivityTaskResponse> activitiesToDispatchLocally
 }
 
 struct RespondDecisionTaskFailedRequest {
@@ -1208,6 +1337,27 @@ struct SignalWorkflowExecutionRequest {
   50: optional string identity
   60: optional string requestId
   70: optional binary control
+  80: optional i32 delaySeconds
+}
+
+struct CancelDelayedSignalRequest {
+  10: optional string domain
+  20: optional WorkflowExecution workflowExecution
+  30: optional string requestId
+  40: optional string identity
+}
+
+struct UpdateWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional WorkflowExecution workflowExecution
+  30: optional string updateName
+  40: optional binary input
+  50: optional string identity
+  60: optional string requestId
+}
+
+struct UpdateWorkflowExecutionResponse {
+  10: optional binary result
 }
 
 struct SignalWithStartWorkflowExecutionRequest {
@@ -1229,6 +1379,9 @@ struct SignalWithStartWorkflowExecutionRequest {
   160: optional Memo memo
   161: optional SearchAttributes searchAttributes
   170: optional Header header
+  180: optional i32 delayStartSeconds
+  190: optional i32 cronMaxJitterSeconds
+  200: optional i32 taskPriority
 }
 
 struct TerminateWorkflowExecutionRequest {
@@ -1239,12 +1392,27 @@ struct TerminateWorkflowExecutionRequest {
   50: optional string identity
 }
 
+struct PauseWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional WorkflowExecution workflowExecution
+  30: optional string reason
+  40: optional string identity
+}
+
+struct UnpauseWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional WorkflowExecution workflowExecution
+  30: optional string reason
+  40: optional string identity
+}
+
 struct ResetWorkflowExecutionRequest {
   10: optional string domain
   20: optional WorkflowExecution workflowExecution
   30: optional string reason
   40: optional i64 (js.type = "Long") decisionFinishEventId
   50: optional string requestId
+  60: optional ResetReapplyPolicy reapplyPolicy
 }
 
 struct ResetWorkflowExecutionResponse {
@@ -1364,11 +1532,19 @@ struct PendingChildExecutionInfo {
   40: optional i64 (js.type = "Long") initiatedID
 }
 
+struct PendingDelayedSignalInfo {
+  10: optional string requestId
+  20: optional string signalName
+  30: optional string identity
+  40: optional i64 (js.type = "Long") fireTimestamp
+}
+
 struct DescribeWorkflowExecutionResponse {
   10: optional WorkflowExecutionConfiguration executionConfiguration
   20: optional WorkflowExecutionInfo workflowExecutionInfo
   30: optional list<PendingActivityInfo> pendingActivities
   40: optional list<PendingChildExecutionInfo> pendingChildren
+  50: optional list<PendingDelayedSignalInfo> pendingDelayedSignals
 }
 
 struct DescribeTaskListRequest {
@@ -1409,6 +1585,12 @@ struct DescribeHistoryHostResponse{
   30: optional DomainCacheInfo      domainCache
   40: optional string               shardControllerStatus
   50: optional string               address
+  60: optional list<ShardStatusInfo> shardStatuses
+}
+
+struct ShardStatusInfo {
+  10: optional i32 shardID
+  20: optional string status
 }
 
 struct DomainCacheInfo{
@@ -1416,6 +1598,73 @@ struct DomainCacheInfo{
   20: optional i64 numOfItemsInCacheByName
 }
 
+enum ShardQueueType {
+  Transfer,
+  Timer,
+  Replication,
+}
+
+struct DescribeShardQueuesRequest {
+  10: optional i32 shardID
+}
+
+// ackLevel and readLevel are task IDs for transfer and replication queues,
+// and task visibility timestamps in unix nanoseconds for timer queues
+struct ShardQueueProcessorInfo {
+  10: optional ShardQueueType queueType
+  20: optional string clusterName
+  30: optional i64 (js.type = "Long") ackLevel
+  40: optional i64 (js.type = "Long") readLevel
+  50: optional i32 outstandingTaskCount
+}
+
+struct DescribeShardQueuesResponse {
+  10: optional i32 shardID
+  20: optional string hostAddress
+  30: optional list<ShardQueueProcessorInfo> queueProcessors
+}
+
+// minLevel (exclusive) and maxLevel (inclusive) are task IDs for transfer and replication queues,
+// minLevel (inclusive) and maxLevel (exclusive) are visibility timestamps in unix nanoseconds for timer queues
+struct ListShardTasksRequest {
+  10: optional i32 shardID
+  20: optional ShardQueueType queueType
+  30: optional i64 (js.type = "Long") minLevel
+  40: optional i64 (js.type = "Long") maxLevel
+  50: optional i32 pageSize
+  60: optional binary nextPageToken
+}
+
+struct ShardTaskInfo {
+  10: optional i64 (js.type = "Long") taskId
+  20: optional i32 taskType
+  30: optional string domainId
+  40: optional string workflowId
+  50: optional string runId
+  60: optional i64 (js.type = "Long") visibilityTimestamp
+  70: optional i64 (js.type = "Long") version
+}
+
+struct ListShardTasksResponse {
+  10: optional list<ShardTaskInfo> tasks
+  20: optional binary nextPageToken
+}
+
+// visibilityTimestamp in unix nanoseconds is required to remove a timer task
+struct RemoveShardTaskRequest {
+  10: optional i32 shardID
+  20: optional ShardQueueType queueType
+  30: optional i64 (js.type = "Long") taskId
+  40: optional i64 (js.type = "Long") visibilityTimestamp
+}
+
+// the shard is closed on the host it is assigned to by the membership ring,
+// unless hostAddress is set
+struct CloseShardRequest {
+  10: optional i32 shardID
+  20: optional string hostAddress
+}
+
 enum TaskListType {
   /*
    * Decision type of tasklist
@@ -1432,6 +1681,7 @@ struct PollerInfo {
   10: optional i64 (js.type = "Long")  lastAccessTime
   20: optional string identity
   30: optional double ratePerSecond
+  40: optional string buildId
 }
 
 struct RetryPolicy {
@@ -1458,6 +1708,122 @@ struct RetryPolicy {
   60: optional i32 expirationIntervalInSeconds
 }
 
+// ScheduleSpec describes when a schedule takes its action
+struct ScheduleSpec {
+  // prefix the expression with CRON_TZ=<time zone> to evaluate it in that time zone instead of UTC
+  10: optional string cronExpression
+  // max random delay added to each action, the nominal time of the action is not affected
+  20: optional i32 maxJitterSeconds
+}
+
+// StartWorkflowAction describes the workflow started by a schedule each time it fires
+struct StartWorkflowAction {
+  // the workflowId of each run is the prefix followed by the scheduled time of the run
+  10: optional string workflowIdPrefix
+  20: optional WorkflowType workflowType
+  30: optional TaskList taskList
+  40: optional binary input
+  50: optional i32 executionStartToCloseTimeoutSeconds
+  60: optional i32 taskStartToCloseTimeoutSeconds
+  70: optional RetryPolicy retryPolicy
+  80: optional Memo memo
+  90: optional SearchAttributes searchAttributes
+  100: optional Header header
+}
+
+struct ScheduleAction {
+  10: optional StartWorkflowAction startWorkflow
+}
+
+struct SchedulePolicies {
+  10: optional ScheduleOverlapPolicy overlapPolicy
+}
+
+struct ScheduleState {
+  10: optional bool paused
+  20: optional string pauseReason
+}
+
+struct ScheduleActionResult {
+  // Unix Nano
+  10: optional i64 (js.type = "Long") scheduledTime
+  // Unix Nano
+  20: optional i64 (js.type = "Long") actualTime
+  30: optional WorkflowExecution startedWorkflow
+}
+
+struct ScheduleInfo {
+  10: optional i64 (js.type = "Long") numActions
+  20: optional i64 (js.type = "Long") numSkippedActions
+  30: optional list<ScheduleActionResult> recentActions
+  // Unix Nano
+  40: optional list<i64> nextActionTimes
+  50: optional i64 (js.type = "Long") createTime
+  60: optional i64 (js.type = "Long") updateTime
+  70: optional bool bufferedAction
+  // Unix Nano, nominal times of the most recent actions which were skipped or missed
+  80: optional list<i64> recentSkippedTimes
+}
+
+struct CreateScheduleRequest {
+  10: optional string domain
+  20: optional string scheduleId
+  30: optional ScheduleSpec spec
+  40: optional ScheduleAction action
+  50: optional SchedulePolicies policies
+  60: optional bool paused
+  70: optional string identity
+  80: optional string requestId
+}
+
+struct DescribeScheduleRequest {
+  10: optional string domain
+  20: optional string scheduleId
+}
+
+struct DescribeScheduleResponse {
+  10: optional ScheduleSpec spec
+  20: optional ScheduleAction action
+  30: optional SchedulePolicies policies
+  40: optional ScheduleState state
+  50: optional ScheduleInfo info
+}
+
+struct UpdateScheduleRequest {
+  10: optional string domain
+  20: optional string scheduleId
+  30: optional ScheduleSpec spec
+  40: optional ScheduleAction action
+  50: optional SchedulePolicies policies
+  60: optional string identity
+}
+
+struct PauseScheduleRequest {
+  10: optional string domain
+  20: optional string scheduleId
+  // false to unpause the schedule
+  30: optional bool pause
+  40: optional string reason
+  50: optional string identity
+}
+
+struct BackfillScheduleRequest {
+  10: optional string domain
+  20: optional string scheduleId
+  // Unix Nano, exclusive
+  30: optional i64 (js.type = "Long") startTime
+  // Unix Nano, inclusive
+  40: optional i64 (js.type = "Long") endTime
+  50: optional ScheduleOverlapPolicy overlapPolicy
+  60: optional string identity
+}
+
+struct DeleteScheduleRequest {
+  10: optional string domain
+  20: optional string scheduleId
+  30: optional string identity
+}
+
 // HistoryBranchRange represents a piece of range for a branch.
 struct HistoryBranchRange{
   // branchID of original branch forked from
@@ -56,6 +56,8 @@ struct DomainInfo {
   44: optional string historyArchivalURI
   46: optional i16 visibilityArchivalStatus
   48: optional string visibilityArchivalURI
+  50: optional binary workerVersioning
+  52: optional string workerVersioningEncoding
 }
 
 struct HistoryTreeInfo {
@@ -127,6 +129,10 @@ struct WorkflowExecutionInfo {
   116: optional string autoResetPointsEncoding
   118: optional map<string, binary> searchAttributes
   120: optional map<string, binary> memo
+  122: optional bool paused
+  124: optional i32 cronMaxJitterSeconds
+  126: optional i32 taskPriority
+  128: optional string buildId
 }
 
 struct ActivityInfo {
@@ -160,6 +166,7 @@ struct ActivityInfo {
   64: optional list<string> retryNonRetryableErrors
   66: optional string retryLastFailureReason
   68: optional string retryLastWorkerIdentity
+  70: optional i32 taskPriority
 }
 
 struct ChildExecutionInfo {
@@ -185,6 +192,15 @@ struct SignalInfo {
   18: optional binary control
 }
 
+struct DelayedSignalInfo {
+  10: optional i64 (js.type = "Long") version
+  12: optional string name
+  14: optional binary input
+  16: optional binary control
+  18: optional string identity
+  20: optional i64 (js.type = "Long") fireTimeNanos
+}
+
 struct RequestCancelInfo {
   10: optional i64 (js.type = "Long") version
   12: optional string cancelRequestID
@@ -203,6 +219,7 @@ struct TaskInfo {
   13: optional i64 (js.type = "Long") scheduleID
   14: optional i64 (js.type = "Long") expiryTimeNanos
   15: optional i64 (js.type = "Long") createdTimeNanos
+  16: optional i32 priority
 }
 
 struct TaskListInfo {
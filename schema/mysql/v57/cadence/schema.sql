@@ -222,6 +222,29 @@ CREATE TABLE signals_requested_sets (
   PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, signal_id)
 );
 
+CREATE TABLE delayed_signal_info_maps (
+  shard_id INT NOT NULL,
+  domain_id BINARY(16) NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id BINARY(16) NOT NULL,
+  request_id VARCHAR(255) NOT NULL,
+--
+  data BLOB NOT NULL,
+  data_encoding VARCHAR(16),
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, request_id)
+);
+
+CREATE TABLE updates_requested_sets (
+  shard_id INT NOT NULL,
+  domain_id BINARY(16) NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id BINARY(16) NOT NULL,
+  request_id VARCHAR(255) NOT NULL,
+  --
+  event_id BIGINT NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, request_id)
+);
+
 -- history eventsV2: history_node stores history event data
 CREATE TABLE history_node (
   shard_id       INT NOT NULL,
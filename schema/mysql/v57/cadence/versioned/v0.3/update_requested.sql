@@ -0,0 +1,10 @@
+CREATE TABLE updates_requested_sets (
+  shard_id INT NOT NULL,
+  domain_id BINARY(16) NOT NULL,
+  workflow_id VARCHAR(255) NOT NULL,
+  run_id BINARY(16) NOT NULL,
+  request_id VARCHAR(255) NOT NULL,
+  --
+  event_id BIGINT NOT NULL,
+  PRIMARY KEY (shard_id, domain_id, workflow_id, run_id, request_id)
+);
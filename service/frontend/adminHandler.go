@@ -24,6 +24,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/olivere/elastic"
+	"sort"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -39,6 +40,7 @@ import (
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/codec"
 	"github.com/uber/cadence/common/definition"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
@@ -228,6 +230,237 @@ func (adh *AdminHandler) DescribeHistoryHost(ctx context.Context, request *gen.D
 	return resp, err
 }
 
+// DescribeShardQueues returns the ack levels, read levels and outstanding task counts of the queue processors of a shard
+func (adh *AdminHandler) DescribeShardQueues(ctx context.Context, request *gen.DescribeShardQueuesRequest) (resp *gen.DescribeShardQueuesResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminDescribeShardQueuesScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateShardID(request.ShardID); err != nil {
+		return nil, adh.error(err, scope)
+	}
+
+	resp, err := adh.history.DescribeShardQueues(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// ListShardTasks returns the transfer, timer or replication tasks of a shard
+func (adh *AdminHandler) ListShardTasks(ctx context.Context, request *gen.ListShardTasksRequest) (resp *gen.ListShardTasksResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminListShardTasksScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return nil, adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateShardID(request.ShardID); err != nil {
+		return nil, adh.error(err, scope)
+	}
+	if request.QueueType == nil {
+		return nil, adh.error(errQueueTypeNotSet, scope)
+	}
+
+	resp, err := adh.history.ListShardTasks(ctx, request)
+	if err != nil {
+		return nil, adh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// RemoveShardTask removes a transfer, timer or replication task of a shard and reloads the shard
+func (adh *AdminHandler) RemoveShardTask(ctx context.Context, request *gen.RemoveShardTaskRequest) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminRemoveShardTaskScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateShardID(request.ShardID); err != nil {
+		return adh.error(err, scope)
+	}
+	if request.QueueType == nil {
+		return adh.error(errQueueTypeNotSet, scope)
+	}
+	if request.TaskId == nil {
+		return adh.error(errTaskIDNotSet, scope)
+	}
+	if request.GetQueueType() == gen.ShardQueueTypeTimer && request.VisibilityTimestamp == nil {
+		return adh.error(errVisibilityTimestampNotSet, scope)
+	}
+
+	if err := adh.history.RemoveShardTask(ctx, request); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// CloseShard unloads a history shard from its current host, so that it is acquired by the host the membership ring assigns it to
+func (adh *AdminHandler) CloseShard(ctx context.Context, request *gen.CloseShardRequest) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminCloseShardScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if err := adh.validateShardID(request.ShardID); err != nil {
+		return adh.error(err, scope)
+	}
+
+	if err := adh.history.CloseShard(ctx, request); err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// DescribeCluster returns the members of each service ring, and the host and status of each history shard
+func (adh *AdminHandler) DescribeCluster(ctx context.Context) (resp *admin.DescribeClusterResponse, retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminDescribeClusterScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	monitor := adh.GetMembershipMonitor()
+	resp = &admin.DescribeClusterResponse{}
+	var historyHosts []string
+	for _, role := range []string{
+		common.FrontendServiceName,
+		common.HistoryServiceName,
+		common.MatchingServiceName,
+		common.WorkerServiceName,
+	} {
+		resolver, err := monitor.GetResolver(role)
+		if err != nil {
+			return nil, adh.error(err, scope)
+		}
+		members := []string{}
+		for _, host := range resolver.Members() {
+			members = append(members, host.GetAddress())
+		}
+		sort.Strings(members)
+		if role == common.HistoryServiceName {
+			historyHosts = members
+		}
+		resp.Rings = append(resp.Rings, &admin.RingInfo{
+			Role:    common.StringPtr(role),
+			Members: members,
+		})
+	}
+
+	// a history host which cannot be reached is skipped, its shards are reported as not loaded
+	loadedShards := make(map[int32]*admin.HistoryShardInfo)
+	for _, address := range historyHosts {
+		hostResp, err := adh.history.DescribeHistoryHost(ctx, &gen.DescribeHistoryHostRequest{
+			HostAddress: common.StringPtr(address),
+		})
+		if err != nil {
+			adh.GetLogger().Warn("Failed to describe history host.", tag.Address(address), tag.Error(err))
+			continue
+		}
+		for _, shardStatus := range hostResp.ShardStatuses {
+			loadedShards[shardStatus.GetShardID()] = &admin.HistoryShardInfo{
+				HostAddress: common.StringPtr(address),
+				Status:      shardStatus.Status,
+			}
+		}
+	}
+
+	for shardID := 0; shardID < adh.numberOfHistoryShards; shardID++ {
+		shardInfo, ok := loadedShards[int32(shardID)]
+		if !ok {
+			shardInfo = &admin.HistoryShardInfo{Status: common.StringPtr("not loaded")}
+		}
+		shardInfo.ShardID = common.Int32Ptr(int32(shardID))
+		if owner, err := monitor.Lookup(common.HistoryServiceName, string(rune(shardID))); err == nil {
+			shardInfo.RingOwner = common.StringPtr(owner.GetAddress())
+		}
+		resp.HistoryShards = append(resp.HistoryShards, shardInfo)
+	}
+	return resp, nil
+}
+
+// RefreshWorkflowTasks regenerates the transfer and timer tasks of a workflow execution from its mutable state
+func (adh *AdminHandler) RefreshWorkflowTasks(ctx context.Context, request *admin.RefreshWorkflowTasksRequest) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminRefreshWorkflowTasksScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return adh.error(errDomainNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return adh.error(err, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.history.RefreshWorkflowTasks(ctx, &hist.RefreshWorkflowTasksRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  request.Execution,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
+// DeleteWorkflowExecution permanently removes a workflow execution through its owning history shard
+func (adh *AdminHandler) DeleteWorkflowExecution(ctx context.Context, request *admin.DeleteWorkflowExecutionRequest) (retError error) {
+	defer log.CapturePanic(adh.GetLogger(), &retError)
+
+	scope := metrics.AdminDeleteWorkflowExecutionScope
+	sw := adh.startRequestProfile(scope)
+	defer sw.Stop()
+
+	if request == nil {
+		return adh.error(errRequestNotSet, scope)
+	}
+	if request.GetDomain() == "" {
+		return adh.error(errDomainNotSet, scope)
+	}
+	if err := validateExecution(request.Execution); err != nil {
+		return adh.error(err, scope)
+	}
+
+	domainID, err := adh.domainCache.GetDomainID(request.GetDomain())
+	if err != nil {
+		return adh.error(err, scope)
+	}
+
+	err = adh.history.DeleteWorkflowExecution(ctx, &hist.DeleteWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  request.Execution,
+	})
+	if err != nil {
+		return adh.error(err, scope)
+	}
+	return nil
+}
+
 // GetWorkflowExecutionRawHistory - retrieves the history of workflow execution
 func (adh *AdminHandler) GetWorkflowExecutionRawHistory(
 	ctx context.Context, request *admin.GetWorkflowExecutionRawHistoryRequest) (resp *admin.GetWorkflowExecutionRawHistoryResponse, retError error) {
@@ -368,16 +601,18 @@ func (adh *AdminHandler) GetWorkflowExecutionRawHistory(
 	adh.metricsClient.RecordTimer(scope, metrics.HistorySize, time.Duration(size))
 	domainScope.RecordTimer(metrics.HistorySize, time.Duration(size))
 
-	serializer := persistence.NewPayloadSerializer()
+	// the history is encoded directly instead of using the payload serializer, so the
+	// returned batches are never encrypted even if encryption at rest is enabled
+	encoder := codec.NewThriftRWEncoder()
 	blobs := []*gen.DataBlob{}
 	for _, historyBatch := range historyBatches {
-		blob, err := serializer.SerializeBatchEvents(historyBatch.Events, common.EncodingTypeThriftRW)
+		data, err := encoder.Encode(&gen.History{Events: historyBatch.Events})
 		if err != nil {
 			return nil, err
 		}
 		blobs = append(blobs, &gen.DataBlob{
 			EncodingType: gen.EncodingTypeThriftRW.Ptr(),
-			Data:         blob.Data,
+			Data:         data,
 		})
 	}
 
@@ -399,6 +634,16 @@ func (adh *AdminHandler) GetWorkflowExecutionRawHistory(
 }
 
 // startRequestProfile initiates recording of request metrics
+func (adh *AdminHandler) validateShardID(shardID *int32) error {
+	if shardID == nil {
+		return errShardIDNotSet
+	}
+	if *shardID < 0 || int(*shardID) >= adh.numberOfHistoryShards {
+		return errInvalidShardID
+	}
+	return nil
+}
+
 func (adh *AdminHandler) startRequestProfile(scope int) tally.Stopwatch {
 	adh.startWG.Wait()
 	sw := adh.metricsClient.StartTimer(scope, metrics.CadenceLatency)
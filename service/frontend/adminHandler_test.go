@@ -23,6 +23,7 @@ package frontend
 import (
 	"github.com/stretchr/testify/require"
 	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
 	"testing"
 )
 
@@ -65,3 +66,12 @@ func Test_ConvertIndexedValueTypeToESDataType(t *testing.T) {
 		require.Equal(t, test.expected, convertIndexedValueTypeToESDataType(test.input))
 	}
 }
+
+func Test_ValidateShardID(t *testing.T) {
+	adh := &AdminHandler{numberOfHistoryShards: 4}
+	require.Equal(t, errShardIDNotSet, adh.validateShardID(nil))
+	require.Equal(t, errInvalidShardID, adh.validateShardID(common.Int32Ptr(-1)))
+	require.Equal(t, errInvalidShardID, adh.validateShardID(common.Int32Ptr(4)))
+	require.NoError(t, adh.validateShardID(common.Int32Ptr(0)))
+	require.NoError(t, adh.validateShardID(common.Int32Ptr(3)))
+}
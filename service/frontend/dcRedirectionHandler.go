@@ -250,6 +250,36 @@ func (handler *DCRedirectionHandlerImpl) DescribeWorkflowExecution(
 	return resp, err
 }
 
+// DescribeSchedule API call
+func (handler *DCRedirectionHandlerImpl) DescribeSchedule(
+	ctx context.Context,
+	request *shared.DescribeScheduleRequest,
+) (resp *shared.DescribeScheduleResponse, retError error) {
+
+	var apiName = "DescribeSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionDescribeScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.frontendHandler.DescribeSchedule(ctx, request)
+		default:
+			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.DescribeSchedule(ctx, request)
+		}
+		return err
+	})
+
+	return resp, err
+}
+
 // GetWorkflowExecutionHistory API call
 func (handler *DCRedirectionHandlerImpl) GetWorkflowExecutionHistory(
 	ctx context.Context,
@@ -1019,6 +1049,36 @@ func (handler *DCRedirectionHandlerImpl) SignalWithStartWorkflowExecution(
 	return resp, err
 }
 
+// UpdateWorkflowExecution API call
+func (handler *DCRedirectionHandlerImpl) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *shared.UpdateWorkflowExecutionRequest,
+) (resp *shared.UpdateWorkflowExecutionResponse, retError error) {
+
+	var apiName = "UpdateWorkflowExecution"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUpdateWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			resp, err = handler.frontendHandler.UpdateWorkflowExecution(ctx, request)
+		default:
+			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
+			resp, err = remoteClient.UpdateWorkflowExecution(ctx, request)
+		}
+		return err
+	})
+
+	return resp, err
+}
+
 // SignalWorkflowExecution API call
 func (handler *DCRedirectionHandlerImpl) SignalWorkflowExecution(
 	ctx context.Context,
@@ -1048,6 +1108,180 @@ func (handler *DCRedirectionHandlerImpl) SignalWorkflowExecution(
 	return err
 }
 
+// CancelDelayedSignal API call
+func (handler *DCRedirectionHandlerImpl) CancelDelayedSignal(
+	ctx context.Context,
+	request *shared.CancelDelayedSignalRequest,
+) (retError error) {
+
+	var apiName = "CancelDelayedSignal"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionCancelDelayedSignalScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.CancelDelayedSignal(ctx, request)
+		default:
+			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
+			err = remoteClient.CancelDelayedSignal(ctx, request)
+		}
+		return err
+	})
+	return err
+}
+
+// CreateSchedule API call
+func (handler *DCRedirectionHandlerImpl) CreateSchedule(
+	ctx context.Context,
+	request *shared.CreateScheduleRequest,
+) (retError error) {
+
+	var apiName = "CreateSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionCreateScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.CreateSchedule(ctx, request)
+		default:
+			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
+			err = remoteClient.CreateSchedule(ctx, request)
+		}
+		return err
+	})
+	return err
+}
+
+// UpdateSchedule API call
+func (handler *DCRedirectionHandlerImpl) UpdateSchedule(
+	ctx context.Context,
+	request *shared.UpdateScheduleRequest,
+) (retError error) {
+
+	var apiName = "UpdateSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUpdateScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.UpdateSchedule(ctx, request)
+		default:
+			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
+			err = remoteClient.UpdateSchedule(ctx, request)
+		}
+		return err
+	})
+	return err
+}
+
+// PauseSchedule API call
+func (handler *DCRedirectionHandlerImpl) PauseSchedule(
+	ctx context.Context,
+	request *shared.PauseScheduleRequest,
+) (retError error) {
+
+	var apiName = "PauseSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionPauseScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.PauseSchedule(ctx, request)
+		default:
+			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
+			err = remoteClient.PauseSchedule(ctx, request)
+		}
+		return err
+	})
+	return err
+}
+
+// BackfillSchedule API call
+func (handler *DCRedirectionHandlerImpl) BackfillSchedule(
+	ctx context.Context,
+	request *shared.BackfillScheduleRequest,
+) (retError error) {
+
+	var apiName = "BackfillSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionBackfillScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.BackfillSchedule(ctx, request)
+		default:
+			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
+			err = remoteClient.BackfillSchedule(ctx, request)
+		}
+		return err
+	})
+	return err
+}
+
+// DeleteSchedule API call
+func (handler *DCRedirectionHandlerImpl) DeleteSchedule(
+	ctx context.Context,
+	request *shared.DeleteScheduleRequest,
+) (retError error) {
+
+	var apiName = "DeleteSchedule"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionDeleteScheduleScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.DeleteSchedule(ctx, request)
+		default:
+			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
+			err = remoteClient.DeleteSchedule(ctx, request)
+		}
+		return err
+	})
+	return err
+}
+
 // StartWorkflowExecution API call
 func (handler *DCRedirectionHandlerImpl) StartWorkflowExecution(
 	ctx context.Context,
@@ -1108,6 +1342,66 @@ func (handler *DCRedirectionHandlerImpl) TerminateWorkflowExecution(
 	return err
 }
 
+// PauseWorkflowExecution API call
+func (handler *DCRedirectionHandlerImpl) PauseWorkflowExecution(
+	ctx context.Context,
+	request *shared.PauseWorkflowExecutionRequest,
+) (retError error) {
+
+	var apiName = "PauseWorkflowExecution"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionPauseWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.PauseWorkflowExecution(ctx, request)
+		default:
+			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
+			err = remoteClient.PauseWorkflowExecution(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
+// UnpauseWorkflowExecution API call
+func (handler *DCRedirectionHandlerImpl) UnpauseWorkflowExecution(
+	ctx context.Context,
+	request *shared.UnpauseWorkflowExecutionRequest,
+) (retError error) {
+
+	var apiName = "UnpauseWorkflowExecution"
+	var err error
+	var cluster string
+
+	scope, startTime := handler.beforeCall(metrics.DCRedirectionUnpauseWorkflowExecutionScope)
+	defer func() {
+		handler.afterCall(scope, startTime, cluster, &retError)
+	}()
+
+	err = handler.redirectionPolicy.WithDomainNameRedirect(request.GetDomain(), apiName, func(targetDC string) error {
+		cluster = targetDC
+		switch {
+		case targetDC == handler.currentClusterName:
+			err = handler.frontendHandler.UnpauseWorkflowExecution(ctx, request)
+		default:
+			remoteClient := handler.clientBeanProvider().GetRemoteFrontendClient(targetDC)
+			err = remoteClient.UnpauseWorkflowExecution(ctx, request)
+		}
+		return err
+	})
+
+	return err
+}
+
 func (handler *DCRedirectionHandlerImpl) beforeCall(
 	scope int,
 ) (metrics.Scope, time.Time) {
@@ -730,6 +730,29 @@ func (s *dcRedirectionHandlerSuite) TestSignalWithStartWorkflowExecution() {
 	s.Nil(err)
 }
 
+func (s *dcRedirectionHandlerSuite) TestUpdateWorkflowExecution() {
+	apiName := "UpdateWorkflowExecution"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Once()
+
+	req := &shared.UpdateWorkflowExecutionRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+	resp, err := s.handler.UpdateWorkflowExecution(context.Background(), req)
+	s.Nil(err)
+	// the resp is initialized to nil, since inner function is not called
+	s.Nil(resp)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.On(apiName, mock.Anything, req).Return(&shared.UpdateWorkflowExecutionResponse{}, nil).Once()
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.On(apiName, mock.Anything, req).Return(&shared.UpdateWorkflowExecutionResponse{}, nil).Once()
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
 func (s *dcRedirectionHandlerSuite) TestSignalWorkflowExecution() {
 	apiName := "SignalWorkflowExecution"
 
@@ -751,6 +774,155 @@ func (s *dcRedirectionHandlerSuite) TestSignalWorkflowExecution() {
 	s.Nil(err)
 }
 
+func (s *dcRedirectionHandlerSuite) TestCancelDelayedSignal() {
+	apiName := "CancelDelayedSignal"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Once()
+
+	req := &shared.CancelDelayedSignalRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+	err := s.handler.CancelDelayedSignal(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.On(apiName, mock.Anything, req).Return(nil).Once()
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.On(apiName, mock.Anything, req).Return(nil).Once()
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestCreateSchedule() {
+	apiName := "CreateSchedule"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Once()
+
+	req := &shared.CreateScheduleRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+	err := s.handler.CreateSchedule(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.On(apiName, mock.Anything, req).Return(nil).Once()
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.On(apiName, mock.Anything, req).Return(nil).Once()
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestDescribeSchedule() {
+	apiName := "DescribeSchedule"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Once()
+
+	req := &shared.DescribeScheduleRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+	resp, err := s.handler.DescribeSchedule(context.Background(), req)
+	s.Nil(err)
+	// the resp is initialized to nil, since inner function is not called
+	s.Nil(resp)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.On(apiName, mock.Anything, req).Return(&shared.DescribeScheduleResponse{}, nil).Once()
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.On(apiName, mock.Anything, req).Return(&shared.DescribeScheduleResponse{}, nil).Once()
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestUpdateSchedule() {
+	apiName := "UpdateSchedule"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Once()
+
+	req := &shared.UpdateScheduleRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+	err := s.handler.UpdateSchedule(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.On(apiName, mock.Anything, req).Return(nil).Once()
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.On(apiName, mock.Anything, req).Return(nil).Once()
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestPauseSchedule() {
+	apiName := "PauseSchedule"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Once()
+
+	req := &shared.PauseScheduleRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+	err := s.handler.PauseSchedule(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.On(apiName, mock.Anything, req).Return(nil).Once()
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.On(apiName, mock.Anything, req).Return(nil).Once()
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestBackfillSchedule() {
+	apiName := "BackfillSchedule"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Once()
+
+	req := &shared.BackfillScheduleRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+	err := s.handler.BackfillSchedule(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.On(apiName, mock.Anything, req).Return(nil).Once()
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.On(apiName, mock.Anything, req).Return(nil).Once()
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestDeleteSchedule() {
+	apiName := "DeleteSchedule"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Once()
+
+	req := &shared.DeleteScheduleRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+	err := s.handler.DeleteSchedule(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.On(apiName, mock.Anything, req).Return(nil).Once()
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.On(apiName, mock.Anything, req).Return(nil).Once()
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
 func (s *dcRedirectionHandlerSuite) TestStartWorkflowExecution() {
 	apiName := "StartWorkflowExecution"
 
@@ -794,3 +966,45 @@ func (s *dcRedirectionHandlerSuite) TestTerminateWorkflowExecution() {
 	err = callFn(s.alternativeClusterName)
 	s.Nil(err)
 }
+
+func (s *dcRedirectionHandlerSuite) TestPauseWorkflowExecution() {
+	apiName := "PauseWorkflowExecution"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Once()
+
+	req := &shared.PauseWorkflowExecutionRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+	err := s.handler.PauseWorkflowExecution(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.On(apiName, mock.Anything, req).Return(nil).Once()
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.On(apiName, mock.Anything, req).Return(nil).Once()
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
+
+func (s *dcRedirectionHandlerSuite) TestUnpauseWorkflowExecution() {
+	apiName := "UnpauseWorkflowExecution"
+
+	s.mockDCRedirectionPolicy.On("WithDomainNameRedirect",
+		s.domainName, apiName, mock.Anything).Return(nil).Once()
+
+	req := &shared.UnpauseWorkflowExecutionRequest{
+		Domain: common.StringPtr(s.domainName),
+	}
+	err := s.handler.UnpauseWorkflowExecution(context.Background(), req)
+	s.Nil(err)
+
+	callFn := s.mockDCRedirectionPolicy.Calls[0].Arguments[2].(func(string) error)
+	s.mockFrontendHandler.On(apiName, mock.Anything, req).Return(nil).Once()
+	err = callFn(s.currentClusterName)
+	s.Nil(err)
+	s.mockRemoteFrontendClient.On(apiName, mock.Anything, req).Return(nil).Once()
+	err = callFn(s.alternativeClusterName)
+	s.Nil(err)
+}
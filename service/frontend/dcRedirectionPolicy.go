@@ -40,6 +40,10 @@ const (
 	// 3. SignalWorkflowExecution
 	// 4. RequestCancelWorkflowExecution
 	// 5. TerminateWorkflowExecution
+	// 6. PauseWorkflowExecution
+	// 7. UnpauseWorkflowExecution
+	// 8. UpdateWorkflowExecution
+	// 9. CancelDelayedSignal
 	// please also reference selectedAPIsForwardingRedirectionPolicyWhitelistedAPIs
 	DCRedirectionPolicySelectedAPIsForwarding = "selected-apis-forwarding"
 )
@@ -72,6 +76,10 @@ var selectedAPIsForwardingRedirectionPolicyWhitelistedAPIs = map[string]struct{}
 	"SignalWorkflowExecution":          {},
 	"RequestCancelWorkflowExecution":   {},
 	"TerminateWorkflowExecution":       {},
+	"PauseWorkflowExecution":           {},
+	"UnpauseWorkflowExecution":         {},
+	"UpdateWorkflowExecution":          {},
+	"CancelDelayedSignal":              {},
 }
 
 // RedirectionPolicyGenerator generate corresponding redirection policy
@@ -446,6 +446,7 @@ func (d *domainHandlerImpl) updateDomain(
 			config.VisibilityArchivalURI = nextVisibilityArchivalState.URI
 		}
 		if updatedConfig.BadBinaries != nil {
+			configurationChanged = true
 			maxLength := d.config.MaxBadBinaries(updateRequest.GetName())
 			// only do merging
 			config.BadBinaries = d.mergeBadBinaries(config.BadBinaries.Binaries, updatedConfig.BadBinaries.Binaries, time.Now().UnixNano())
@@ -455,6 +456,17 @@ func (d *domainHandlerImpl) updateDomain(
 				}
 			}
 		}
+		if updatedConfig.WorkerVersioning != nil {
+			if err := d.validateWorkerVersioning(updatedConfig.WorkerVersioning); err != nil {
+				return nil, err
+			}
+			if err := d.validateWorkerVersioningUpdate(&config.WorkerVersioning, updatedConfig.WorkerVersioning); err != nil {
+				return nil, err
+			}
+			configurationChanged = true
+			// the compatible build sets are always replaced as a whole
+			config.WorkerVersioning = *updatedConfig.WorkerVersioning
+		}
 	}
 
 	if updateRequest.DeleteBadBinary != nil {
@@ -674,6 +686,7 @@ func (d *domainHandlerImpl) createResponse(
 		VisibilityArchivalStatus:               common.ArchivalStatusPtr(config.VisibilityArchivalStatus),
 		VisibilityArchivalURI:                  common.StringPtr(config.VisibilityArchivalURI),
 		BadBinaries:                            &config.BadBinaries,
+		WorkerVersioning:                       &config.WorkerVersioning,
 	}
 
 	clusters := []*shared.ClusterReplicationConfiguration{}
@@ -701,7 +714,14 @@ func (d *domainHandlerImpl) mergeBadBinaries(
 		old = map[string]*shared.BadBinaryInfo{}
 	}
 	for k, v := range new {
-		v.CreatedTimeNano = common.Int64Ptr(createTimeNano)
+		// the created time and the auto reset execution are only set by the server
+		// when it records the auto reset of the bad binary
+		if v.CreatedTimeNano == nil {
+			v.CreatedTimeNano = common.Int64Ptr(createTimeNano)
+		}
+		if prev, ok := old[k]; ok && v.AutoResetExecution == nil {
+			v.AutoResetExecution = prev.AutoResetExecution
+		}
 		old[k] = v
 	}
 	return shared.BadBinaries{
@@ -709,6 +729,145 @@ func (d *domainHandlerImpl) mergeBadBinaries(
 	}
 }
 
+func (d *domainHandlerImpl) validateWorkerVersioning(
+	workerVersioning *shared.WorkerVersioning,
+) error {
+
+	if err := d.validateBuildIDCompatibility(workerVersioning.DefaultCompatibility); err != nil {
+		return err
+	}
+	for taskList, compatibility := range workerVersioning.TaskLists {
+		if taskList == "" {
+			return &shared.BadRequestError{Message: "Task list name of compatible build sets is not set."}
+		}
+		if err := d.validateBuildIDCompatibility(compatibility); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *domainHandlerImpl) validateBuildIDCompatibility(
+	compatibility *shared.BuildIdCompatibility,
+) error {
+
+	if compatibility == nil {
+		return nil
+	}
+	buildIDs := map[string]struct{}{}
+	for _, set := range compatibility.CompatibleBuildSets {
+		if set == nil || len(set.BuildIds) == 0 {
+			return &shared.BadRequestError{Message: "Compatible build set cannot be empty."}
+		}
+		for _, buildID := range set.BuildIds {
+			if buildID == "" {
+				return &shared.BadRequestError{Message: "Build ID cannot be empty."}
+			}
+			if _, ok := buildIDs[buildID]; ok {
+				return &shared.BadRequestError{
+					Message: fmt.Sprintf("Build ID %v belongs to more than one compatible build set.", buildID),
+				}
+			}
+			buildIDs[buildID] = struct{}{}
+		}
+	}
+	return nil
+}
+
+// validateWorkerVersioningUpdate makes sure the existing compatible build sets are only appended to.
+// Matching identifies a set by its first build ID, so dropping or reordering the build IDs of a set
+// would strand the tasks already routed to it.
+func (d *domainHandlerImpl) validateWorkerVersioningUpdate(
+	old *shared.WorkerVersioning,
+	new *shared.WorkerVersioning,
+) error {
+
+	if err := d.validateBuildIDCompatibilityUpdate(old.DefaultCompatibility, new.DefaultCompatibility); err != nil {
+		return err
+	}
+	taskLists := map[string]struct{}{}
+	for taskList := range old.TaskLists {
+		taskLists[taskList] = struct{}{}
+	}
+	for taskList := range new.TaskLists {
+		taskLists[taskList] = struct{}{}
+	}
+	for taskList := range taskLists {
+		if err := d.validateBuildIDCompatibilityUpdate(
+			getBuildIDCompatibility(old, taskList),
+			getBuildIDCompatibility(new, taskList),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *domainHandlerImpl) validateBuildIDCompatibilityUpdate(
+	old *shared.BuildIdCompatibility,
+	new *shared.BuildIdCompatibility,
+) error {
+
+	if old == nil || new == nil {
+		return nil
+	}
+	newSets := map[string]*shared.CompatibleBuildSet{}
+	for _, set := range new.CompatibleBuildSets {
+		for _, buildID := range set.BuildIds {
+			newSets[buildID] = set
+		}
+	}
+	for _, oldSet := range old.CompatibleBuildSets {
+		if oldSet == nil {
+			continue
+		}
+		var newSet *shared.CompatibleBuildSet
+		for _, buildID := range oldSet.BuildIds {
+			if set, ok := newSets[buildID]; ok {
+				newSet = set
+				break
+			}
+		}
+		if newSet == nil {
+			// the set is removed as a whole
+			continue
+		}
+		if !isBuildIDPrefix(oldSet.BuildIds, newSet.BuildIds) {
+			return &shared.BadRequestError{
+				Message: fmt.Sprintf("Build IDs can only be appended to the compatible build set of %v.", oldSet.BuildIds[0]),
+			}
+		}
+	}
+	return nil
+}
+
+func getBuildIDCompatibility(
+	workerVersioning *shared.WorkerVersioning,
+	taskList string,
+) *shared.BuildIdCompatibility {
+
+	if compatibility, ok := workerVersioning.TaskLists[taskList]; ok {
+		return compatibility
+	}
+	return workerVersioning.DefaultCompatibility
+}
+
+func isBuildIDPrefix(
+	prefix []string,
+	buildIDs []string,
+) bool {
+
+	if len(prefix) > len(buildIDs) {
+		return false
+	}
+	for i, buildID := range prefix {
+		if buildIDs[i] != buildID {
+			return false
+		}
+	}
+	return true
+}
+
 func (d *domainHandlerImpl) mergeDomainData(
 	old map[string]string,
 	new map[string]string,
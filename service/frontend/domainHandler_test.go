@@ -222,6 +222,26 @@ func (s *domainHandlerCommonSuite) TestMergeBadBinaries_Merging() {
 	}))
 }
 
+func (s *domainHandlerCommonSuite) TestMergeBadBinaries_ServerSetFields() {
+	execution := &shared.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid")}
+	out := s.handler.mergeBadBinaries(
+		map[string]*shared.BadBinaryInfo{
+			"k0": {Reason: common.StringPtr("reason0"), CreatedTimeNano: common.Int64Ptr(1), AutoResetExecution: execution},
+		},
+		map[string]*shared.BadBinaryInfo{
+			"k0": {Reason: common.StringPtr("reason1")},
+			"k1": {Reason: common.StringPtr("reason2"), CreatedTimeNano: common.Int64Ptr(2), AutoResetExecution: execution},
+		}, nowInt64,
+	)
+
+	assert.True(s.T(), out.Equals(&shared.BadBinaries{
+		Binaries: map[string]*shared.BadBinaryInfo{
+			"k0": {Reason: common.StringPtr("reason1"), CreatedTimeNano: common.Int64Ptr(nowInt64), AutoResetExecution: execution},
+			"k1": {Reason: common.StringPtr("reason2"), CreatedTimeNano: common.Int64Ptr(2), AutoResetExecution: execution},
+		},
+	}))
+}
+
 func (s *domainHandlerCommonSuite) TestMergeBadBinaries_Nil() {
 	out := s.handler.mergeBadBinaries(
 		nil,
@@ -239,6 +259,75 @@ func (s *domainHandlerCommonSuite) TestMergeBadBinaries_Nil() {
 	}))
 }
 
+func (s *domainHandlerCommonSuite) TestValidateWorkerVersioningUpdate() {
+	compatibility := func(sets ...[]string) *shared.BuildIdCompatibility {
+		result := &shared.BuildIdCompatibility{}
+		for _, buildIDs := range sets {
+			result.CompatibleBuildSets = append(result.CompatibleBuildSets, &shared.CompatibleBuildSet{BuildIds: buildIDs})
+		}
+		return result
+	}
+	old := &shared.WorkerVersioning{
+		DefaultCompatibility: compatibility([]string{"a1", "a2"}, []string{"b1"}),
+		TaskLists: map[string]*shared.BuildIdCompatibility{
+			"tl": compatibility([]string{"c1"}),
+		},
+	}
+
+	testCases := []struct {
+		name  string
+		new   *shared.WorkerVersioning
+		valid bool
+	}{
+		{
+			name: "append build IDs and sets",
+			new: &shared.WorkerVersioning{
+				DefaultCompatibility: compatibility([]string{"a1", "a2", "a3"}, []string{"b1"}, []string{"d1"}),
+				TaskLists: map[string]*shared.BuildIdCompatibility{
+					"tl": compatibility([]string{"c1", "c2"}),
+				},
+			},
+			valid: true,
+		},
+		{
+			name: "remove set",
+			new: &shared.WorkerVersioning{
+				DefaultCompatibility: compatibility([]string{"b1"}),
+			},
+			valid: true,
+		},
+		{
+			name: "drop build ID",
+			new: &shared.WorkerVersioning{
+				DefaultCompatibility: compatibility([]string{"a2"}, []string{"b1"}),
+			},
+			valid: false,
+		},
+		{
+			name: "reorder build IDs",
+			new: &shared.WorkerVersioning{
+				DefaultCompatibility: compatibility([]string{"a2", "a1"}, []string{"b1"}),
+			},
+			valid: false,
+		},
+		{
+			name: "move task list to default compatibility",
+			new: &shared.WorkerVersioning{
+				DefaultCompatibility: compatibility([]string{"a1", "a2"}, []string{"b1"}, []string{"e1", "c1"}),
+			},
+			valid: false,
+		},
+	}
+	for _, tc := range testCases {
+		err := s.handler.validateWorkerVersioningUpdate(old, tc.new)
+		if tc.valid {
+			s.NoError(err, tc.name)
+		} else {
+			s.IsType(&shared.BadRequestError{}, err, tc.name)
+		}
+	}
+}
+
 func (s *domainHandlerCommonSuite) TestListDomain() {
 	domainName1 := s.getRandomDomainName()
 	description1 := "some random description 1"
@@ -95,6 +95,7 @@ func (domainReplicator *domainReplicatorImpl) HandleTransmissionTask(domainOpera
 			VisibilityArchivalStatus:               common.ArchivalStatusPtr(config.VisibilityArchivalStatus),
 			VisibilityArchivalURI:                  common.StringPtr(config.VisibilityArchivalURI),
 			BadBinaries:                            &config.BadBinaries,
+			WorkerVersioning:                       &config.WorkerVersioning,
 		},
 		ReplicationConfig: &shared.DomainReplicationConfiguration{
 			ActiveClusterName: common.StringPtr(replicationConfig.ActiveClusterName),
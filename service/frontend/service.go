@@ -25,6 +25,7 @@ import (
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/healthcheck"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
@@ -41,6 +42,7 @@ import (
 type Config struct {
 	NumHistoryShards                int
 	PersistenceMaxQPS               dynamicconfig.IntPropertyFn
+	PersistenceDomainMaxQPS         dynamicconfig.IntPropertyFnWithDomainFilter
 	VisibilityMaxPageSize           dynamicconfig.IntPropertyFnWithDomainFilter
 	EnableVisibilitySampling        dynamicconfig.BoolPropertyFn
 	EnableReadFromClosedExecutionV2 dynamicconfig.BoolPropertyFn
@@ -87,6 +89,7 @@ func NewConfig(dc *dynamicconfig.Collection, numHistoryShards int, enableVisibil
 	return &Config{
 		NumHistoryShards:                    numHistoryShards,
 		PersistenceMaxQPS:                   dc.GetIntProperty(dynamicconfig.FrontendPersistenceMaxQPS, 2000),
+		PersistenceDomainMaxQPS:             dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendPersistenceDomainMaxQPS, 0),
 		VisibilityMaxPageSize:               dc.GetIntPropertyFilteredByDomain(dynamicconfig.FrontendVisibilityMaxPageSize, 1000),
 		EnableVisibilitySampling:            dc.GetBoolProperty(dynamicconfig.EnableVisibilitySampling, true),
 		EnableReadFromClosedExecutionV2:     dc.GetBoolProperty(dynamicconfig.EnableReadFromClosedExecutionV2, false),
@@ -149,12 +152,13 @@ func (s *Service) Start() {
 	pConfig := params.PersistenceConfig
 	pConfig.HistoryMaxConns = s.config.HistoryMgrNumConns()
 	pConfig.SetMaxQPS(pConfig.DefaultStore, s.config.PersistenceMaxQPS())
+	pConfig.DomainMaxQPS = s.config.PersistenceDomainMaxQPS
 	pConfig.VisibilityConfig = &config.VisibilityConfig{
 		VisibilityListMaxQPS:            s.config.VisibilityListMaxQPS,
 		EnableSampling:                  s.config.EnableVisibilitySampling,
 		EnableReadFromClosedExecutionV2: s.config.EnableReadFromClosedExecutionV2,
 	}
-	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), base.GetMetricsClient(), log)
+	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), base.GetMetricsClient(), params.PayloadEncryptor, log)
 
 	metadata, err := pFactory.NewMetadataManager(persistencefactory.MetadataV1V2)
 	if err != nil {
@@ -201,6 +205,7 @@ func (s *Service) Start() {
 	}
 
 	domainCache := cache.NewDomainCache(metadata, base.GetClusterMetadata(), base.GetMetricsClient(), base.GetLogger())
+	pFactory.SetDomainNameResolver(domainCache.GetDomainName)
 
 	historyArchiverBootstrapContainer := &archiver.HistoryBootstrapContainer{
 		HistoryManager:   history,
@@ -233,6 +238,10 @@ func (s *Service) Start() {
 		log.Fatal("Admin handler failed to start", tag.Error(err))
 	}
 
+	healthCheckServer := base.GetHealthCheckServer()
+	healthCheckServer.AddReadinessCheck("persistence", healthcheck.NewPersistenceCheck(metadata))
+	healthCheckServer.SetServing(true)
+
 	// base (service is not started in frontend or admin handler) in case of race condition in yarpc registration function
 
 	log.Info("started", tag.Service(common.FrontendServiceName))
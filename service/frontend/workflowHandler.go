@@ -50,11 +50,18 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/quotas"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/systemworkflow"
 	"go.uber.org/yarpc/yarpcerrors"
 )
 
 var _ workflowserviceserver.Interface = (*WorkflowHandler)(nil)
 
+const (
+	autoResetIdentity                 = "cadence-frontend"
+	autoResetDecisionTimeoutInSeconds = 10
+	scheduleDecisionTimeoutInSeconds  = 10
+)
+
 type (
 	// WorkflowHandler - Thrift handler interface for workflow service
 	WorkflowHandler struct {
@@ -111,15 +118,30 @@ var (
 	errNextPageTokenRunIDMismatch                 = &gen.BadRequestError{Message: "RunID in the request does not match the NextPageToken."}
 	errQueryNotSet                                = &gen.BadRequestError{Message: "WorkflowQuery is not set on request."}
 	errQueryTypeNotSet                            = &gen.BadRequestError{Message: "QueryType is not set on request."}
+	errUpdateNameNotSet                           = &gen.BadRequestError{Message: "UpdateName is not set on request."}
 	errRequestNotSet                              = &gen.BadRequestError{Message: "Request is nil."}
 	errNoPermission                               = &gen.BadRequestError{Message: "No permission to do this operation."}
 	errRequestIDNotSet                            = &gen.BadRequestError{Message: "RequestId is not set on request."}
 	errWorkflowTypeNotSet                         = &gen.BadRequestError{Message: "WorkflowType is not set on request."}
 	errInvalidExecutionStartToCloseTimeoutSeconds = &gen.BadRequestError{Message: "A valid ExecutionStartToCloseTimeoutSeconds is not set on request."}
 	errInvalidTaskStartToCloseTimeoutSeconds      = &gen.BadRequestError{Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}
+	errInvalidDelayStartSeconds                   = &gen.BadRequestError{Message: "A valid DelayStartSeconds is not set on request."}
+	errInvalidDelaySeconds                        = &gen.BadRequestError{Message: "A valid DelaySeconds is not set on request."}
 	errClientVersionNotSet                        = &gen.BadRequestError{Message: "Client version is not set on request."}
 	errInvalidRetentionPeriod                     = &gen.BadRequestError{Message: "A valid retention period is not set on request."}
 	errInvalidArchivalConfig                      = &gen.BadRequestError{Message: "Invalid to enable archival without specifying a uri."}
+	errScheduleIDNotSet                           = &gen.BadRequestError{Message: "ScheduleId is not set on request."}
+	errScheduleSpecNotSet                         = &gen.BadRequestError{Message: "Schedule spec is not set on request."}
+	errScheduleActionNotSet                       = &gen.BadRequestError{Message: "Schedule action is not set on request."}
+	errInvalidCronExpression                      = &gen.BadRequestError{Message: "Invalid CronExpression."}
+	errInvalidBackfillTimeRange                   = &gen.BadRequestError{Message: "A valid backfill time range is not set on request."}
+	errScheduleAlreadyExists                      = &gen.BadRequestError{Message: "Schedule already exists."}
+	errScheduleNotFound                           = &gen.EntityNotExistsError{Message: "Schedule not found."}
+	errShardIDNotSet                              = &gen.BadRequestError{Message: "ShardID is not set on request."}
+	errInvalidShardID                             = &gen.BadRequestError{Message: "ShardID is out of range."}
+	errQueueTypeNotSet                            = &gen.BadRequestError{Message: "QueueType is not set on request."}
+	errTaskIDNotSet                               = &gen.BadRequestError{Message: "TaskID is not set on request."}
+	errVisibilityTimestampNotSet                  = &gen.BadRequestError{Message: "VisibilityTimestamp is not set on request."}
 
 	// err for archival
 	errHistoryHasPassedRetentionPeriod = &gen.BadRequestError{Message: "Requested workflow history has passed retention period."}
@@ -129,8 +151,10 @@ var (
 	errWorkflowTypeTooLong = &gen.BadRequestError{Message: "WorkflowType length exceeds limit."}
 	errWorkflowIDTooLong   = &gen.BadRequestError{Message: "WorkflowID length exceeds limit."}
 	errSignalNameTooLong   = &gen.BadRequestError{Message: "SignalName length exceeds limit."}
+	errUpdateNameTooLong   = &gen.BadRequestError{Message: "UpdateName length exceeds limit."}
 	errTaskListTooLong     = &gen.BadRequestError{Message: "TaskList length exceeds limit."}
 	errRequestIDTooLong    = &gen.BadRequestError{Message: "RequestID length exceeds limit."}
+	errScheduleIDTooLong   = &gen.BadRequestError{Message: "ScheduleID length exceeds limit."}
 	errIdentityTooLong     = &gen.BadRequestError{Message: "Identity length exceeds limit."}
 
 	// err indicating that this cluster is not the master, so cannot do domain registration or update
@@ -308,11 +332,124 @@ func (wh *WorkflowHandler) UpdateDomain(
 		return nil, wh.error(err, scope)
 	}
 
+	clearServerSetBadBinaryFields(updateRequest)
 	resp, err := wh.domainHandler.updateDomain(ctx, updateRequest)
 	if err != nil {
 		return resp, wh.error(err, scope)
 	}
-	return resp, err
+
+	if err := wh.startAutoResetForBadBinaries(ctx, updateRequest, resp); err != nil {
+		return nil, wh.error(err, scope)
+	}
+	return resp, nil
+}
+
+// clearServerSetBadBinaryFields drops the auto reset executions and the created times from the bad binaries
+// of the update request, they can only be set by server
+func clearServerSetBadBinaryFields(updateRequest *gen.UpdateDomainRequest) {
+	if updateRequest == nil || updateRequest.Configuration == nil || updateRequest.Configuration.BadBinaries == nil {
+		return
+	}
+	for _, info := range updateRequest.Configuration.BadBinaries.Binaries {
+		info.AutoResetExecution = nil
+		info.CreatedTimeNano = nil
+	}
+}
+
+// startAutoResetForBadBinaries starts the auto reset system workflow for each bad binary added by the domain update,
+// if auto reset of bad binaries is enabled for the updated domain. It must be called after the update is persisted,
+// so that the bad binary is already rejected by history when the workflow starts resetting.
+// The started workflow executions are recorded in the bad binary infos by a follow up domain update.
+// Each bad binary has a single auto reset workflow ID and an existing run of it is recorded instead of
+// starting a new one, so that a retry of a domain update which failed here completes the recording.
+func (wh *WorkflowHandler) startAutoResetForBadBinaries(
+	ctx context.Context,
+	updateRequest *gen.UpdateDomainRequest,
+	updateResponse *gen.UpdateDomainResponse,
+) error {
+
+	if updateRequest == nil || updateRequest.Configuration == nil || updateRequest.Configuration.BadBinaries == nil ||
+		len(updateRequest.Configuration.BadBinaries.Binaries) == 0 {
+		return nil
+	}
+	if updateResponse == nil || updateResponse.DomainInfo == nil ||
+		!cache.IsAutoResetBadBinariesEnabledInData(updateResponse.DomainInfo.Data) {
+		return nil
+	}
+
+	domainName := updateRequest.GetName()
+	persisted := map[string]*gen.BadBinaryInfo{}
+	if updateResponse.Configuration != nil && updateResponse.Configuration.BadBinaries != nil {
+		persisted = updateResponse.Configuration.BadBinaries.Binaries
+	}
+	started := map[string]*gen.BadBinaryInfo{}
+	for binaryChecksum, info := range updateRequest.Configuration.BadBinaries.Binaries {
+		persistedInfo, ok := persisted[binaryChecksum]
+		if !ok || persistedInfo.AutoResetExecution != nil {
+			// the auto reset of this bad binary is already recorded
+			continue
+		}
+		input, err := json.Marshal(systemworkflow.AutoResetParams{
+			DomainName:     domainName,
+			BinaryChecksum: binaryChecksum,
+			Reason:         info.GetReason(),
+		})
+		if err != nil {
+			return err
+		}
+		workflowID := systemworkflow.GetAutoResetWorkflowID(domainName, binaryChecksum)
+		resp, err := wh.StartWorkflowExecution(ctx, &gen.StartWorkflowExecutionRequest{
+			Domain:                              common.StringPtr(common.SystemGlobalDomainName),
+			WorkflowId:                          common.StringPtr(workflowID),
+			WorkflowType:                        &gen.WorkflowType{Name: common.StringPtr(systemworkflow.AutoResetWFTypeName)},
+			TaskList:                            &gen.TaskList{Name: common.StringPtr(systemworkflow.BatcherTaskListName)},
+			Input:                               input,
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(systemworkflow.InfiniteDuration.Seconds())),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(autoResetDecisionTimeoutInSeconds),
+			Identity:                            common.StringPtr(autoResetIdentity),
+			RequestId:                           common.StringPtr(uuid.NewSHA1(uuid.NameSpace_OID, []byte(workflowID)).String()),
+			WorkflowIdReusePolicy:               common.WorkflowIDReusePolicyPtr(gen.WorkflowIdReusePolicyRejectDuplicate),
+		})
+		runID := ""
+		if err != nil {
+			alreadyStartedErr, ok := err.(*gen.WorkflowExecutionAlreadyStartedError)
+			if !ok {
+				wh.GetLogger().Error("Failed to start auto reset workflow for bad binary",
+					tag.WorkflowDomainName(domainName),
+					tag.WorkflowBinaryChecksum(binaryChecksum),
+					tag.Error(err))
+				return err
+			}
+			// the auto reset of this bad binary was started by an earlier update
+			runID = alreadyStartedErr.GetRunId()
+		} else {
+			runID = resp.GetRunId()
+		}
+		started[binaryChecksum] = &gen.BadBinaryInfo{
+			Reason:          persistedInfo.Reason,
+			Operator:        persistedInfo.Operator,
+			CreatedTimeNano: persistedInfo.CreatedTimeNano,
+			AutoResetExecution: &gen.WorkflowExecution{
+				WorkflowId: common.StringPtr(workflowID),
+				RunId:      common.StringPtr(runID),
+			},
+		}
+	}
+
+	if len(started) == 0 {
+		return nil
+	}
+	recordResp, err := wh.domainHandler.updateDomain(ctx, &gen.UpdateDomainRequest{
+		Name: common.StringPtr(domainName),
+		Configuration: &gen.DomainConfiguration{
+			BadBinaries: &gen.BadBinaries{Binaries: started},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	*updateResponse = *recordResp
+	return nil
 }
 
 // DeprecateDomain us used to update status of a registered domain to DEPRECATED. Once the domain is deprecated
@@ -1367,6 +1504,9 @@ func (wh *WorkflowHandler) RespondDecisionTaskCompleted(
 		}
 		completedResp.DecisionTask = newDecisionTask
 	}
+	if histResp != nil {
+		completedResp.ActivitiesToDispatchLocally = histResp.ActivitiesToDispatchLocally
+	}
 
 	return completedResp, nil
 }
@@ -1543,6 +1683,10 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(err, scope)
 	}
 
+	if err := backoff.ValidateCronMaxJitter(startRequest.GetCronMaxJitterSeconds()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	wh.Service.GetLogger().Debug(
 		"Received StartWorkflowExecution. WorkflowID",
 		tag.WorkflowID(startRequest.GetWorkflowId()))
@@ -1567,6 +1711,10 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, wh.error(errInvalidTaskStartToCloseTimeoutSeconds, scope)
 	}
 
+	if startRequest.GetDelayStartSeconds() < 0 {
+		return nil, wh.error(errInvalidDelayStartSeconds, scope)
+	}
+
 	if startRequest.GetRequestId() == "" {
 		return nil, wh.error(errRequestIDNotSet, scope)
 	}
@@ -1866,6 +2014,15 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(
 		return wh.error(errRequestIDTooLong, scope)
 	}
 
+	if signalRequest.GetDelaySeconds() < 0 {
+		return wh.error(errInvalidDelaySeconds, scope)
+	}
+
+	// delayed signal is identified by its requestId, which is needed to cancel it
+	if signalRequest.GetDelaySeconds() > 0 && signalRequest.GetRequestId() == "" {
+		return wh.error(errRequestIDNotSet, scope)
+	}
+
 	domainID, err := wh.domainCache.GetDomainID(signalRequest.GetDomain())
 	if err != nil {
 		return wh.error(err, scope)
@@ -1897,6 +2054,145 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(
 	return nil
 }
 
+// CancelDelayedSignal is used to cancel a signal which was sent with a delay and has not been delivered yet to
+// the workflow execution.  The delayed signal is identified by the requestId of the original signal request.
+func (wh *WorkflowHandler) CancelDelayedSignal(
+	ctx context.Context,
+	cancelRequest *gen.CancelDelayedSignalRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendCancelDelayedSignalScope, cancelRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if cancelRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(cancelRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if cancelRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope)
+	}
+
+	if len(cancelRequest.GetDomain()) > wh.config.MaxIDLengthLimit() {
+		return wh.error(errDomainTooLong, scope)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(cancelRequest.WorkflowExecution, scope); err != nil {
+		return err
+	}
+
+	if cancelRequest.GetRequestId() == "" {
+		return wh.error(errRequestIDNotSet, scope)
+	}
+
+	if len(cancelRequest.GetRequestId()) > wh.config.MaxIDLengthLimit() {
+		return wh.error(errRequestIDTooLong, scope)
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(cancelRequest.GetDomain())
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	err = wh.history.CancelDelayedSignal(ctx, &h.CancelDelayedSignalRequest{
+		DomainUUID:    common.StringPtr(domainID),
+		CancelRequest: cancelRequest,
+	})
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
+// UpdateWorkflowExecution is used to send an update request to running workflow execution and wait for its result.
+// This results in WorkflowExecutionUpdateRequested event recorded in the history and a decision task being created
+// for the execution. The call blocks until the workflow responds with a CompleteWorkflowExecutionUpdate decision,
+// or until the wait times out.
+func (wh *WorkflowHandler) UpdateWorkflowExecution(
+	ctx context.Context,
+	updateRequest *gen.UpdateWorkflowExecutionRequest,
+) (resp *gen.UpdateWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendUpdateWorkflowExecutionScope, updateRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if updateRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(updateRequest); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if updateRequest.GetDomain() == "" {
+		return nil, wh.error(errDomainNotSet, scope)
+	}
+
+	if len(updateRequest.GetDomain()) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errDomainTooLong, scope)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(updateRequest.WorkflowExecution, scope); err != nil {
+		return nil, err
+	}
+
+	if updateRequest.GetUpdateName() == "" {
+		return nil, wh.error(errUpdateNameNotSet, scope)
+	}
+
+	if len(updateRequest.GetUpdateName()) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errUpdateNameTooLong, scope)
+	}
+
+	if len(updateRequest.GetRequestId()) > wh.config.MaxIDLengthLimit() {
+		return nil, wh.error(errRequestIDTooLong, scope)
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(updateRequest.GetDomain())
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	sizeLimitError := wh.config.BlobSizeLimitError(updateRequest.GetDomain())
+	sizeLimitWarn := wh.config.BlobSizeLimitWarn(updateRequest.GetDomain())
+	if err := common.CheckEventBlobSizeLimit(
+		len(updateRequest.Input),
+		sizeLimitWarn,
+		sizeLimitError,
+		domainID,
+		updateRequest.GetWorkflowExecution().GetWorkflowId(),
+		updateRequest.GetWorkflowExecution().GetRunId(),
+		scope,
+		wh.GetThrottledLogger(),
+	); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	resp, err = wh.history.UpdateWorkflowExecution(ctx, &h.UpdateWorkflowExecutionRequest{
+		DomainUUID:    common.StringPtr(domainID),
+		UpdateRequest: updateRequest,
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	return resp, nil
+}
+
 // SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.
 // If workflow is running, this results in WorkflowExecutionSignaled event recorded in the history
 // and a decision task being created for the execution.
@@ -1974,6 +2270,10 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 			Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}, scope)
 	}
 
+	if signalWithStartRequest.GetDelayStartSeconds() < 0 {
+		return nil, wh.error(errInvalidDelayStartSeconds, scope)
+	}
+
 	if err := common.ValidateRetryPolicy(signalWithStartRequest.RetryPolicy); err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -1982,6 +2282,10 @@ func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(
 		return nil, wh.error(err, scope)
 	}
 
+	if err := backoff.ValidateCronMaxJitter(signalWithStartRequest.GetCronMaxJitterSeconds()); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
 	if err := wh.searchAttributesValidator.ValidateSearchAttributes(signalWithStartRequest.SearchAttributes, domainName); err != nil {
 		return nil, wh.error(err, scope)
 	}
@@ -2083,6 +2387,100 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(
 	return nil
 }
 
+// PauseWorkflowExecution pauses an existing workflow execution, no decision or activity tasks are dispatched
+// until the workflow execution is unpaused.
+func (wh *WorkflowHandler) PauseWorkflowExecution(
+	ctx context.Context,
+	pauseRequest *gen.PauseWorkflowExecutionRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendPauseWorkflowExecutionScope, pauseRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if pauseRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(pauseRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if pauseRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(pauseRequest.WorkflowExecution, scope); err != nil {
+		return err
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(pauseRequest.GetDomain())
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	err = wh.history.PauseWorkflowExecution(ctx, &h.PauseWorkflowExecutionRequest{
+		DomainUUID:   common.StringPtr(domainID),
+		PauseRequest: pauseRequest,
+	})
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
+// UnpauseWorkflowExecution resumes a paused workflow execution, the decision and activity tasks held back
+// while the workflow execution was paused are dispatched again.
+func (wh *WorkflowHandler) UnpauseWorkflowExecution(
+	ctx context.Context,
+	unpauseRequest *gen.UnpauseWorkflowExecutionRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendUnpauseWorkflowExecutionScope, unpauseRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if unpauseRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(unpauseRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if unpauseRequest.GetDomain() == "" {
+		return wh.error(errDomainNotSet, scope)
+	}
+
+	if err := wh.validateExecutionAndEmitMetrics(unpauseRequest.WorkflowExecution, scope); err != nil {
+		return err
+	}
+
+	domainID, err := wh.domainCache.GetDomainID(unpauseRequest.GetDomain())
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	err = wh.history.UnpauseWorkflowExecution(ctx, &h.UnpauseWorkflowExecutionRequest{
+		DomainUUID:     common.StringPtr(domainID),
+		UnpauseRequest: unpauseRequest,
+	})
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
 // ResetWorkflowExecution reset an existing workflow execution to the nextFirstEventID
 // in the history and immediately terminating the current execution instance.
 func (wh *WorkflowHandler) ResetWorkflowExecution(
@@ -2709,6 +3107,7 @@ func (wh *WorkflowHandler) QueryWorkflow(
 	)
 
 	queryRequest.Execution.RunId = response.Execution.RunId
+	matchingRequest.BuildId = response.BuildId
 	if len(response.StickyTaskList.GetName()) != 0 && clientFeature.SupportStickyQuery() {
 		matchingRequest.TaskList = response.StickyTaskList
 		stickyDecisionTimeout := response.GetStickyTaskListScheduleToStartTimeout()
@@ -2859,6 +3258,302 @@ func (wh *WorkflowHandler) DescribeTaskList(
 	return response, nil
 }
 
+// CreateSchedule creates a schedule which periodically takes its action in the given domain. Each schedule is
+// backed by a system workflow run by the scheduler of the worker service.
+func (wh *WorkflowHandler) CreateSchedule(
+	ctx context.Context,
+	createRequest *gen.CreateScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendCreateScheduleScope, createRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if createRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(createRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if err := wh.validateScheduleID(createRequest.GetDomain(), createRequest.GetScheduleId(), scope); err != nil {
+		return err
+	}
+
+	if err := validateScheduleSpec(createRequest.Spec); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if err := wh.validateScheduleAction(createRequest.Action); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if len(createRequest.GetRequestId()) > wh.config.MaxIDLengthLimit() {
+		return wh.error(errRequestIDTooLong, scope)
+	}
+
+	if _, err := wh.domainCache.GetDomainID(createRequest.GetDomain()); err != nil {
+		return wh.error(err, scope)
+	}
+
+	input, err := json.Marshal(systemworkflow.ScheduleState{
+		DomainName: createRequest.GetDomain(),
+		ScheduleID: createRequest.GetScheduleId(),
+		Spec:       createRequest.Spec,
+		Action:     createRequest.Action,
+		Policies:   createRequest.Policies,
+		Paused:     createRequest.GetPaused(),
+	})
+	if err != nil {
+		return wh.error(err, scope)
+	}
+	requestID := createRequest.GetRequestId()
+	if requestID == "" {
+		requestID = uuid.New()
+	}
+	_, err = wh.StartWorkflowExecution(ctx, &gen.StartWorkflowExecutionRequest{
+		Domain:                              common.StringPtr(common.SystemGlobalDomainName),
+		WorkflowId:                          common.StringPtr(systemworkflow.GetScheduleWorkflowID(createRequest.GetDomain(), createRequest.GetScheduleId())),
+		WorkflowType:                        &gen.WorkflowType{Name: common.StringPtr(systemworkflow.ScheduleWFTypeName)},
+		TaskList:                            &gen.TaskList{Name: common.StringPtr(systemworkflow.SchedulerTaskListName)},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(systemworkflow.InfiniteDuration.Seconds())),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(scheduleDecisionTimeoutInSeconds),
+		Identity:                            createRequest.Identity,
+		RequestId:                           common.StringPtr(requestID),
+		WorkflowIdReusePolicy:               common.WorkflowIDReusePolicyPtr(gen.WorkflowIdReusePolicyAllowDuplicate),
+	})
+	if err != nil {
+		if _, ok := err.(*gen.WorkflowExecutionAlreadyStartedError); ok {
+			return wh.error(errScheduleAlreadyExists, scope)
+		}
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
+// DescribeSchedule returns the definition, state and recent actions of a schedule.
+func (wh *WorkflowHandler) DescribeSchedule(
+	ctx context.Context,
+	describeRequest *gen.DescribeScheduleRequest,
+) (resp *gen.DescribeScheduleResponse, retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendDescribeScheduleScope, describeRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	if describeRequest == nil {
+		return nil, wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(describeRequest); !ok {
+		return nil, wh.error(createServiceBusyError(), scope)
+	}
+
+	if err := wh.validateScheduleID(describeRequest.GetDomain(), describeRequest.GetScheduleId(), scope); err != nil {
+		return nil, err
+	}
+
+	workflowID := systemworkflow.GetScheduleWorkflowID(describeRequest.GetDomain(), describeRequest.GetScheduleId())
+	describeResp, err := wh.DescribeWorkflowExecution(ctx, &gen.DescribeWorkflowExecutionRequest{
+		Domain:    common.StringPtr(common.SystemGlobalDomainName),
+		Execution: &gen.WorkflowExecution{WorkflowId: common.StringPtr(workflowID)},
+	})
+	if err != nil {
+		if _, ok := err.(*gen.EntityNotExistsError); ok {
+			return nil, wh.error(errScheduleNotFound, scope)
+		}
+		return nil, wh.error(err, scope)
+	}
+	// a deleted schedule leaves a closed workflow behind
+	if describeResp.WorkflowExecutionInfo.CloseStatus != nil {
+		return nil, wh.error(errScheduleNotFound, scope)
+	}
+
+	queryResp, err := wh.QueryWorkflow(ctx, &gen.QueryWorkflowRequest{
+		Domain:    common.StringPtr(common.SystemGlobalDomainName),
+		Execution: describeResp.WorkflowExecutionInfo.Execution,
+		Query:     &gen.WorkflowQuery{QueryType: common.StringPtr(systemworkflow.QueryTypeDescribe)},
+	})
+	if err != nil {
+		return nil, wh.error(err, scope)
+	}
+	resp = &gen.DescribeScheduleResponse{}
+	if err := json.Unmarshal(queryResp.QueryResult, resp); err != nil {
+		return nil, wh.error(err, scope)
+	}
+
+	return resp, nil
+}
+
+// UpdateSchedule replaces the spec, action and / or policies of a schedule. Fields not set on the request
+// are left unchanged.
+func (wh *WorkflowHandler) UpdateSchedule(
+	ctx context.Context,
+	updateRequest *gen.UpdateScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendUpdateScheduleScope, updateRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if updateRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(updateRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if err := wh.validateScheduleID(updateRequest.GetDomain(), updateRequest.GetScheduleId(), scope); err != nil {
+		return err
+	}
+
+	if updateRequest.Spec != nil {
+		if err := validateScheduleSpec(updateRequest.Spec); err != nil {
+			return wh.error(err, scope)
+		}
+	}
+
+	if updateRequest.Action != nil {
+		if err := wh.validateScheduleAction(updateRequest.Action); err != nil {
+			return wh.error(err, scope)
+		}
+	}
+
+	err := wh.signalSchedule(ctx, updateRequest.GetDomain(), updateRequest.GetScheduleId(), systemworkflow.SignalNameUpdate,
+		updateRequest, updateRequest.Identity)
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
+// PauseSchedule pauses or unpauses a schedule. A paused schedule takes no action until it is unpaused.
+func (wh *WorkflowHandler) PauseSchedule(
+	ctx context.Context,
+	pauseRequest *gen.PauseScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendPauseScheduleScope, pauseRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if pauseRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(pauseRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if err := wh.validateScheduleID(pauseRequest.GetDomain(), pauseRequest.GetScheduleId(), scope); err != nil {
+		return err
+	}
+
+	err := wh.signalSchedule(ctx, pauseRequest.GetDomain(), pauseRequest.GetScheduleId(), systemworkflow.SignalNamePause,
+		pauseRequest, pauseRequest.Identity)
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
+// BackfillSchedule takes the actions a schedule would have taken in the given time range.
+func (wh *WorkflowHandler) BackfillSchedule(
+	ctx context.Context,
+	backfillRequest *gen.BackfillScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendBackfillScheduleScope, backfillRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if backfillRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(backfillRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if err := wh.validateScheduleID(backfillRequest.GetDomain(), backfillRequest.GetScheduleId(), scope); err != nil {
+		return err
+	}
+
+	if backfillRequest.StartTime == nil || backfillRequest.EndTime == nil ||
+		backfillRequest.GetStartTime() >= backfillRequest.GetEndTime() {
+		return wh.error(errInvalidBackfillTimeRange, scope)
+	}
+
+	err := wh.signalSchedule(ctx, backfillRequest.GetDomain(), backfillRequest.GetScheduleId(), systemworkflow.SignalNameBackfill,
+		backfillRequest, backfillRequest.Identity)
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
+// DeleteSchedule deletes a schedule. Workflows already started by the schedule are left running.
+func (wh *WorkflowHandler) DeleteSchedule(
+	ctx context.Context,
+	deleteRequest *gen.DeleteScheduleRequest,
+) (retError error) {
+	defer log.CapturePanic(wh.GetLogger(), &retError)
+
+	scope, sw := wh.startRequestProfileWithDomain(metrics.FrontendDeleteScheduleScope, deleteRequest)
+	defer sw.Stop()
+
+	if err := wh.versionChecker.checkClientVersion(ctx); err != nil {
+		return wh.error(err, scope)
+	}
+
+	if deleteRequest == nil {
+		return wh.error(errRequestNotSet, scope)
+	}
+
+	if ok := wh.allow(deleteRequest); !ok {
+		return wh.error(createServiceBusyError(), scope)
+	}
+
+	if err := wh.validateScheduleID(deleteRequest.GetDomain(), deleteRequest.GetScheduleId(), scope); err != nil {
+		return err
+	}
+
+	err := wh.signalSchedule(ctx, deleteRequest.GetDomain(), deleteRequest.GetScheduleId(), systemworkflow.SignalNameDelete,
+		deleteRequest, deleteRequest.Identity)
+	if err != nil {
+		return wh.error(err, scope)
+	}
+
+	return nil
+}
+
 func (wh *WorkflowHandler) getHistory(
 	scope metrics.Scope,
 	domainID string,
@@ -3040,6 +3735,90 @@ func (wh *WorkflowHandler) validateExecutionAndEmitMetrics(w *gen.WorkflowExecut
 	return nil
 }
 
+func (wh *WorkflowHandler) validateScheduleID(domain string, scheduleID string, scope metrics.Scope) error {
+	if domain == "" {
+		return wh.error(errDomainNotSet, scope)
+	}
+	if len(domain) > wh.config.MaxIDLengthLimit() {
+		return wh.error(errDomainTooLong, scope)
+	}
+	if scheduleID == "" {
+		return wh.error(errScheduleIDNotSet, scope)
+	}
+	if len(scheduleID) > wh.config.MaxIDLengthLimit() {
+		return wh.error(errScheduleIDTooLong, scope)
+	}
+	return nil
+}
+
+func (wh *WorkflowHandler) validateScheduleAction(action *gen.ScheduleAction) error {
+	if action == nil || action.StartWorkflow == nil {
+		return errScheduleActionNotSet
+	}
+	startWorkflow := action.StartWorkflow
+	if len(startWorkflow.GetWorkflowIdPrefix()) > wh.config.MaxIDLengthLimit() {
+		return errWorkflowIDTooLong
+	}
+	if startWorkflow.WorkflowType == nil || startWorkflow.WorkflowType.GetName() == "" {
+		return errWorkflowTypeNotSet
+	}
+	if len(startWorkflow.WorkflowType.GetName()) > wh.config.MaxIDLengthLimit() {
+		return errWorkflowTypeTooLong
+	}
+	if startWorkflow.TaskList == nil || startWorkflow.TaskList.GetName() == "" {
+		return errTaskListNotSet
+	}
+	if len(startWorkflow.TaskList.GetName()) > wh.config.MaxIDLengthLimit() {
+		return errTaskListTooLong
+	}
+	if startWorkflow.GetExecutionStartToCloseTimeoutSeconds() <= 0 {
+		return errInvalidExecutionStartToCloseTimeoutSeconds
+	}
+	if startWorkflow.GetTaskStartToCloseTimeoutSeconds() <= 0 {
+		return errInvalidTaskStartToCloseTimeoutSeconds
+	}
+	return nil
+}
+
+// signalSchedule delivers a schedule request to the system workflow backing the schedule
+func (wh *WorkflowHandler) signalSchedule(
+	ctx context.Context,
+	domain string,
+	scheduleID string,
+	signalName string,
+	request interface{},
+	identity *string,
+) error {
+	input, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+	err = wh.SignalWorkflowExecution(ctx, &gen.SignalWorkflowExecutionRequest{
+		Domain: common.StringPtr(common.SystemGlobalDomainName),
+		WorkflowExecution: &gen.WorkflowExecution{
+			WorkflowId: common.StringPtr(systemworkflow.GetScheduleWorkflowID(domain, scheduleID)),
+		},
+		SignalName: common.StringPtr(signalName),
+		Input:      input,
+		Identity:   identity,
+		RequestId:  common.StringPtr(uuid.New()),
+	})
+	if _, ok := err.(*gen.EntityNotExistsError); ok {
+		return errScheduleNotFound
+	}
+	return err
+}
+
+func validateScheduleSpec(spec *gen.ScheduleSpec) error {
+	if spec == nil || spec.GetCronExpression() == "" {
+		return errScheduleSpecNotSet
+	}
+	if backoff.GetNextScheduleTime(spec.GetCronExpression(), time.Now()).IsZero() {
+		return errInvalidCronExpression
+	}
+	return backoff.ValidateCronMaxJitter(spec.GetMaxJitterSeconds())
+}
+
 func validateExecution(w *gen.WorkflowExecution) error {
 	if w == nil {
 		return errExecutionNotSet
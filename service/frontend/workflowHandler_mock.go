@@ -138,6 +138,29 @@ func (_m *MockWorkflowHandler) DescribeWorkflowExecution(ctx context.Context, De
 	return r0, r1
 }
 
+// DescribeSchedule provides a mock function with given fields: ctx, DescribeRequest
+func (_m *MockWorkflowHandler) DescribeSchedule(ctx context.Context, DescribeRequest *shared.DescribeScheduleRequest) (*shared.DescribeScheduleResponse, error) {
+	ret := _m.Called(ctx, DescribeRequest)
+
+	var r0 *shared.DescribeScheduleResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.DescribeScheduleRequest) *shared.DescribeScheduleResponse); ok {
+		r0 = rf(ctx, DescribeRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.DescribeScheduleResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.DescribeScheduleRequest) error); ok {
+		r1 = rf(ctx, DescribeRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSearchAttributes provides a mock function with given fields: ctx
 func (_m *MockWorkflowHandler) GetSearchAttributes(ctx context.Context) (*shared.GetSearchAttributesResponse, error) {
 	ret := _m.Called(ctx)
@@ -646,6 +669,29 @@ func (_m *MockWorkflowHandler) SignalWithStartWorkflowExecution(ctx context.Cont
 	return r0, r1
 }
 
+// UpdateWorkflowExecution provides a mock function with given fields: ctx, UpdateRequest
+func (_m *MockWorkflowHandler) UpdateWorkflowExecution(ctx context.Context, UpdateRequest *shared.UpdateWorkflowExecutionRequest) (*shared.UpdateWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, UpdateRequest)
+
+	var r0 *shared.UpdateWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.UpdateWorkflowExecutionRequest) *shared.UpdateWorkflowExecutionResponse); ok {
+		r0 = rf(ctx, UpdateRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.UpdateWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *shared.UpdateWorkflowExecutionRequest) error); ok {
+		r1 = rf(ctx, UpdateRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SignalWorkflowExecution provides a mock function with given fields: ctx, SignalRequest
 func (_m *MockWorkflowHandler) SignalWorkflowExecution(ctx context.Context, SignalRequest *shared.SignalWorkflowExecutionRequest) error {
 	ret := _m.Called(ctx, SignalRequest)
@@ -660,6 +706,90 @@ func (_m *MockWorkflowHandler) SignalWorkflowExecution(ctx context.Context, Sign
 	return r0
 }
 
+// CancelDelayedSignal provides a mock function with given fields: ctx, CancelRequest
+func (_m *MockWorkflowHandler) CancelDelayedSignal(ctx context.Context, CancelRequest *shared.CancelDelayedSignalRequest) error {
+	ret := _m.Called(ctx, CancelRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.CancelDelayedSignalRequest) error); ok {
+		r0 = rf(ctx, CancelRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateSchedule provides a mock function with given fields: ctx, CreateRequest
+func (_m *MockWorkflowHandler) CreateSchedule(ctx context.Context, CreateRequest *shared.CreateScheduleRequest) error {
+	ret := _m.Called(ctx, CreateRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.CreateScheduleRequest) error); ok {
+		r0 = rf(ctx, CreateRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateSchedule provides a mock function with given fields: ctx, UpdateRequest
+func (_m *MockWorkflowHandler) UpdateSchedule(ctx context.Context, UpdateRequest *shared.UpdateScheduleRequest) error {
+	ret := _m.Called(ctx, UpdateRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.UpdateScheduleRequest) error); ok {
+		r0 = rf(ctx, UpdateRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PauseSchedule provides a mock function with given fields: ctx, PauseRequest
+func (_m *MockWorkflowHandler) PauseSchedule(ctx context.Context, PauseRequest *shared.PauseScheduleRequest) error {
+	ret := _m.Called(ctx, PauseRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.PauseScheduleRequest) error); ok {
+		r0 = rf(ctx, PauseRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BackfillSchedule provides a mock function with given fields: ctx, BackfillRequest
+func (_m *MockWorkflowHandler) BackfillSchedule(ctx context.Context, BackfillRequest *shared.BackfillScheduleRequest) error {
+	ret := _m.Called(ctx, BackfillRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.BackfillScheduleRequest) error); ok {
+		r0 = rf(ctx, BackfillRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteSchedule provides a mock function with given fields: ctx, DeleteRequest
+func (_m *MockWorkflowHandler) DeleteSchedule(ctx context.Context, DeleteRequest *shared.DeleteScheduleRequest) error {
+	ret := _m.Called(ctx, DeleteRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.DeleteScheduleRequest) error); ok {
+		r0 = rf(ctx, DeleteRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // StartWorkflowExecution provides a mock function with given fields: ctx, StartRequest
 func (_m *MockWorkflowHandler) StartWorkflowExecution(ctx context.Context, StartRequest *shared.StartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error) {
 	ret := _m.Called(ctx, StartRequest)
@@ -697,6 +827,34 @@ func (_m *MockWorkflowHandler) TerminateWorkflowExecution(ctx context.Context, T
 	return r0
 }
 
+// PauseWorkflowExecution provides a mock function with given fields: ctx, PauseRequest
+func (_m *MockWorkflowHandler) PauseWorkflowExecution(ctx context.Context, PauseRequest *shared.PauseWorkflowExecutionRequest) error {
+	ret := _m.Called(ctx, PauseRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.PauseWorkflowExecutionRequest) error); ok {
+		r0 = rf(ctx, PauseRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnpauseWorkflowExecution provides a mock function with given fields: ctx, UnpauseRequest
+func (_m *MockWorkflowHandler) UnpauseWorkflowExecution(ctx context.Context, UnpauseRequest *shared.UnpauseWorkflowExecutionRequest) error {
+	ret := _m.Called(ctx, UnpauseRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *shared.UnpauseWorkflowExecutionRequest) error); ok {
+		r0 = rf(ctx, UnpauseRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateDomain provides a mock function with given fields: ctx, UpdateRequest
 func (_m *MockWorkflowHandler) UpdateDomain(ctx context.Context, UpdateRequest *shared.UpdateDomainRequest) (*shared.UpdateDomainResponse, error) {
 	ret := _m.Called(ctx, UpdateRequest)
@@ -22,6 +22,7 @@ package frontend
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
@@ -31,6 +32,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber-go/tally"
+	h "github.com/uber/cadence/.gen/go/history"
 	"github.com/uber/cadence/.gen/go/shared"
 	gen "github.com/uber/cadence/.gen/go/shared"
 	workflow "github.com/uber/cadence/.gen/go/shared"
@@ -48,6 +50,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	cs "github.com/uber/cadence/common/service"
 	dc "github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/common/systemworkflow"
 )
 
 const (
@@ -484,6 +487,134 @@ func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidTaskStar
 	assert.Equal(s.T(), errInvalidTaskStartToCloseTimeoutSeconds, err)
 }
 
+func (s *workflowHandlerSuite) TestStartWorkflowExecution_Failed_InvalidDelayStartSeconds() {
+	config := s.newConfig()
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+
+	startWorkflowExecutionRequest := &shared.StartWorkflowExecutionRequest{
+		Domain:     common.StringPtr("test-domain"),
+		WorkflowId: common.StringPtr("workflow-id"),
+		WorkflowType: &shared.WorkflowType{
+			Name: common.StringPtr("workflow-type"),
+		},
+		TaskList: &shared.TaskList{
+			Name: common.StringPtr("task-list"),
+		},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+		DelayStartSeconds:                   common.Int32Ptr(-1),
+		RequestId:                           common.StringPtr(uuid.New()),
+	}
+	_, err := wh.StartWorkflowExecution(context.Background(), startWorkflowExecutionRequest)
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), errInvalidDelayStartSeconds, err)
+}
+
+func (s *workflowHandlerSuite) TestSignalWorkflowExecution_Failed_DelayedRequestIdNotSet() {
+	config := s.newConfig()
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+
+	signalRequest := &shared.SignalWorkflowExecutionRequest{
+		Domain: common.StringPtr("test-domain"),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr("workflow-id"),
+		},
+		SignalName:   common.StringPtr("signal-name"),
+		DelaySeconds: common.Int32Ptr(30),
+	}
+	err := wh.SignalWorkflowExecution(context.Background(), signalRequest)
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), errRequestIDNotSet, err)
+}
+
+func (s *workflowHandlerSuite) getValidCreateScheduleRequest() *shared.CreateScheduleRequest {
+	return &shared.CreateScheduleRequest{
+		Domain:     common.StringPtr("test-domain"),
+		ScheduleId: common.StringPtr("schedule-id"),
+		Spec:       &shared.ScheduleSpec{CronExpression: common.StringPtr("*/5 * * * *")},
+		Action: &shared.ScheduleAction{
+			StartWorkflow: &shared.StartWorkflowAction{
+				WorkflowType:                        &shared.WorkflowType{Name: common.StringPtr("workflow-type")},
+				TaskList:                            &shared.TaskList{Name: common.StringPtr("task-list")},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(60),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+			},
+		},
+	}
+}
+
+func (s *workflowHandlerSuite) TestCreateSchedule_Failed_ScheduleIdNotSet() {
+	config := s.newConfig()
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+
+	createRequest := s.getValidCreateScheduleRequest()
+	createRequest.ScheduleId = nil
+	err := wh.CreateSchedule(context.Background(), createRequest)
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), errScheduleIDNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestCreateSchedule_Failed_InvalidCronExpression() {
+	config := s.newConfig()
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+
+	createRequest := s.getValidCreateScheduleRequest()
+	createRequest.Spec.CronExpression = common.StringPtr("invalid cron")
+	err := wh.CreateSchedule(context.Background(), createRequest)
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), errInvalidCronExpression, err)
+}
+
+func (s *workflowHandlerSuite) TestCreateSchedule_Failed_ActionNotSet() {
+	config := s.newConfig()
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+
+	createRequest := s.getValidCreateScheduleRequest()
+	createRequest.Action = nil
+	err := wh.CreateSchedule(context.Background(), createRequest)
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), errScheduleActionNotSet, err)
+
+	createRequest = s.getValidCreateScheduleRequest()
+	createRequest.Action.StartWorkflow.TaskList = nil
+	err = wh.CreateSchedule(context.Background(), createRequest)
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), errTaskListNotSet, err)
+}
+
+func (s *workflowHandlerSuite) TestBackfillSchedule_Failed_InvalidTimeRange() {
+	config := s.newConfig()
+	config.RPS = dc.GetIntPropertyFn(10)
+	wh := s.getWorkflowHandler(config)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+
+	backfillRequest := &shared.BackfillScheduleRequest{
+		Domain:     common.StringPtr("test-domain"),
+		ScheduleId: common.StringPtr("schedule-id"),
+		StartTime:  common.Int64Ptr(200),
+		EndTime:    common.Int64Ptr(100),
+	}
+	err := wh.BackfillSchedule(context.Background(), backfillRequest)
+	assert.Error(s.T(), err)
+	assert.Equal(s.T(), errInvalidBackfillTimeRange, err)
+}
+
 func (s *workflowHandlerSuite) getWorkflowHandlerWithParams(mService cs.Service, config *Config,
 	mMetadataManager persistence.MetadataManager) *WorkflowHandler {
 	domainCache := cache.NewDomainCache(mMetadataManager, mService.GetClusterMetadata(), mService.GetMetricsClient(), mService.GetLogger())
@@ -978,6 +1109,137 @@ func (s *workflowHandlerSuite) TestUpdateDomain_Success_ArchivalNeverEnabledToEn
 	assert.Equal(s.T(), testVisibilityArchivalURI, result.Configuration.GetVisibilityArchivalURI())
 }
 
+func (s *workflowHandlerSuite) TestUpdateDomain_AutoResetForBadBinaries() {
+	config := s.newConfig()
+	mMetadataManager := &mocks.MetadataManager{}
+	mMetadataManager.On("GetMetadata").Return(&persistence.GetMetadataResponse{
+		NotificationVersion: int64(0),
+	}, nil)
+	getDomainResp := persistenceGetDomainResponse(
+		&archivalState{status: shared.ArchivalStatusDisabled, URI: ""},
+		&archivalState{status: shared.ArchivalStatusDisabled, URI: ""},
+	)
+	mMetadataManager.On("GetDomain", mock.Anything).Return(getDomainResp, nil)
+	var calls []string
+	// the persisted bad binary info of each domain update
+	var updates []shared.BadBinaryInfo
+	mMetadataManager.On("UpdateDomain", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		calls = append(calls, "UpdateDomain")
+		request := args.Get(0).(*persistence.UpdateDomainRequest)
+		updates = append(updates, *request.Config.BadBinaries.Binaries["bad-checksum"])
+	})
+	s.mockClusterMetadata.On("IsGlobalDomainEnabled").Return(false)
+	s.mockClusterMetadata.On("GetAllClusterInfo").Return(cluster.TestAllClusterInfo)
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	s.mockArchivalMetadata.On("GetHistoryConfig").Return(archiver.NewArchivalConfig("disabled", false, "disabled", ""))
+	s.mockArchivalMetadata.On("GetVisibilityConfig").Return(archiver.NewArchivalConfig("disabled", false, "disabled", ""))
+	mService := cs.NewTestService(s.mockClusterMetadata, s.mockMessagingClient, s.mockMetricClient, s.mockClientBean, s.mockArchivalMetadata, s.mockArchiverProvider)
+	wh := s.getWorkflowHandlerWithParams(mService, config, mMetadataManager)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+	mockHistoryClient := &mocks.HistoryClient{}
+	wh.history = mockHistoryClient
+
+	var startRequest *h.StartWorkflowExecutionRequest
+	mockHistoryClient.On("StartWorkflowExecution", mock.Anything, mock.Anything).Return(&shared.StartWorkflowExecutionResponse{
+		RunId: common.StringPtr(testRunID),
+	}, nil).Run(func(args mock.Arguments) {
+		calls = append(calls, "StartWorkflowExecution")
+		startRequest = args.Get(1).(*h.StartWorkflowExecutionRequest)
+	}).Once()
+
+	newUpdateRequest := func(data map[string]string) *shared.UpdateDomainRequest {
+		return &shared.UpdateDomainRequest{
+			Name:        common.StringPtr("test-name"),
+			UpdatedInfo: &shared.UpdateDomainInfo{Data: data},
+			Configuration: &shared.DomainConfiguration{
+				BadBinaries: &shared.BadBinaries{
+					Binaries: map[string]*shared.BadBinaryInfo{
+						"bad-checksum": {
+							Reason:             common.StringPtr("test reason"),
+							AutoResetExecution: &shared.WorkflowExecution{WorkflowId: common.StringPtr(testWorkflowID)},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	// auto reset is not enabled, execution set by client is dropped
+	_, err := wh.UpdateDomain(context.Background(), newUpdateRequest(nil))
+	s.NoError(err)
+	s.Equal([]string{"UpdateDomain"}, calls)
+	s.Nil(updates[0].AutoResetExecution)
+
+	// auto reset is enabled by the update request, the workflow is started after the domain is updated
+	// and the started execution is recorded by a second update
+	calls = nil
+	updates = nil
+	resp, err := wh.UpdateDomain(context.Background(), newUpdateRequest(map[string]string{cache.AutoResetBadBinariesKey: "true"}))
+	s.NoError(err)
+	s.Equal([]string{"UpdateDomain", "StartWorkflowExecution", "UpdateDomain"}, calls)
+	s.Nil(updates[0].AutoResetExecution)
+	expectedExecution := &shared.WorkflowExecution{
+		WorkflowId: common.StringPtr(systemworkflow.GetAutoResetWorkflowID("test-name", "bad-checksum")),
+		RunId:      common.StringPtr(testRunID),
+	}
+	s.Equal(expectedExecution, updates[1].AutoResetExecution)
+	s.Equal("test reason", updates[1].GetReason())
+	s.Equal(expectedExecution, resp.Configuration.BadBinaries.Binaries["bad-checksum"].AutoResetExecution)
+
+	var params systemworkflow.AutoResetParams
+	s.NoError(json.Unmarshal(startRequest.StartRequest.Input, &params))
+	s.Equal(systemworkflow.AutoResetParams{
+		DomainName:     "test-name",
+		BinaryChecksum: "bad-checksum",
+		Reason:         "test reason",
+	}, params)
+	s.Equal(shared.WorkflowIdReusePolicyRejectDuplicate, startRequest.StartRequest.GetWorkflowIdReusePolicy())
+	s.Equal(updates[0].GetCreatedTimeNano(), updates[1].GetCreatedTimeNano())
+
+	// the recorded auto reset of a re-submitted bad binary is kept, the workflow is not started again
+	calls = nil
+	updates = nil
+	_, err = wh.UpdateDomain(context.Background(), newUpdateRequest(map[string]string{cache.AutoResetBadBinariesKey: "true"}))
+	s.NoError(err)
+	s.Equal([]string{"UpdateDomain"}, calls)
+	s.Equal(expectedExecution, updates[0].AutoResetExecution)
+
+	// a retry of an update which failed to record the auto reset records the run started by the
+	// earlier update instead of starting a new one
+	getDomainResp.Config.BadBinaries = shared.BadBinaries{}
+	firstRequestID := startRequest.StartRequest.GetRequestId()
+	mockHistoryClient.On("StartWorkflowExecution", mock.Anything, mock.Anything).Return(nil, &shared.WorkflowExecutionAlreadyStartedError{
+		RunId: common.StringPtr("earlier-run-id"),
+	}).Run(func(args mock.Arguments) {
+		calls = append(calls, "StartWorkflowExecution")
+		startRequest = args.Get(1).(*h.StartWorkflowExecutionRequest)
+	}).Once()
+	calls = nil
+	updates = nil
+	resp, err = wh.UpdateDomain(context.Background(), newUpdateRequest(map[string]string{cache.AutoResetBadBinariesKey: "true"}))
+	s.NoError(err)
+	s.Equal([]string{"UpdateDomain", "StartWorkflowExecution", "UpdateDomain"}, calls)
+	s.Equal(firstRequestID, startRequest.StartRequest.GetRequestId())
+	s.Equal("earlier-run-id", updates[1].AutoResetExecution.GetRunId())
+	s.Equal(updates[0].GetCreatedTimeNano(), updates[1].GetCreatedTimeNano())
+
+	// the auto reset workflow is not started if the domain update fails
+	calls = nil
+	failedMetadataManager := &mocks.MetadataManager{}
+	failedMetadataManager.On("GetMetadata").Return(&persistence.GetMetadataResponse{}, nil)
+	failedMetadataManager.On("GetDomain", mock.Anything).Return(getDomainResp, nil)
+	failedMetadataManager.On("UpdateDomain", mock.Anything).Return(errors.New("some random error"))
+	wh = s.getWorkflowHandlerWithParams(mService, config, failedMetadataManager)
+	wh.metricsClient = wh.Service.GetMetricsClient()
+	wh.startWG.Done()
+	wh.history = mockHistoryClient
+	_, err = wh.UpdateDomain(context.Background(), newUpdateRequest(map[string]string{cache.AutoResetBadBinariesKey: "true"}))
+	s.Error(err)
+	s.Empty(calls)
+	mockHistoryClient.AssertExpectations(s.T())
+}
+
 func (s *workflowHandlerSuite) TestHistoryArchived() {
 	wh := &WorkflowHandler{}
 	getHistoryRequest := &shared.GetWorkflowExecutionHistoryRequest{}
@@ -337,6 +337,44 @@ func (_m *MockHistoryEngine) SignalWorkflowExecution(ctx context.Context, reques
 	return r0
 }
 
+// CancelDelayedSignal is mock implementation for CancelDelayedSignal of HistoryEngine
+func (_m *MockHistoryEngine) CancelDelayedSignal(ctx context.Context, request *gohistory.CancelDelayedSignalRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.CancelDelayedSignalRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateWorkflowExecution is mock implementation for UpdateWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) UpdateWorkflowExecution(ctx context.Context, request *gohistory.UpdateWorkflowExecutionRequest) (
+	*shared.UpdateWorkflowExecutionResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *shared.UpdateWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.UpdateWorkflowExecutionRequest) *shared.UpdateWorkflowExecutionResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.UpdateWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.UpdateWorkflowExecutionRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SignalWithStartWorkflowExecution is mock implementation for SignalWithStartWorkflowExecution of HistoryEngine
 func (_m *MockHistoryEngine) SignalWithStartWorkflowExecution(ctx context.Context, request *gohistory.SignalWithStartWorkflowExecutionRequest) (
 	*shared.StartWorkflowExecutionResponse, error) {
@@ -389,6 +427,34 @@ func (_m *MockHistoryEngine) TerminateWorkflowExecution(ctx context.Context, req
 	return r0
 }
 
+// PauseWorkflowExecution is mock implementation for PauseWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) PauseWorkflowExecution(ctx context.Context, request *gohistory.PauseWorkflowExecutionRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.PauseWorkflowExecutionRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnpauseWorkflowExecution is mock implementation for UnpauseWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) UnpauseWorkflowExecution(ctx context.Context, request *gohistory.UnpauseWorkflowExecutionRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.UnpauseWorkflowExecutionRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResetWorkflowExecution is mock implementation for TerminateWorkflowExecution of HistoryEngine
 func (_m *MockHistoryEngine) ResetWorkflowExecution(ctx context.Context, request *gohistory.ResetWorkflowExecutionRequest) (*shared.ResetWorkflowExecutionResponse, error) {
 	ret := _m.Called(request)
@@ -496,6 +562,94 @@ func (_m *MockHistoryEngine) SyncActivity(ctx context.Context, request *gohistor
 	return r0
 }
 
+// DescribeShardQueues is mock implementation for DescribeShardQueues of HistoryEngine
+func (_m *MockHistoryEngine) DescribeShardQueues(ctx context.Context, request *shared.DescribeShardQueuesRequest) (*shared.DescribeShardQueuesResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.DescribeShardQueuesResponse
+	if rf, ok := ret.Get(0).(func(*shared.DescribeShardQueuesRequest) *shared.DescribeShardQueuesResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.DescribeShardQueuesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*shared.DescribeShardQueuesRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListShardTasks is mock implementation for ListShardTasks of HistoryEngine
+func (_m *MockHistoryEngine) ListShardTasks(ctx context.Context, request *shared.ListShardTasksRequest) (*shared.ListShardTasksResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.ListShardTasksResponse
+	if rf, ok := ret.Get(0).(func(*shared.ListShardTasksRequest) *shared.ListShardTasksResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ListShardTasksResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*shared.ListShardTasksRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveShardTask is mock implementation for RemoveShardTask of HistoryEngine
+func (_m *MockHistoryEngine) RemoveShardTask(ctx context.Context, request *shared.RemoveShardTaskRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*shared.RemoveShardTaskRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RefreshWorkflowTasks is mock implementation for RefreshWorkflowTasks of HistoryEngine
+func (_m *MockHistoryEngine) RefreshWorkflowTasks(ctx context.Context, request *gohistory.RefreshWorkflowTasksRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.RefreshWorkflowTasksRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteWorkflowExecution is mock implementation for DeleteWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) DeleteWorkflowExecution(ctx context.Context, request *gohistory.DeleteWorkflowExecutionRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.DeleteWorkflowExecutionRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NotifyNewHistoryEvent is mock implementation for NotifyNewHistoryEvent of HistoryEngine
 func (_m *MockHistoryEngine) NotifyNewHistoryEvent(event *historyEventNotification) {
 	_m.Called(event)
@@ -1034,6 +1034,75 @@ func (_m *mockMutableState) AddWorkflowExecutionCanceledEvent(_a0 int64, _a1 *sh
 	return r0, r1
 }
 
+// AddWorkflowExecutionDelayedSignalCanceledEvent provides a mock function with given fields: requestID, identity
+func (_m *mockMutableState) AddWorkflowExecutionDelayedSignalCanceledEvent(requestID string, identity string) (*shared.HistoryEvent, error) {
+	ret := _m.Called(requestID, identity)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(string, string) *shared.HistoryEvent); ok {
+		r0 = rf(requestID, identity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(requestID, identity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddWorkflowExecutionDelayedSignalRequestedEvent provides a mock function with given fields: requestID, signalName, input, control, identity, fireTime
+func (_m *mockMutableState) AddWorkflowExecutionDelayedSignalRequestedEvent(requestID string, signalName string, input []byte, control []byte, identity string, fireTime time.Time) (*shared.HistoryEvent, error) {
+	ret := _m.Called(requestID, signalName, input, control, identity, fireTime)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(string, string, []byte, []byte, string, time.Time) *shared.HistoryEvent); ok {
+		r0 = rf(requestID, signalName, input, control, identity, fireTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, []byte, []byte, string, time.Time) error); ok {
+		r1 = rf(requestID, signalName, input, control, identity, fireTime)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddWorkflowExecutionPausedEvent provides a mock function with given fields: reason, identity
+func (_m *mockMutableState) AddWorkflowExecutionPausedEvent(reason string, identity string) (*shared.HistoryEvent, error) {
+	ret := _m.Called(reason, identity)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(string, string) *shared.HistoryEvent); ok {
+		r0 = rf(reason, identity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(reason, identity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddWorkflowExecutionSignaled provides a mock function with given fields: signalName, input, identity
 func (_m *mockMutableState) AddWorkflowExecutionSignaled(signalName string, input []byte, identity string) (*shared.HistoryEvent, error) {
 	ret := _m.Called(signalName, input, identity)
@@ -1057,6 +1126,29 @@ func (_m *mockMutableState) AddWorkflowExecutionSignaled(signalName string, inpu
 	return r0, r1
 }
 
+// AddWorkflowExecutionSignaledForDelayedSignal provides a mock function with given fields: requestID
+func (_m *mockMutableState) AddWorkflowExecutionSignaledForDelayedSignal(requestID string) (*shared.HistoryEvent, error) {
+	ret := _m.Called(requestID)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(string) *shared.HistoryEvent); ok {
+		r0 = rf(requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AddWorkflowExecutionStartedEvent provides a mock function with given fields: _a0, _a1
 func (_m *mockMutableState) AddWorkflowExecutionStartedEvent(_a0 *cache.DomainCacheEntry, _a1 shared.WorkflowExecution, _a2 *h.StartWorkflowExecutionRequest) (*shared.HistoryEvent, error) {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -1103,6 +1195,75 @@ func (_m *mockMutableState) AddWorkflowExecutionTerminatedEvent(_a0 string, _a1
 	return r0, r1
 }
 
+// AddWorkflowExecutionUnpausedEvent provides a mock function with given fields: reason, identity
+func (_m *mockMutableState) AddWorkflowExecutionUnpausedEvent(reason string, identity string) (*shared.HistoryEvent, error) {
+	ret := _m.Called(reason, identity)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(string, string) *shared.HistoryEvent); ok {
+		r0 = rf(reason, identity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(reason, identity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddWorkflowExecutionUpdateCompletedEvent provides a mock function with given fields: _a0, _a1
+func (_m *mockMutableState) AddWorkflowExecutionUpdateCompletedEvent(_a0 int64, _a1 *shared.CompleteWorkflowExecutionUpdateDecisionAttributes) (*shared.HistoryEvent, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(int64, *shared.CompleteWorkflowExecutionUpdateDecisionAttributes) *shared.HistoryEvent); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int64, *shared.CompleteWorkflowExecutionUpdateDecisionAttributes) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AddWorkflowExecutionUpdateRequestedEvent provides a mock function with given fields: updateName, input, identity, requestID
+func (_m *mockMutableState) AddWorkflowExecutionUpdateRequestedEvent(updateName string, input []byte, identity string, requestID string) (*shared.HistoryEvent, error) {
+	ret := _m.Called(updateName, input, identity, requestID)
+
+	var r0 *shared.HistoryEvent
+	if rf, ok := ret.Get(0).(func(string, []byte, string, string) *shared.HistoryEvent); ok {
+		r0 = rf(updateName, input, identity, requestID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.HistoryEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, []byte, string, string) error); ok {
+		r1 = rf(updateName, input, identity, requestID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CheckResettable provides a mock function with given fields:
 func (_m *mockMutableState) CheckResettable() error {
 	ret := _m.Called()
@@ -1488,6 +1649,29 @@ func (_m *mockMutableState) GetCurrentVersion() int64 {
 	return r0
 }
 
+// GetDelayedSignal provides a mock function with given fields: _a0
+func (_m *mockMutableState) GetDelayedSignal(_a0 string) (*persistence.DelayedSignalInfo, bool) {
+	ret := _m.Called(_a0)
+
+	var r0 *persistence.DelayedSignalInfo
+	if rf, ok := ret.Get(0).(func(string) *persistence.DelayedSignalInfo); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.DelayedSignalInfo)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GetEventStoreVersion provides a mock function with given fields:
 func (_m *mockMutableState) GetEventStoreVersion() int32 {
 	ret := _m.Called()
@@ -1654,6 +1838,22 @@ func (_m *mockMutableState) GetPendingDecision(_a0 int64) (*decisionInfo, bool)
 	return r0, r1
 }
 
+// GetPendingDelayedSignalInfos provides a mock function with given fields:
+func (_m *mockMutableState) GetPendingDelayedSignalInfos() map[string]*persistence.DelayedSignalInfo {
+	ret := _m.Called()
+
+	var r0 map[string]*persistence.DelayedSignalInfo
+	if rf, ok := ret.Get(0).(func() map[string]*persistence.DelayedSignalInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*persistence.DelayedSignalInfo)
+		}
+	}
+
+	return r0
+}
+
 // GetPendingTimerInfos provides a mock function with given fields:
 func (_m *mockMutableState) GetPendingTimerInfos() map[string]*persistence.TimerInfo {
 	ret := _m.Called()
@@ -1962,6 +2162,27 @@ func (_m *mockMutableState) IsSignalRequested(requestID string) bool {
 	return r0
 }
 
+// GetUpdateRequestedEventID provides a mock function with given fields: requestID
+func (_m *mockMutableState) GetUpdateRequestedEventID(requestID string) (int64, bool) {
+	ret := _m.Called(requestID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(string) int64); ok {
+		r0 = rf(requestID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(requestID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // IsStickyTaskListEnabled provides a mock function with given fields:
 func (_m *mockMutableState) IsStickyTaskListEnabled() bool {
 	ret := _m.Called()
@@ -1976,6 +2197,20 @@ func (_m *mockMutableState) IsStickyTaskListEnabled() bool {
 	return r0
 }
 
+// IsWorkflowExecutionPaused provides a mock function with given fields:
+func (_m *mockMutableState) IsWorkflowExecutionPaused() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // IsWorkflowExecutionRunning provides a mock function with given fields:
 func (_m *mockMutableState) IsWorkflowExecutionRunning() bool {
 	ret := _m.Called()
@@ -1990,6 +2225,20 @@ func (_m *mockMutableState) IsWorkflowExecutionRunning() bool {
 	return r0
 }
 
+// IsWorkflowPendingOnDecisionBackoff provides a mock function with given fields:
+func (_m *mockMutableState) IsWorkflowPendingOnDecisionBackoff() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // Load provides a mock function with given fields: _a0
 func (_m *mockMutableState) Load(_a0 *persistence.WorkflowMutableState) {
 	_m.Called(_a0)
@@ -2251,13 +2500,13 @@ func (_m *mockMutableState) ReplicateDecisionTaskScheduledEvent(_a0 int64, _a1 i
 	return r0, r1
 }
 
-// ReplicateDecisionTaskStartedEvent provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4, _a5
-func (_m *mockMutableState) ReplicateDecisionTaskStartedEvent(_a0 *decisionInfo, _a1 int64, _a2 int64, _a3 int64, _a4 string, _a5 int64) (*decisionInfo, error) {
-	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5)
+// ReplicateDecisionTaskStartedEvent provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4, _a5, _a6
+func (_m *mockMutableState) ReplicateDecisionTaskStartedEvent(_a0 *decisionInfo, _a1 int64, _a2 int64, _a3 int64, _a4 string, _a5 int64, _a6 string) (*decisionInfo, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5, _a6)
 
 	var r0 *decisionInfo
-	if rf, ok := ret.Get(0).(func(*decisionInfo, int64, int64, int64, string, int64) *decisionInfo); ok {
-		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
+	if rf, ok := ret.Get(0).(func(*decisionInfo, int64, int64, int64, string, int64, string) *decisionInfo); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*decisionInfo)
@@ -2265,8 +2514,8 @@ func (_m *mockMutableState) ReplicateDecisionTaskStartedEvent(_a0 *decisionInfo,
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*decisionInfo, int64, int64, int64, string, int64) error); ok {
-		r1 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
+	if rf, ok := ret.Get(1).(func(*decisionInfo, int64, int64, int64, string, int64, string) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2576,6 +2825,76 @@ func (_m *mockMutableState) ReplicateWorkflowExecutionFailedEvent(_a0 int64, _a1
 	return r0
 }
 
+// ReplicateWorkflowExecutionDelayedSignalCanceledEvent provides a mock function with given fields: _a0
+func (_m *mockMutableState) ReplicateWorkflowExecutionDelayedSignalCanceledEvent(_a0 *shared.HistoryEvent) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*shared.HistoryEvent) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReplicateWorkflowExecutionDelayedSignalRequestedEvent provides a mock function with given fields: _a0
+func (_m *mockMutableState) ReplicateWorkflowExecutionDelayedSignalRequestedEvent(_a0 *shared.HistoryEvent) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*shared.HistoryEvent) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReplicateWorkflowExecutionPausedEvent provides a mock function with given fields: _a0
+func (_m *mockMutableState) ReplicateWorkflowExecutionPausedEvent(_a0 *shared.HistoryEvent) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*shared.HistoryEvent) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReplicateWorkflowExecutionUpdateRequestedEvent provides a mock function with given fields: _a0
+func (_m *mockMutableState) ReplicateWorkflowExecutionUpdateRequestedEvent(_a0 *shared.HistoryEvent) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*shared.HistoryEvent) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReplicateWorkflowExecutionUpdateCompletedEvent provides a mock function with given fields: _a0
+func (_m *mockMutableState) ReplicateWorkflowExecutionUpdateCompletedEvent(_a0 *shared.HistoryEvent) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*shared.HistoryEvent) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ReplicateWorkflowExecutionSignaled provides a mock function with given fields: _a0
 func (_m *mockMutableState) ReplicateWorkflowExecutionSignaled(_a0 *shared.HistoryEvent) error {
 	ret := _m.Called(_a0)
@@ -2632,6 +2951,20 @@ func (_m *mockMutableState) ReplicateWorkflowExecutionTimedoutEvent(_a0 int64, _
 	return r0
 }
 
+// ReplicateWorkflowExecutionUnpausedEvent provides a mock function with given fields: _a0
+func (_m *mockMutableState) ReplicateWorkflowExecutionUnpausedEvent(_a0 *shared.HistoryEvent) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*shared.HistoryEvent) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetHistoryBuilder provides a mock function with given fields: hBuilder
 func (_m *mockMutableState) SetHistoryBuilder(hBuilder *historyBuilder) {
 	_m.Called(hBuilder)
@@ -109,6 +109,19 @@ func (_m *MockQueueAckMgr) getQueueReadLevel() int64 {
 	return r0
 }
 
+// getQueueOutstandingTaskCount is mock implementation for getQueueOutstandingTaskCount of QueueAckMgr
+func (_m *MockQueueAckMgr) getQueueOutstandingTaskCount() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	return r0
+}
+
 // updateQueueAckLevel is mock implementation for updateQueueAckLevel of QueueAckMgr
 func (_m *MockQueueAckMgr) updateQueueAckLevel() {
 	_m.Called()
@@ -120,6 +120,18 @@ func (_m *MockTimerQueueAckMgr) getReadLevel() TimerSequenceID {
 	return r0
 }
 
+func (_m *MockTimerQueueAckMgr) getOutstandingTaskCount() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+	return r0
+}
+
 func (_m *MockTimerQueueAckMgr) updateAckLevel() {
 	_m.Called()
 }
@@ -22,6 +22,7 @@ package history
 
 import (
 	"github.com/stretchr/testify/mock"
+	"github.com/uber/cadence/.gen/go/shared"
 
 	"github.com/uber/cadence/common/persistence"
 )
@@ -60,3 +61,18 @@ func (_m *MockTimerQueueProcessor) LockTaskPrrocessing() {
 func (_m *MockTimerQueueProcessor) UnlockTaskPrrocessing() {
 	_m.Called()
 }
+
+// describeQueues is mock implementation for describeQueues of Processor
+func (_m *MockTimerQueueProcessor) describeQueues() []*shared.ShardQueueProcessorInfo {
+	ret := _m.Called()
+
+	var r0 []*shared.ShardQueueProcessorInfo
+	if rf, ok := ret.Get(0).(func() []*shared.ShardQueueProcessorInfo); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*shared.ShardQueueProcessorInfo)
+		}
+	}
+	return r0
+}
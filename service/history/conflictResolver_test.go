@@ -342,6 +342,8 @@ func (s *conflictResolverSuite) TestReset() {
 				RequestCancelInfos:  []*persistence.RequestCancelInfo{},
 				SignalInfos:         []*persistence.SignalInfo{},
 				SignalRequestedIDs:  []string{},
+				DelayedSignalInfos:  []*persistence.DelayedSignalInfo{},
+				UpdateRequestedIDs:  map[string]int64{},
 				TransferTasks:       nil,
 				ReplicationTasks:    nil,
 				TimerTasks:          nil,
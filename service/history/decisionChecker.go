@@ -353,6 +353,23 @@ func (v *decisionAttrValidator) validateRecordMarkerAttributes(
 	return nil
 }
 
+func (v *decisionAttrValidator) validateCompleteWorkflowExecutionUpdateAttributes(
+	attributes *workflow.CompleteWorkflowExecutionUpdateDecisionAttributes,
+) error {
+
+	if attributes == nil {
+		return &workflow.BadRequestError{Message: "CompleteWorkflowExecutionUpdateDecisionAttributes is not set on decision."}
+	}
+	if attributes.GetRequestId() == "" {
+		return &workflow.BadRequestError{Message: "RequestId is not set on decision."}
+	}
+	if len(attributes.GetRequestId()) > v.maxIDLengthLimit {
+		return &workflow.BadRequestError{Message: "RequestId exceeds length limit."}
+	}
+
+	return nil
+}
+
 func (v *decisionAttrValidator) validateCompleteWorkflowExecutionAttributes(
 	attributes *workflow.CompleteWorkflowExecutionDecisionAttributes,
 ) error {
@@ -517,6 +534,10 @@ func (v *decisionAttrValidator) validateContinueAsNewWorkflowExecutionAttributes
 		attributes.TaskStartToCloseTimeoutSeconds = common.Int32Ptr(executionInfo.DecisionTimeoutValue)
 	}
 
+	if err := backoff.ValidateCronMaxJitter(attributes.GetCronMaxJitterSeconds()); err != nil {
+		return err
+	}
+
 	domainEntry, err := v.domainCache.GetDomainByID(executionInfo.DomainID)
 	if err != nil {
 		return err
@@ -574,6 +595,10 @@ func (v *decisionAttrValidator) validateStartChildExecutionAttributes(
 		return err
 	}
 
+	if err := backoff.ValidateCronMaxJitter(attributes.GetCronMaxJitterSeconds()); err != nil {
+		return err
+	}
+
 	// Inherit tasklist from parent workflow execution if not provided on decision
 	taskList, err := v.validatedTaskList(attributes.TaskList, parentInfo.TaskList)
 	if err != nil {
@@ -200,6 +200,11 @@ func (handler *decisionHandlerImpl) handleDecisionTaskStarted(
 				return nil, &h.EventAlreadyStartedError{Message: "Decision task already started."}
 			}
 
+			if msBuilder.IsWorkflowExecutionPaused() {
+				// It is OK to drop the task at this point, it is dispatched again once the workflow execution is unpaused.
+				return nil, ErrWorkflowPaused
+			}
+
 			_, di, err = msBuilder.AddDecisionTaskStartedEvent(scheduleID, requestID, req.PollRequest)
 			if err != nil {
 				// Unable to add DecisionTaskStarted event to history
@@ -353,6 +358,7 @@ Update_History_Loop:
 			transferTasks               []persistence.Task
 			timerTasks                  []persistence.Task
 			continueAsNewBuilder        mutableState
+			activitiesToDispatchLocally []int64
 
 			tBuilder           *timerBuilder
 			hasUnhandledEvents bool
@@ -400,6 +406,7 @@ Update_History_Loop:
 				completedEvent.GetEventId(),
 				eventStoreVersion,
 				domainEntry,
+				handler.config.EnableActivityLocalDispatchByDomain(domainName),
 				msBuilder,
 				handler.decisionAttrValidator,
 				workflowSizeChecker,
@@ -433,6 +440,7 @@ Update_History_Loop:
 			timerTasks = append(timerTasks, decisionTaskHandler.timerTasks...)
 
 			continueAsNewBuilder = decisionTaskHandler.continueAsNewBuilder
+			activitiesToDispatchLocally = decisionTaskHandler.activitiesToDispatchLocally
 
 			tBuilder = decisionTaskHandler.timerBuilder
 			hasUnhandledEvents = decisionTaskHandler.hasUnhandledEventsBeforeDecisions
@@ -452,6 +460,7 @@ Update_History_Loop:
 			isComplete = false
 			hasUnhandledEvents = true
 			continueAsNewBuilder = nil
+			activitiesToDispatchLocally = nil
 		}
 
 		if tt := tBuilder.GetUserTimerTaskIfNeeded(msBuilder); tt != nil {
@@ -461,6 +470,8 @@ Update_History_Loop:
 			timerTasks = append(timerTasks, tt)
 		}
 
+		// the new decision task of a paused workflow execution is held back like any other decision task
+		returnNewDecisionTask := request.GetReturnNewDecisionTask() && !msBuilder.IsWorkflowExecutionPaused()
 		// Schedule another decision task if new events came in during this decision or if request forced to
 		createNewDecisionTask := !isComplete && (hasUnhandledEvents ||
 			request.GetForceCreateNewDecisionTask() || activityNotStartedCancelled)
@@ -472,7 +483,7 @@ Update_History_Loop:
 			}
 			newDecisionTaskScheduledID = di.ScheduleID
 			// skip transfer task for decision if request asking to return new decision task
-			if !request.GetReturnNewDecisionTask() {
+			if !returnNewDecisionTask {
 				transferTasks = append(transferTasks, &persistence.DecisionTask{
 					DomainID:   domainID,
 					TaskList:   di.TaskList,
@@ -490,6 +501,7 @@ Update_History_Loop:
 				_, _, err := msBuilder.AddDecisionTaskStartedEvent(di.ScheduleID, "request-from-RespondDecisionTaskCompleted", &workflow.PollForDecisionTaskRequest{
 					TaskList: &workflow.TaskList{Name: common.StringPtr(di.TaskList)},
 					Identity: request.Identity,
+					BuildId:  common.StringPtr(executionInfo.BuildID),
 				})
 				if err != nil {
 					return nil, err
@@ -576,12 +588,21 @@ Update_History_Loop:
 		}
 
 		resp = &h.RespondDecisionTaskCompletedResponse{}
-		if request.GetReturnNewDecisionTask() && createNewDecisionTask {
+		if returnNewDecisionTask && createNewDecisionTask {
 			di, _ := msBuilder.GetPendingDecision(newDecisionTaskScheduledID)
 			resp.StartedResponse = handler.createRecordDecisionTaskStartedResponse(domainID, msBuilder, di, request.GetIdentity())
 			// sticky is always enabled when worker request for new decision task from RespondDecisionTaskCompleted
 			resp.StartedResponse.StickyExecutionEnabled = common.BoolPtr(true)
 		}
+		if !isComplete {
+			for _, scheduleID := range activitiesToDispatchLocally {
+				activityTask, err := handler.createPollForActivityTaskResponse(domainEntry, msBuilder, scheduleID)
+				if err != nil {
+					return nil, err
+				}
+				resp.ActivitiesToDispatchLocally = append(resp.ActivitiesToDispatchLocally, activityTask)
+			}
+		}
 
 		return resp, nil
 	}
@@ -589,6 +610,57 @@ Update_History_Loop:
 	return nil, ErrMaxAttemptsExceeded
 }
 
+func (handler *decisionHandlerImpl) createPollForActivityTaskResponse(
+	domainEntry *cache.DomainCacheEntry,
+	msBuilder mutableState,
+	scheduleID int64,
+) (*workflow.PollForActivityTaskResponse, error) {
+
+	ai, ok := msBuilder.GetActivityInfo(scheduleID)
+	if !ok {
+		return nil, &workflow.InternalServiceError{Message: "Unable to get locally dispatched activity info."}
+	}
+	scheduledEvent, ok := msBuilder.GetActivityScheduledEvent(scheduleID)
+	if !ok {
+		return nil, &workflow.InternalServiceError{Message: "Unable to get activity schedule event."}
+	}
+	attributes := scheduledEvent.ActivityTaskScheduledEventAttributes
+	executionInfo := msBuilder.GetExecutionInfo()
+
+	token, err := handler.tokenSerializer.Serialize(&common.TaskToken{
+		DomainID:        executionInfo.DomainID,
+		WorkflowID:      executionInfo.WorkflowID,
+		RunID:           executionInfo.RunID,
+		ScheduleID:      scheduleID,
+		ScheduleAttempt: int64(ai.Attempt),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &workflow.PollForActivityTaskResponse{
+		TaskToken: token,
+		WorkflowExecution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(executionInfo.WorkflowID),
+			RunId:      common.StringPtr(executionInfo.RunID),
+		},
+		ActivityId:                      attributes.ActivityId,
+		ActivityType:                    attributes.ActivityType,
+		Input:                           attributes.Input,
+		ScheduledTimestamp:              scheduledEvent.Timestamp,
+		ScheduleToCloseTimeoutSeconds:   attributes.ScheduleToCloseTimeoutSeconds,
+		StartedTimestamp:                common.Int64Ptr(ai.StartedTime.UnixNano()),
+		StartToCloseTimeoutSeconds:      attributes.StartToCloseTimeoutSeconds,
+		HeartbeatTimeoutSeconds:         attributes.HeartbeatTimeoutSeconds,
+		Attempt:                         common.Int32Ptr(ai.Attempt),
+		ScheduledTimestampOfThisAttempt: common.Int64Ptr(ai.ScheduledTime.UnixNano()),
+		HeartbeatDetails:                ai.Details,
+		WorkflowType:                    msBuilder.GetWorkflowType(),
+		WorkflowDomain:                  common.StringPtr(domainEntry.GetInfo().Name),
+		Header:                          attributes.Header,
+	}, nil
+}
+
 func (handler *decisionHandlerImpl) createRecordDecisionTaskStartedResponse(
 	domainID string,
 	msBuilder mutableState,
@@ -44,12 +44,14 @@ type (
 		decisionTaskCompletedID int64
 		eventStoreVersion       int32
 		domainEntry             *cache.DomainCacheEntry
+		enableLocalDispatch     bool
 
 		// internal state
 		hasUnhandledEventsBeforeDecisions bool
 		timerBuilder                      *timerBuilder
 		transferTasks                     []persistence.Task
 		timerTasks                        []persistence.Task
+		activitiesToDispatchLocally       []int64
 		failDecision                      bool
 		failDecisionCause                 *workflow.DecisionTaskFailedCause
 		failMessage                       *string
@@ -74,6 +76,7 @@ func newDecisionTaskHandler(
 	decisionTaskCompletedID int64,
 	eventStoreVersion int32,
 	domainEntry *cache.DomainCacheEntry,
+	enableLocalDispatch bool,
 	mutableState mutableState,
 	attrValidator *decisionAttrValidator,
 	sizeLimitChecker *workflowSizeChecker,
@@ -88,11 +91,13 @@ func newDecisionTaskHandler(
 		decisionTaskCompletedID: decisionTaskCompletedID,
 		eventStoreVersion:       eventStoreVersion,
 		domainEntry:             domainEntry,
+		enableLocalDispatch:     enableLocalDispatch,
 
 		// internal state
 		hasUnhandledEventsBeforeDecisions: mutableState.HasBufferedEvents(),
 		transferTasks:                     nil,
 		timerTasks:                        nil,
+		activitiesToDispatchLocally:       nil,
 		failDecision:                      false,
 		failDecisionCause:                 nil,
 		failMessage:                       nil,
@@ -177,6 +182,9 @@ func (handler *decisionTaskHandlerImpl) handleDecision(decision *workflow.Decisi
 	case workflow.DecisionTypeUpsertWorkflowSearchAttributes:
 		return handler.handleDecisionUpsertWorkflowSearchAttributes(decision.UpsertWorkflowSearchAttributesDecisionAttributes)
 
+	case workflow.DecisionTypeCompleteWorkflowExecutionUpdate:
+		return handler.handleDecisionCompleteWorkflowExecutionUpdate(decision.CompleteWorkflowExecutionUpdateDecisionAttributes)
+
 	default:
 		return &workflow.BadRequestError{Message: fmt.Sprintf("Unknown decision type: %v", decision.GetDecisionType())}
 	}
@@ -227,9 +235,23 @@ func (handler *decisionTaskHandlerImpl) handleDecisionScheduleActivity(
 		return err
 	}
 
-	scheduleEvent, _, err := handler.mutableState.AddActivityTaskScheduledEvent(handler.decisionTaskCompletedID, attr)
+	scheduleEvent, ai, err := handler.mutableState.AddActivityTaskScheduledEvent(handler.decisionTaskCompletedID, attr)
 	switch err.(type) {
 	case nil:
+		if handler.shouldDispatchLocally(attr, targetDomainID) {
+			// start the activity right away, it is returned to the worker with the decision completion response
+			if _, err := handler.mutableState.AddActivityTaskStartedEvent(
+				ai, scheduleEvent.GetEventId(), uuid.New(), handler.identity,
+			); err != nil {
+				return err
+			}
+			handler.metricsClient.IncCounter(
+				metrics.HistoryRespondDecisionTaskCompletedScope,
+				metrics.ActivityLocalDispatchCounter,
+			)
+			handler.activitiesToDispatchLocally = append(handler.activitiesToDispatchLocally, scheduleEvent.GetEventId())
+			return nil
+		}
 		handler.transferTasks = append(handler.transferTasks, &persistence.ActivityTask{
 			DomainID:   targetDomainID,
 			TaskList:   attr.TaskList.GetName(),
@@ -245,6 +267,18 @@ func (handler *decisionTaskHandlerImpl) handleDecisionScheduleActivity(
 	}
 }
 
+func (handler *decisionTaskHandlerImpl) shouldDispatchLocally(
+	attr *workflow.ScheduleActivityTaskDecisionAttributes,
+	targetDomainID string,
+) bool {
+
+	// activities of other domains and of paused workflows always go through matching
+	return attr.GetRequestLocalDispatch() &&
+		handler.enableLocalDispatch &&
+		targetDomainID == handler.mutableState.GetExecutionInfo().DomainID &&
+		!handler.mutableState.IsWorkflowExecutionPaused()
+}
+
 func (handler *decisionTaskHandlerImpl) handleDecisionRequestCancelActivity(
 	attr *workflow.RequestCancelActivityTaskDecisionAttributes,
 ) error {
@@ -904,6 +938,37 @@ func (handler *decisionTaskHandlerImpl) handleDecisionUpsertWorkflowSearchAttrib
 	return nil
 }
 
+func (handler *decisionTaskHandlerImpl) handleDecisionCompleteWorkflowExecutionUpdate(
+	attr *workflow.CompleteWorkflowExecutionUpdateDecisionAttributes,
+) error {
+
+	handler.metricsClient.IncCounter(
+		metrics.HistoryRespondDecisionTaskCompletedScope,
+		metrics.DecisionTypeCompleteWorkflowUpdateCounter,
+	)
+
+	if err := handler.validateDecisionAttr(
+		func() error {
+			return handler.attrValidator.validateCompleteWorkflowExecutionUpdateAttributes(attr)
+		},
+		workflow.DecisionTaskFailedCauseBadCompleteWorkflowExecutionUpdateAttributes,
+	); err != nil || handler.stopProcessing {
+		return err
+	}
+
+	failWorkflow, err := handler.sizeLimitChecker.failWorkflowIfBlobSizeExceedsLimit(
+		attr.Result,
+		"CompleteWorkflowExecutionUpdateDecisionAttributes.Result exceeds size limit.",
+	)
+	if err != nil || failWorkflow {
+		handler.stopProcessing = true
+		return err
+	}
+
+	_, err = handler.mutableState.AddWorkflowExecutionUpdateCompletedEvent(handler.decisionTaskCompletedID, attr)
+	return err
+}
+
 func convertSearchAttributesToByteArray(fields map[string][]byte) []byte {
 	result := make([]byte, 0)
 
@@ -931,6 +996,7 @@ func (handler *decisionTaskHandlerImpl) retryCronContinueAsNew(
 		ExecutionStartToCloseTimeoutSeconds: attr.ExecutionStartToCloseTimeoutSeconds,
 		TaskStartToCloseTimeoutSeconds:      attr.TaskStartToCloseTimeoutSeconds,
 		CronSchedule:                        attr.CronSchedule,
+		CronMaxJitterSeconds:                attr.CronMaxJitterSeconds,
 		BackoffStartIntervalInSeconds:       common.Int32Ptr(backoffInterval),
 		Initiator:                           continueAsNewIter,
 		FailureReason:                       failureReason,
@@ -85,6 +85,7 @@ var (
 	errSourceClusterNotSet     = &gen.BadRequestError{Message: "Source Cluster not set on request."}
 	errShardIDNotSet           = &gen.BadRequestError{Message: "Shard ID not set on request."}
 	errTimestampNotSet         = &gen.BadRequestError{Message: "Timestamp not set on request."}
+	errQueueTypeNotSet         = &gen.BadRequestError{Message: "Queue type not set on request."}
 	errHistoryHostThrottle     = &gen.ServiceBusyError{Message: "History host rps exceeded"}
 )
 
@@ -176,8 +177,13 @@ func (h *Handler) Start() error {
 
 // Stop stops the handler
 func (h *Handler) Stop() {
-	h.domainCache.Stop()
+	// fail the readiness check before releasing the shards, so that load balancers
+	// stop routing to this host while it hands its shards off
+	h.GetHealthCheckServer().SetServing(false)
+	// release the shards first, then leave the membership ring
 	h.controller.Stop()
+	h.Service.Stop()
+	h.domainCache.Stop()
 	h.shardManager.Close()
 	h.historyMgr.Close()
 	if h.historyV2Mgr != nil {
@@ -186,7 +192,6 @@ func (h *Handler) Stop() {
 	h.executionMgrFactory.Close()
 	h.metadataMgr.Close()
 	h.visibilityMgr.Close()
-	h.Service.Stop()
 	h.historyEventNotifier.Stop()
 }
 
@@ -645,10 +650,206 @@ func (h *Handler) DescribeHistoryHost(ctx context.Context,
 		},
 		ShardControllerStatus: &status,
 		Address:               common.StringPtr(h.GetHostInfo().GetAddress()),
+		ShardStatuses:         h.controller.shardStatuses(),
+	}
+	return resp, nil
+}
+
+// DescribeShardQueues returns the ack levels, read levels and outstanding task counts of the queue processors of a shard
+func (h *Handler) DescribeShardQueues(ctx context.Context,
+	request *gen.DescribeShardQueuesRequest) (resp *gen.DescribeShardQueuesResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryDescribeShardQueuesScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.ShardID == nil {
+		return nil, h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	resp, err = engine.DescribeShardQueues(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+	resp.HostAddress = common.StringPtr(h.GetHostInfo().GetAddress())
+	return resp, nil
+}
+
+// ListShardTasks returns the transfer, timer or replication tasks of a shard
+func (h *Handler) ListShardTasks(ctx context.Context,
+	request *gen.ListShardTasksRequest) (resp *gen.ListShardTasksResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryListShardTasksScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.ShardID == nil {
+		return nil, h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	if request.QueueType == nil {
+		return nil, h.error(errQueueTypeNotSet, scope, "", "")
+	}
+
+	engine, err := h.controller.getEngineForShard(int(request.GetShardID()))
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
+	}
+
+	resp, err = engine.ListShardTasks(ctx, request)
+	if err != nil {
+		return nil, h.error(err, scope, "", "")
 	}
 	return resp, nil
 }
 
+// RemoveShardTask removes a transfer, timer or replication task of a shard. The shard is
+// reloaded afterwards, so its queue processors drop the task if they had already loaded it.
+func (h *Handler) RemoveShardTask(ctx context.Context,
+	request *gen.RemoveShardTaskRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryRemoveShardTaskScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.ShardID == nil {
+		return h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	if request.QueueType == nil {
+		return h.error(errQueueTypeNotSet, scope, "", "")
+	}
+
+	shardID := int(request.GetShardID())
+	engine, err := h.controller.getEngineForShard(shardID)
+	if err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	if err := engine.RemoveShardTask(ctx, request); err != nil {
+		return h.error(err, scope, "", "")
+	}
+
+	// the task may already be loaded, or stuck retrying, in a queue processor, so the shard is
+	// reloaded right away for its queue processors to start over from what is left in persistence
+	h.GetLogger().Info("Removed shard task, reloading shard.",
+		tag.ShardID(shardID), tag.TaskID(request.GetTaskId()))
+	h.controller.removeEngineForShard(shardID)
+	if _, err := h.controller.getEngineForShard(shardID); err != nil {
+		return h.error(err, scope, "", "")
+	}
+	return nil
+}
+
+// CloseShard stops the queue processors of a shard, persists its shard info and unloads it from this host
+func (h *Handler) CloseShard(ctx context.Context,
+	request *gen.CloseShardRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryCloseShardScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if request.ShardID == nil {
+		return h.error(errShardIDNotSet, scope, "", "")
+	}
+
+	shardID := int(request.GetShardID())
+	h.controller.closeShard(shardID)
+	h.GetLogger().Info("Closed shard.", tag.ShardID(shardID))
+	return nil
+}
+
+// RefreshWorkflowTasks - regenerates the transfer and timer tasks of a workflow execution from its mutable state
+func (h *Handler) RefreshWorkflowTasks(ctx context.Context,
+	request *hist.RefreshWorkflowTasksRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryRefreshWorkflowTasksScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	workflowExecution := request.Execution
+	if workflowExecution == nil {
+		return h.error(errWorkflowExecutionNotSet, scope, domainID, "")
+	}
+	workflowID := workflowExecution.GetWorkflowId()
+	if workflowID == "" {
+		return h.error(errWorkflowIDNotSet, scope, domainID, "")
+	}
+
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.RefreshWorkflowTasks(ctx, request)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+	return nil
+}
+
+// DeleteWorkflowExecution - permanently removes a workflow execution
+func (h *Handler) DeleteWorkflowExecution(ctx context.Context,
+	request *hist.DeleteWorkflowExecutionRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryDeleteWorkflowExecutionScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := request.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	workflowExecution := request.Execution
+	if workflowExecution == nil {
+		return h.error(errWorkflowExecutionNotSet, scope, domainID, "")
+	}
+	workflowID := workflowExecution.GetWorkflowId()
+	if workflowID == "" {
+		return h.error(errWorkflowIDNotSet, scope, domainID, "")
+	}
+
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.DeleteWorkflowExecution(ctx, request)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+	return nil
+}
+
 // DescribeMutableState - returns the internal analysis of workflow execution state
 func (h *Handler) DescribeMutableState(ctx context.Context,
 	request *hist.DescribeMutableStateRequest) (resp *hist.DescribeMutableStateResponse, retError error) {
@@ -823,6 +1024,79 @@ func (h *Handler) SignalWorkflowExecution(ctx context.Context,
 	return nil
 }
 
+// CancelDelayedSignal is used to cancel a signal which was sent with a delay and has not been delivered yet to
+// the workflow execution.
+func (h *Handler) CancelDelayedSignal(ctx context.Context,
+	wrappedRequest *hist.CancelDelayedSignalRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryCancelDelayedSignalScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.CancelRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.CancelDelayedSignal(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
+// UpdateWorkflowExecution is used to send an update request to running workflow execution and wait for its result.
+// This results in WorkflowExecutionUpdateRequested event recorded in the history and a decision task being created
+// for the execution.
+func (h *Handler) UpdateWorkflowExecution(ctx context.Context,
+	wrappedRequest *hist.UpdateWorkflowExecutionRequest) (resp *gen.UpdateWorkflowExecutionResponse, retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryUpdateWorkflowExecutionScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return nil, h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return nil, h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.UpdateRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return nil, h.error(err1, scope, domainID, workflowID)
+	}
+
+	resp, err2 := engine.UpdateWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return nil, h.error(err2, scope, domainID, workflowID)
+	}
+
+	return resp, nil
+}
+
 // SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.
 // If workflow is running, this results in WorkflowExecutionSignaled event recorded in the history
 // and a decision task being created for the execution.
@@ -934,6 +1208,76 @@ func (h *Handler) TerminateWorkflowExecution(ctx context.Context,
 	return nil
 }
 
+// PauseWorkflowExecution stops dispatching decision and activity tasks of an existing workflow execution
+func (h *Handler) PauseWorkflowExecution(ctx context.Context,
+	wrappedRequest *hist.PauseWorkflowExecutionRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryPauseWorkflowExecutionScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.PauseRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.PauseWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
+// UnpauseWorkflowExecution resumes dispatching decision and activity tasks of a paused workflow execution
+func (h *Handler) UnpauseWorkflowExecution(ctx context.Context,
+	wrappedRequest *hist.UnpauseWorkflowExecutionRequest) (retError error) {
+	defer log.CapturePanic(h.GetLogger(), &retError)
+	h.startWG.Wait()
+
+	scope := metrics.HistoryUnpauseWorkflowExecutionScope
+	h.metricsClient.IncCounter(scope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(scope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	domainID := wrappedRequest.GetDomainUUID()
+	if domainID == "" {
+		return h.error(errDomainNotSet, scope, domainID, "")
+	}
+
+	if ok := h.rateLimiter.Allow(); !ok {
+		return h.error(errHistoryHostThrottle, scope, domainID, "")
+	}
+
+	workflowExecution := wrappedRequest.UnpauseRequest.WorkflowExecution
+	workflowID := workflowExecution.GetWorkflowId()
+	engine, err1 := h.controller.GetEngine(workflowID)
+	if err1 != nil {
+		return h.error(err1, scope, domainID, workflowID)
+	}
+
+	err2 := engine.UnpauseWorkflowExecution(ctx, wrappedRequest)
+	if err2 != nil {
+		return h.error(err2, scope, domainID, workflowID)
+	}
+
+	return nil
+}
+
 // ResetWorkflowExecution reset an existing workflow execution
 // in the history and immediately terminating the execution instance.
 func (h *Handler) ResetWorkflowExecution(ctx context.Context,
@@ -95,15 +95,15 @@ func (b *historyBuilder) AddTransientDecisionTaskScheduledEvent(taskList string,
 }
 
 func (b *historyBuilder) AddDecisionTaskStartedEvent(scheduleEventID int64, requestID string,
-	identity string) *workflow.HistoryEvent {
-	event := b.newDecisionTaskStartedEvent(scheduleEventID, requestID, identity)
+	identity string, buildID string) *workflow.HistoryEvent {
+	event := b.newDecisionTaskStartedEvent(scheduleEventID, requestID, identity, buildID)
 
 	return b.addEventToHistory(event)
 }
 
 func (b *historyBuilder) AddTransientDecisionTaskStartedEvent(scheduleEventID int64, requestID string,
-	identity string, timestamp int64) *workflow.HistoryEvent {
-	event := b.newTransientDecisionTaskStartedEvent(scheduleEventID, requestID, identity, timestamp)
+	identity string, buildID string, timestamp int64) *workflow.HistoryEvent {
+	event := b.newTransientDecisionTaskStartedEvent(scheduleEventID, requestID, identity, buildID, timestamp)
 
 	return b.addTransientEvent(event)
 }
@@ -382,6 +382,57 @@ func (b *historyBuilder) AddWorkflowExecutionSignaledEvent(
 	return b.addEventToHistory(event)
 }
 
+func (b *historyBuilder) AddWorkflowExecutionSignaledForDelayedSignalEvent(
+	signalName string, input []byte, identity string, requestID string) *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionSignaledEvent(signalName, input, identity)
+	event.WorkflowExecutionSignaledEventAttributes.DelayedSignalRequestId = common.StringPtr(requestID)
+
+	return b.addEventToHistory(event)
+}
+
+func (b *historyBuilder) AddWorkflowExecutionDelayedSignalRequestedEvent(
+	requestID string, signalName string, input []byte, control []byte, identity string,
+	fireTimestamp int64) *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionDelayedSignalRequestedEvent(requestID, signalName, input, control, identity, fireTimestamp)
+
+	return b.addEventToHistory(event)
+}
+
+func (b *historyBuilder) AddWorkflowExecutionDelayedSignalCanceledEvent(
+	requestID string, identity string) *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionDelayedSignalCanceledEvent(requestID, identity)
+
+	return b.addEventToHistory(event)
+}
+
+func (b *historyBuilder) AddWorkflowExecutionUpdateRequestedEvent(
+	updateName string, input []byte, identity string, requestID string) *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionUpdateRequestedEvent(updateName, input, identity, requestID)
+
+	return b.addEventToHistory(event)
+}
+
+func (b *historyBuilder) AddWorkflowExecutionPausedEvent(
+	reason string, identity string) *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionPausedEvent(reason, identity)
+
+	return b.addEventToHistory(event)
+}
+
+func (b *historyBuilder) AddWorkflowExecutionUnpausedEvent(
+	reason string, identity string) *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionUnpausedEvent(reason, identity)
+
+	return b.addEventToHistory(event)
+}
+
+func (b *historyBuilder) AddWorkflowExecutionUpdateCompletedEvent(decisionCompletedEventID int64,
+	attributes *workflow.CompleteWorkflowExecutionUpdateDecisionAttributes) *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionUpdateCompletedEvent(decisionCompletedEventID, attributes)
+
+	return b.addEventToHistory(event)
+}
+
 func (b *historyBuilder) AddStartChildWorkflowExecutionInitiatedEvent(decisionCompletedEventID int64,
 	attributes *workflow.StartChildWorkflowExecutionDecisionAttributes) *workflow.HistoryEvent {
 	event := b.newStartChildWorkflowExecutionInitiatedEvent(decisionCompletedEventID, attributes)
@@ -489,6 +540,8 @@ func (b *historyBuilder) newWorkflowExecutionStartedEvent(
 	attributes.Attempt = common.Int32Ptr(startRequest.GetAttempt())
 	attributes.ExpirationTimestamp = startRequest.ExpirationTimestamp
 	attributes.CronSchedule = request.CronSchedule
+	attributes.CronMaxJitterSeconds = request.CronMaxJitterSeconds
+	attributes.TaskPriority = request.TaskPriority
 	attributes.LastCompletionResult = startRequest.LastCompletionResult
 	attributes.ContinuedFailureReason = startRequest.ContinuedFailureReason
 	attributes.ContinuedFailureDetails = startRequest.ContinuedFailureDetails
@@ -525,17 +578,17 @@ func (b *historyBuilder) newTransientDecisionTaskScheduledEvent(taskList string,
 }
 
 func (b *historyBuilder) newDecisionTaskStartedEvent(scheduledEventID int64, requestID string,
-	identity string) *workflow.HistoryEvent {
+	identity string, buildID string) *workflow.HistoryEvent {
 	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeDecisionTaskStarted)
 
-	return setDecisionTaskStartedEventInfo(historyEvent, scheduledEventID, requestID, identity)
+	return setDecisionTaskStartedEventInfo(historyEvent, scheduledEventID, requestID, identity, buildID)
 }
 
 func (b *historyBuilder) newTransientDecisionTaskStartedEvent(scheduledEventID int64, requestID string,
-	identity string, timestamp int64) *workflow.HistoryEvent {
+	identity string, buildID string, timestamp int64) *workflow.HistoryEvent {
 	historyEvent := b.msBuilder.CreateNewHistoryEventWithTimestamp(workflow.EventTypeDecisionTaskStarted, timestamp)
 
-	return setDecisionTaskStartedEventInfo(historyEvent, scheduledEventID, requestID, identity)
+	return setDecisionTaskStartedEventInfo(historyEvent, scheduledEventID, requestID, identity, buildID)
 }
 
 func (b *historyBuilder) newDecisionTaskCompletedEvent(scheduleEventID, startedEventID int64,
@@ -584,6 +637,7 @@ func (b *historyBuilder) newActivityTaskScheduledEvent(decisionTaskCompletedEven
 	attributes.HeartbeatTimeoutSeconds = common.Int32Ptr(common.Int32Default(scheduleAttributes.HeartbeatTimeoutSeconds))
 	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionTaskCompletedEventID)
 	attributes.RetryPolicy = scheduleAttributes.RetryPolicy
+	attributes.TaskPriority = scheduleAttributes.TaskPriority
 	historyEvent.ActivityTaskScheduledEventAttributes = attributes
 
 	return historyEvent
@@ -686,6 +740,80 @@ func (b *historyBuilder) newWorkflowExecutionSignaledEvent(
 	return historyEvent
 }
 
+func (b *historyBuilder) newWorkflowExecutionDelayedSignalRequestedEvent(
+	requestID string, signalName string, input []byte, control []byte, identity string,
+	fireTimestamp int64) *workflow.HistoryEvent {
+	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionDelayedSignalRequested)
+	attributes := &workflow.WorkflowExecutionDelayedSignalRequestedEventAttributes{}
+	attributes.RequestId = common.StringPtr(requestID)
+	attributes.SignalName = common.StringPtr(signalName)
+	attributes.Input = input
+	attributes.Control = control
+	attributes.Identity = common.StringPtr(identity)
+	attributes.FireTimestamp = common.Int64Ptr(fireTimestamp)
+	historyEvent.WorkflowExecutionDelayedSignalRequestedEventAttributes = attributes
+
+	return historyEvent
+}
+
+func (b *historyBuilder) newWorkflowExecutionDelayedSignalCanceledEvent(
+	requestID string, identity string) *workflow.HistoryEvent {
+	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionDelayedSignalCanceled)
+	attributes := &workflow.WorkflowExecutionDelayedSignalCanceledEventAttributes{}
+	attributes.RequestId = common.StringPtr(requestID)
+	attributes.Identity = common.StringPtr(identity)
+	historyEvent.WorkflowExecutionDelayedSignalCanceledEventAttributes = attributes
+
+	return historyEvent
+}
+
+func (b *historyBuilder) newWorkflowExecutionUpdateRequestedEvent(
+	updateName string, input []byte, identity string, requestID string) *workflow.HistoryEvent {
+	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionUpdateRequested)
+	attributes := &workflow.WorkflowExecutionUpdateRequestedEventAttributes{}
+	attributes.UpdateName = common.StringPtr(updateName)
+	attributes.Input = input
+	attributes.Identity = common.StringPtr(identity)
+	attributes.RequestId = common.StringPtr(requestID)
+	historyEvent.WorkflowExecutionUpdateRequestedEventAttributes = attributes
+
+	return historyEvent
+}
+
+func (b *historyBuilder) newWorkflowExecutionUpdateCompletedEvent(decisionTaskCompletedEventID int64,
+	request *workflow.CompleteWorkflowExecutionUpdateDecisionAttributes) *workflow.HistoryEvent {
+	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionUpdateCompleted)
+	attributes := &workflow.WorkflowExecutionUpdateCompletedEventAttributes{}
+	attributes.RequestId = common.StringPtr(request.GetRequestId())
+	attributes.Result = request.Result
+	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionTaskCompletedEventID)
+	historyEvent.WorkflowExecutionUpdateCompletedEventAttributes = attributes
+
+	return historyEvent
+}
+
+func (b *historyBuilder) newWorkflowExecutionPausedEvent(
+	reason string, identity string) *workflow.HistoryEvent {
+	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionPaused)
+	attributes := &workflow.WorkflowExecutionPausedEventAttributes{}
+	attributes.Reason = common.StringPtr(reason)
+	attributes.Identity = common.StringPtr(identity)
+	historyEvent.WorkflowExecutionPausedEventAttributes = attributes
+
+	return historyEvent
+}
+
+func (b *historyBuilder) newWorkflowExecutionUnpausedEvent(
+	reason string, identity string) *workflow.HistoryEvent {
+	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionUnpaused)
+	attributes := &workflow.WorkflowExecutionUnpausedEventAttributes{}
+	attributes.Reason = common.StringPtr(reason)
+	attributes.Identity = common.StringPtr(identity)
+	historyEvent.WorkflowExecutionUnpausedEventAttributes = attributes
+
+	return historyEvent
+}
+
 func (b *historyBuilder) newWorkflowExecutionTerminatedEvent(
 	reason string, details []byte, identity string) *workflow.HistoryEvent {
 	historyEvent := b.msBuilder.CreateNewHistoryEvent(workflow.EventTypeWorkflowExecutionTerminated)
@@ -897,6 +1025,8 @@ func (b *historyBuilder) newStartChildWorkflowExecutionInitiatedEvent(decisionTa
 	attributes.WorkflowIdReusePolicy = startAttributes.WorkflowIdReusePolicy
 	attributes.RetryPolicy = startAttributes.RetryPolicy
 	attributes.CronSchedule = startAttributes.CronSchedule
+	attributes.CronMaxJitterSeconds = startAttributes.CronMaxJitterSeconds
+	attributes.TaskPriority = startAttributes.TaskPriority
 	attributes.Memo = startAttributes.Memo
 	attributes.SearchAttributes = startAttributes.SearchAttributes
 	historyEvent.StartChildWorkflowExecutionInitiatedEventAttributes = attributes
@@ -1028,10 +1158,10 @@ func newDecisionTaskScheduledEventWithInfo(eventID, timestamp int64, taskList st
 }
 
 func newDecisionTaskStartedEventWithInfo(eventID, timestamp int64, scheduledEventID int64, requestID string,
-	identity string) *workflow.HistoryEvent {
+	identity string, buildID string) *workflow.HistoryEvent {
 	historyEvent := createNewHistoryEvent(eventID, workflow.EventTypeDecisionTaskStarted, timestamp)
 
-	return setDecisionTaskStartedEventInfo(historyEvent, scheduledEventID, requestID, identity)
+	return setDecisionTaskStartedEventInfo(historyEvent, scheduledEventID, requestID, identity, buildID)
 }
 
 func createNewHistoryEvent(eventID int64, eventType workflow.EventType, timestamp int64) *workflow.HistoryEvent {
@@ -1056,11 +1186,14 @@ func setDecisionTaskScheduledEventInfo(historyEvent *workflow.HistoryEvent, task
 }
 
 func setDecisionTaskStartedEventInfo(historyEvent *workflow.HistoryEvent, scheduledEventID int64, requestID string,
-	identity string) *workflow.HistoryEvent {
+	identity string, buildID string) *workflow.HistoryEvent {
 	attributes := &workflow.DecisionTaskStartedEventAttributes{}
 	attributes.ScheduledEventId = common.Int64Ptr(scheduledEventID)
 	attributes.Identity = common.StringPtr(identity)
 	attributes.RequestId = common.StringPtr(requestID)
+	if buildID != "" {
+		attributes.BuildId = common.StringPtr(buildID)
+	}
 	historyEvent.DecisionTaskStartedEventAttributes = attributes
 
 	return historyEvent
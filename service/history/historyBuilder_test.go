@@ -224,6 +224,122 @@ func (s *historyBuilderSuite) TestHistoryBuilderDynamicSuccess() {
 	s.Equal(int64(3), s.getPreviousDecisionStartedEventID())
 }
 
+func (s *historyBuilderSuite) TestHistoryBuilderTaskPriority() {
+	id := "historybuilder-task-priority-test-workflow-id"
+	rid := "historybuilder-task-priority-test-run-id"
+	wt := "historybuilder-task-priority-type"
+	tl := "historybuilder-task-priority-tasklist"
+	identity := "historybuilder-task-priority-worker"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(id),
+		RunId:      common.StringPtr(rid),
+	}
+	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything).Return()
+
+	workflowStartedEvent, err := s.msBuilder.AddWorkflowExecutionStartedEvent(
+		s.domainEntry,
+		we, &history.StartWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(s.domainID),
+			StartRequest: &workflow.StartWorkflowExecutionRequest{
+				WorkflowId:                          common.StringPtr(id),
+				WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(wt)},
+				TaskList:                            &workflow.TaskList{Name: common.StringPtr(tl)},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(60),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+				Identity:                            common.StringPtr(identity),
+				TaskPriority:                        common.Int32Ptr(3),
+			},
+		},
+	)
+	s.Nil(err)
+	s.Equal(int32(3), workflowStartedEvent.WorkflowExecutionStartedEventAttributes.GetTaskPriority())
+	s.Equal(int32(3), s.msBuilder.GetExecutionInfo().TaskPriority)
+
+	s.addDecisionTaskScheduledEvent()
+	s.addDecisionTaskStartedEvent(2, tl, identity)
+	s.addDecisionTaskCompletedEvent(2, 3, nil, identity)
+
+	activityScheduledEvent, ai, err := s.msBuilder.AddActivityTaskScheduledEvent(4,
+		&workflow.ScheduleActivityTaskDecisionAttributes{
+			ActivityId:                    common.StringPtr("activity-id"),
+			ActivityType:                  &workflow.ActivityType{Name: common.StringPtr("activity-type")},
+			TaskList:                      &workflow.TaskList{Name: common.StringPtr(tl)},
+			ScheduleToCloseTimeoutSeconds: common.Int32Ptr(60),
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(20),
+			StartToCloseTimeoutSeconds:    common.Int32Ptr(40),
+			TaskPriority:                  common.Int32Ptr(-2),
+		})
+	s.Nil(err)
+	s.Equal(int32(-2), activityScheduledEvent.ActivityTaskScheduledEventAttributes.GetTaskPriority())
+	s.Equal(int32(-2), ai.TaskPriority)
+
+	childInitiatedEvent, _, err := s.msBuilder.AddStartChildWorkflowExecutionInitiatedEvent(4, uuid.New(),
+		&workflow.StartChildWorkflowExecutionDecisionAttributes{
+			WorkflowId:   common.StringPtr("child-workflow-id"),
+			WorkflowType: &workflow.WorkflowType{Name: common.StringPtr("child-workflow-type")},
+			TaskList:     &workflow.TaskList{Name: common.StringPtr(tl)},
+			TaskPriority: common.Int32Ptr(5),
+		})
+	s.Nil(err)
+	s.Equal(int32(5), childInitiatedEvent.StartChildWorkflowExecutionInitiatedEventAttributes.GetTaskPriority())
+}
+
+func (s *historyBuilderSuite) TestHistoryBuilderDecisionTaskBuildID() {
+	id := "historybuilder-build-id-test-workflow-id"
+	rid := "historybuilder-build-id-test-run-id"
+	wt := "historybuilder-build-id-type"
+	tl := "historybuilder-build-id-tasklist"
+	identity := "historybuilder-build-id-worker"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(id),
+		RunId:      common.StringPtr(rid),
+	}
+	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything).Return()
+
+	_, err := s.msBuilder.AddWorkflowExecutionStartedEvent(
+		s.domainEntry,
+		we, &history.StartWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(s.domainID),
+			StartRequest: &workflow.StartWorkflowExecutionRequest{
+				WorkflowId:                          common.StringPtr(id),
+				WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(wt)},
+				TaskList:                            &workflow.TaskList{Name: common.StringPtr(tl)},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(60),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+				Identity:                            common.StringPtr(identity),
+			},
+		},
+	)
+	s.Nil(err)
+	s.addDecisionTaskScheduledEvent()
+	// the first decision task is routed to the default compatible build set
+	s.Nil(getDecisionTaskBuildID(s.msBuilder.GetExecutionInfo()))
+
+	startedEvent, _, err := s.msBuilder.AddDecisionTaskStartedEvent(2, uuid.New(), &workflow.PollForDecisionTaskRequest{
+		TaskList: &workflow.TaskList{Name: common.StringPtr(tl)},
+		Identity: common.StringPtr(identity),
+		BuildId:  common.StringPtr("v1"),
+	})
+	s.Nil(err)
+	s.Equal("v1", startedEvent.DecisionTaskStartedEventAttributes.GetBuildId())
+	s.Equal("v1", s.msBuilder.GetExecutionInfo().BuildID)
+	s.addDecisionTaskCompletedEvent(2, 3, nil, identity)
+
+	s.addDecisionTaskScheduledEvent()
+	startedEvent, _, err = s.msBuilder.AddDecisionTaskStartedEvent(5, uuid.New(), &workflow.PollForDecisionTaskRequest{
+		TaskList: &workflow.TaskList{Name: common.StringPtr(tl)},
+		Identity: common.StringPtr(identity),
+		BuildId:  common.StringPtr("v2"),
+	})
+	s.Nil(err)
+	s.Equal("v2", startedEvent.DecisionTaskStartedEventAttributes.GetBuildId())
+	// the workflow stays pinned to the build which picked up its first decision task
+	s.Equal("v1", s.msBuilder.GetExecutionInfo().BuildID)
+	s.Equal(common.StringPtr("v1"), getDecisionTaskBuildID(s.msBuilder.GetExecutionInfo()))
+}
+
 func (s *historyBuilderSuite) TestHistoryBuilderWorkflowStartFailures() {
 	id := "historybuilder-workflowstart-failures-test-workflow-id"
 	rid := "historybuilder-workflowstart-failures-test-run-id"
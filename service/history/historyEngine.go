@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/pborman/uuid"
@@ -33,6 +34,7 @@ import (
 	hc "github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/clock"
 	"github.com/uber/cadence/common/cluster"
@@ -45,6 +47,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	warchiver "github.com/uber/cadence/service/worker/archiver"
 	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/yarpc/yarpcerrors"
 )
 
 const (
@@ -52,6 +55,11 @@ const (
 	activityCancellationMsgActivityIDUnknown  = "ACTIVITY_ID_UNKNOWN"
 	activityCancellationMsgActivityNotStarted = "ACTIVITY_ID_NOT_STARTED"
 	timerCancellationMsgTimerIDUnknown        = "TIMER_ID_UNKNOWN"
+	workflowTerminateIfRunningReason          = "Terminated by new run %v due to TerminateIfRunning workflow ID reuse policy."
+	defaultShardTasksPageSize                 = 100
+	// workflowUpdateResultTimeBudget is the time left before the deadline of the request
+	// to reply that the update result is not available yet
+	workflowUpdateResultTimeBudget = time.Second
 )
 
 type (
@@ -101,6 +109,12 @@ var (
 	ErrActivityTaskNotFound = &workflow.EntityNotExistsError{Message: "Activity task not found."}
 	// ErrWorkflowCompleted is the error to indicate workflow execution already completed
 	ErrWorkflowCompleted = &workflow.EntityNotExistsError{Message: "Workflow execution already completed."}
+	// ErrWorkflowRunning is the error to indicate workflow execution is still running, and cannot be deleted
+	ErrWorkflowRunning = &workflow.BadRequestError{Message: "Workflow execution is still running, terminate it before deleting."}
+	// ErrWorkflowPaused is the error to indicate workflow execution is paused, and no task can be started
+	ErrWorkflowPaused = &workflow.EntityNotExistsError{Message: "Workflow execution is paused."}
+	// ErrWorkflowUpdateTimedOut is the error indicating timeout while waiting for the result of workflow update
+	ErrWorkflowUpdateTimedOut = yarpcerrors.DeadlineExceededErrorf("Timed out waiting for workflow update result.")
 	// ErrWorkflowParent is the error to parent execution is given and mismatch
 	ErrWorkflowParent = &workflow.EntityNotExistsError{Message: "Workflow parent does not match."}
 	// ErrDeserializingToken is the error to indicate task token is invalid
@@ -380,6 +394,21 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 	e.overrideStartWorkflowExecutionRequest(domainEntry, request)
 
 	workflowID := request.GetWorkflowId()
+	// the running workflow to be terminated has to be locked before the current context,
+	// same lock order as signal with start
+	var prevContext workflowExecutionContext
+	var prevMutableState mutableState
+	if request.GetWorkflowIdReusePolicy() == workflow.WorkflowIdReusePolicyTerminateIfRunning {
+		var prevRelease releaseWorkflowExecutionFunc
+		prevContext, prevMutableState, prevRelease, err = e.loadRunningWorkflowExecution(ctx, domainID, workflowID)
+		if err != nil {
+			return nil, err
+		}
+		if prevRelease != nil {
+			defer func() { prevRelease(retError) }()
+		}
+	}
+
 	// grab the current context as a lock, nothing more
 	_, currentRelease, err := e.historyCache.getOrCreateCurrentWorkflowExecution(
 		ctx,
@@ -440,6 +469,10 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 	msBuilder.AddTransferTasks(transferTasks...)
 	msBuilder.AddTimerTasks(timerTasks...)
 
+	if prevMutableState != nil {
+		return e.terminateAndStartWorkflowExecution(domainEntry, prevContext, prevMutableState, context, msBuilder, request)
+	}
+
 	now := e.timeSource.Now()
 	newWorkflow, newWorkflowEventsSeq, err := msBuilder.CloseTransactionAsSnapshot(
 		now,
@@ -504,6 +537,97 @@ func (e *historyEngineImpl) StartWorkflowExecution(
 	}, nil
 }
 
+// loadRunningWorkflowExecution locks and loads the current run of the workflow,
+// the returned context and mutable state are nil if the workflow is not running
+func (e *historyEngineImpl) loadRunningWorkflowExecution(
+	ctx ctx.Context,
+	domainID string,
+	workflowID string,
+) (workflowExecutionContext, mutableState, releaseWorkflowExecutionFunc, error) {
+
+	context, release, err := e.historyCache.getOrCreateWorkflowExecution(ctx, domainID, workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(workflowID),
+	})
+	if err != nil {
+		if _, ok := err.(*workflow.EntityNotExistsError); ok {
+			return nil, nil, nil, nil
+		}
+		return nil, nil, nil, err
+	}
+
+	msBuilder, err := context.loadWorkflowExecution()
+	if err != nil {
+		release(err)
+		if _, ok := err.(*workflow.EntityNotExistsError); ok {
+			return nil, nil, nil, nil
+		}
+		return nil, nil, nil, err
+	}
+	if !msBuilder.IsWorkflowExecutionRunning() {
+		return nil, nil, release, nil
+	}
+	return context, msBuilder, release, nil
+}
+
+// terminateAndStartWorkflowExecution terminates the running workflow and creates the new run
+// in a single update, used by the TerminateIfRunning workflow ID reuse policy
+func (e *historyEngineImpl) terminateAndStartWorkflowExecution(
+	domainEntry *cache.DomainCacheEntry,
+	prevContext workflowExecutionContext,
+	prevMutableState mutableState,
+	newContext workflowExecutionContext,
+	newMutableState mutableState,
+	request *workflow.StartWorkflowExecutionRequest,
+) (*workflow.StartWorkflowExecutionResponse, error) {
+
+	prevExecutionInfo := prevMutableState.GetExecutionInfo()
+	if prevExecutionInfo.CreateRequestID == request.GetRequestId() {
+		// duplicate start request of the running workflow
+		return &workflow.StartWorkflowExecutionResponse{
+			RunId: common.StringPtr(prevExecutionInfo.RunID),
+		}, nil
+	}
+
+	if prevMutableState.GetLastWriteVersion() > newMutableState.GetCurrentVersion() {
+		clusterMetadata := e.shard.GetService().GetClusterMetadata()
+		return nil, ce.NewDomainNotActiveError(
+			domainEntry.GetInfo().Name,
+			clusterMetadata.GetCurrentClusterName(),
+			clusterMetadata.ClusterNameForFailoverVersion(prevMutableState.GetLastWriteVersion()),
+		)
+	}
+
+	newRunID := newContext.getExecution().GetRunId()
+	if _, err := prevMutableState.AddWorkflowExecutionTerminatedEvent(
+		fmt.Sprintf(workflowTerminateIfRunningReason, newRunID),
+		nil,
+		request.GetIdentity(),
+	); err != nil {
+		return nil, &workflow.InternalServiceError{Message: "Unable to terminate workflow execution."}
+	}
+	closeTask, cleanupTask, err := e.getWorkflowHistoryCleanupTasks(
+		prevExecutionInfo.DomainID,
+		prevExecutionInfo.WorkflowID,
+		e.getTimerBuilder(prevContext.getExecution()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	prevMutableState.AddTransferTasks(closeTask)
+	prevMutableState.AddTimerTasks(cleanupTask)
+
+	if err := prevContext.updateWorkflowExecutionWithNewAsActive(
+		e.timeSource.Now(),
+		newContext,
+		newMutableState,
+	); err != nil {
+		return nil, err
+	}
+	return &workflow.StartWorkflowExecutionResponse{
+		RunId: common.StringPtr(newRunID),
+	}, nil
+}
+
 // GetMutableState retrieves the mutable state of the workflow execution
 func (e *historyEngineImpl) GetMutableState(
 	ctx ctx.Context,
@@ -611,6 +735,7 @@ func (e *historyEngineImpl) getMutableState(
 		IsWorkflowRunning:      common.BoolPtr(msBuilder.IsWorkflowExecutionRunning()),
 		EventStoreVersion:      common.Int32Ptr(msBuilder.GetEventStoreVersion()),
 		BranchToken:            msBuilder.GetCurrentBranch(),
+		BuildId:                getDecisionTaskBuildID(executionInfo),
 	}
 
 	if msBuilder.IsStickyTaskListEnabled() {
@@ -749,6 +874,7 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 			HistoryLength:    common.Int64Ptr(msBuilder.GetNextEventID() - common.FirstEventID),
 			AutoResetPoints:  executionInfo.AutoResetPoints,
 			SearchAttributes: &workflow.SearchAttributes{IndexedFields: executionInfo.SearchAttributes},
+			Paused:           common.BoolPtr(executionInfo.Paused),
 		},
 	}
 
@@ -837,6 +963,18 @@ func (e *historyEngineImpl) DescribeWorkflowExecution(
 		}
 	}
 
+	if len(msBuilder.GetPendingDelayedSignalInfos()) > 0 {
+		for _, ds := range msBuilder.GetPendingDelayedSignalInfos() {
+			p := &workflow.PendingDelayedSignalInfo{
+				RequestId:     common.StringPtr(ds.RequestID),
+				SignalName:    common.StringPtr(ds.SignalName),
+				Identity:      common.StringPtr(ds.Identity),
+				FireTimestamp: common.Int64Ptr(ds.FireTime.UnixNano()),
+			}
+			result.PendingDelayedSignals = append(result.PendingDelayedSignals, p)
+		}
+	}
+
 	return result, nil
 }
 
@@ -908,6 +1046,11 @@ func (e *historyEngineImpl) RecordActivityTaskStarted(
 				return nil, &h.EventAlreadyStartedError{Message: "Activity task already started."}
 			}
 
+			if msBuilder.IsWorkflowExecutionPaused() {
+				// It is OK to drop the task at this point, it is dispatched again once the workflow execution is unpaused.
+				return nil, ErrWorkflowPaused
+			}
+
 			if _, err := msBuilder.AddActivityTaskStartedEvent(
 				ai, scheduleID, requestID, request.PollRequest.GetIdentity(),
 			); err != nil {
@@ -1315,8 +1458,8 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 	return e.updateWorkflowExecutionWithAction(ctx, domainID, execution, func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
 		executionInfo := msBuilder.GetExecutionInfo()
 		createDecisionTask := true
-		// Do not create decision task when the workflow is cron and the cron has not been started yet
-		if msBuilder.GetExecutionInfo().CronSchedule != "" && !msBuilder.HasProcessedOrPendingDecisionTask() {
+		// Do not create decision task when the workflow is cron or delayed and has not been started yet
+		if msBuilder.IsWorkflowPendingOnDecisionBackoff() {
 			createDecisionTask = false
 		}
 		postActions := &updateWorkflowAction{
@@ -1347,6 +1490,31 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 			}
 		}
 
+		// delayed signal is recorded in the history and delivered later by the timer queue
+		if delaySeconds := request.GetDelaySeconds(); delaySeconds > 0 {
+			requestID := request.GetRequestId()
+			if _, ok := msBuilder.GetDelayedSignal(requestID); ok || msBuilder.IsSignalRequested(requestID) {
+				return &updateWorkflowAction{noop: true}, nil
+			}
+			fireTime := e.shard.GetTimeSource().Now().Add(time.Duration(delaySeconds) * time.Second)
+			if _, err := msBuilder.AddWorkflowExecutionDelayedSignalRequestedEvent(
+				requestID,
+				request.GetSignalName(),
+				request.GetInput(),
+				request.GetControl(),
+				request.GetIdentity(),
+				fireTime,
+			); err != nil {
+				return nil, &workflow.InternalServiceError{Message: "Unable to signal workflow execution."}
+			}
+			return &updateWorkflowAction{
+				createDecision: false,
+				timerTasks: []persistence.Task{&persistence.DelayedSignalTimerTask{
+					VisibilityTimestamp: fireTime,
+				}},
+			}, nil
+		}
+
 		// deduplicate by request id for signal decision
 		if requestID := request.GetRequestId(); requestID != "" {
 			if msBuilder.IsSignalRequested(requestID) {
@@ -1366,6 +1534,236 @@ func (e *historyEngineImpl) SignalWorkflowExecution(
 	})
 }
 
+// CancelDelayedSignal removes a pending delayed signal from the workflow execution before it is delivered
+func (e *historyEngineImpl) CancelDelayedSignal(
+	ctx ctx.Context,
+	cancelRequest *h.CancelDelayedSignalRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainEntry(cancelRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := cancelRequest.CancelRequest
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+
+	return e.updateWorkflowExecutionWithAction(ctx, domainID, execution, func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
+		if !msBuilder.IsWorkflowExecutionRunning() {
+			return nil, ErrWorkflowCompleted
+		}
+
+		if _, ok := msBuilder.GetDelayedSignal(request.GetRequestId()); !ok {
+			return nil, &workflow.EntityNotExistsError{Message: "Delayed signal not found."}
+		}
+		if _, err := msBuilder.AddWorkflowExecutionDelayedSignalCanceledEvent(
+			request.GetRequestId(),
+			request.GetIdentity(),
+		); err != nil {
+			return nil, &workflow.InternalServiceError{Message: "Unable to cancel delayed signal."}
+		}
+
+		return &updateWorkflowAction{
+			createDecision: false,
+		}, nil
+	})
+}
+
+// UpdateWorkflowExecution records the update request in the history of the workflow execution,
+// and waits until the workflow responds to it with a CompleteWorkflowExecutionUpdate decision.
+func (e *historyEngineImpl) UpdateWorkflowExecution(
+	ctx ctx.Context,
+	updateRequest *h.UpdateWorkflowExecutionRequest,
+) (*workflow.UpdateWorkflowExecutionResponse, error) {
+
+	domainEntry, err := e.getActiveDomainEntry(updateRequest.DomainUUID)
+	if err != nil {
+		return nil, err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := updateRequest.UpdateRequest
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+	requestID := request.GetRequestId()
+	if requestID == "" {
+		requestID = uuid.New()
+	}
+
+	// the update requested event may be buffered and get its event ID later on,
+	// so the result is looked up from the history starting at the next event ID
+	// at the time the request is recorded
+	var firstEventID int64
+	err = e.updateWorkflowExecutionWithAction(ctx, domainID, execution, func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
+		execution.RunId = common.StringPtr(msBuilder.GetExecutionInfo().RunID)
+		// deduplicate by request id until the update is completed,
+		// the retried request waits for the result of the recorded one
+		if eventID, ok := msBuilder.GetUpdateRequestedEventID(requestID); ok {
+			firstEventID = eventID
+			return &updateWorkflowAction{noop: true}, nil
+		}
+
+		if !msBuilder.IsWorkflowExecutionRunning() {
+			return nil, ErrWorkflowCompleted
+		}
+
+		firstEventID = msBuilder.GetNextEventID()
+		if _, err := msBuilder.AddWorkflowExecutionUpdateRequestedEvent(
+			request.GetUpdateName(),
+			request.GetInput(),
+			request.GetIdentity(),
+			requestID); err != nil {
+			return nil, &workflow.InternalServiceError{Message: "Unable to update workflow execution."}
+		}
+
+		return &updateWorkflowAction{
+			createDecision: true,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return e.waitForWorkflowUpdateResult(ctx, domainEntry, execution, requestID, firstEventID)
+}
+
+// waitForWorkflowUpdateResult long polls the history of the workflow execution,
+// until the WorkflowExecutionUpdateCompleted event of the given update request is recorded
+func (e *historyEngineImpl) waitForWorkflowUpdateResult(
+	ctx ctx.Context,
+	domainEntry *cache.DomainCacheEntry,
+	execution workflow.WorkflowExecution,
+	requestID string,
+	firstEventID int64,
+) (*workflow.UpdateWorkflowExecutionResponse, error) {
+
+	domainID := domainEntry.GetInfo().ID
+	identifier := definition.NewWorkflowIdentifier(domainID, execution.GetWorkflowId(), execution.GetRunId())
+	subscriberID, channel, err := e.historyEventNotifier.WatchHistoryEvent(identifier)
+	if err != nil {
+		return nil, err
+	}
+	defer e.historyEventNotifier.UnwatchHistoryEvent(identifier, subscriberID)
+
+	// check the events recorded before subscribing to the notifications
+	mutableStateResp, err := e.getMutableState(ctx, domainID, execution)
+	if err != nil {
+		return nil, err
+	}
+	eventStoreVersion := mutableStateResp.GetEventStoreVersion()
+	branchToken := mutableStateResp.BranchToken
+
+	checkResult := func(nextEventID int64, isWorkflowRunning bool) (*workflow.UpdateWorkflowExecutionResponse, error) {
+		if nextEventID > firstEventID {
+			response, err := e.getWorkflowUpdateResult(
+				domainID, execution, requestID, firstEventID, nextEventID, eventStoreVersion, branchToken,
+			)
+			if err != nil || response != nil {
+				return response, err
+			}
+			firstEventID = nextEventID
+		}
+		if !isWorkflowRunning {
+			return nil, ErrWorkflowCompleted
+		}
+		return nil, nil
+	}
+
+	response, err := checkResult(mutableStateResp.GetNextEventId(), mutableStateResp.GetIsWorkflowRunning())
+	if err != nil || response != nil {
+		return response, err
+	}
+
+	timeout := e.shard.GetConfig().LongPollExpirationInterval(domainEntry.GetInfo().Name)
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = deadline.Sub(time.Now()) - workflowUpdateResultTimeBudget
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		select {
+		case event := <-channel:
+			response, err := checkResult(event.nextEventID, event.isWorkflowRunning)
+			if err != nil || response != nil {
+				return response, err
+			}
+		case <-timer.C:
+			return nil, ErrWorkflowUpdateTimedOut
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// getWorkflowUpdateResult looks for the WorkflowExecutionUpdateCompleted event of the given update request
+// within the given range of history events, it returns nil if the event is not found.
+func (e *historyEngineImpl) getWorkflowUpdateResult(
+	domainID string,
+	execution workflow.WorkflowExecution,
+	requestID string,
+	firstEventID int64,
+	nextEventID int64,
+	eventStoreVersion int32,
+	branchToken []byte,
+) (*workflow.UpdateWorkflowExecutionResponse, error) {
+
+	var nextPageToken []byte
+	for {
+		var historyEvents []*workflow.HistoryEvent
+		if eventStoreVersion == persistence.EventStoreVersionV2 {
+			response, err := e.historyV2Mgr.ReadHistoryBranch(&persistence.ReadHistoryBranchRequest{
+				BranchToken:   branchToken,
+				MinEventID:    firstEventID,
+				MaxEventID:    nextEventID,
+				PageSize:      defaultHistoryPageSize,
+				NextPageToken: nextPageToken,
+				ShardID:       common.IntPtr(e.shard.GetShardID()),
+			})
+			if err != nil {
+				return nil, err
+			}
+			historyEvents = response.HistoryEvents
+			nextPageToken = response.NextPageToken
+		} else {
+			response, err := e.historyMgr.GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
+				DomainID:      domainID,
+				Execution:     execution,
+				FirstEventID:  firstEventID,
+				NextEventID:   nextEventID,
+				PageSize:      defaultHistoryPageSize,
+				NextPageToken: nextPageToken,
+			})
+			if err != nil {
+				return nil, err
+			}
+			historyEvents = response.History.Events
+			nextPageToken = response.NextPageToken
+		}
+
+		for _, event := range historyEvents {
+			if event.GetEventType() != workflow.EventTypeWorkflowExecutionUpdateCompleted {
+				continue
+			}
+			attributes := event.WorkflowExecutionUpdateCompletedEventAttributes
+			if attributes.GetRequestId() == requestID {
+				return &workflow.UpdateWorkflowExecutionResponse{
+					Result: attributes.Result,
+				}, nil
+			}
+		}
+
+		if len(nextPageToken) == 0 {
+			return nil, nil
+		}
+	}
+}
+
 func (e *historyEngineImpl) SignalWithStartWorkflowExecution(
 	ctx ctx.Context,
 	signalWithStartRequest *h.SignalWithStartWorkflowExecutionRequest,
@@ -1662,6 +2060,124 @@ func (e *historyEngineImpl) TerminateWorkflowExecution(
 		})
 }
 
+// PauseWorkflowExecution stops dispatching decision and activity tasks of a workflow execution
+func (e *historyEngineImpl) PauseWorkflowExecution(
+	ctx ctx.Context,
+	pauseRequest *h.PauseWorkflowExecutionRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainEntry(pauseRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := pauseRequest.PauseRequest
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+
+	return e.updateWorkflowExecutionWithAction(ctx, domainID, execution,
+		func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
+			if !msBuilder.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+			if msBuilder.IsWorkflowExecutionPaused() {
+				return &updateWorkflowAction{noop: true}, nil
+			}
+
+			if _, err := msBuilder.AddWorkflowExecutionPausedEvent(request.GetReason(), request.GetIdentity()); err != nil {
+				return nil, &workflow.InternalServiceError{Message: "Unable to pause workflow execution."}
+			}
+			e.logger.Info("Workflow execution paused.",
+				tag.WorkflowDomainID(domainID),
+				tag.WorkflowID(execution.GetWorkflowId()),
+				tag.WorkflowRunID(msBuilder.GetExecutionInfo().RunID),
+				tag.WorkflowRequestIdentity(request.GetIdentity()),
+				tag.WorkflowRequestReason(request.GetReason()))
+			return &updateWorkflowAction{}, nil
+		})
+}
+
+// UnpauseWorkflowExecution resumes a paused workflow execution, the decision and activity tasks
+// held back while the workflow execution was paused are dispatched again
+func (e *historyEngineImpl) UnpauseWorkflowExecution(
+	ctx ctx.Context,
+	unpauseRequest *h.UnpauseWorkflowExecutionRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainEntry(unpauseRequest.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	request := unpauseRequest.UnpauseRequest
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.WorkflowExecution.WorkflowId,
+		RunId:      request.WorkflowExecution.RunId,
+	}
+
+	return e.updateWorkflowExecutionWithAction(ctx, domainID, execution,
+		func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
+			if !msBuilder.IsWorkflowExecutionRunning() {
+				return nil, ErrWorkflowCompleted
+			}
+			if !msBuilder.IsWorkflowExecutionPaused() {
+				return &updateWorkflowAction{noop: true}, nil
+			}
+
+			if _, err := msBuilder.AddWorkflowExecutionUnpausedEvent(request.GetReason(), request.GetIdentity()); err != nil {
+				return nil, &workflow.InternalServiceError{Message: "Unable to unpause workflow execution."}
+			}
+			e.logger.Info("Workflow execution unpaused.",
+				tag.WorkflowDomainID(domainID),
+				tag.WorkflowID(execution.GetWorkflowId()),
+				tag.WorkflowRunID(msBuilder.GetExecutionInfo().RunID),
+				tag.WorkflowRequestIdentity(request.GetIdentity()),
+				tag.WorkflowRequestReason(request.GetReason()))
+			var timerTasks []persistence.Task
+			if tt := tBuilder.GetActivityTimerTaskIfNeeded(msBuilder); tt != nil {
+				timerTasks = append(timerTasks, tt)
+			}
+			return &updateWorkflowAction{
+				timerTasks:    timerTasks,
+				transferTasks: getUnpauseTransferTasks(msBuilder, e.shard.GetTimeSource().Now()),
+			}, nil
+		})
+}
+
+// getUnpauseTransferTasks re-creates the transfer tasks of the scheduled but not started decision and activities,
+// since the tasks dispatched while the workflow execution was paused are dropped
+func getUnpauseTransferTasks(
+	msBuilder mutableState,
+	now time.Time,
+) []persistence.Task {
+
+	executionInfo := msBuilder.GetExecutionInfo()
+	var transferTasks []persistence.Task
+	if msBuilder.HasPendingDecisionTask() && !msBuilder.HasInFlightDecisionTask() {
+		transferTasks = append(transferTasks, &persistence.DecisionTask{
+			DomainID:   executionInfo.DomainID,
+			TaskList:   executionInfo.TaskList,
+			ScheduleID: executionInfo.DecisionScheduleID,
+		})
+	}
+	for _, ai := range msBuilder.GetPendingActivityInfos() {
+		// activities waiting for the next retry are dispatched by the retry timer
+		if ai.StartedID != common.EmptyEventID || ai.ScheduledTime.After(now) {
+			continue
+		}
+		transferTasks = append(transferTasks, &persistence.ActivityTask{
+			DomainID:   ai.DomainID,
+			TaskList:   ai.TaskList,
+			ScheduleID: ai.ScheduleID,
+		})
+	}
+	return transferTasks
+}
+
 // RecordChildExecutionCompleted records the completion of child execution into parent execution history
 func (e *historyEngineImpl) RecordChildExecutionCompleted(
 	ctx ctx.Context,
@@ -1760,6 +2276,294 @@ func (e *historyEngineImpl) SyncActivity(
 	return e.replicator.SyncActivity(ctx, request)
 }
 
+// DescribeShardQueues returns the ack levels, read levels and outstanding task counts of the queue processors
+func (e *historyEngineImpl) DescribeShardQueues(
+	ctx ctx.Context,
+	request *workflow.DescribeShardQueuesRequest,
+) (*workflow.DescribeShardQueuesResponse, error) {
+
+	queues := e.txProcessor.describeQueues()
+	queues = append(queues, e.timerProcessor.describeQueues()...)
+	if e.replicatorProcessor != nil {
+		queues = append(queues, e.replicatorProcessor.describeQueue(workflow.ShardQueueTypeReplication))
+	}
+	return &workflow.DescribeShardQueuesResponse{
+		ShardID:         common.Int32Ptr(int32(e.shard.GetShardID())),
+		QueueProcessors: queues,
+	}, nil
+}
+
+// ListShardTasks returns a page of the persisted transfer, timer or replication tasks of the shard
+func (e *historyEngineImpl) ListShardTasks(
+	ctx ctx.Context,
+	request *workflow.ListShardTasksRequest,
+) (*workflow.ListShardTasksResponse, error) {
+
+	pageSize := int(request.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultShardTasksPageSize
+	}
+	response := &workflow.ListShardTasksResponse{
+		Tasks: []*workflow.ShardTaskInfo{},
+	}
+
+	switch request.GetQueueType() {
+	case workflow.ShardQueueTypeTransfer:
+		maxReadLevel := int64(math.MaxInt64)
+		if request.MaxLevel != nil {
+			maxReadLevel = request.GetMaxLevel()
+		}
+		resp, err := e.executionManager.GetTransferTasks(&persistence.GetTransferTasksRequest{
+			ReadLevel:     request.GetMinLevel(),
+			MaxReadLevel:  maxReadLevel,
+			BatchSize:     pageSize,
+			NextPageToken: request.NextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, task := range resp.Tasks {
+			response.Tasks = append(response.Tasks, &workflow.ShardTaskInfo{
+				TaskId:              common.Int64Ptr(task.TaskID),
+				TaskType:            common.Int32Ptr(int32(task.TaskType)),
+				DomainId:            common.StringPtr(task.DomainID),
+				WorkflowId:          common.StringPtr(task.WorkflowID),
+				RunId:               common.StringPtr(task.RunID),
+				VisibilityTimestamp: common.Int64Ptr(task.VisibilityTimestamp.UnixNano()),
+				Version:             common.Int64Ptr(task.Version),
+			})
+		}
+		response.NextPageToken = resp.NextPageToken
+
+	case workflow.ShardQueueTypeTimer:
+		maxTimestamp := maximumTime
+		if request.MaxLevel != nil {
+			maxTimestamp = time.Unix(0, request.GetMaxLevel())
+		}
+		resp, err := e.executionManager.GetTimerIndexTasks(&persistence.GetTimerIndexTasksRequest{
+			MinTimestamp:  time.Unix(0, request.GetMinLevel()),
+			MaxTimestamp:  maxTimestamp,
+			BatchSize:     pageSize,
+			NextPageToken: request.NextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, task := range resp.Timers {
+			response.Tasks = append(response.Tasks, &workflow.ShardTaskInfo{
+				TaskId:              common.Int64Ptr(task.TaskID),
+				TaskType:            common.Int32Ptr(int32(task.TaskType)),
+				DomainId:            common.StringPtr(task.DomainID),
+				WorkflowId:          common.StringPtr(task.WorkflowID),
+				RunId:               common.StringPtr(task.RunID),
+				VisibilityTimestamp: common.Int64Ptr(task.VisibilityTimestamp.UnixNano()),
+				Version:             common.Int64Ptr(task.Version),
+			})
+		}
+		response.NextPageToken = resp.NextPageToken
+
+	case workflow.ShardQueueTypeReplication:
+		maxReadLevel := int64(math.MaxInt64)
+		if request.MaxLevel != nil {
+			maxReadLevel = request.GetMaxLevel()
+		}
+		resp, err := e.executionManager.GetReplicationTasks(&persistence.GetReplicationTasksRequest{
+			ReadLevel:     request.GetMinLevel(),
+			MaxReadLevel:  maxReadLevel,
+			BatchSize:     pageSize,
+			NextPageToken: request.NextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, task := range resp.Tasks {
+			response.Tasks = append(response.Tasks, &workflow.ShardTaskInfo{
+				TaskId:     common.Int64Ptr(task.TaskID),
+				TaskType:   common.Int32Ptr(int32(task.TaskType)),
+				DomainId:   common.StringPtr(task.DomainID),
+				WorkflowId: common.StringPtr(task.WorkflowID),
+				RunId:      common.StringPtr(task.RunID),
+				Version:    common.Int64Ptr(task.Version),
+			})
+		}
+		response.NextPageToken = resp.NextPageToken
+
+	default:
+		return nil, &workflow.BadRequestError{Message: "Unknown shard queue type."}
+	}
+
+	return response, nil
+}
+
+// RemoveShardTask deletes a transfer, timer or replication task of the shard from persistence.
+// Queue processors keep the tasks they loaded in memory, so the shard has to be reloaded afterwards.
+func (e *historyEngineImpl) RemoveShardTask(
+	ctx ctx.Context,
+	request *workflow.RemoveShardTaskRequest,
+) error {
+
+	if request.TaskId == nil {
+		return &workflow.BadRequestError{Message: "Task ID not set on request."}
+	}
+
+	switch request.GetQueueType() {
+	case workflow.ShardQueueTypeTransfer:
+		return e.executionManager.CompleteTransferTask(&persistence.CompleteTransferTaskRequest{
+			TaskID: request.GetTaskId(),
+		})
+	case workflow.ShardQueueTypeTimer:
+		if request.VisibilityTimestamp == nil {
+			return &workflow.BadRequestError{Message: "Visibility timestamp not set on request."}
+		}
+		return e.executionManager.CompleteTimerTask(&persistence.CompleteTimerTaskRequest{
+			VisibilityTimestamp: time.Unix(0, request.GetVisibilityTimestamp()),
+			TaskID:              request.GetTaskId(),
+		})
+	case workflow.ShardQueueTypeReplication:
+		return e.executionManager.CompleteReplicationTask(&persistence.CompleteReplicationTaskRequest{
+			TaskID: request.GetTaskId(),
+		})
+	default:
+		return &workflow.BadRequestError{Message: "Unknown shard queue type."}
+	}
+}
+
+// RefreshWorkflowTasks regenerates the transfer and timer tasks implied by the mutable state of a workflow execution
+func (e *historyEngineImpl) RefreshWorkflowTasks(
+	ctx ctx.Context,
+	request *h.RefreshWorkflowTasksRequest,
+) error {
+
+	domainEntry, err := e.getActiveDomainEntry(request.DomainUUID)
+	if err != nil {
+		return err
+	}
+	domainID := domainEntry.GetInfo().ID
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.Execution.WorkflowId,
+		RunId:      request.Execution.RunId,
+	}
+
+	refresher := newWorkflowTaskRefresher(e)
+	return e.updateWorkflowExecutionWithAction(ctx, domainID, execution,
+		func(msBuilder mutableState, tBuilder *timerBuilder) (*updateWorkflowAction, error) {
+			transferTasks, timerTasks, err := refresher.refreshTasks(msBuilder, tBuilder)
+			if err != nil {
+				return nil, err
+			}
+			e.logger.Info("Workflow execution tasks refreshed.",
+				tag.WorkflowDomainID(domainID),
+				tag.WorkflowID(execution.GetWorkflowId()),
+				tag.WorkflowRunID(msBuilder.GetExecutionInfo().RunID))
+			return &updateWorkflowAction{
+				transferTasks: transferTasks,
+				timerTasks:    timerTasks,
+			}, nil
+		})
+}
+
+// DeleteWorkflowExecution permanently removes the visibility records, history and mutable state of a workflow execution,
+// the mutable state is removed last so a partially failed deletion can be retried
+func (e *historyEngineImpl) DeleteWorkflowExecution(
+	ctx ctx.Context,
+	request *h.DeleteWorkflowExecutionRequest,
+) (retError error) {
+
+	domainID, err := validateDomainUUID(request.DomainUUID)
+	if err != nil {
+		return err
+	}
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: request.Execution.WorkflowId,
+		RunId:      request.Execution.RunId,
+	}
+	context, release, err := e.historyCache.getOrCreateWorkflowExecution(ctx, domainID, execution)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	msBuilder, err := context.loadWorkflowExecution()
+	if err != nil {
+		return err
+	}
+	// deleting a running workflow execution would leave its pending tasks and timers behind
+	if msBuilder.IsWorkflowExecutionRunning() {
+		return ErrWorkflowRunning
+	}
+	executionInfo := msBuilder.GetExecutionInfo()
+
+	visibilityRequest := &persistence.VisibilityDeleteWorkflowExecutionRequest{
+		DomainID:       domainID,
+		WorkflowID:     executionInfo.WorkflowID,
+		RunID:          executionInfo.RunID,
+		StartTimestamp: executionInfo.StartTimestamp.UnixNano(),
+	}
+	if completionEvent, ok := msBuilder.GetCompletionEvent(); ok {
+		visibilityRequest.CloseTimestamp = completionEvent.GetTimestamp()
+	}
+	// the task ID orders the deletion after the visibility records written by the transfer tasks
+	visibilityRequest.TaskID, err = e.shard.GenerateTransferTaskID()
+	if err != nil {
+		return err
+	}
+	op := func() error {
+		return e.visibilityMgr.DeleteWorkflowExecution(visibilityRequest)
+	}
+	if err := backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError); err != nil {
+		return err
+	}
+
+	op = func() error {
+		if msBuilder.GetEventStoreVersion() == persistence.EventStoreVersionV2 {
+			return persistence.DeleteWorkflowExecutionHistoryV2(e.historyV2Mgr, msBuilder.GetCurrentBranch(),
+				common.IntPtr(e.shard.GetShardID()), e.logger)
+		}
+		return e.historyMgr.DeleteWorkflowExecutionHistory(&persistence.DeleteWorkflowExecutionHistoryRequest{
+			DomainID: domainID,
+			Execution: workflow.WorkflowExecution{
+				WorkflowId: common.StringPtr(executionInfo.WorkflowID),
+				RunId:      common.StringPtr(executionInfo.RunID),
+			},
+		})
+	}
+	if err := backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError); err != nil {
+		return err
+	}
+
+	op = func() error {
+		return e.executionManager.DeleteCurrentWorkflowExecution(&persistence.DeleteCurrentWorkflowExecutionRequest{
+			DomainID:   domainID,
+			WorkflowID: executionInfo.WorkflowID,
+			RunID:      executionInfo.RunID,
+		})
+	}
+	if err := backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError); err != nil {
+		return err
+	}
+
+	op = func() error {
+		return e.executionManager.DeleteWorkflowExecution(&persistence.DeleteWorkflowExecutionRequest{
+			DomainID:   domainID,
+			WorkflowID: executionInfo.WorkflowID,
+			RunID:      executionInfo.RunID,
+		})
+	}
+	if err := backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError); err != nil {
+		return err
+	}
+
+	// force the next access of the workflow execution to read the database
+	context.clear()
+	e.logger.Info("Workflow execution deleted.",
+		tag.WorkflowDomainID(domainID),
+		tag.WorkflowID(executionInfo.WorkflowID),
+		tag.WorkflowRunID(executionInfo.RunID))
+	return nil
+}
+
 func (e *historyEngineImpl) ResetWorkflowExecution(
 	ctx ctx.Context,
 	resetRequest *h.ResetWorkflowExecutionRequest,
@@ -2253,9 +3057,12 @@ func getStartRequest(
 		WorkflowIdReusePolicy:               request.WorkflowIdReusePolicy,
 		RetryPolicy:                         request.RetryPolicy,
 		CronSchedule:                        request.CronSchedule,
+		CronMaxJitterSeconds:                request.CronMaxJitterSeconds,
+		TaskPriority:                        request.TaskPriority,
 		Memo:                                request.Memo,
 		SearchAttributes:                    request.SearchAttributes,
 		Header:                              request.Header,
+		DelayStartSeconds:                   request.DelayStartSeconds,
 	}
 
 	startRequest := common.CreateHistoryStartWorkflowRequest(domainID, req)
@@ -2317,7 +3124,7 @@ func (e *historyEngineImpl) applyWorkflowIDReusePolicyHelper(
 			msg := "Workflow execution already finished successfully. WorkflowId: %v, RunId: %v. Workflow ID reuse policy: allow duplicate workflow ID if last run failed."
 			return getWorkflowAlreadyStartedError(msg, prevStartRequestID, execution.GetWorkflowId(), prevRunID)
 		}
-	case workflow.WorkflowIdReusePolicyAllowDuplicate:
+	case workflow.WorkflowIdReusePolicyAllowDuplicate, workflow.WorkflowIdReusePolicyTerminateIfRunning:
 		// as long as workflow not running, so this case has no check
 	case workflow.WorkflowIdReusePolicyRejectDuplicate:
 		msg := "Workflow execution already finished. WorkflowId: %v, RunId: %v. Workflow ID reuse policy: reject duplicate workflow ID."
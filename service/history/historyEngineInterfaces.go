@@ -60,9 +60,13 @@ type (
 		RecordActivityTaskHeartbeat(ctx context.Context, request *h.RecordActivityTaskHeartbeatRequest) (*workflow.RecordActivityTaskHeartbeatResponse, error)
 		RequestCancelWorkflowExecution(ctx context.Context, request *h.RequestCancelWorkflowExecutionRequest) error
 		SignalWorkflowExecution(ctx context.Context, request *h.SignalWorkflowExecutionRequest) error
+		CancelDelayedSignal(ctx context.Context, request *h.CancelDelayedSignalRequest) error
+		UpdateWorkflowExecution(ctx context.Context, request *h.UpdateWorkflowExecutionRequest) (*workflow.UpdateWorkflowExecutionResponse, error)
 		SignalWithStartWorkflowExecution(ctx context.Context, request *h.SignalWithStartWorkflowExecutionRequest) (*workflow.StartWorkflowExecutionResponse, error)
 		RemoveSignalMutableState(ctx context.Context, request *h.RemoveSignalMutableStateRequest) error
 		TerminateWorkflowExecution(ctx context.Context, request *h.TerminateWorkflowExecutionRequest) error
+		PauseWorkflowExecution(ctx context.Context, request *h.PauseWorkflowExecutionRequest) error
+		UnpauseWorkflowExecution(ctx context.Context, request *h.UnpauseWorkflowExecutionRequest) error
 		ResetWorkflowExecution(ctx context.Context, request *h.ResetWorkflowExecutionRequest) (*workflow.ResetWorkflowExecutionResponse, error)
 		ScheduleDecisionTask(ctx context.Context, request *h.ScheduleDecisionTaskRequest) error
 		RecordChildExecutionCompleted(ctx context.Context, request *h.RecordChildExecutionCompletedRequest) error
@@ -70,6 +74,11 @@ type (
 		ReplicateRawEvents(ctx context.Context, request *h.ReplicateRawEventsRequest) error
 		SyncShardStatus(ctx context.Context, request *h.SyncShardStatusRequest) error
 		SyncActivity(ctx context.Context, request *h.SyncActivityRequest) error
+		DescribeShardQueues(ctx context.Context, request *workflow.DescribeShardQueuesRequest) (*workflow.DescribeShardQueuesResponse, error)
+		ListShardTasks(ctx context.Context, request *workflow.ListShardTasksRequest) (*workflow.ListShardTasksResponse, error)
+		RemoveShardTask(ctx context.Context, request *workflow.RemoveShardTaskRequest) error
+		RefreshWorkflowTasks(ctx context.Context, request *h.RefreshWorkflowTasksRequest) error
+		DeleteWorkflowExecution(ctx context.Context, request *h.DeleteWorkflowExecutionRequest) error
 
 		NotifyNewHistoryEvent(event *historyEventNotification)
 		NotifyNewTransferTasks(tasks []persistence.Task)
@@ -85,6 +94,7 @@ type (
 	queueProcessor interface {
 		common.Daemon
 		notifyNewTask()
+		describeQueue(queueType workflow.ShardQueueType) *workflow.ShardQueueProcessorInfo
 	}
 
 	queueAckMgr interface {
@@ -93,6 +103,7 @@ type (
 		completeQueueTask(taskID int64)
 		getQueueAckLevel() int64
 		getQueueReadLevel() int64
+		getQueueOutstandingTaskCount() int
 		updateQueueAckLevel()
 	}
 
@@ -117,6 +128,7 @@ type (
 		NotifyNewTask(clusterName string, transferTasks []persistence.Task)
 		LockTaskPrrocessing()
 		UnlockTaskPrrocessing()
+		describeQueues() []*workflow.ShardQueueProcessorInfo
 	}
 
 	// TODO the timer queue processor and the one below, timer processor
@@ -128,6 +140,7 @@ type (
 		NotifyNewTimers(clusterName string, timerTask []persistence.Task)
 		LockTaskPrrocessing()
 		UnlockTaskPrrocessing()
+		describeQueues() []*workflow.ShardQueueProcessorInfo
 	}
 
 	timerProcessor interface {
@@ -142,6 +155,7 @@ type (
 		completeTimerTask(timerTask *persistence.TimerTaskInfo)
 		getAckLevel() TimerSequenceID
 		getReadLevel() TimerSequenceID
+		getOutstandingTaskCount() int
 		updateAckLevel()
 	}
 
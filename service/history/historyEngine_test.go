@@ -48,6 +48,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 	"github.com/uber/cadence/service/worker/archiver"
 )
 
@@ -178,6 +179,7 @@ func (s *engineSuite) SetupTest() {
 		executionManager:     s.mockExecutionMgr,
 		historyMgr:           s.mockHistoryMgr,
 		historyV2Mgr:         s.mockHistoryV2Mgr,
+		visibilityMgr:        s.mockVisibilityMgr,
 		historyCache:         historyCache,
 		logger:               s.logger,
 		metricsClient:        metricsClient,
@@ -1475,6 +1477,101 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedSingleActivityScheduledDec
 	s.Equal(int32(5), *activity1Attributes.HeartbeatTimeoutSeconds)
 }
 
+func (s *engineSuite) TestRespondDecisionTaskCompletedSingleActivityScheduledDecision_LocalDispatch() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: "wId",
+		RunID:      we.GetRunId(),
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+	input := []byte("input")
+	s.mockHistoryEngine.config.EnableActivityLocalDispatchByDomain = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+
+	decisions := []*workflow.Decision{{
+		DecisionType: common.DecisionTypePtr(workflow.DecisionTypeScheduleActivityTask),
+		ScheduleActivityTaskDecisionAttributes: &workflow.ScheduleActivityTaskDecisionAttributes{
+			ActivityId:                    common.StringPtr("activity1"),
+			ActivityType:                  &workflow.ActivityType{Name: common.StringPtr("activity_type1")},
+			TaskList:                      &workflow.TaskList{Name: &tl},
+			Input:                         input,
+			ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(10),
+			StartToCloseTimeoutSeconds:    common.Int32Ptr(50),
+			HeartbeatTimeoutSeconds:       common.Int32Ptr(5),
+			RequestLocalDispatch:          common.BoolPtr(true),
+		},
+	}}
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	var updateRequest *p.UpdateWorkflowExecutionRequest
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Run(func(args mock.Arguments) {
+		updateRequest = args.Get(0).(*p.UpdateWorkflowExecutionRequest)
+	}).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID, Name: "testDomain"},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	resp, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(context.Background(), &history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken: taskToken,
+			Decisions: decisions,
+			Identity:  &identity,
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(7), executionBuilder.GetExecutionInfo().NextEventID)
+	ai, ok := executionBuilder.GetActivityInfo(int64(5))
+	s.True(ok)
+	s.Equal(int64(6), ai.StartedID)
+	for _, task := range updateRequest.UpdateWorkflowMutation.TransferTasks {
+		s.NotEqual(persistence.TransferTaskTypeActivityTask, task.GetType())
+	}
+
+	s.Len(resp.ActivitiesToDispatchLocally, 1)
+	activityTask := resp.ActivitiesToDispatchLocally[0]
+	s.Equal("activity1", activityTask.GetActivityId())
+	s.Equal("activity_type1", activityTask.ActivityType.GetName())
+	s.Equal(input, activityTask.Input)
+	s.Equal(we.GetWorkflowId(), activityTask.WorkflowExecution.GetWorkflowId())
+	s.Equal(we.GetRunId(), activityTask.WorkflowExecution.GetRunId())
+	s.Equal(int32(50), activityTask.GetStartToCloseTimeoutSeconds())
+	s.Equal(int32(0), activityTask.GetAttempt())
+	s.Equal("testDomain", activityTask.GetWorkflowDomain())
+	token, err := s.mockHistoryEngine.tokenSerializer.Deserialize(activityTask.TaskToken)
+	s.Nil(err)
+	s.Equal(int64(5), token.ScheduleID)
+	s.Equal(we.GetRunId(), token.RunID)
+}
+
 func (s *engineSuite) TestRespondDecisionTaskCompletedCompleteWorkflowSuccess() {
 	domainID := validDomainID
 	we := workflow.WorkflowExecution{
@@ -4727,6 +4824,134 @@ func (s *engineSuite) TestSignalWorkflowExecution() {
 	s.Nil(err)
 }
 
+func (s *engineSuite) TestSignalWorkflowExecution_Delayed() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	signalName := "my signal name"
+	requestID := uuid.New()
+	signalRequest := &history.SignalWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		SignalRequest: &workflow.SignalWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			Identity:          common.StringPtr(identity),
+			SignalName:        common.StringPtr(signalName),
+			Input:             []byte("test input"),
+			RequestId:         common.StringPtr(requestID),
+			DelaySeconds:      common.Int32Ptr(30),
+		},
+	}
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = validDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.MatchedBy(func(request *p.AppendHistoryNodesRequest) bool {
+		return len(request.Events) == 1 &&
+			request.Events[0].GetEventType() == workflow.EventTypeWorkflowExecutionDelayedSignalRequested &&
+			request.Events[0].WorkflowExecutionDelayedSignalRequestedEventAttributes.GetRequestId() == requestID
+	})).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		mutation := request.UpdateWorkflowMutation
+		if len(mutation.UpsertDelayedSignalInfos) != 1 || len(mutation.TimerTasks) != 1 {
+			return false
+		}
+		_, ok := mutation.TimerTasks[0].(*persistence.DelayedSignalTimerTask)
+		return ok && mutation.UpsertDelayedSignalInfos[0].RequestID == requestID
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	err := s.mockHistoryEngine.SignalWorkflowExecution(context.Background(), signalRequest)
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestCancelDelayedSignal() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	requestID := uuid.New()
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+	_, err := msBuilder.AddWorkflowExecutionDelayedSignalRequestedEvent(requestID, "my signal name", []byte("test input"), nil, identity, time.Now().Add(time.Minute))
+	s.NoError(err)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = validDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.MatchedBy(func(request *p.AppendHistoryNodesRequest) bool {
+		return len(request.Events) == 1 &&
+			request.Events[0].GetEventType() == workflow.EventTypeWorkflowExecutionDelayedSignalCanceled &&
+			request.Events[0].WorkflowExecutionDelayedSignalCanceledEventAttributes.GetIdentity() == identity
+	})).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		deleted := request.UpdateWorkflowMutation.DeleteDelayedSignalInfos
+		return len(deleted) == 1 && deleted[0] == requestID
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	cancelRequest := &history.CancelDelayedSignalRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CancelRequest: &workflow.CancelDelayedSignalRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			RequestId:         common.StringPtr(requestID),
+			Identity:          common.StringPtr(identity),
+		},
+	}
+	err = s.mockHistoryEngine.CancelDelayedSignal(context.Background(), cancelRequest)
+	s.Nil(err)
+
+	// unknown delayed signal cannot be cancelled
+	cancelRequest.CancelRequest.RequestId = common.StringPtr(uuid.New())
+	err = s.mockHistoryEngine.CancelDelayedSignal(context.Background(), cancelRequest)
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+}
+
 // Test signal decision by adding request ID
 func (s *engineSuite) TestSignalWorkflowExecution_DuplicateRequest() {
 	signalRequest := &history.SignalWorkflowExecutionRequest{}
@@ -4888,61 +5113,623 @@ func (s *engineSuite) TestRemoveSignalMutableState() {
 	s.Nil(err)
 }
 
-func (s *engineSuite) getBuilder(domainID string, we workflow.WorkflowExecution) mutableState {
-	context, release, err := s.mockHistoryEngine.historyCache.getOrCreateWorkflowExecutionForBackground(domainID, we)
-	if err != nil {
-		return nil
-	}
-	defer release(nil)
-
-	return context.(*workflowExecutionContextImpl).msBuilder
-}
-
-func (s *engineSuite) getActivityScheduledEvent(msBuilder mutableState,
-	scheduleID int64) *workflow.HistoryEvent {
-	event, _ := msBuilder.GetActivityScheduledEvent(scheduleID)
-	return event
-}
-
-func (s *engineSuite) printHistory(builder mutableState) string {
-	return builder.GetHistoryBuilder().GetHistory().String()
-}
-
-func addWorkflowExecutionStartedEventWithParent(builder mutableState, workflowExecution workflow.WorkflowExecution,
-	workflowType, taskList string, input []byte, executionStartToCloseTimeout, taskStartToCloseTimeout int32,
-	parentInfo *history.ParentExecutionInfo, identity string) *workflow.HistoryEvent {
+func (s *engineSuite) TestPauseUnpauseWorkflowExecution() {
 	domainID := validDomainID
-	startRequest := &workflow.StartWorkflowExecutionRequest{
-		WorkflowId:                          common.StringPtr(*workflowExecution.WorkflowId),
-		WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(workflowType)},
-		TaskList:                            &workflow.TaskList{Name: common.StringPtr(taskList)},
-		Input:                               input,
-		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(executionStartToCloseTimeout),
-		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(taskStartToCloseTimeout),
-		Identity:                            common.StringPtr(identity),
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
 	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
 
-	event, _ := builder.AddWorkflowExecutionStartedEvent(
-		cache.NewLocalDomainCacheEntryForTest(&persistence.DomainInfo{ID: domainID}, &persistence.DomainConfig{}, "", nil),
-		workflowExecution,
-		&history.StartWorkflowExecutionRequest{
-			DomainUUID:          common.StringPtr(domainID),
-			StartRequest:        startRequest,
-			ParentExecutionInfo: parentInfo,
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = validDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
 		},
+		nil,
 	)
 
-	return event
-}
+	var appendedEvents []*workflow.HistoryEvent
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Run(func(args mock.Arguments) {
+		appendedEvents = append(appendedEvents, args.Get(0).(*p.AppendHistoryNodesRequest).Events...)
+	}).Twice()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		return request.UpdateWorkflowMutation.ExecutionInfo.Paused && len(request.UpdateWorkflowMutation.TransferTasks) == 0
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+	err := s.mockHistoryEngine.PauseWorkflowExecution(context.Background(), &history.PauseWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		PauseRequest: &workflow.PauseWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			Reason:            common.StringPtr("pause reason"),
+			Identity:          common.StringPtr(identity),
+		},
+	})
+	s.Nil(err)
+	s.True(s.getBuilder(domainID, we).IsWorkflowExecutionPaused())
+	s.Equal(1, len(appendedEvents))
+	s.Equal(workflow.EventTypeWorkflowExecutionPaused, appendedEvents[0].GetEventType())
+	s.Equal("pause reason", appendedEvents[0].WorkflowExecutionPausedEventAttributes.GetReason())
+	s.Equal(identity, appendedEvents[0].WorkflowExecutionPausedEventAttributes.GetIdentity())
+
+	// pausing a paused workflow execution is a noop
+	err = s.mockHistoryEngine.PauseWorkflowExecution(context.Background(), &history.PauseWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		PauseRequest: &workflow.PauseWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			Identity:          common.StringPtr(identity),
+		},
+	})
+	s.Nil(err)
 
-func addWorkflowExecutionStartedEvent(builder mutableState, workflowExecution workflow.WorkflowExecution,
-	workflowType, taskList string, input []byte, executionStartToCloseTimeout, taskStartToCloseTimeout int32,
-	identity string) *workflow.HistoryEvent {
-	return addWorkflowExecutionStartedEventWithParent(builder, workflowExecution, workflowType, taskList, input,
-		executionStartToCloseTimeout, taskStartToCloseTimeout, nil, identity)
-}
+	// the failed decision task start clears the cached mutable state, reload the paused one
+	pausedMs := createMutableState(s.getBuilder(domainID, we))
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionResponse{State: pausedMs}, nil).Once()
+	_, err = s.mockHistoryEngine.RecordDecisionTaskStarted(context.Background(), &history.RecordDecisionTaskStartedRequest{
+		DomainUUID:        common.StringPtr(domainID),
+		WorkflowExecution: &we,
+		ScheduleId:        common.Int64Ptr(di.ScheduleID),
+		TaskId:            common.Int64Ptr(100),
+		RequestId:         common.StringPtr("reqId"),
+		PollRequest: &workflow.PollForDecisionTaskRequest{
+			TaskList: &workflow.TaskList{Name: common.StringPtr(tasklist)},
+			Identity: common.StringPtr(identity),
+		},
+	})
+	s.Equal(ErrWorkflowPaused, err)
 
-func addDecisionTaskScheduledEvent(builder mutableState) *decisionInfo {
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		transferTasks := request.UpdateWorkflowMutation.TransferTasks
+		if request.UpdateWorkflowMutation.ExecutionInfo.Paused || len(transferTasks) != 1 {
+			return false
+		}
+		decisionTask, ok := transferTasks[0].(*p.DecisionTask)
+		return ok && decisionTask.ScheduleID == di.ScheduleID && decisionTask.TaskList == tasklist
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+	err = s.mockHistoryEngine.UnpauseWorkflowExecution(context.Background(), &history.UnpauseWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		UnpauseRequest: &workflow.UnpauseWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			Identity:          common.StringPtr(identity),
+		},
+	})
+	s.Nil(err)
+	s.False(s.getBuilder(domainID, we).IsWorkflowExecutionPaused())
+	s.Equal(2, len(appendedEvents))
+	s.Equal(workflow.EventTypeWorkflowExecutionUnpaused, appendedEvents[1].GetEventType())
+}
+
+func (s *engineSuite) TestRespondDecisionTaskCompletedReturnNewDecisionTask_Paused() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: *we.WorkflowId,
+		RunID:      *we.RunId,
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	msBuilder.GetExecutionInfo().Paused = true
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		// the new decision task is scheduled, but not started
+		transferTasks := request.UpdateWorkflowMutation.TransferTasks
+		if len(transferTasks) != 1 {
+			return false
+		}
+		decisionTask, ok := transferTasks[0].(*p.DecisionTask)
+		return ok && decisionTask.ScheduleID == 5
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	resp, err := s.mockHistoryEngine.RespondDecisionTaskCompleted(context.Background(), &history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken:                  taskToken,
+			Identity:                   &identity,
+			ForceCreateNewDecisionTask: common.BoolPtr(true),
+			ReturnNewDecisionTask:      common.BoolPtr(true),
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+	s.Nil(resp.StartedResponse)
+	executionBuilder := s.getBuilder(domainID, we)
+	s.True(executionBuilder.HasPendingDecisionTask())
+	s.False(executionBuilder.HasInFlightDecisionTask())
+}
+
+func (s *engineSuite) TestRefreshWorkflowTasks() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
+		decisionStartedEvent.GetEventId(), nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(),
+		"activity1", "activity_type1", tasklist, []byte("input1"), 100, 10, 5)
+	addTimerStartedEvent(msBuilder, decisionCompletedEvent.GetEventId(), "timer1", 50)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = validDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		transferTasks := request.UpdateWorkflowMutation.TransferTasks
+		if len(transferTasks) != 1 {
+			return false
+		}
+		activityTask, ok := transferTasks[0].(*p.ActivityTask)
+		if !ok || activityTask.ScheduleID != activityScheduledEvent.GetEventId() || activityTask.TaskList != tasklist {
+			return false
+		}
+
+		timerTaskTypes := make(map[int]int)
+		for _, task := range request.UpdateWorkflowMutation.TimerTasks {
+			timerTaskTypes[task.GetType()]++
+		}
+		return len(request.UpdateWorkflowMutation.TimerTasks) == 3 &&
+			timerTaskTypes[p.TaskTypeWorkflowTimeout] == 1 &&
+			timerTaskTypes[p.TaskTypeActivityTimeout] == 1 &&
+			timerTaskTypes[p.TaskTypeUserTimer] == 1
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+
+	err := s.mockHistoryEngine.RefreshWorkflowTasks(context.Background(), &history.RefreshWorkflowTasksRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &we,
+	})
+	s.Nil(err)
+
+	builder := s.getBuilder(domainID, we)
+	ai, ok := builder.GetActivityInfo(activityScheduledEvent.GetEventId())
+	s.True(ok)
+	s.NotEqual(int32(TimerTaskStatusNone), ai.TimerTaskStatus)
+	_, ti := builder.GetUserTimer("timer1")
+	s.Equal(int64(TimerTaskStatusCreated), ti.TaskID)
+}
+
+func (s *engineSuite) TestDeleteWorkflowExecution() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	msBuilder.GetExecutionInfo().DomainID = validDomainID
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	startedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tasklist, identity)
+	completedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, startedEvent.GetEventId(), nil, identity)
+	completionEvent := addCompleteWorkflowEvent(msBuilder, completedEvent.GetEventId(), nil)
+	ms := createMutableState(msBuilder)
+	startTimestamp := ms.ExecutionInfo.StartTimestamp.UnixNano()
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+	s.mockVisibilityMgr.On("DeleteWorkflowExecution", mock.MatchedBy(func(request *p.VisibilityDeleteWorkflowExecutionRequest) bool {
+		return request.DomainID == domainID && request.WorkflowID == we.GetWorkflowId() && request.RunID == we.GetRunId() &&
+			request.StartTimestamp == startTimestamp && request.CloseTimestamp == completionEvent.GetTimestamp() &&
+			request.TaskID > 0
+	})).Return(nil).Once()
+	s.mockHistoryV2Mgr.On("DeleteHistoryBranch", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("DeleteCurrentWorkflowExecution", &p.DeleteCurrentWorkflowExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+	}).Return(nil).Once()
+	s.mockExecutionMgr.On("DeleteWorkflowExecution", &p.DeleteWorkflowExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+	}).Return(nil).Once()
+
+	err := s.mockHistoryEngine.DeleteWorkflowExecution(context.Background(), &history.DeleteWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &we,
+	})
+	s.Nil(err)
+
+	// the deleted workflow execution is no longer cached
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(
+		nil, &workflow.EntityNotExistsError{}).Once()
+	_, err = s.mockHistoryEngine.GetMutableState(context.Background(), &history.GetMutableStateRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &we,
+	})
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+}
+
+func (s *engineSuite) TestDeleteWorkflowExecution_Running() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = validDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	err := s.mockHistoryEngine.DeleteWorkflowExecution(context.Background(), &history.DeleteWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		Execution:  &we,
+	})
+	s.Equal(ErrWorkflowRunning, err)
+	s.mockVisibilityMgr.AssertNotCalled(s.T(), "DeleteWorkflowExecution", mock.Anything)
+	s.mockExecutionMgr.AssertNotCalled(s.T(), "DeleteWorkflowExecution", mock.Anything)
+}
+
+func (s *engineSuite) TestUpdateWorkflowExecution() {
+	updateRequest := &history.UpdateWorkflowExecutionRequest{}
+	_, err := s.mockHistoryEngine.UpdateWorkflowExecution(context.Background(), updateRequest)
+	s.EqualError(err, "BadRequestError{Message: Missing domain UUID.}")
+
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	requestID := uuid.New()
+	updateRequest = &history.UpdateWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		UpdateRequest: &workflow.UpdateWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			Identity:          common.StringPtr(identity),
+			UpdateName:        common.StringPtr("my update name"),
+			Input:             []byte("test input"),
+			RequestId:         common.StringPtr(requestID),
+		},
+	}
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = validDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	firstEventID := msBuilder.GetNextEventID()
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		return len(request.UpdateWorkflowMutation.UpsertUpdateRequestedIDs) == 1 &&
+			request.UpdateWorkflowMutation.UpsertUpdateRequestedIDs[requestID] == firstEventID
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.MatchedBy(func(request *p.ReadHistoryBranchRequest) bool {
+		return request.MinEventID == firstEventID
+	})).Return(&p.ReadHistoryBranchResponse{
+		HistoryEvents: []*workflow.HistoryEvent{
+			{
+				EventId:   common.Int64Ptr(firstEventID),
+				EventType: common.EventTypePtr(workflow.EventTypeWorkflowExecutionUpdateCompleted),
+				WorkflowExecutionUpdateCompletedEventAttributes: &workflow.WorkflowExecutionUpdateCompletedEventAttributes{
+					RequestId: common.StringPtr(uuid.New()),
+					Result:    []byte("other result"),
+				},
+			},
+			{
+				EventId:   common.Int64Ptr(firstEventID + 1),
+				EventType: common.EventTypePtr(workflow.EventTypeWorkflowExecutionUpdateCompleted),
+				WorkflowExecutionUpdateCompletedEventAttributes: &workflow.WorkflowExecutionUpdateCompletedEventAttributes{
+					RequestId: common.StringPtr(requestID),
+					Result:    []byte("test result"),
+				},
+			},
+		},
+	}, nil).Once()
+
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	resp, err := s.mockHistoryEngine.UpdateWorkflowExecution(context.Background(), updateRequest)
+	s.Nil(err)
+	s.Equal([]byte("test result"), resp.Result)
+}
+
+func (s *engineSuite) TestUpdateWorkflowExecution_DuplicateRequest() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	requestID := uuid.New()
+	updateRequest := &history.UpdateWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		UpdateRequest: &workflow.UpdateWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			Identity:          common.StringPtr(identity),
+			UpdateName:        common.StringPtr("my update name"),
+			Input:             []byte("test input"),
+			RequestId:         common.StringPtr(requestID),
+		},
+	}
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = validDomainID
+	requestedEventID := msBuilder.GetNextEventID() - 1
+	ms.UpdateRequestedIDs = map[string]int64{requestID: requestedEventID}
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	// the update is already recorded, so no new event is appended and the result is looked up
+	// from the recorded update requested event
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.MatchedBy(func(request *p.ReadHistoryBranchRequest) bool {
+		return request.MinEventID == requestedEventID
+	})).Return(&p.ReadHistoryBranchResponse{
+		HistoryEvents: []*workflow.HistoryEvent{
+			{
+				EventId:   common.Int64Ptr(requestedEventID),
+				EventType: common.EventTypePtr(workflow.EventTypeWorkflowExecutionUpdateCompleted),
+				WorkflowExecutionUpdateCompletedEventAttributes: &workflow.WorkflowExecutionUpdateCompletedEventAttributes{
+					RequestId: common.StringPtr(requestID),
+					Result:    []byte("test result"),
+				},
+			},
+		},
+	}, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	resp, err := s.mockHistoryEngine.UpdateWorkflowExecution(context.Background(), updateRequest)
+	s.Nil(err)
+	s.Equal([]byte("test result"), resp.Result)
+	s.mockHistoryV2Mgr.AssertNotCalled(s.T(), "AppendHistoryNodes", mock.Anything)
+	s.mockExecutionMgr.AssertNotCalled(s.T(), "UpdateWorkflowExecution", mock.Anything)
+}
+
+func (s *engineSuite) TestUpdateWorkflowExecution_TimedOut() {
+	domainID := validDomainID
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(validRunID),
+	}
+	tasklist := "testTaskList"
+	identity := "testIdentity"
+	updateRequest := &history.UpdateWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		UpdateRequest: &workflow.UpdateWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			Identity:          common.StringPtr(identity),
+			UpdateName:        common.StringPtr("my update name"),
+		},
+	}
+	// the wait is bounded by the deadline of the request rather than the long poll interval
+	s.config.LongPollExpirationInterval = dynamicconfig.GetDurationPropertyFnFilteredByDomain(time.Hour)
+	ctx, cancel := context.WithTimeout(context.Background(), workflowUpdateResultTimeBudget+100*time.Millisecond)
+	defer cancel()
+
+	msBuilder := newMutableStateBuilderWithEventV2(s.mockHistoryEngine.shard, s.eventsCache,
+		loggerimpl.NewDevelopmentForTest(s.Suite), we.GetRunId())
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tasklist, []byte("input"), 100, 200, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+	ms := createMutableState(msBuilder)
+	ms.ExecutionInfo.DomainID = validDomainID
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Once()
+	s.mockHistoryV2Mgr.On("ReadHistoryBranch", mock.Anything).Return(&p.ReadHistoryBranchResponse{}, nil).Once()
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(
+		&persistence.GetDomainResponse{
+			Info:   &persistence.DomainInfo{ID: domainID},
+			Config: &persistence.DomainConfig{Retention: 1},
+			ReplicationConfig: &persistence.DomainReplicationConfig{
+				ActiveClusterName: cluster.TestCurrentClusterName,
+				Clusters: []*persistence.ClusterReplicationConfig{
+					{ClusterName: cluster.TestCurrentClusterName},
+				},
+			},
+			TableVersion: persistence.DomainTableVersionV1,
+		},
+		nil,
+	)
+
+	_, err := s.mockHistoryEngine.UpdateWorkflowExecution(ctx, updateRequest)
+	s.Equal(ErrWorkflowUpdateTimedOut, err)
+}
+
+func (s *engineSuite) getBuilder(domainID string, we workflow.WorkflowExecution) mutableState {
+	context, release, err := s.mockHistoryEngine.historyCache.getOrCreateWorkflowExecutionForBackground(domainID, we)
+	if err != nil {
+		return nil
+	}
+	defer release(nil)
+
+	return context.(*workflowExecutionContextImpl).msBuilder
+}
+
+func (s *engineSuite) getActivityScheduledEvent(msBuilder mutableState,
+	scheduleID int64) *workflow.HistoryEvent {
+	event, _ := msBuilder.GetActivityScheduledEvent(scheduleID)
+	return event
+}
+
+func (s *engineSuite) printHistory(builder mutableState) string {
+	return builder.GetHistoryBuilder().GetHistory().String()
+}
+
+func addWorkflowExecutionStartedEventWithParent(builder mutableState, workflowExecution workflow.WorkflowExecution,
+	workflowType, taskList string, input []byte, executionStartToCloseTimeout, taskStartToCloseTimeout int32,
+	parentInfo *history.ParentExecutionInfo, identity string) *workflow.HistoryEvent {
+	domainID := validDomainID
+	startRequest := &workflow.StartWorkflowExecutionRequest{
+		WorkflowId:                          common.StringPtr(*workflowExecution.WorkflowId),
+		WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(workflowType)},
+		TaskList:                            &workflow.TaskList{Name: common.StringPtr(taskList)},
+		Input:                               input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(executionStartToCloseTimeout),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(taskStartToCloseTimeout),
+		Identity:                            common.StringPtr(identity),
+	}
+
+	event, _ := builder.AddWorkflowExecutionStartedEvent(
+		cache.NewLocalDomainCacheEntryForTest(&persistence.DomainInfo{ID: domainID}, &persistence.DomainConfig{}, "", nil),
+		workflowExecution,
+		&history.StartWorkflowExecutionRequest{
+			DomainUUID:          common.StringPtr(domainID),
+			StartRequest:        startRequest,
+			ParentExecutionInfo: parentInfo,
+		},
+	)
+
+	return event
+}
+
+func addWorkflowExecutionStartedEvent(builder mutableState, workflowExecution workflow.WorkflowExecution,
+	workflowType, taskList string, input []byte, executionStartToCloseTimeout, taskStartToCloseTimeout int32,
+	identity string) *workflow.HistoryEvent {
+	return addWorkflowExecutionStartedEventWithParent(builder, workflowExecution, workflowType, taskList, input,
+		executionStartToCloseTimeout, taskStartToCloseTimeout, nil, identity)
+}
+
+func addDecisionTaskScheduledEvent(builder mutableState) *decisionInfo {
 	di, _ := builder.AddDecisionTaskScheduledEvent()
 	return di
 }
@@ -5166,6 +5953,10 @@ func createMutableState(ms mutableState) *persistence.WorkflowMutableState {
 	for id, info := range builder.pendingChildExecutionInfoIDs {
 		childInfos[id] = copyChildInfo(info)
 	}
+	delayedSignalInfos := make(map[string]*persistence.DelayedSignalInfo)
+	for id, info := range builder.pendingDelayedSignalInfoIDs {
+		delayedSignalInfos[id] = copyDelayedSignalInfo(info)
+	}
 
 	builder.FlushBufferedEvents()
 	var bufferedEvents []*workflow.HistoryEvent
@@ -5189,6 +5980,7 @@ func createMutableState(ms mutableState) *persistence.WorkflowMutableState {
 		SignalInfos:         signalInfos,
 		RequestCancelInfos:  cancellationInfos,
 		ChildExecutionInfos: childInfos,
+		DelayedSignalInfos:  delayedSignalInfos,
 		ReplicationState:    replicationState,
 	}
 }
@@ -5230,6 +6022,7 @@ func copyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *p
 		DecisionStartedTimestamp:     sourceInfo.DecisionStartedTimestamp,
 		CancelRequested:              sourceInfo.CancelRequested,
 		CancelRequestID:              sourceInfo.CancelRequestID,
+		Paused:                       sourceInfo.Paused,
 		CronSchedule:                 sourceInfo.CronSchedule,
 		ClientLibraryVersion:         sourceInfo.ClientLibraryVersion,
 		ClientFeatureVersion:         sourceInfo.ClientFeatureVersion,
@@ -5350,6 +6143,21 @@ func copySignalInfo(sourceInfo *persistence.SignalInfo) *persistence.SignalInfo
 	return result
 }
 
+func copyDelayedSignalInfo(sourceInfo *persistence.DelayedSignalInfo) *persistence.DelayedSignalInfo {
+	result := &persistence.DelayedSignalInfo{
+		Version:    sourceInfo.Version,
+		RequestID:  sourceInfo.RequestID,
+		SignalName: sourceInfo.SignalName,
+		Identity:   sourceInfo.Identity,
+		FireTime:   sourceInfo.FireTime,
+	}
+	result.Input = make([]byte, len(sourceInfo.Input))
+	copy(result.Input, sourceInfo.Input)
+	result.Control = make([]byte, len(sourceInfo.Control))
+	copy(result.Control, sourceInfo.Control)
+	return result
+}
+
 func copyChildInfo(sourceInfo *persistence.ChildExecutionInfo) *persistence.ChildExecutionInfo {
 	result := &persistence.ChildExecutionInfo{
 		Version:               sourceInfo.Version,
@@ -1177,11 +1177,17 @@ func (r *historyReplicator) deserializeBlob(
 	blob *workflow.DataBlob,
 ) ([]*workflow.HistoryEvent, error) {
 
-	if blob.GetEncodingType() != workflow.EncodingTypeThriftRW {
+	var encoding common.EncodingType
+	switch blob.GetEncodingType() {
+	case workflow.EncodingTypeThriftRW:
+		encoding = common.EncodingTypeThriftRW
+	case workflow.EncodingTypeThriftRWSnappy:
+		encoding = common.EncodingTypeThriftRWSnappy
+	default:
 		return nil, ErrUnknownEncodingType
 	}
 	historyEvents, err := r.historySerializer.DeserializeBatchEvents(&persistence.DataBlob{
-		Encoding: common.EncodingTypeThriftRW,
+		Encoding: encoding,
 		Data:     blob.Data,
 	})
 	if err != nil {
@@ -2588,6 +2588,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_BrandNew() {
 			CreateWorkflowMode:  persistence.CreateWorkflowModeBrandNew,
 			PreviousRunID:       "",
 			NewWorkflowSnapshot: *newWorkflowSnapshot,
+			Encoding:            common.EncodingTypeThriftRW,
 		}, input)
 		return true
 	})).Return(&persistence.CreateWorkflowExecutionResponse{}, nil).Once()
@@ -2947,6 +2948,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_CurrentComplete_In
 			CreateWorkflowMode:  persistence.CreateWorkflowModeBrandNew,
 			PreviousRunID:       "",
 			NewWorkflowSnapshot: *newWorkflowSnapshot,
+			Encoding:            common.EncodingTypeThriftRW,
 		}, input)
 	})).Return(nil, errRet).Once()
 	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.MatchedBy(func(input *persistence.CreateWorkflowExecutionRequest) bool {
@@ -2956,6 +2958,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_CurrentComplete_In
 			PreviousRunID:            currentRunID,
 			PreviousLastWriteVersion: currentVersion,
 			NewWorkflowSnapshot:      *newWorkflowSnapshot,
+			Encoding:                 common.EncodingTypeThriftRW,
 		}, input)
 	})).Return(&persistence.CreateWorkflowExecutionResponse{}, nil).Once()
 	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(&persistence.GetDomainResponse{
@@ -3076,6 +3079,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_CurrentComplete_In
 			CreateWorkflowMode:  persistence.CreateWorkflowModeBrandNew,
 			PreviousRunID:       "",
 			NewWorkflowSnapshot: *newWorkflowSnapshot,
+			Encoding:            common.EncodingTypeThriftRW,
 		}, input)
 	})).Return(nil, errRet).Once()
 	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.MatchedBy(func(input *persistence.CreateWorkflowExecutionRequest) bool {
@@ -3085,6 +3089,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_CurrentComplete_In
 			PreviousRunID:            currentRunID,
 			PreviousLastWriteVersion: currentVersion,
 			NewWorkflowSnapshot:      *newWorkflowSnapshot,
+			Encoding:                 common.EncodingTypeThriftRW,
 		}, input)
 	})).Return(&persistence.CreateWorkflowExecutionResponse{}, nil).Once()
 	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(&persistence.GetDomainResponse{
@@ -3205,6 +3210,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_CurrentComplete_In
 			CreateWorkflowMode:  persistence.CreateWorkflowModeBrandNew,
 			PreviousRunID:       "",
 			NewWorkflowSnapshot: *newWorkflowSnapshot,
+			Encoding:            common.EncodingTypeThriftRW,
 		}, input)
 	})).Return(nil, errRet).Once()
 	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.MatchedBy(func(input *persistence.CreateWorkflowExecutionRequest) bool {
@@ -3214,6 +3220,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_CurrentComplete_In
 			PreviousRunID:            currentRunID,
 			PreviousLastWriteVersion: currentVersion,
 			NewWorkflowSnapshot:      *newWorkflowSnapshot,
+			Encoding:                 common.EncodingTypeThriftRW,
 		}, input)
 	})).Return(&persistence.CreateWorkflowExecutionResponse{}, nil).Once()
 	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(&persistence.GetDomainResponse{
@@ -4165,6 +4172,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_CurrentRunning_Inc
 			CreateWorkflowMode:  persistence.CreateWorkflowModeBrandNew,
 			PreviousRunID:       "",
 			NewWorkflowSnapshot: *newWorkflowSnapshot,
+			Encoding:            common.EncodingTypeThriftRW,
 		}, input)
 	})).Return(nil, errRet).Once()
 	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.MatchedBy(func(input *persistence.CreateWorkflowExecutionRequest) bool {
@@ -4174,6 +4182,7 @@ func (s *historyReplicatorSuite) TestReplicateWorkflowStarted_CurrentRunning_Inc
 			PreviousRunID:            currentRunID,
 			PreviousLastWriteVersion: currentVersion,
 			NewWorkflowSnapshot:      *newWorkflowSnapshot,
+			Encoding:                 common.EncodingTypeThriftRW,
 		}, input)
 	})).Return(&persistence.CreateWorkflowExecutionResponse{}, nil).Once()
 
@@ -87,9 +87,16 @@ type (
 		AddUpsertWorkflowSearchAttributesEvent(int64, *workflow.UpsertWorkflowSearchAttributesDecisionAttributes) (*workflow.HistoryEvent, error)
 		AddWorkflowExecutionCancelRequestedEvent(string, *h.RequestCancelWorkflowExecutionRequest) (*workflow.HistoryEvent, error)
 		AddWorkflowExecutionCanceledEvent(int64, *workflow.CancelWorkflowExecutionDecisionAttributes) (*workflow.HistoryEvent, error)
+		AddWorkflowExecutionDelayedSignalCanceledEvent(requestID string, identity string) (*workflow.HistoryEvent, error)
+		AddWorkflowExecutionDelayedSignalRequestedEvent(requestID string, signalName string, input []byte, control []byte, identity string, fireTime time.Time) (*workflow.HistoryEvent, error)
+		AddWorkflowExecutionPausedEvent(reason string, identity string) (*workflow.HistoryEvent, error)
 		AddWorkflowExecutionSignaled(signalName string, input []byte, identity string) (*workflow.HistoryEvent, error)
+		AddWorkflowExecutionSignaledForDelayedSignal(requestID string) (*workflow.HistoryEvent, error)
 		AddWorkflowExecutionStartedEvent(*cache.DomainCacheEntry, workflow.WorkflowExecution, *h.StartWorkflowExecutionRequest) (*workflow.HistoryEvent, error)
 		AddWorkflowExecutionTerminatedEvent(reason string, details []byte, identity string) (*workflow.HistoryEvent, error)
+		AddWorkflowExecutionUnpausedEvent(reason string, identity string) (*workflow.HistoryEvent, error)
+		AddWorkflowExecutionUpdateCompletedEvent(int64, *workflow.CompleteWorkflowExecutionUpdateDecisionAttributes) (*workflow.HistoryEvent, error)
+		AddWorkflowExecutionUpdateRequestedEvent(updateName string, input []byte, identity string, requestID string) (*workflow.HistoryEvent, error)
 		ClearStickyness()
 		CheckResettable() error
 		CopyToPersistence() *persistence.WorkflowMutableState
@@ -115,6 +122,7 @@ type (
 		GetStartEvent() (*workflow.HistoryEvent, bool)
 		GetCurrentBranch() []byte
 		GetCurrentVersion() int64
+		GetDelayedSignal(string) (*persistence.DelayedSignalInfo, bool)
 		GetExecutionInfo() *persistence.WorkflowExecutionInfo
 		GetEventStoreVersion() int32
 		GetHistoryBuilder() *historyBuilder
@@ -127,6 +135,7 @@ type (
 		GetPendingActivityInfos() map[int64]*persistence.ActivityInfo
 		GetPendingTimerInfos() map[string]*persistence.TimerInfo
 		GetPendingChildExecutionInfos() map[int64]*persistence.ChildExecutionInfo
+		GetPendingDelayedSignalInfos() map[string]*persistence.DelayedSignalInfo
 		GetReplicationState() *persistence.ReplicationState
 		GetRequestCancelInfo(int64) (*persistence.RequestCancelInfo, bool)
 		GetRetryBackoffDuration(errReason string) time.Duration
@@ -146,7 +155,10 @@ type (
 		IsCancelRequested() (bool, string)
 		IsSignalRequested(requestID string) bool
 		IsStickyTaskListEnabled() bool
+		GetUpdateRequestedEventID(requestID string) (int64, bool)
+		IsWorkflowExecutionPaused() bool
 		IsWorkflowExecutionRunning() bool
+		IsWorkflowPendingOnDecisionBackoff() bool
 		Load(*persistence.WorkflowMutableState)
 		ReplicateActivityInfo(*h.SyncActivityRequest, bool) error
 		ReplicateActivityTaskCancelRequestedEvent(*workflow.HistoryEvent) error
@@ -165,7 +177,7 @@ type (
 		ReplicateDecisionTaskCompletedEvent(*workflow.HistoryEvent) error
 		ReplicateDecisionTaskFailedEvent() error
 		ReplicateDecisionTaskScheduledEvent(int64, int64, string, int32, int64, int64) (*decisionInfo, error)
-		ReplicateDecisionTaskStartedEvent(*decisionInfo, int64, int64, int64, string, int64) (*decisionInfo, error)
+		ReplicateDecisionTaskStartedEvent(*decisionInfo, int64, int64, int64, string, int64, string) (*decisionInfo, error)
 		ReplicateDecisionTaskTimedOutEvent(workflow.TimeoutType) error
 		ReplicateExternalWorkflowExecutionCancelRequested(*workflow.HistoryEvent) error
 		ReplicateExternalWorkflowExecutionSignaled(*workflow.HistoryEvent) error
@@ -184,11 +196,17 @@ type (
 		ReplicateWorkflowExecutionCanceledEvent(int64, *workflow.HistoryEvent) error
 		ReplicateWorkflowExecutionCompletedEvent(int64, *workflow.HistoryEvent) error
 		ReplicateWorkflowExecutionContinuedAsNewEvent(int64, string, *workflow.HistoryEvent, *workflow.HistoryEvent, *decisionInfo, mutableState, int32) error
+		ReplicateWorkflowExecutionDelayedSignalCanceledEvent(*workflow.HistoryEvent) error
+		ReplicateWorkflowExecutionDelayedSignalRequestedEvent(*workflow.HistoryEvent) error
 		ReplicateWorkflowExecutionFailedEvent(int64, *workflow.HistoryEvent) error
+		ReplicateWorkflowExecutionPausedEvent(*workflow.HistoryEvent) error
 		ReplicateWorkflowExecutionSignaled(*workflow.HistoryEvent) error
 		ReplicateWorkflowExecutionStartedEvent(*cache.DomainCacheEntry, *string, workflow.WorkflowExecution, string, *workflow.HistoryEvent) error
 		ReplicateWorkflowExecutionTerminatedEvent(int64, *workflow.HistoryEvent) error
 		ReplicateWorkflowExecutionTimedoutEvent(int64, *workflow.HistoryEvent) error
+		ReplicateWorkflowExecutionUnpausedEvent(*workflow.HistoryEvent) error
+		ReplicateWorkflowExecutionUpdateRequestedEvent(*workflow.HistoryEvent) error
+		ReplicateWorkflowExecutionUpdateCompletedEvent(*workflow.HistoryEvent) error
 		SetHistoryBuilder(hBuilder *historyBuilder)
 		SetHistoryTree(treeID string) error
 		UpdateActivity(*persistence.ActivityInfo) error
@@ -23,6 +23,7 @@ package history
 import (
 	"fmt"
 	"math"
+	"sort"
 	"time"
 
 	"github.com/pborman/uuid"
@@ -81,6 +82,14 @@ type (
 		updateSignalRequestedIDs  map[string]struct{} // Set of signaled requestIds since last update
 		deleteSignalRequestedID   string              // Deleted signaled requestId
 
+		pendingDelayedSignalInfoIDs map[string]*persistence.DelayedSignalInfo   // Request ID -> DelayedSignalInfo
+		updateDelayedSignalInfos    map[*persistence.DelayedSignalInfo]struct{} // Modified DelayedSignalInfo since last update
+		deleteDelayedSignalInfos    map[string]struct{}                         // Deleted DelayedSignalInfo since last update
+
+		pendingUpdateRequestedIDs map[string]int64    // Request ID -> event ID to look up the update result from
+		updateUpdateRequestedIDs  map[string]int64    // Added update requestIds since last update
+		deleteUpdateRequestedIDs  map[string]struct{} // Deleted update requestIds since last update
+
 		bufferedEvents       []*workflow.HistoryEvent // buffered history events that are already persisted
 		updateBufferedEvents []*workflow.HistoryEvent // buffered history events that needs to be persisted
 		clearBufferedEvents  bool                     // delete buffered events from persistence
@@ -146,6 +155,14 @@ func newMutableStateBuilder(
 		pendingSignalRequestedIDs: make(map[string]struct{}),
 		deleteSignalRequestedID:   "",
 
+		pendingDelayedSignalInfoIDs: make(map[string]*persistence.DelayedSignalInfo),
+		updateDelayedSignalInfos:    make(map[*persistence.DelayedSignalInfo]struct{}),
+		deleteDelayedSignalInfos:    make(map[string]struct{}),
+
+		pendingUpdateRequestedIDs: make(map[string]int64),
+		updateUpdateRequestedIDs:  make(map[string]int64),
+		deleteUpdateRequestedIDs:  make(map[string]struct{}),
+
 		hasBufferedEventsInPersistence: false,
 		condition:                      0,
 
@@ -203,6 +220,8 @@ func (e *mutableStateBuilder) CopyToPersistence() *persistence.WorkflowMutableSt
 	state.RequestCancelInfos = e.pendingRequestCancelInfoIDs
 	state.SignalInfos = e.pendingSignalInfoIDs
 	state.SignalRequestedIDs = e.pendingSignalRequestedIDs
+	state.DelayedSignalInfos = e.pendingDelayedSignalInfoIDs
+	state.UpdateRequestedIDs = e.pendingUpdateRequestedIDs
 	state.ExecutionInfo = e.executionInfo
 	state.ReplicationState = e.replicationState
 	state.BufferedEvents = e.bufferedEvents
@@ -217,6 +236,12 @@ func (e *mutableStateBuilder) Load(state *persistence.WorkflowMutableState) {
 	e.pendingRequestCancelInfoIDs = state.RequestCancelInfos
 	e.pendingSignalInfoIDs = state.SignalInfos
 	e.pendingSignalRequestedIDs = state.SignalRequestedIDs
+	if state.DelayedSignalInfos != nil {
+		e.pendingDelayedSignalInfoIDs = state.DelayedSignalInfos
+	}
+	if state.UpdateRequestedIDs != nil {
+		e.pendingUpdateRequestedIDs = state.UpdateRequestedIDs
+	}
 	e.executionInfo = state.ExecutionInfo
 
 	e.replicationState = state.ReplicationState
@@ -664,7 +689,8 @@ func (e *mutableStateBuilder) shouldBufferEvent(eventType workflow.EventType) bo
 		workflow.EventTypeMarkerRecorded,
 		workflow.EventTypeStartChildWorkflowExecutionInitiated,
 		workflow.EventTypeSignalExternalWorkflowExecutionInitiated,
-		workflow.EventTypeUpsertWorkflowSearchAttributes:
+		workflow.EventTypeUpsertWorkflowSearchAttributes,
+		workflow.EventTypeWorkflowExecutionUpdateCompleted:
 		// do not buffer event if event is directly generated from a corresponding decision
 
 		// sanity check there is no decision on the fly
@@ -777,7 +803,12 @@ func (e *mutableStateBuilder) GetCronBackoffDuration() time.Duration {
 	if len(info.CronSchedule) == 0 {
 		return backoff.NoBackoff
 	}
-	return backoff.GetBackoffForNextSchedule(info.CronSchedule, e.executionInfo.StartTimestamp, e.timeSource.Now())
+	return backoff.GetBackoffForNextSchedule(
+		info.CronSchedule,
+		e.executionInfo.StartTimestamp,
+		e.timeSource.Now(),
+		time.Duration(info.CronMaxJitterSeconds)*time.Second,
+	)
 }
 
 // GetSignalInfo get details about a signal request that is currently in progress.
@@ -962,6 +993,12 @@ func (e *mutableStateBuilder) DeleteUserTimer(timerID string) {
 	e.deleteTimerInfos[timerID] = struct{}{}
 }
 
+// GetDelayedSignal gives details about a pending delayed signal.
+func (e *mutableStateBuilder) GetDelayedSignal(requestID string) (*persistence.DelayedSignalInfo, bool) {
+	si, ok := e.pendingDelayedSignalInfoIDs[requestID]
+	return si, ok
+}
+
 func (e *mutableStateBuilder) getDecisionInfo() *decisionInfo {
 	return &decisionInfo{
 		Version:            e.executionInfo.DecisionVersion,
@@ -996,6 +1033,10 @@ func (e *mutableStateBuilder) GetPendingChildExecutionInfos() map[int64]*persist
 	return e.pendingChildExecutionInfoIDs
 }
 
+func (e *mutableStateBuilder) GetPendingDelayedSignalInfos() map[string]*persistence.DelayedSignalInfo {
+	return e.pendingDelayedSignalInfoIDs
+}
+
 func (e *mutableStateBuilder) HasPendingDecisionTask() bool {
 	return e.executionInfo.DecisionScheduleID != common.EmptyEventID
 }
@@ -1004,6 +1045,20 @@ func (e *mutableStateBuilder) HasProcessedOrPendingDecisionTask() bool {
 	return e.HasPendingDecisionTask() || e.GetPreviousStartedEventID() != common.EmptyEventID
 }
 
+// IsWorkflowPendingOnDecisionBackoff returns true if the first decision of the workflow is still held back,
+// either by a cron schedule or by a delayed start
+func (e *mutableStateBuilder) IsWorkflowPendingOnDecisionBackoff() bool {
+	if e.HasProcessedOrPendingDecisionTask() {
+		return false
+	}
+	// first decision of cron workflow is always backed off until the next schedule
+	if e.executionInfo.CronSchedule != "" {
+		return true
+	}
+	startEvent, ok := e.GetStartEvent()
+	return ok && startEvent.GetWorkflowExecutionStartedEventAttributes().GetFirstDecisionTaskBackoffSeconds() > 0
+}
+
 func (e *mutableStateBuilder) HasInFlightDecisionTask() bool {
 	return e.executionInfo.DecisionStartedID > 0
 }
@@ -1109,6 +1164,11 @@ func (e *mutableStateBuilder) IsWorkflowExecutionRunning() bool {
 	return e.executionInfo.State != persistence.WorkflowStateCompleted
 }
 
+// IsWorkflowExecutionPaused returns whether dispatching of decision and activity tasks is paused
+func (e *mutableStateBuilder) IsWorkflowExecutionPaused() bool {
+	return e.executionInfo.Paused
+}
+
 func (e *mutableStateBuilder) IsCancelRequested() (bool, string) {
 	if e.executionInfo.CancelRequested {
 		return e.executionInfo.CancelRequested, e.executionInfo.CancelRequestID
@@ -1140,6 +1200,28 @@ func (e *mutableStateBuilder) DeleteSignalRequested(requestID string) {
 	e.deleteSignalRequestedID = requestID
 }
 
+// GetUpdateRequestedEventID returns the event ID to look up the result of a pending update with the given
+// requestID from, it returns false if no such update is pending
+func (e *mutableStateBuilder) GetUpdateRequestedEventID(requestID string) (int64, bool) {
+	eventID, ok := e.pendingUpdateRequestedIDs[requestID]
+	return eventID, ok
+}
+
+func (e *mutableStateBuilder) addUpdateRequested(requestID string, eventID int64) {
+	e.pendingUpdateRequestedIDs[requestID] = eventID
+	e.updateUpdateRequestedIDs[requestID] = eventID
+	delete(e.deleteUpdateRequestedIDs, requestID)
+}
+
+func (e *mutableStateBuilder) deleteUpdateRequested(requestID string) {
+	if _, ok := e.pendingUpdateRequestedIDs[requestID]; !ok {
+		return
+	}
+	delete(e.pendingUpdateRequestedIDs, requestID)
+	delete(e.updateUpdateRequestedIDs, requestID)
+	e.deleteUpdateRequestedIDs[requestID] = struct{}{}
+}
+
 func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 	domainEntry *cache.DomainCacheEntry,
 	parentExecutionInfo *h.ParentExecutionInfo,
@@ -1181,6 +1263,8 @@ func (e *mutableStateBuilder) addWorkflowExecutionStartedEventForContinueAsNew(
 		Header:                              attributes.Header,
 		RetryPolicy:                         attributes.RetryPolicy,
 		CronSchedule:                        attributes.CronSchedule,
+		CronMaxJitterSeconds:                attributes.CronMaxJitterSeconds,
+		TaskPriority:                        common.Int32Ptr(previousExecutionInfo.TaskPriority),
 		Memo:                                attributes.Memo,
 		SearchAttributes:                    attributes.SearchAttributes,
 	}
@@ -1298,6 +1382,8 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionStartedEvent(
 	e.executionInfo.DecisionTimeout = 0
 
 	e.executionInfo.CronSchedule = event.GetCronSchedule()
+	e.executionInfo.CronMaxJitterSeconds = event.GetCronMaxJitterSeconds()
+	e.executionInfo.TaskPriority = event.GetTaskPriority()
 
 	if parentDomainID != nil {
 		e.executionInfo.ParentDomainID = *parentDomainID
@@ -1495,12 +1581,13 @@ func (e *mutableStateBuilder) AddDecisionTaskStartedEvent(
 	// Avoid creating new history events when decisions are continuously failing
 	if di.Attempt == 0 {
 		// Now create DecisionTaskStartedEvent
-		event = e.hBuilder.AddDecisionTaskStartedEvent(scheduleID, requestID, request.GetIdentity())
+		event = e.hBuilder.AddDecisionTaskStartedEvent(scheduleID, requestID, request.GetIdentity(), request.GetBuildId())
 		startedID = event.GetEventId()
 		timestamp = event.GetTimestamp()
 	}
 
-	di, err := e.ReplicateDecisionTaskStartedEvent(di, e.GetCurrentVersion(), scheduleID, startedID, requestID, timestamp)
+	di, err := e.ReplicateDecisionTaskStartedEvent(di, e.GetCurrentVersion(), scheduleID, startedID, requestID, timestamp,
+		request.GetBuildId())
 	return event, di, err
 }
 
@@ -1511,6 +1598,7 @@ func (e *mutableStateBuilder) ReplicateDecisionTaskStartedEvent(
 	startedID int64,
 	requestID string,
 	timestamp int64,
+	buildID string,
 ) (*decisionInfo, error) {
 	// Replicator calls it with a nil decision info, and it is safe to always lookup the decision in this case as it
 	// does not have to deal with transient decision case.
@@ -1532,6 +1620,10 @@ func (e *mutableStateBuilder) ReplicateDecisionTaskStartedEvent(
 	}
 
 	e.executionInfo.State = persistence.WorkflowStateRunning
+	// The workflow is pinned to the build of the worker which picks up its first decision task
+	if e.executionInfo.BuildID == "" && e.executionInfo.LastProcessedEvent == common.EmptyEventID {
+		e.executionInfo.BuildID = buildID
+	}
 	// Update mutable decision state
 	di = &decisionInfo{
 		Version:            version,
@@ -1556,7 +1648,7 @@ func (e *mutableStateBuilder) CreateTransientDecisionEvents(
 	scheduledEvent := newDecisionTaskScheduledEventWithInfo(di.ScheduleID, di.ScheduledTimestamp, tasklist, di.DecisionTimeout,
 		di.Attempt)
 	startedEvent := newDecisionTaskStartedEventWithInfo(di.StartedID, di.StartedTimestamp, di.ScheduleID, di.RequestID,
-		identity)
+		identity, e.executionInfo.BuildID)
 
 	return scheduledEvent, startedEvent
 }
@@ -1680,7 +1772,7 @@ func (e *mutableStateBuilder) AddDecisionTaskCompletedEvent(
 		scheduledEvent := e.hBuilder.AddTransientDecisionTaskScheduledEvent(e.executionInfo.TaskList, di.DecisionTimeout,
 			di.Attempt, di.ScheduledTimestamp)
 		startedEvent := e.hBuilder.AddTransientDecisionTaskStartedEvent(scheduledEvent.GetEventId(), di.RequestID,
-			request.GetIdentity(), di.StartedTimestamp)
+			request.GetIdentity(), e.executionInfo.BuildID, di.StartedTimestamp)
 		startedEventID = startedEvent.GetEventId()
 	}
 	// Now write the completed event
@@ -1890,6 +1982,7 @@ func (e *mutableStateBuilder) ReplicateActivityTaskScheduledEvent(
 		TimerTaskStatus:          TimerTaskStatusNone,
 		TaskList:                 attributes.TaskList.GetName(),
 		HasRetryPolicy:           attributes.RetryPolicy != nil,
+		TaskPriority:             attributes.GetTaskPriority(),
 	}
 	ai.ExpirationTime = ai.ScheduledTime.Add(time.Duration(scheduleToCloseTimeout) * time.Second)
 	if ai.HasRetryPolicy {
@@ -2798,6 +2891,61 @@ func (e *mutableStateBuilder) AddRecordMarkerEvent(
 	return e.hBuilder.AddMarkerRecordedEvent(decisionCompletedEventID, attributes), nil
 }
 
+func (e *mutableStateBuilder) AddWorkflowExecutionUpdateRequestedEvent(
+	updateName string,
+	input []byte,
+	identity string,
+	requestID string,
+) (*workflow.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUpdateRequested
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionUpdateRequestedEvent(updateName, input, identity, requestID)
+	if err := e.ReplicateWorkflowExecutionUpdateRequestedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// ReplicateWorkflowExecutionUpdateRequestedEvent records the requestID of the update, so duplicated requests are
+// not recorded again until the update is completed. A buffered event gets its event ID later on, so the next
+// event ID at the time the request is recorded is kept for it instead.
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionUpdateRequestedEvent(event *workflow.HistoryEvent) error {
+	eventID := event.GetEventId()
+	if eventID == common.BufferedEventID {
+		eventID = e.GetNextEventID()
+	}
+	e.addUpdateRequested(event.WorkflowExecutionUpdateRequestedEventAttributes.GetRequestId(), eventID)
+	return nil
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionUpdateCompletedEvent(
+	decisionCompletedEventID int64,
+	attributes *workflow.CompleteWorkflowExecutionUpdateDecisionAttributes,
+) (*workflow.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUpdateCompleted
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionUpdateCompletedEvent(decisionCompletedEventID, attributes)
+	if err := e.ReplicateWorkflowExecutionUpdateCompletedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// ReplicateWorkflowExecutionUpdateCompletedEvent drops the requestID of the completed update, retries of the
+// request after this point are served from the history by the caller or recorded as a new update
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionUpdateCompletedEvent(event *workflow.HistoryEvent) error {
+	e.deleteUpdateRequested(event.WorkflowExecutionUpdateCompletedEventAttributes.GetRequestId())
+	return nil
+}
+
 func (e *mutableStateBuilder) AddWorkflowExecutionTerminatedEvent(
 	reason string, details []byte, identity string,
 ) (*workflow.HistoryEvent, error) {
@@ -2845,9 +2993,194 @@ func (e *mutableStateBuilder) AddWorkflowExecutionSignaled(
 	return event, nil
 }
 
+func (e *mutableStateBuilder) AddWorkflowExecutionSignaledForDelayedSignal(
+	requestID string,
+) (*workflow.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowSignaled
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	si, ok := e.GetDelayedSignal(requestID)
+	if !ok {
+		e.logger.Warn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction)
+		return nil, e.createInternalServerError(opTag)
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionSignaledForDelayedSignalEvent(si.SignalName, si.Input, si.Identity, requestID)
+	if err := e.ReplicateWorkflowExecutionSignaled(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
 func (e *mutableStateBuilder) ReplicateWorkflowExecutionSignaled(event *workflow.HistoryEvent) error {
 	// Increment signal count in mutable state for this workflow execution
 	e.executionInfo.SignalCount++
+	// signal delivered from a delayed signal is no longer pending
+	if requestID := event.WorkflowExecutionSignaledEventAttributes.GetDelayedSignalRequestId(); requestID != "" {
+		e.deleteDelayedSignal(requestID)
+	}
+	return nil
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionDelayedSignalRequestedEvent(
+	requestID string,
+	signalName string,
+	input []byte,
+	control []byte,
+	identity string,
+	fireTime time.Time,
+) (*workflow.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowDelayedSignalRequested
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionDelayedSignalRequestedEvent(
+		requestID, signalName, input, control, identity, fireTime.UnixNano(),
+	)
+	if err := e.ReplicateWorkflowExecutionDelayedSignalRequestedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// ReplicateWorkflowExecutionDelayedSignalRequestedEvent records a signal which will be delivered at the fire time
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionDelayedSignalRequestedEvent(event *workflow.HistoryEvent) error {
+	attributes := event.WorkflowExecutionDelayedSignalRequestedEventAttributes
+	si := &persistence.DelayedSignalInfo{
+		Version:    event.GetVersion(),
+		RequestID:  attributes.GetRequestId(),
+		SignalName: attributes.GetSignalName(),
+		Input:      attributes.Input,
+		Control:    attributes.Control,
+		Identity:   attributes.GetIdentity(),
+		FireTime:   time.Unix(0, attributes.GetFireTimestamp()),
+	}
+	e.pendingDelayedSignalInfoIDs[si.RequestID] = si
+	e.updateDelayedSignalInfos[si] = struct{}{}
+	delete(e.deleteDelayedSignalInfos, si.RequestID)
+	return nil
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionDelayedSignalCanceledEvent(
+	requestID string,
+	identity string,
+) (*workflow.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowDelayedSignalCanceled
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	if _, ok := e.GetDelayedSignal(requestID); !ok {
+		e.logger.Warn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(e.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction)
+		return nil, e.createInternalServerError(opTag)
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionDelayedSignalCanceledEvent(requestID, identity)
+	if err := e.ReplicateWorkflowExecutionDelayedSignalCanceledEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// ReplicateWorkflowExecutionDelayedSignalCanceledEvent removes a pending delayed signal
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionDelayedSignalCanceledEvent(event *workflow.HistoryEvent) error {
+	e.deleteDelayedSignal(event.WorkflowExecutionDelayedSignalCanceledEventAttributes.GetRequestId())
+	return nil
+}
+
+func (e *mutableStateBuilder) getPendingDelayedSignalsByFireTime() []*persistence.DelayedSignalInfo {
+	result := make([]*persistence.DelayedSignalInfo, 0, len(e.pendingDelayedSignalInfoIDs))
+	for _, ds := range e.pendingDelayedSignalInfoIDs {
+		result = append(result, ds)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].FireTime.Equal(result[j].FireTime) {
+			return result[i].RequestID < result[j].RequestID
+		}
+		return result[i].FireTime.Before(result[j].FireTime)
+	})
+	return result
+}
+
+func (e *mutableStateBuilder) deleteDelayedSignal(requestID string) {
+	si, ok := e.pendingDelayedSignalInfoIDs[requestID]
+	if !ok {
+		return
+	}
+	delete(e.pendingDelayedSignalInfoIDs, requestID)
+	delete(e.updateDelayedSignalInfos, si)
+	e.deleteDelayedSignalInfos[requestID] = struct{}{}
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionPausedEvent(
+	reason string,
+	identity string,
+) (*workflow.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowPaused
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionPausedEvent(reason, identity)
+	if err := e.ReplicateWorkflowExecutionPausedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// ReplicateWorkflowExecutionPausedEvent stops dispatching of decision and activity tasks
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionPausedEvent(event *workflow.HistoryEvent) error {
+	e.executionInfo.Paused = true
+	return nil
+}
+
+func (e *mutableStateBuilder) AddWorkflowExecutionUnpausedEvent(
+	reason string,
+	identity string,
+) (*workflow.HistoryEvent, error) {
+
+	opTag := tag.WorkflowActionWorkflowUnpaused
+	if err := e.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := e.hBuilder.AddWorkflowExecutionUnpausedEvent(reason, identity)
+	if err := e.ReplicateWorkflowExecutionUnpausedEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// ReplicateWorkflowExecutionUnpausedEvent resumes dispatching of decision and activity tasks, the activities
+// held back while the workflow execution was paused are scheduled again at the time of the event, so their
+// timeouts and retry expiration do not count the time spent paused
+func (e *mutableStateBuilder) ReplicateWorkflowExecutionUnpausedEvent(event *workflow.HistoryEvent) error {
+	e.executionInfo.Paused = false
+
+	unpausedTime := time.Unix(0, event.GetTimestamp())
+	for _, ai := range e.pendingActivityInfoIDs {
+		// activities waiting for the next retry are dispatched by the retry timer
+		if ai.StartedID != common.EmptyEventID || ai.ScheduledTime.After(unpausedTime) {
+			continue
+		}
+		if !ai.ExpirationTime.IsZero() {
+			ai.ExpirationTime = ai.ExpirationTime.Add(unpausedTime.Sub(ai.ScheduledTime))
+		}
+		ai.ScheduledTime = unpausedTime
+		ai.TimerTaskStatus = ai.TimerTaskStatus &^ (TimerTaskStatusCreatedScheduleToStart | TimerTaskStatusCreatedScheduleToClose)
+		e.updateActivityInfos[ai] = struct{}{}
+	}
 	return nil
 }
 
@@ -2913,6 +3246,23 @@ func (e *mutableStateBuilder) AddContinueAsNewEvent(
 		return nil, nil, &workflow.InternalServiceError{Message: "Failed to add workflow execution started event."}
 	}
 
+	// pending delayed signals are carried over and delivered to the new run
+	for _, ds := range e.getPendingDelayedSignalsByFireTime() {
+		if _, err := newStateBuilder.AddWorkflowExecutionDelayedSignalRequestedEvent(
+			ds.RequestID,
+			ds.SignalName,
+			ds.Input,
+			ds.Control,
+			ds.Identity,
+			ds.FireTime,
+		); err != nil {
+			return nil, nil, err
+		}
+		newStateBuilder.AddTimerTasks(&persistence.DelayedSignalTimerTask{
+			VisibilityTimestamp: ds.FireTime,
+		})
+	}
+
 	var di *decisionInfo
 	// First decision for retry will be created by a backoff timer
 	if attributes.GetBackoffStartIntervalInSeconds() == 0 {
@@ -3000,7 +3350,7 @@ func (e *mutableStateBuilder) ReplicateWorkflowExecutionContinuedAsNewEvent(
 		newStateBuilder.AddTimerTasks(backoffTimer)
 
 		if newStateBuilder.GetReplicationState() != nil {
-			newStateBuilder.UpdateReplicationStateLastEventID(newStartedEvent.GetVersion(), newStartedEvent.GetEventId())
+			newStateBuilder.UpdateReplicationStateLastEventID(newStartedEvent.GetVersion(), newStateBuilder.GetNextEventID()-1)
 		}
 	}
 
@@ -3457,6 +3807,10 @@ func (e *mutableStateBuilder) CloseTransactionAsMutation(
 		DeleteSignalInfo:          e.deleteSignalInfo,
 		UpsertSignalRequestedIDs:  convertSignalRequestedIDs(e.updateSignalRequestedIDs),
 		DeleteSignalRequestedID:   e.deleteSignalRequestedID,
+		UpsertDelayedSignalInfos:  convertUpdateDelayedSignalInfos(e.updateDelayedSignalInfos),
+		DeleteDelayedSignalInfos:  convertDeleteDelayedSignalInfos(e.deleteDelayedSignalInfos),
+		UpsertUpdateRequestedIDs:  convertUpdateRequestedIDs(e.updateUpdateRequestedIDs),
+		DeleteUpdateRequestedIDs:  convertDeleteUpdateRequestedIDs(e.deleteUpdateRequestedIDs),
 		NewBufferedEvents:         e.updateBufferedEvents,
 		ClearBufferedEvents:       e.clearBufferedEvents,
 
@@ -3523,6 +3877,8 @@ func (e *mutableStateBuilder) CloseTransactionAsSnapshot(
 		RequestCancelInfos:  convertPendingRequestCancelInfos(e.pendingRequestCancelInfoIDs),
 		SignalInfos:         convertPendingSignalInfos(e.pendingSignalInfoIDs),
 		SignalRequestedIDs:  convertSignalRequestedIDs(e.pendingSignalRequestedIDs),
+		DelayedSignalInfos:  convertPendingDelayedSignalInfos(e.pendingDelayedSignalInfoIDs),
+		UpdateRequestedIDs:  convertUpdateRequestedIDs(e.pendingUpdateRequestedIDs),
 
 		TransferTasks:    e.insertTransferTasks,
 		ReplicationTasks: e.insertReplicationTasks,
@@ -3595,6 +3951,12 @@ func (e *mutableStateBuilder) cleanupTransaction(
 	e.updateSignalRequestedIDs = make(map[string]struct{})
 	e.deleteSignalRequestedID = ""
 
+	e.updateDelayedSignalInfos = make(map[*persistence.DelayedSignalInfo]struct{})
+	e.deleteDelayedSignalInfos = make(map[string]struct{})
+
+	e.updateUpdateRequestedIDs = make(map[string]int64)
+	e.deleteUpdateRequestedIDs = make(map[string]struct{})
+
 	e.clearBufferedEvents = false
 	if e.updateBufferedEvents != nil {
 		e.bufferedEvents = append(e.bufferedEvents, e.updateBufferedEvents...)
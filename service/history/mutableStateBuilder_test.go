@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber/cadence/.gen/go/shared"
 	workflow "github.com/uber/cadence/.gen/go/shared"
@@ -190,6 +191,7 @@ func (s *mutableStateSuite) TestShouldBufferEvent() {
 		workflow.EventTypeStartChildWorkflowExecutionInitiated:            true,
 		workflow.EventTypeSignalExternalWorkflowExecutionInitiated:        true,
 		workflow.EventTypeUpsertWorkflowSearchAttributes:                  true,
+		workflow.EventTypeWorkflowExecutionUpdateCompleted:                true,
 	}
 
 	// other events will not be assign event ID immediately
@@ -387,6 +389,95 @@ func (s *mutableStateSuite) TestMergeMapOfByteArray() {
 	s.Equal(2, len(resultMap))
 }
 
+func (s *mutableStateSuite) TestContinueAsNew_CarryOverDelayedSignals() {
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	s.msBuilder = newMutableStateBuilderWithEventV2(s.mockShard, s.mockEventsCache, s.logger, execution.GetRunId())
+	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	startEvent := addWorkflowExecutionStartedEvent(s.msBuilder, execution, "some random workflow type",
+		"some random tasklist", nil, 100, 10, "some random identity")
+	s.mockEventsCache.On("getEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything).Return(startEvent, nil)
+
+	now := time.Now()
+	firstRequestID := uuid.New()
+	secondRequestID := uuid.New()
+	_, err := s.msBuilder.AddWorkflowExecutionDelayedSignalRequestedEvent(secondRequestID, "second signal", nil, nil, "", now.Add(time.Hour))
+	s.NoError(err)
+	_, err = s.msBuilder.AddWorkflowExecutionDelayedSignalRequestedEvent(firstRequestID, "first signal", []byte("input"), nil, "", now.Add(time.Minute))
+	s.NoError(err)
+
+	domainEntry := cache.NewLocalDomainCacheEntryForTest(&persistence.DomainInfo{ID: validDomainID}, &persistence.DomainConfig{}, "", nil)
+	_, newBuilder, err := s.msBuilder.AddContinueAsNewEvent(
+		s.msBuilder.GetNextEventID(),
+		common.EmptyEventID,
+		domainEntry,
+		"",
+		&workflow.ContinueAsNewWorkflowExecutionDecisionAttributes{
+			WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("some random workflow type")},
+			TaskList:                            &workflow.TaskList{Name: common.StringPtr("some random tasklist")},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+		},
+		0,
+	)
+	s.NoError(err)
+
+	newMsBuilder := newBuilder.(*mutableStateBuilder)
+	pending := newMsBuilder.GetPendingDelayedSignalInfos()
+	s.Equal(2, len(pending))
+	s.True(now.Add(time.Minute).Equal(pending[firstRequestID].FireTime))
+	s.Equal([]byte("input"), pending[firstRequestID].Input)
+	s.True(now.Add(time.Hour).Equal(pending[secondRequestID].FireTime))
+
+	// delayed signals are recorded in the new run right after the started event, in fire time order
+	newHistory := newMsBuilder.GetHistoryBuilder().history
+	s.Equal(4, len(newHistory))
+	s.Equal(workflow.EventTypeWorkflowExecutionStarted, newHistory[0].GetEventType())
+	s.Equal(firstRequestID, newHistory[1].WorkflowExecutionDelayedSignalRequestedEventAttributes.GetRequestId())
+	s.Equal(secondRequestID, newHistory[2].WorkflowExecutionDelayedSignalRequestedEventAttributes.GetRequestId())
+	s.Equal(workflow.EventTypeDecisionTaskScheduled, newHistory[3].GetEventType())
+
+	delayedSignalTimers := 0
+	for _, task := range newMsBuilder.insertTimerTasks {
+		if _, ok := task.(*persistence.DelayedSignalTimerTask); ok {
+			delayedSignalTimers++
+		}
+	}
+	s.Equal(2, delayedSignalTimers)
+}
+
+func (s *mutableStateSuite) TestUpdateRequested_DeletedOnUpdateCompleted() {
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	s.msBuilder = newMutableStateBuilderWithEventV2(s.mockShard, s.mockEventsCache, s.logger, execution.GetRunId())
+	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+	addWorkflowExecutionStartedEvent(s.msBuilder, execution, "some random workflow type",
+		"some random tasklist", nil, 100, 10, "some random identity")
+
+	requestID := uuid.New()
+	event, err := s.msBuilder.AddWorkflowExecutionUpdateRequestedEvent("some random update", nil, "", requestID)
+	s.NoError(err)
+	// the update requested event is buffered, so the result is looked up from the next event ID
+	s.Equal(common.BufferedEventID, event.GetEventId())
+	eventID, ok := s.msBuilder.GetUpdateRequestedEventID(requestID)
+	s.True(ok)
+	s.Equal(s.msBuilder.GetNextEventID(), eventID)
+	s.Equal(map[string]int64{requestID: eventID}, s.msBuilder.updateUpdateRequestedIDs)
+
+	_, err = s.msBuilder.AddWorkflowExecutionUpdateCompletedEvent(common.EmptyEventID,
+		&workflow.CompleteWorkflowExecutionUpdateDecisionAttributes{RequestId: common.StringPtr(requestID)})
+	s.NoError(err)
+	_, ok = s.msBuilder.GetUpdateRequestedEventID(requestID)
+	s.False(ok)
+	s.Empty(s.msBuilder.updateUpdateRequestedIDs)
+	s.Equal([]string{requestID}, convertDeleteUpdateRequestedIDs(s.msBuilder.deleteUpdateRequestedIDs))
+}
+
 func (s *mutableStateSuite) prepareTransientDecisionCompletionFirstBatchReplicated(version int64, runID string) (*shared.HistoryEvent, *shared.HistoryEvent) {
 	domainID := validDomainID
 	execution := shared.WorkflowExecution{
@@ -483,6 +574,7 @@ func (s *mutableStateSuite) prepareTransientDecisionCompletionFirstBatchReplicat
 		decisionStartedEvent.GetEventId(),
 		decisionStartedEvent.DecisionTaskStartedEventAttributes.GetRequestId(),
 		decisionStartedEvent.GetTimestamp(),
+		decisionStartedEvent.DecisionTaskStartedEventAttributes.GetBuildId(),
 	)
 	s.Nil(err)
 	s.NotNil(di)
@@ -533,6 +625,7 @@ func (s *mutableStateSuite) prepareTransientDecisionCompletionFirstBatchReplicat
 		newDecisionStartedEvent.GetEventId(),
 		newDecisionStartedEvent.DecisionTaskStartedEventAttributes.GetRequestId(),
 		newDecisionStartedEvent.GetTimestamp(),
+		newDecisionStartedEvent.DecisionTaskStartedEventAttributes.GetBuildId(),
 	)
 	s.Nil(err)
 	s.NotNil(di)
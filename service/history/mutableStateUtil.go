@@ -21,6 +21,7 @@
 package history
 
 import (
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/persistence"
 )
 
@@ -198,3 +199,71 @@ func convertSignalRequestedIDs(
 	}
 	return outputs
 }
+
+func convertUpdateRequestedIDs(
+	inputs map[string]int64,
+) map[string]int64 {
+
+	outputs := make(map[string]int64, len(inputs))
+	for requestID, eventID := range inputs {
+		outputs[requestID] = eventID
+	}
+	return outputs
+}
+
+func convertDeleteUpdateRequestedIDs(
+	inputs map[string]struct{},
+) []string {
+
+	outputs := make([]string, 0, len(inputs))
+	for item := range inputs {
+		outputs = append(outputs, item)
+	}
+	return outputs
+}
+
+func convertPendingDelayedSignalInfos(
+	inputs map[string]*persistence.DelayedSignalInfo,
+) []*persistence.DelayedSignalInfo {
+
+	outputs := make([]*persistence.DelayedSignalInfo, 0, len(inputs))
+	for _, item := range inputs {
+		outputs = append(outputs, item)
+	}
+	return outputs
+}
+
+func convertUpdateDelayedSignalInfos(
+	inputs map[*persistence.DelayedSignalInfo]struct{},
+) []*persistence.DelayedSignalInfo {
+
+	outputs := make([]*persistence.DelayedSignalInfo, 0, len(inputs))
+	for item := range inputs {
+		outputs = append(outputs, item)
+	}
+	return outputs
+}
+
+func convertDeleteDelayedSignalInfos(
+	inputs map[string]struct{},
+) []string {
+
+	outputs := make([]string, 0, len(inputs))
+	for item := range inputs {
+		outputs = append(outputs, item)
+	}
+	return outputs
+}
+
+// getDecisionTaskBuildID returns the worker build ID decision tasks of the workflow are routed by.
+// It is nil for a workflow whose first decision task has not been picked up yet, so matching routes
+// it to the default compatible build set, and empty for a workflow which is not pinned to a build.
+func getDecisionTaskBuildID(
+	executionInfo *persistence.WorkflowExecutionInfo,
+) *string {
+
+	if executionInfo.BuildID == "" && executionInfo.LastProcessedEvent == common.EmptyEventID {
+		return nil
+	}
+	return common.StringPtr(executionInfo.BuildID)
+}
@@ -157,6 +157,12 @@ func (a *queueAckMgrImpl) getQueueReadLevel() int64 {
 	return a.readLevel
 }
 
+func (a *queueAckMgrImpl) getQueueOutstandingTaskCount() int {
+	a.RLock()
+	defer a.RUnlock()
+	return len(a.outstandingTasks)
+}
+
 func (a *queueAckMgrImpl) getFinishedChan() <-chan struct{} {
 	return a.finishedChan
 }
@@ -154,6 +154,16 @@ func (p *queueProcessorBase) notifyNewTask() {
 	}
 }
 
+func (p *queueProcessorBase) describeQueue(queueType workflow.ShardQueueType) *workflow.ShardQueueProcessorInfo {
+	return &workflow.ShardQueueProcessorInfo{
+		QueueType:            queueType.Ptr(),
+		ClusterName:          common.StringPtr(p.clusterName),
+		AckLevel:             common.Int64Ptr(p.ackMgr.getQueueAckLevel()),
+		ReadLevel:            common.Int64Ptr(p.ackMgr.getQueueReadLevel()),
+		OutstandingTaskCount: common.Int32Ptr(int32(p.ackMgr.getQueueOutstandingTaskCount())),
+	}
+}
+
 func (p *queueProcessorBase) processorPump() {
 	<-time.NewTimer(backoff.NewJitter().JitDuration(p.options.StartDelay(), 0.99)).C
 
@@ -22,6 +22,7 @@ package history
 
 import (
 	"github.com/stretchr/testify/mock"
+	"github.com/uber/cadence/.gen/go/shared"
 )
 
 // mockQueueProcessor is an autogenerated mock type for the queueProcessor type
@@ -43,3 +44,18 @@ func (_m *mockQueueProcessor) Stop() {
 func (_m *mockQueueProcessor) notifyNewTask() {
 	_m.Called()
 }
+
+// describeQueue provides a mock function with given fields: queueType
+func (_m *mockQueueProcessor) describeQueue(queueType shared.ShardQueueType) *shared.ShardQueueProcessorInfo {
+	ret := _m.Called(queueType)
+
+	var r0 *shared.ShardQueueProcessorInfo
+	if rf, ok := ret.Get(0).(func(shared.ShardQueueType) *shared.ShardQueueProcessorInfo); ok {
+		r0 = rf(queueType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ShardQueueProcessorInfo)
+		}
+	}
+	return r0
+}
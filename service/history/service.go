@@ -27,8 +27,11 @@ import (
 	"github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/elasticsearch/bulk"
+	"github.com/uber/cadence/common/healthcheck"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/messaging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	espersistence "github.com/uber/cadence/common/persistence/elasticsearch"
@@ -45,6 +48,7 @@ type Config struct {
 	RPS                             dynamicconfig.IntPropertyFn
 	MaxIDLengthLimit                dynamicconfig.IntPropertyFn
 	PersistenceMaxQPS               dynamicconfig.IntPropertyFn
+	PersistenceDomainMaxQPS         dynamicconfig.IntPropertyFnWithDomainFilter
 	EnableVisibilitySampling        dynamicconfig.BoolPropertyFn
 	EnableReadFromClosedExecutionV2 dynamicconfig.BoolPropertyFn
 	VisibilityOpenMaxQPS            dynamicconfig.IntPropertyFnWithDomainFilter
@@ -160,6 +164,19 @@ type Config struct {
 
 	// StickyTTL is to expire a sticky tasklist if no update more than this duration
 	StickyTTL dynamicconfig.DurationPropertyFnWithDomainFilter
+
+	// EnableActivityLocalDispatchByDomain allows activities to be started and returned to the worker
+	// in the decision completion response, skipping matching
+	EnableActivityLocalDispatchByDomain dynamicconfig.BoolPropertyFnWithDomainFilter
+	// EnableParentClosePolicy is whether the child policy is applied to the pending children of a closed workflow
+	EnableParentClosePolicy dynamicconfig.BoolPropertyFnWithDomainFilter
+
+	// ElasticSearch bulk processor settings, only used when writing visibility records to ES without kafka
+	ESProcessorNumOfWorkers  dynamicconfig.IntPropertyFn
+	ESProcessorBulkActions   dynamicconfig.IntPropertyFn
+	ESProcessorBulkSize      dynamicconfig.IntPropertyFn
+	ESProcessorFlushInterval dynamicconfig.DurationPropertyFn
+	ESProcessorAckTimeout    dynamicconfig.DurationPropertyFn
 }
 
 const (
@@ -173,6 +190,7 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, enableVisibilit
 		RPS:                                                   dc.GetIntProperty(dynamicconfig.HistoryRPS, 3000),
 		MaxIDLengthLimit:                                      dc.GetIntProperty(dynamicconfig.MaxIDLengthLimit, 1000),
 		PersistenceMaxQPS:                                     dc.GetIntProperty(dynamicconfig.HistoryPersistenceMaxQPS, 9000),
+		PersistenceDomainMaxQPS:                               dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryPersistenceDomainMaxQPS, 0),
 		EnableVisibilitySampling:                              dc.GetBoolProperty(dynamicconfig.EnableVisibilitySampling, true),
 		EnableReadFromClosedExecutionV2:                       dc.GetBoolProperty(dynamicconfig.EnableReadFromClosedExecutionV2, false),
 		VisibilityOpenMaxQPS:                                  dc.GetIntPropertyFilteredByDomain(dynamicconfig.HistoryVisibilityOpenMaxQPS, 300),
@@ -258,6 +276,15 @@ func NewConfig(dc *dynamicconfig.Collection, numberOfShards int, enableVisibilit
 		SearchAttributesSizeOfValueLimit:  dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesSizeOfValueLimit, 2*1024),
 		SearchAttributesTotalSizeLimit:    dc.GetIntPropertyFilteredByDomain(dynamicconfig.SearchAttributesTotalSizeLimit, 40*1024),
 		StickyTTL:                         dc.GetDurationPropertyFilteredByDomain(dynamicconfig.StickyTTL, time.Hour*24*365),
+
+		EnableActivityLocalDispatchByDomain: dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.EnableActivityLocalDispatchByDomain, false),
+		EnableParentClosePolicy:             dc.GetBoolPropertyFnWithDomainFilter(dynamicconfig.EnableParentClosePolicy, false),
+
+		ESProcessorNumOfWorkers:  dc.GetIntProperty(dynamicconfig.HistoryESProcessorNumOfWorkers, 1),
+		ESProcessorBulkActions:   dc.GetIntProperty(dynamicconfig.HistoryESProcessorBulkActions, 1000),
+		ESProcessorBulkSize:      dc.GetIntProperty(dynamicconfig.HistoryESProcessorBulkSize, 2<<24), // 16MB
+		ESProcessorFlushInterval: dc.GetDurationProperty(dynamicconfig.HistoryESProcessorFlushInterval, 200*time.Millisecond),
+		ESProcessorAckTimeout:    dc.GetDurationProperty(dynamicconfig.HistoryESProcessorAckTimeout, 10*time.Second),
 	}
 
 	return cfg
@@ -307,13 +334,14 @@ func (s *Service) Start() {
 	pConfig := params.PersistenceConfig
 	pConfig.HistoryMaxConns = s.config.HistoryMgrNumConns()
 	pConfig.SetMaxQPS(pConfig.DefaultStore, s.config.PersistenceMaxQPS())
+	pConfig.DomainMaxQPS = s.config.PersistenceDomainMaxQPS
 	pConfig.VisibilityConfig = &config.VisibilityConfig{
 		VisibilityOpenMaxQPS:            s.config.VisibilityOpenMaxQPS,
 		VisibilityClosedMaxQPS:          s.config.VisibilityClosedMaxQPS,
 		EnableSampling:                  s.config.EnableVisibilitySampling,
 		EnableReadFromClosedExecutionV2: s.config.EnableReadFromClosedExecutionV2,
 	}
-	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), s.metricsClient, log)
+	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), s.metricsClient, params.PayloadEncryptor, log)
 
 	shardMgr, err := pFactory.NewShardManager()
 	if err != nil {
@@ -332,7 +360,7 @@ func (s *Service) Start() {
 
 	var esVisibility persistence.VisibilityManager
 	if params.ESConfig.Enable {
-		visibilityProducer, err := s.params.MessagingClient.NewProducer(common.VisibilityAppName)
+		visibilityProducer, err := s.newVisibilityProducer()
 		if err != nil {
 			log.Fatal("Creating visibility producer failed", tag.Error(err))
 		}
@@ -352,6 +380,7 @@ func (s *Service) Start() {
 	}
 
 	domainCache := cache.NewDomainCache(metadata, base.GetClusterMetadata(), base.GetMetricsClient(), base.GetLogger())
+	pFactory.SetDomainNameResolver(domainCache.GetDomainName)
 
 	historyArchiverBootstrapContainer := &archiver.HistoryBootstrapContainer{
 		HistoryManager:   history,
@@ -376,10 +405,36 @@ func (s *Service) Start() {
 		log.Fatal("History handler failed to start", tag.Error(err))
 	}
 
+	healthCheckServer := base.GetHealthCheckServer()
+	healthCheckServer.AddReadinessCheck("persistence", healthcheck.NewPersistenceCheck(metadata))
+	healthCheckServer.AddReadinessCheck("shards", handler.controller.checkShardsAcquired)
+	healthCheckServer.SetServing(true)
+
 	log.Info("started", tag.Service(common.HistoryServiceName))
 
 	<-s.stopC
-	base.Stop()
+	handler.Stop()
+}
+
+// newVisibilityProducer creates the producer for advanced visibility records, which either
+// publishes to kafka for worker to index, or writes to ElasticSearch directly
+func (s *Service) newVisibilityProducer() (messaging.Producer, error) {
+	if !s.params.ESConfig.WriteDirect {
+		return s.params.MessagingClient.NewProducer(common.VisibilityAppName)
+	}
+
+	indexerConfig := &bulk.Config{
+		// only used to shard in-flight requests
+		IndexerConcurrency:       s.config.TransferTaskWorkerCount,
+		ESProcessorNumOfWorkers:  s.config.ESProcessorNumOfWorkers,
+		ESProcessorBulkActions:   s.config.ESProcessorBulkActions,
+		ESProcessorBulkSize:      s.config.ESProcessorBulkSize,
+		ESProcessorFlushInterval: s.config.ESProcessorFlushInterval,
+		ESProcessorAckTimeout:    s.config.ESProcessorAckTimeout,
+		ValidSearchAttributes:    s.config.ValidSearchAttributes,
+	}
+	return bulk.NewESProducer(indexerConfig, s.params.ESClient, s.params.ESConfig.GetVisibilityIndex(),
+		s.params.Logger, s.metricsClient)
 }
 
 // Stop stops the service
@@ -405,6 +405,7 @@ func (s *shardContextImpl) CreateWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
+	request.Encoding = s.getDefaultEncoding(domainEntry)
 
 	s.Lock()
 	defer s.Unlock()
@@ -885,6 +886,18 @@ func (s *shardContextImpl) closeShard() {
 	}
 }
 
+// flush persists the shard info regardless of when it was last updated
+func (s *shardContextImpl) flush() error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.isClosed {
+		return nil
+	}
+	s.lastUpdated = time.Time{}
+	return s.updateShardInfoLocked()
+}
+
 func (s *shardContextImpl) generateTransferTaskIDLocked() (int64, error) {
 	if err := s.updateRangeIfNeededLocked(); err != nil {
 		return -1, err
@@ -1149,7 +1162,7 @@ func (s *shardContextImpl) GetCurrentTime(cluster string) time.Time {
 	return s.GetTimeSource().Now()
 }
 
-func acquireShard(shardItem *historyShardsItem, closeCh chan<- int) (ShardContext,
+func acquireShard(shardItem *historyShardsItem, closeCh chan<- int) (*shardContextImpl,
 	error) {
 
 	var shardInfo *persistence.ShardInfo
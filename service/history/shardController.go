@@ -22,10 +22,12 @@ package history
 
 import (
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/log"
@@ -64,7 +66,10 @@ type (
 
 		sync.RWMutex
 		historyShards map[int]*historyShardsItem
-		isStopping    bool
+		// closedShards holds the shards released on purpose through closeShard, they are not
+		// reported as missing by the readiness check until this host acquires them again
+		closedShards map[int]struct{}
+		isStopping   bool
 	}
 
 	historyShardsItemStatus int
@@ -81,6 +86,7 @@ type (
 		domainCache     cache.DomainCache
 		engineFactory   EngineFactory
 		host            *membership.HostInfo
+		shard           *shardContextImpl
 		engine          Engine
 		config          *Config
 		logger          log.Logger
@@ -95,6 +101,19 @@ const (
 	historyShardsItemStatusStopped
 )
 
+func (s historyShardsItemStatus) String() string {
+	switch s {
+	case historyShardsItemStatusInitialized:
+		return "initialized"
+	case historyShardsItemStatusStarted:
+		return "started"
+	case historyShardsItemStatusStopped:
+		return "stopped"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(s))
+	}
+}
+
 func newShardController(svc service.Service, host *membership.HostInfo, resolver membership.ServiceResolver,
 	shardMgr persistence.ShardManager, historyMgr persistence.HistoryManager, historyV2Mgr persistence.HistoryV2Manager, domainCache cache.DomainCache,
 	executionMgrFactory persistence.ExecutionManagerFactory, factory EngineFactory,
@@ -112,6 +131,7 @@ func newShardController(svc service.Service, host *membership.HostInfo, resolver
 		domainCache:         domainCache,
 		engineFactory:       factory,
 		historyShards:       make(map[int]*historyShardsItem),
+		closedShards:        make(map[int]struct{}),
 		shardClosedCh:       make(chan int, config.NumberOfShards),
 		shutdownCh:          make(chan struct{}),
 		logger:              logger,
@@ -210,6 +230,18 @@ func (c *shardController) removeEngineForShard(shardID int) {
 	}
 }
 
+// closeShard stops the queue processors of a shard, persists its shard info and unloads it from this host.
+// The shard is acquired again by the host the membership ring assigns it to on its next request.
+func (c *shardController) closeShard(shardID int) {
+	item, _ := c.removeHistoryShardItem(shardID)
+	if item != nil {
+		c.Lock()
+		c.closedShards[shardID] = struct{}{}
+		c.Unlock()
+		item.releaseEngine()
+	}
+}
+
 func (c *shardController) getOrCreateHistoryShardItem(shardID int) (*historyShardsItem, error) {
 	c.RLock()
 	if item, ok := c.historyShards[shardID]; ok {
@@ -246,6 +278,7 @@ func (c *shardController) getOrCreateHistoryShardItem(shardID int) (*historyShar
 			return nil, err
 		}
 		c.historyShards[shardID] = shardItem
+		delete(c.closedShards, shardID)
 		c.metricsClient.IncCounter(metrics.HistoryShardControllerScope, metrics.ShardItemCreatedCounter)
 
 		shardItem.logger.Info("", tag.LifeCycleStarted, tag.ComponentShardItem, tag.Address(info.Identity()), tag.ShardID(shardID))
@@ -353,9 +386,17 @@ func (c *shardController) doShutdown() {
 	c.logger.Info("", tag.LifeCycleStopping, tag.Address(c.host.Identity()))
 	c.Lock()
 	defer c.Unlock()
+	// release the shards before this host leaves the membership ring,
+	// so that their next owners resume from the latest persisted ack levels
+	var releaseWG sync.WaitGroup
 	for _, item := range c.historyShards {
-		item.stopEngine()
+		releaseWG.Add(1)
+		go func(item *historyShardsItem) {
+			defer releaseWG.Done()
+			item.releaseEngine()
+		}(item)
 	}
+	releaseWG.Wait()
 	c.historyShards = nil
 }
 
@@ -391,6 +432,55 @@ func (c *shardController) shardIDs() []int32 {
 	return ids
 }
 
+// shardStatuses returns the status of each shard loaded by this host, ordered by shard ID
+func (c *shardController) shardStatuses() []*workflow.ShardStatusInfo {
+	c.RLock()
+	statuses := make([]*workflow.ShardStatusInfo, 0, len(c.historyShards))
+	for id, item := range c.historyShards {
+		statuses = append(statuses, &workflow.ShardStatusInfo{
+			ShardID: common.Int32Ptr(int32(id)),
+			Status:  common.StringPtr(item.getStatus().String()),
+		})
+	}
+	c.RUnlock()
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].GetShardID() < statuses[j].GetShardID()
+	})
+	return statuses
+}
+
+// checkShardsAcquired verifies that every shard the membership ring assigns to this host is loaded,
+// shards released through closeShard are skipped until they are acquired again
+func (c *shardController) checkShardsAcquired() error {
+	notAcquired := 0
+	for shardID := 0; shardID < c.config.NumberOfShards; shardID++ {
+		info, err := c.hServiceResolver.Lookup(string(rune(shardID)))
+		if err != nil {
+			return err
+		}
+		if info.Identity() != c.host.Identity() {
+			continue
+		}
+
+		c.RLock()
+		item, ok := c.historyShards[shardID]
+		_, closed := c.closedShards[shardID]
+		c.RUnlock()
+		if closed {
+			continue
+		}
+		if !ok || item.getStatus() != historyShardsItemStatusStarted {
+			notAcquired++
+		}
+	}
+
+	if notAcquired > 0 {
+		return fmt.Errorf("%v shards owned by host %v are not acquired", notAcquired, c.host.Identity())
+	}
+	return nil
+}
+
 func (i *historyShardsItem) getOrCreateEngine(shardClosedCh chan<- int) (Engine, error) {
 	i.RLock()
 	if i.status == historyShardsItemStatusStarted {
@@ -408,6 +498,7 @@ func (i *historyShardsItem) getOrCreateEngine(shardClosedCh chan<- int) (Engine,
 		if err != nil {
 			return nil, err
 		}
+		i.shard = context
 		i.engine = i.engineFactory.CreateEngine(context)
 		i.engine.Start()
 		i.logger.Info("", tag.LifeCycleStarted, tag.ComponentShardEngine, tag.ShardID(i.shardID), tag.Address(i.host.Identity()))
@@ -426,6 +517,20 @@ func (i *historyShardsItem) stopEngine() {
 	i.Lock()
 	defer i.Unlock()
 
+	i.stopEngineLocked(false)
+}
+
+// releaseEngine stops the engine of a shard this host is giving up voluntarily. Once the
+// queue processors are stopped, the shard info is persisted regardless of when it was last
+// updated, so that the next owner of the shard does not reprocess the tasks acked here.
+func (i *historyShardsItem) releaseEngine() {
+	i.Lock()
+	defer i.Unlock()
+
+	i.stopEngineLocked(true)
+}
+
+func (i *historyShardsItem) stopEngineLocked(flush bool) {
 	switch i.status {
 	case historyShardsItemStatusInitialized:
 		i.status = historyShardsItemStatusStopped
@@ -433,6 +538,12 @@ func (i *historyShardsItem) stopEngine() {
 		i.logger.Info("", tag.LifeCycleStopping, tag.ComponentShardEngine, tag.ShardID(i.shardID), tag.Address(i.host.Identity()))
 		i.engine.Stop()
 		i.engine = nil
+		if flush {
+			if err := i.shard.flush(); err != nil {
+				i.logger.Warn("Failed to persist shard info on release.", tag.Error(err), tag.ShardID(i.shardID))
+			}
+		}
+		i.shard = nil
 		i.logger.Info("", tag.LifeCycleStopped, tag.ComponentShardEngine, tag.ShardID(i.shardID), tag.Address(i.host.Identity()))
 		i.status = historyShardsItemStatusStopped
 	case historyShardsItemStatusStopped:
@@ -442,6 +553,13 @@ func (i *historyShardsItem) stopEngine() {
 	}
 }
 
+func (i *historyShardsItem) getStatus() historyShardsItemStatus {
+	i.RLock()
+	defer i.RUnlock()
+
+	return i.status
+}
+
 func (i *historyShardsItem) isValid() bool {
 	i.RLock()
 	defer i.RUnlock()
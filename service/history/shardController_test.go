@@ -433,6 +433,7 @@ func (s *shardControllerSuite) TestHistoryEngineClosed() {
 		mockEngine := historyEngines[shardID]
 		mockEngine.On("Stop").Return().Once()
 		s.mockServiceResolver.On("Lookup", string(shardID)).Return(s.hostInfo, nil)
+		s.setupMocksForReleaseShard(shardID, 6)
 	}
 	s.controller.Stop()
 
@@ -513,6 +514,7 @@ func (s *shardControllerSuite) TestRingUpdated() {
 		mockEngine := historyEngines[shardID]
 		mockEngine.On("Stop").Return().Once()
 		s.mockServiceResolver.On("Lookup", string(shardID)).Return(s.hostInfo, nil)
+		s.setupMocksForReleaseShard(shardID, 6)
 	}
 	s.controller.Stop()
 
@@ -566,11 +568,63 @@ func (s *shardControllerSuite) TestShardControllerClosed() {
 		mockEngine := historyEngines[shardID]
 		mockEngine.On("Stop").Return().Once()
 		s.mockServiceResolver.On("Lookup", string(shardID)).Return(s.hostInfo, nil)
+		s.setupMocksForReleaseShard(shardID, 6)
 	}
 	s.controller.Stop()
 	workerWG.Wait()
 }
 
+func (s *shardControllerSuite) TestCloseShard() {
+	numShards := 2
+	s.config.NumberOfShards = numShards
+	s.controller = newShardController(s.mockService, s.hostInfo, s.mockServiceResolver, s.mockShardManager, s.mockHistoryMgr, s.mockHistoryV2Mgr,
+		s.domainCache, s.mockExecutionMgrFactory, s.mockEngineFactory, s.config, s.logger, s.metricsClient)
+	historyEngines := make(map[int]*MockHistoryEngine)
+	for shardID := 0; shardID < numShards; shardID++ {
+		mockEngine := &MockHistoryEngine{}
+		historyEngines[shardID] = mockEngine
+		s.setupMocksForAcquireShard(shardID, mockEngine, 5, 6)
+	}
+
+	s.mockServiceResolver.On("AddListener", shardControllerMembershipUpdateListenerName,
+		mock.Anything).Return(nil)
+	// when shard is initialized, it will use the 2 mock function below to initialize the "current" time of each cluster
+	s.mockClusterMetadata.On("GetCurrentClusterName").Return(cluster.TestCurrentClusterName)
+	s.mockClusterMetadata.On("GetAllClusterInfo").Return(cluster.TestSingleDCClusterInfo)
+	s.controller.Start()
+
+	statuses := s.controller.shardStatuses()
+	s.Equal(numShards, len(statuses))
+	for shardID, status := range statuses {
+		s.Equal(int32(shardID), status.GetShardID())
+		s.Equal("started", status.GetStatus())
+	}
+	for shardID := 0; shardID < numShards; shardID++ {
+		s.mockServiceResolver.On("Lookup", string(rune(shardID))).Return(s.hostInfo, nil)
+	}
+	s.NoError(s.controller.checkShardsAcquired())
+
+	historyEngines[0].On("Stop").Return().Once()
+	s.setupMocksForReleaseShard(0, 6)
+	s.controller.closeShard(0)
+	historyEngines[0].AssertExpectations(s.T())
+	// a shard moved away on purpose does not fail readiness
+	s.NoError(s.controller.checkShardsAcquired())
+
+	statuses = s.controller.shardStatuses()
+	s.Equal(1, len(statuses))
+	s.Equal(int32(1), statuses[0].GetShardID())
+
+	// closing a shard which is not loaded is a no-op
+	s.controller.closeShard(0)
+
+	s.mockServiceResolver.On("RemoveListener", shardControllerMembershipUpdateListenerName).Return(nil)
+	historyEngines[1].On("Stop").Return().Once()
+	s.setupMocksForReleaseShard(1, 6)
+	s.controller.Stop()
+	historyEngines[1].AssertExpectations(s.T())
+}
+
 func (s *shardControllerSuite) setupMocksForAcquireShard(shardID int, mockEngine *MockHistoryEngine, currentRangeID,
 	newRangeID int64) {
 
@@ -628,3 +682,11 @@ func (s *shardControllerSuite) setupMocksForAcquireShard(shardID int, mockEngine
 		PreviousRangeID: currentRangeID,
 	}).Return(nil).Once()
 }
+
+func (s *shardControllerSuite) setupMocksForReleaseShard(shardID int, rangeID int64) {
+	s.mockShardManager.On("UpdateShard", mock.MatchedBy(func(request *persistence.UpdateShardRequest) bool {
+		return request.ShardInfo.ShardID == shardID &&
+			request.ShardInfo.RangeID == rangeID &&
+			request.PreviousRangeID == rangeID
+	})).Return(nil).Once()
+}
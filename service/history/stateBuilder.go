@@ -172,7 +172,7 @@ func (b *stateBuilderImpl) applyEvents(domainID, requestID string, execution sha
 		case shared.EventTypeDecisionTaskStarted:
 			attributes := event.DecisionTaskStartedEventAttributes
 			di, err := b.msBuilder.ReplicateDecisionTaskStartedEvent(nil, event.GetVersion(), attributes.GetScheduledEventId(),
-				event.GetEventId(), attributes.GetRequestId(), event.GetTimestamp())
+				event.GetEventId(), attributes.GetRequestId(), event.GetTimestamp(), attributes.GetBuildId())
 			if err != nil {
 				return nil, nil, nil, err
 			}
@@ -440,11 +440,46 @@ func (b *stateBuilderImpl) applyEvents(domainID, requestID string, execution sha
 		case shared.EventTypeMarkerRecorded:
 			// No mutable state action is needed
 
+		case shared.EventTypeWorkflowExecutionUpdateRequested:
+			if err := b.msBuilder.ReplicateWorkflowExecutionUpdateRequestedEvent(event); err != nil {
+				return nil, nil, nil, err
+			}
+
+		case shared.EventTypeWorkflowExecutionUpdateCompleted:
+			if err := b.msBuilder.ReplicateWorkflowExecutionUpdateCompletedEvent(event); err != nil {
+				return nil, nil, nil, err
+			}
+
+		case shared.EventTypeWorkflowExecutionPaused:
+			if err := b.msBuilder.ReplicateWorkflowExecutionPausedEvent(event); err != nil {
+				return nil, nil, nil, err
+			}
+
+		case shared.EventTypeWorkflowExecutionUnpaused:
+			if err := b.msBuilder.ReplicateWorkflowExecutionUnpausedEvent(event); err != nil {
+				return nil, nil, nil, err
+			}
+
 		case shared.EventTypeWorkflowExecutionSignaled:
 			if err := b.msBuilder.ReplicateWorkflowExecutionSignaled(event); err != nil {
 				return nil, nil, nil, err
 			}
 
+		case shared.EventTypeWorkflowExecutionDelayedSignalRequested:
+			if err := b.msBuilder.ReplicateWorkflowExecutionDelayedSignalRequestedEvent(event); err != nil {
+				return nil, nil, nil, err
+			}
+
+			fireTimestamp := event.WorkflowExecutionDelayedSignalRequestedEventAttributes.GetFireTimestamp()
+			b.timerTasks = append(b.timerTasks, &persistence.DelayedSignalTimerTask{
+				VisibilityTimestamp: time.Unix(0, fireTimestamp),
+			})
+
+		case shared.EventTypeWorkflowExecutionDelayedSignalCanceled:
+			if err := b.msBuilder.ReplicateWorkflowExecutionDelayedSignalCanceledEvent(event); err != nil {
+				return nil, nil, nil, err
+			}
+
 		case shared.EventTypeWorkflowExecutionCancelRequested:
 			if err := b.msBuilder.ReplicateWorkflowExecutionCancelRequestedEvent(event); err != nil {
 				return nil, nil, nil, err
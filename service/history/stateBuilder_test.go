@@ -178,7 +178,7 @@ func (s *stateBuilderSuite) applyWorkflowExecutionStartedEventTest(cronSchedule
 
 	if len(cronSchedule) > 0 {
 		startWorkflowAttribute.Initiator = shared.ContinueAsNewInitiatorCronSchedule.Ptr()
-		startWorkflowAttribute.FirstDecisionTaskBackoffSeconds = common.Int32Ptr(int32(backoff.GetBackoffForNextSchedule(cronSchedule, now, now).Seconds()))
+		startWorkflowAttribute.FirstDecisionTaskBackoffSeconds = common.Int32Ptr(int32(backoff.GetBackoffForNextSchedule(cronSchedule, now, now, 0).Seconds()))
 	}
 	event := &shared.HistoryEvent{
 		Version:                                 common.Int64Ptr(version),
@@ -228,7 +228,7 @@ func (s *stateBuilderSuite) applyWorkflowExecutionStartedEventTest(cronSchedule
 
 	expectedTimerTasksLength := 1
 	timeout := now.Add(time.Duration(executionInfo.WorkflowTimeout) * time.Second)
-	backoffDuration := backoff.GetBackoffForNextSchedule(cronSchedule, now, now)
+	backoffDuration := backoff.GetBackoffForNextSchedule(cronSchedule, now, now, 0)
 	if backoffDuration != backoff.NoBackoff {
 		expectedTimerTasksLength = 2
 		timeout = timeout.Add(backoffDuration)
@@ -390,6 +390,108 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionSignaled()
 	s.Empty(s.stateBuilder.newRunTransferTasks)
 }
 
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionPaused() {
+	version := int64(1)
+	requestID := uuid.New()
+	domainID := validDomainID
+	execution := shared.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	now := time.Now()
+	pausedEventType := shared.EventTypeWorkflowExecutionPaused
+	pausedEvent := &shared.HistoryEvent{
+		Version:                                common.Int64Ptr(version),
+		EventId:                                common.Int64Ptr(130),
+		Timestamp:                              common.Int64Ptr(now.UnixNano()),
+		EventType:                              &pausedEventType,
+		WorkflowExecutionPausedEventAttributes: &shared.WorkflowExecutionPausedEventAttributes{},
+	}
+	unpausedEventType := shared.EventTypeWorkflowExecutionUnpaused
+	unpausedEvent := &shared.HistoryEvent{
+		Version:                                  common.Int64Ptr(version),
+		EventId:                                  common.Int64Ptr(131),
+		Timestamp:                                common.Int64Ptr(now.UnixNano()),
+		EventType:                                &unpausedEventType,
+		WorkflowExecutionUnpausedEventAttributes: &shared.WorkflowExecutionUnpausedEventAttributes{},
+	}
+	s.mockMutableState.On("GetExecutionInfo").Return(&persistence.WorkflowExecutionInfo{})
+	s.mockMutableState.On("ReplicateWorkflowExecutionPausedEvent", pausedEvent).Return(nil).Once()
+	s.mockMutableState.On("ReplicateWorkflowExecutionUnpausedEvent", unpausedEvent).Return(nil).Once()
+	s.mockMutableState.On("ClearStickyness").Twice()
+
+	s.mockUpdateVersion(pausedEvent)
+	_, _, _, err := s.stateBuilder.applyEvents(domainID, requestID, execution, s.toHistory(pausedEvent), nil, 0, 0)
+	s.Nil(err)
+
+	s.mockUpdateVersion(unpausedEvent)
+	_, _, _, err = s.stateBuilder.applyEvents(domainID, requestID, execution, s.toHistory(unpausedEvent), nil, 0, 0)
+	s.Nil(err)
+
+	s.Empty(s.stateBuilder.timerTasks)
+	s.Empty(s.stateBuilder.transferTasks)
+	s.Empty(s.stateBuilder.newRunTimerTasks)
+	s.Empty(s.stateBuilder.newRunTransferTasks)
+}
+
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionDelayedSignal() {
+	version := int64(1)
+	requestID := uuid.New()
+	domainID := validDomainID
+	execution := shared.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(validRunID),
+	}
+
+	now := time.Now()
+	fireTime := now.Add(time.Minute)
+	delayedSignalRequestID := uuid.New()
+	requestedEventType := shared.EventTypeWorkflowExecutionDelayedSignalRequested
+	requestedEvent := &shared.HistoryEvent{
+		Version:   common.Int64Ptr(version),
+		EventId:   common.Int64Ptr(130),
+		Timestamp: common.Int64Ptr(now.UnixNano()),
+		EventType: &requestedEventType,
+		WorkflowExecutionDelayedSignalRequestedEventAttributes: &shared.WorkflowExecutionDelayedSignalRequestedEventAttributes{
+			RequestId:     common.StringPtr(delayedSignalRequestID),
+			SignalName:    common.StringPtr("some random signal name"),
+			FireTimestamp: common.Int64Ptr(fireTime.UnixNano()),
+		},
+	}
+	canceledEventType := shared.EventTypeWorkflowExecutionDelayedSignalCanceled
+	canceledEvent := &shared.HistoryEvent{
+		Version:   common.Int64Ptr(version),
+		EventId:   common.Int64Ptr(131),
+		Timestamp: common.Int64Ptr(now.UnixNano()),
+		EventType: &canceledEventType,
+		WorkflowExecutionDelayedSignalCanceledEventAttributes: &shared.WorkflowExecutionDelayedSignalCanceledEventAttributes{
+			RequestId: common.StringPtr(delayedSignalRequestID),
+		},
+	}
+	s.mockMutableState.On("GetExecutionInfo").Return(&persistence.WorkflowExecutionInfo{})
+	s.mockMutableState.On("ReplicateWorkflowExecutionDelayedSignalRequestedEvent", requestedEvent).Return(nil).Once()
+	s.mockMutableState.On("ReplicateWorkflowExecutionDelayedSignalCanceledEvent", canceledEvent).Return(nil).Once()
+	s.mockMutableState.On("ClearStickyness").Twice()
+
+	s.mockUpdateVersion(requestedEvent)
+	_, _, _, err := s.stateBuilder.applyEvents(domainID, requestID, execution, s.toHistory(requestedEvent), nil, 0, 0)
+	s.Nil(err)
+
+	s.Equal(1, len(s.stateBuilder.timerTasks))
+	timerTask, ok := s.stateBuilder.timerTasks[0].(*persistence.DelayedSignalTimerTask)
+	s.True(ok)
+	s.True(fireTime.Equal(timerTask.VisibilityTimestamp))
+
+	s.mockUpdateVersion(canceledEvent)
+	_, _, _, err = s.stateBuilder.applyEvents(domainID, requestID, execution, s.toHistory(canceledEvent), nil, 0, 0)
+	s.Nil(err)
+
+	s.Empty(s.stateBuilder.transferTasks)
+	s.Empty(s.stateBuilder.newRunTimerTasks)
+	s.Empty(s.stateBuilder.newRunTransferTasks)
+}
+
 func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowExecutionFailed() {
 	version := int64(1)
 	requestID := uuid.New()
@@ -1638,6 +1740,7 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeDecisionTaskStarted() {
 		DecisionTaskStartedEventAttributes: &shared.DecisionTaskStartedEventAttributes{
 			ScheduledEventId: common.Int64Ptr(scheduleID),
 			RequestId:        common.StringPtr(decisionRequestID),
+			BuildId:          common.StringPtr("build-1"),
 		},
 	}
 	di := &decisionInfo{
@@ -1650,7 +1753,7 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeDecisionTaskStarted() {
 		Attempt:         0,
 	}
 	s.mockMutableState.On("ReplicateDecisionTaskStartedEvent",
-		(*decisionInfo)(nil), event.GetVersion(), scheduleID, event.GetEventId(), decisionRequestID, event.GetTimestamp()).Return(di, nil).Once()
+		(*decisionInfo)(nil), event.GetVersion(), scheduleID, event.GetEventId(), decisionRequestID, event.GetTimestamp(), "build-1").Return(di, nil).Once()
 	s.mockMutableState.On("UpdateDecision", di).Once()
 	s.mockUpdateVersion(event)
 	s.mockMutableState.On("GetExecutionInfo").Return(&persistence.WorkflowExecutionInfo{})
@@ -2220,6 +2323,7 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeActivityTaskScheduled() {
 	}
 	s.mockMutableState.On("GetExecutionInfo").Return(executionInfo)
 	s.mockMutableState.On("GetPendingActivityInfos").Return(map[int64]*persistence.ActivityInfo{event.GetEventId(): ai})
+	s.mockMutableState.On("IsWorkflowExecutionPaused").Return(false)
 	s.mockMutableState.On("UpdateActivity", ai).Return(nil).Once()
 	s.mockMutableState.On("ReplicateActivityTaskScheduledEvent", event.GetEventId(), event).Return(ai, nil).Once()
 	s.mockUpdateVersion(event)
@@ -2488,7 +2592,7 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeUpsertWorkflowSearchAttribu
 
 func (s *stateBuilderSuite) TestApplyEventsNewEventsNotHandled() {
 	eventTypes := shared.EventType_Values()
-	s.Equal(42, len(eventTypes), "If you see this error, you are adding new event type. "+
+	s.Equal(48, len(eventTypes), "If you see this error, you are adding new event type. "+
 		"Before updating the number to make this test pass, please make sure you update stateBuilderImpl.applyEvents method "+
 		"to handle the new decision type. Otherwise cross dc will not work on the new event.")
 }
@@ -319,7 +319,9 @@ func (tb *timerBuilder) loadActivityTimers(msBuilder mutableState) {
 						TaskCreated:     (v.TimerTaskStatus & TimerTaskStatusCreatedHeartbeat) != 0}
 					tb.activityTimers = append(tb.activityTimers, td)
 				}
-			} else {
+			} else if !msBuilder.IsWorkflowExecutionPaused() {
+				// the activity is not dispatched while the workflow execution is paused,
+				// its ScheduleToStart timer is re-armed once the workflow execution is unpaused
 				scheduleToStartExpiry := v.ScheduledTime.Add(time.Duration(v.ScheduleToStartTimeout) * time.Second)
 				td := &timerDetails{
 					TimerSequenceID: TimerSequenceID{VisibilityTimestamp: scheduleToStartExpiry},
@@ -218,6 +218,42 @@ func (s *timerBuilderProcessorSuite) TestTimerBuilder_GetActivityTimer() {
 	s.Equal(workflow.TimeoutTypeHeartbeat, workflow.TimeoutType(tt.(*persistence.ActivityTimeoutTask).TimeoutType))
 }
 
+func (s *timerBuilderProcessorSuite) TestTimerBuilder_GetActivityTimer_Paused() {
+	builder := newMutableStateBuilder(s.mockShard, s.mockEventsCache, s.logger, "")
+	_, ai, err := builder.AddActivityTaskScheduledEvent(common.EmptyEventID,
+		&workflow.ScheduleActivityTaskDecisionAttributes{
+			ActivityId:                    common.StringPtr("test-id"),
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(2),
+			StartToCloseTimeoutSeconds:    common.Int32Ptr(2),
+			ScheduleToCloseTimeoutSeconds: common.Int32Ptr(3),
+			TaskList:                      &workflow.TaskList{Name: common.StringPtr("task-list")},
+		})
+	s.Nil(err)
+	tb := newTimerBuilder(s.logger, &mockTimeSource{currTime: time.Now()})
+	tt := tb.GetActivityTimerTaskIfNeeded(builder)
+	s.NotNil(tt)
+	s.Equal(workflow.TimeoutTypeScheduleToStart, workflow.TimeoutType(tt.(*persistence.ActivityTimeoutTask).TimeoutType))
+
+	// the ScheduleToStart timeout is held back while paused
+	s.Nil(builder.ReplicateWorkflowExecutionPausedEvent(&workflow.HistoryEvent{}))
+	tb = newTimerBuilder(s.logger, &mockTimeSource{currTime: time.Now()})
+	tt = tb.GetActivityTimerTaskIfNeeded(builder)
+	s.NotNil(tt)
+	s.Equal(workflow.TimeoutTypeScheduleToClose, workflow.TimeoutType(tt.(*persistence.ActivityTimeoutTask).TimeoutType))
+
+	// and re-armed relative to the unpause time
+	unpausedTime := ai.ScheduledTime.Add(time.Minute)
+	s.Nil(builder.ReplicateWorkflowExecutionUnpausedEvent(&workflow.HistoryEvent{
+		Timestamp: common.Int64Ptr(unpausedTime.UnixNano()),
+	}))
+	s.True(unpausedTime.Equal(ai.ScheduledTime))
+	tb = newTimerBuilder(s.logger, &mockTimeSource{currTime: time.Now()})
+	tt = tb.GetActivityTimerTaskIfNeeded(builder)
+	s.NotNil(tt)
+	s.Equal(workflow.TimeoutTypeScheduleToStart, workflow.TimeoutType(tt.(*persistence.ActivityTimeoutTask).TimeoutType))
+	s.True(unpausedTime.Add(2 * time.Second).Equal(tt.(*persistence.ActivityTimeoutTask).VisibilityTimestamp))
+}
+
 func (s *timerBuilderProcessorSuite) TestDecodeHistory() {
 	historyString := "5b7b226576656e744964223a312c2274696d657374616d70223a313438383332353631383735333431373433312c226576656e7454797065223a22576f726b666c6f77457865637574696f6e53746172746564222c22776f726b666c6f77457865637574696f6e537461727465644576656e7441747472696275746573223a7b22776f726b666c6f7754797065223a7b226e616d65223a22696e7465726174696f6e2d73657175656e7469616c2d757365722d74696d6572732d746573742d74797065227d2c227461736b4c697374223a7b226e616d65223a22696e7465726174696f6e2d73657175656e7469616c2d757365722d74696d6572732d746573742d7461736b6c697374227d2c22657865637574696f6e5374617274546f436c6f736554696d656f75745365636f6e6473223a3130302c227461736b5374617274546f436c6f736554696d656f75745365636f6e6473223a312c226964656e74697479223a22776f726b657231227d7d2c7b226576656e744964223a322c2274696d657374616d70223a313438383332353631383735333435333137312c226576656e7454797065223a224465636973696f6e5461736b5363686564756c6564222c226465636973696f6e5461736b5363686564756c65644576656e7441747472696275746573223a7b227461736b4c697374223a7b226e616d65223a22696e7465726174696f6e2d73657175656e7469616c2d757365722d74696d6572732d746573742d7461736b6c697374227d2c227374617274546f436c6f736554696d656f75745365636f6e6473223a317d7d2c7b226576656e744964223a332c2274696d657374616d70223a313438383332353632333938383637373536302c226576656e7454797065223a224465636973696f6e5461736b53746172746564222c226465636973696f6e5461736b537461727465644576656e7441747472696275746573223a7b227363686564756c65644576656e744964223a322c226964656e74697479223a22776f726b657231222c22726571756573744964223a2235383364326164652d663363332d343862322d383366352d323936636238393931646433227d7d2c7b226576656e744964223a342c2274696d657374616d70223a313438383332353632333939373138303336362c226576656e7454797065223a224465636973696f6e5461736b436f6d706c65746564222c226465636973696f6e5461736b436f6d706c657465644576656e7441747472696275746573223a7b22657865637574696f6e436f6e74657874223a224d513d3d222c227363686564756c65644576656e744964223a322c22737461727465644576656e744964223a332c226964656e74697479223a22776f726b657231227d7d2c7b226576656e744964223a352c2274696d657374616d70223a313438383332353632333939373138343436332c226576656e7454797065223a2254696d657253746172746564222c2274696d6572537461727465644576656e7441747472696275746573223a7b2274696d65724964223a2274696d65722d69642d31222c227374617274546f4669726554696d656f75745365636f6e6473223a312c226465636973696f6e5461736b436f6d706c657465644576656e744964223a347d7d2c7b226576656e744964223a362c2274696d657374616d70223a313438383332353632343939363835383639382c226576656e7454797065223a2254696d65724669726564222c2274696d657246697265644576656e7441747472696275746573223a7b2274696d65724964223a2274696d65722d69642d31222c22737461727465644576656e744964223a357d7d2c7b226576656e744964223a372c2274696d657374616d70223a313438383332353632343939363837333438302c226576656e7454797065223a224465636973696f6e5461736b5363686564756c6564222c226465636973696f6e5461736b5363686564756c65644576656e7441747472696275746573223a7b227461736b4c697374223a7b226e616d65223a22696e7465726174696f6e2d73657175656e7469616c2d757365722d74696d6572732d746573742d7461736b6c697374227d2c227374617274546f436c6f736554696d656f75745365636f6e6473223a317d7d2c7b226576656e744964223a382c2274696d657374616d70223a313438383332353632353238313139373232312c226576656e7454797065223a224465636973696f6e5461736b53746172746564222c226465636973696f6e5461736b537461727465644576656e7441747472696275746573223a7b227363686564756c65644576656e744964223a372c226964656e74697479223a22776f726b657231222c22726571756573744964223a2233646361663661642d663639382d343436342d386363612d333366663431353838393363227d7d2c7b226576656e744964223a392c2274696d657374616d70223a313438383332353632353238343137353337372c226576656e7454797065223a224465636973696f6e5461736b436f6d706c65746564222c226465636973696f6e5461736b436f6d706c657465644576656e7441747472696275746573223a7b22657865637574696f6e436f6e74657874223a224d673d3d222c227363686564756c65644576656e744964223a372c22737461727465644576656e744964223a382c226964656e74697479223a22776f726b657231227d7d2c7b226576656e744964223a31302c2274696d657374616d70223a313438383332353632353238343137373732342c226576656e7454797065223a2254696d657253746172746564222c2274696d6572537461727465644576656e7441747472696275746573223a7b2274696d65724964223a2274696d65722d69642d32222c227374617274546f4669726554696d656f75745365636f6e6473223a312c226465636973696f6e5461736b436f6d706c657465644576656e744964223a397d7d5d"
 	data, err := hex.DecodeString(historyString)
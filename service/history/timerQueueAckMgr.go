@@ -285,6 +285,12 @@ func (t *timerQueueAckMgrImpl) getAckLevel() TimerSequenceID {
 	return t.ackLevel
 }
 
+func (t *timerQueueAckMgrImpl) getOutstandingTaskCount() int {
+	t.Lock()
+	defer t.Unlock()
+	return len(t.outstandingTasks)
+}
+
 func (t *timerQueueAckMgrImpl) updateAckLevel() {
 	t.metricsClient.IncCounter(t.scope, metrics.AckLevelUpdateCounter)
 
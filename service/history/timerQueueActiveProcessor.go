@@ -22,6 +22,7 @@ package history
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/pborman/uuid"
@@ -262,6 +263,12 @@ func (t *timerQueueActiveProcessorImpl) process(
 		}
 		return metrics.TimerActiveTaskWorkflowBackoffTimerScope, err
 
+	case persistence.TaskTypeDelayedSignalTimer:
+		if shouldProcessTask {
+			err = t.processDelayedSignalTimer(timerTask)
+		}
+		return metrics.TimerActiveTaskDelayedSignalTimerScope, err
+
 	case persistence.TaskTypeDeleteHistoryEvent:
 		if shouldProcessTask {
 			err = t.timerQueueProcessorBase.processDeleteHistoryEvent(timerTask)
@@ -528,6 +535,10 @@ func (t *timerQueueActiveProcessorImpl) processDecisionTimeout(
 		}
 	case int(workflow.TimeoutTypeScheduleToStart):
 		t.metricsClient.IncCounter(metrics.TimerActiveTaskDecisionTimeoutScope, metrics.ScheduleToStartTimeoutCounter)
+		if msBuilder.IsWorkflowExecutionPaused() {
+			// the decision is dispatched to the original task list once the workflow execution is unpaused
+			return nil
+		}
 		// check if scheduled decision still pending and not started yet
 		if di.Attempt == task.ScheduleAttempt && di.StartedID == common.EmptyEventID {
 			_, err := msBuilder.AddDecisionTaskScheduleToStartTimeoutEvent(scheduleID)
@@ -583,6 +594,85 @@ func (t *timerQueueActiveProcessorImpl) processWorkflowBackoffTimer(
 	return t.updateWorkflowExecution(context, msBuilder, true, false, nil)
 }
 
+func (t *timerQueueActiveProcessorImpl) processDelayedSignalTimer(
+	task *persistence.TimerTaskInfo,
+) (retError error) {
+
+	context, release, err := t.cache.getOrCreateWorkflowExecutionForBackground(
+		t.timerQueueProcessorBase.getDomainIDAndWorkflowExecution(task),
+	)
+	if err != nil {
+		return err
+	}
+	defer func() { release(retError) }()
+
+	msBuilder, err := loadMutableStateForTimerTask(context, task, t.metricsClient, t.logger)
+	if err != nil {
+		return err
+	} else if msBuilder == nil || !msBuilder.IsWorkflowExecutionRunning() {
+		return nil
+	}
+
+	// deliver all delayed signals which are due in fire time order,
+	// a signal cancelled in the meantime is simply no longer pending
+	now := t.shard.GetTimeSource().Now()
+	var dueSignals []*persistence.DelayedSignalInfo
+	for _, ds := range msBuilder.GetPendingDelayedSignalInfos() {
+		if ds.FireTime.After(now) {
+			continue
+		}
+		// a delayed signal of another version is delivered by the timer task created along with it
+		ok, err := verifyTaskVersion(t.shard, t.logger, task.DomainID, ds.Version, task.Version, task)
+		if err != nil {
+			return err
+		} else if !ok {
+			continue
+		}
+		dueSignals = append(dueSignals, ds)
+	}
+	if len(dueSignals) == 0 {
+		return nil
+	}
+	sort.Slice(dueSignals, func(i, j int) bool {
+		return dueSignals[i].FireTime.Before(dueSignals[j].FireTime)
+	})
+
+	domainEntry, err := t.shard.GetDomainCache().GetDomainByID(task.DomainID)
+	if err != nil {
+		return err
+	}
+	maxAllowedSignals := t.config.MaximumSignalsPerExecution(domainEntry.GetInfo().Name)
+	executionInfo := msBuilder.GetExecutionInfo()
+	for _, ds := range dueSignals {
+		if msBuilder.IsSignalRequested(ds.RequestID) {
+			if _, err := msBuilder.AddWorkflowExecutionDelayedSignalCanceledEvent(ds.RequestID, identityHistoryService); err != nil {
+				return err
+			}
+			continue
+		}
+		// the signal limit may be reached while the signal is delayed, the signal is dropped then
+		if maxAllowedSignals > 0 && int(executionInfo.SignalCount) >= maxAllowedSignals {
+			t.logger.Info("Execution limit reached for maximum signals, delayed signal dropped",
+				tag.WorkflowSignalCount(executionInfo.SignalCount),
+				tag.WorkflowID(executionInfo.WorkflowID),
+				tag.WorkflowRunID(executionInfo.RunID),
+				tag.WorkflowDomainID(executionInfo.DomainID))
+			if _, err := msBuilder.AddWorkflowExecutionDelayedSignalCanceledEvent(ds.RequestID, identityHistoryService); err != nil {
+				return err
+			}
+			continue
+		}
+		msBuilder.AddSignalRequested(ds.RequestID)
+		if _, err := msBuilder.AddWorkflowExecutionSignaledForDelayedSignal(ds.RequestID); err != nil {
+			return err
+		}
+	}
+
+	// do not schedule the first decision of a workflow whose start is still backed off
+	scheduleNewDecision := !msBuilder.IsWorkflowPendingOnDecisionBackoff()
+	return t.updateWorkflowExecution(context, msBuilder, scheduleNewDecision, false, nil)
+}
+
 func (t *timerQueueActiveProcessorImpl) processActivityRetryTimer(
 	task *persistence.TimerTaskInfo,
 ) (retError error) {
@@ -725,6 +815,7 @@ func (t *timerQueueActiveProcessorImpl) processWorkflowTimeout(
 		Initiator:                           continueAsNewInitiator.Ptr(),
 		FailureReason:                       common.StringPtr(timeoutReason),
 		CronSchedule:                        common.StringPtr(msBuilder.GetExecutionInfo().CronSchedule),
+		CronMaxJitterSeconds:                common.Int32Ptr(msBuilder.GetExecutionInfo().CronMaxJitterSeconds),
 	}
 	domainEntry, err := getActiveDomainEntryFromShard(t.shard, &domainID)
 	if err != nil {
@@ -24,11 +24,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"sync/atomic"
 	"time"
 
 	h "github.com/uber/cadence/.gen/go/history"
+	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/matching"
+	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
@@ -194,6 +197,31 @@ func (t *timerQueueProcessorImpl) UnlockTaskPrrocessing() {
 	t.taskAllocator.unlock()
 }
 
+func (t *timerQueueProcessorImpl) describeQueues() []*workflow.ShardQueueProcessorInfo {
+	queues := []*workflow.ShardQueueProcessorInfo{
+		describeTimerQueue(t.currentClusterName, t.activeTimerProcessor.timerQueueAckMgr),
+	}
+	clusterNames := make([]string, 0, len(t.standbyTimerProcessors))
+	for clusterName := range t.standbyTimerProcessors {
+		clusterNames = append(clusterNames, clusterName)
+	}
+	sort.Strings(clusterNames)
+	for _, clusterName := range clusterNames {
+		queues = append(queues, describeTimerQueue(clusterName, t.standbyTimerProcessors[clusterName].timerQueueAckMgr))
+	}
+	return queues
+}
+
+func describeTimerQueue(clusterName string, ackMgr timerQueueAckMgr) *workflow.ShardQueueProcessorInfo {
+	return &workflow.ShardQueueProcessorInfo{
+		QueueType:            workflow.ShardQueueTypeTimer.Ptr(),
+		ClusterName:          common.StringPtr(clusterName),
+		AckLevel:             common.Int64Ptr(ackMgr.getAckLevel().VisibilityTimestamp.UnixNano()),
+		ReadLevel:            common.Int64Ptr(ackMgr.getReadLevel().VisibilityTimestamp.UnixNano()),
+		OutstandingTaskCount: common.Int32Ptr(int32(ackMgr.getOutstandingTaskCount())),
+	}
+}
+
 func (t *timerQueueProcessorImpl) getTimerFiredCount(clusterName string) uint64 {
 	if clusterName == t.currentClusterName {
 		return t.activeTimerProcessor.getTimerFiredCount()
@@ -45,6 +45,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type (
@@ -361,6 +362,172 @@ func (s *timerQueueProcessor2Suite) TestWorkflowTimeout() {
 	s.timerQueueActiveProcessor.Stop()
 }
 
+func (s *timerQueueProcessor2Suite) TestDelayedSignalTimer() {
+	we := workflow.WorkflowExecution{WorkflowId: common.StringPtr("delayed-signal-test"),
+		RunId: common.StringPtr(validRunID)}
+	taskList := "task-delayed-signal"
+
+	builder := newMutableStateBuilderWithEventV2(s.mockShard, s.mockEventsCache, s.logger, we.GetRunId())
+	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything).Return().Once()
+	startRequest := &workflow.StartWorkflowExecutionRequest{
+		WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("wType")},
+		TaskList:                            common.TaskListPtr(workflow.TaskList{Name: common.StringPtr(taskList)}),
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+	}
+	_, _ = builder.AddWorkflowExecutionStartedEvent(
+		s.domainEntry,
+		we,
+		&history.StartWorkflowExecutionRequest{
+			DomainUUID:   common.StringPtr(s.domainID),
+			StartRequest: startRequest,
+		},
+	)
+	di := addDecisionTaskScheduledEvent(builder)
+
+	mockTS := &mockTimeSource{currTime: time.Now()}
+	dueRequestID := uuid.New()
+	pendingRequestID := uuid.New()
+	_, _ = builder.AddWorkflowExecutionDelayedSignalRequestedEvent(dueRequestID, "due-signal", []byte("input"), nil, "identity", mockTS.Now().Add(-time.Second))
+	_, _ = builder.AddWorkflowExecutionDelayedSignalRequestedEvent(pendingRequestID, "pending-signal", []byte("input"), nil, "identity", mockTS.Now().Add(time.Hour))
+
+	waitCh := make(chan struct{})
+
+	taskID := int64(100)
+	timerTask := &persistence.TimerTaskInfo{
+		DomainID:            s.domainID,
+		WorkflowID:          "wid",
+		RunID:               validRunID,
+		TaskID:              taskID,
+		TaskType:            persistence.TaskTypeDelayedSignalTimer,
+		VisibilityTimestamp: mockTS.Now(),
+		EventID:             di.ScheduleID}
+	timerIndexResponse := &persistence.GetTimerIndexTasksResponse{Timers: []*persistence.TimerTaskInfo{timerTask}}
+
+	ms := createMutableState(builder)
+	wfResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(wfResponse, nil).Once()
+
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.Anything).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		// only the due signal is delivered
+		deleted := request.UpdateWorkflowMutation.DeleteDelayedSignalInfos
+		return len(deleted) == 1 && deleted[0] == dueRequestID
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Run(func(arguments mock.Arguments) {
+		// Done.
+		waitCh <- struct{}{}
+	}).Once()
+
+	// Start timer Processor.
+	emptyResponse := &persistence.GetTimerIndexTasksResponse{Timers: []*persistence.TimerTaskInfo{}}
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything).Return(emptyResponse, nil).Run(func(arguments mock.Arguments) {
+		waitCh <- struct{}{}
+	}).Once()
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything).Return(emptyResponse, nil).Run(func(arguments mock.Arguments) {
+		waitCh <- struct{}{}
+	}).Once() // for lookAheadTask
+	s.timerQueueActiveProcessor.Start()
+	<-waitCh
+	<-waitCh
+
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything).Return(timerIndexResponse, nil).Once()
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything).Return(emptyResponse, nil) // for lookAheadTask
+	s.timerQueueActiveProcessor.notifyNewTimers(
+		[]persistence.Task{&persistence.DelayedSignalTimerTask{
+			VisibilityTimestamp: timerTask.VisibilityTimestamp,
+		}})
+
+	<-waitCh
+	s.timerQueueActiveProcessor.Stop()
+}
+
+func (s *timerQueueProcessor2Suite) TestDelayedSignalTimer_SignalsLimitExceeded() {
+	we := workflow.WorkflowExecution{WorkflowId: common.StringPtr("delayed-signal-test"),
+		RunId: common.StringPtr(validRunID)}
+	taskList := "task-delayed-signal"
+	maximumSignalsPerExecution := s.config.MaximumSignalsPerExecution
+	defer func() { s.config.MaximumSignalsPerExecution = maximumSignalsPerExecution }()
+	s.config.MaximumSignalsPerExecution = dynamicconfig.GetIntPropertyFilteredByDomain(1)
+
+	builder := newMutableStateBuilderWithEventV2(s.mockShard, s.mockEventsCache, s.logger, we.GetRunId())
+	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything).Return().Once()
+	startRequest := &workflow.StartWorkflowExecutionRequest{
+		WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr("wType")},
+		TaskList:                            common.TaskListPtr(workflow.TaskList{Name: common.StringPtr(taskList)}),
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+	}
+	_, _ = builder.AddWorkflowExecutionStartedEvent(
+		s.domainEntry,
+		we,
+		&history.StartWorkflowExecutionRequest{
+			DomainUUID:   common.StringPtr(s.domainID),
+			StartRequest: startRequest,
+		},
+	)
+	di := addDecisionTaskScheduledEvent(builder)
+	builder.GetExecutionInfo().SignalCount = 1
+
+	mockTS := &mockTimeSource{currTime: time.Now()}
+	dueRequestID := uuid.New()
+	_, _ = builder.AddWorkflowExecutionDelayedSignalRequestedEvent(dueRequestID, "due-signal", []byte("input"), nil, "identity", mockTS.Now().Add(-time.Second))
+
+	waitCh := make(chan struct{})
+
+	taskID := int64(100)
+	timerTask := &persistence.TimerTaskInfo{
+		DomainID:            s.domainID,
+		WorkflowID:          "wid",
+		RunID:               validRunID,
+		TaskID:              taskID,
+		TaskType:            persistence.TaskTypeDelayedSignalTimer,
+		VisibilityTimestamp: mockTS.Now(),
+		EventID:             di.ScheduleID}
+	timerIndexResponse := &persistence.GetTimerIndexTasksResponse{Timers: []*persistence.TimerTaskInfo{timerTask}}
+
+	ms := createMutableState(builder)
+	wfResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(wfResponse, nil).Once()
+
+	s.mockHistoryV2Mgr.On("AppendHistoryNodes", mock.MatchedBy(func(request *p.AppendHistoryNodesRequest) bool {
+		// the signal over the limit is dropped instead of delivered
+		return len(request.Events) == 1 &&
+			request.Events[0].GetEventType() == workflow.EventTypeWorkflowExecutionDelayedSignalCanceled
+	})).Return(&p.AppendHistoryNodesResponse{Size: 0}, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *p.UpdateWorkflowExecutionRequest) bool {
+		deleted := request.UpdateWorkflowMutation.DeleteDelayedSignalInfos
+		return len(deleted) == 1 && deleted[0] == dueRequestID &&
+			request.UpdateWorkflowMutation.ExecutionInfo.SignalCount == 1
+	})).Return(&p.UpdateWorkflowExecutionResponse{MutableStateUpdateSessionStats: &p.MutableStateUpdateSessionStats{}}, nil).Run(func(arguments mock.Arguments) {
+		// Done.
+		waitCh <- struct{}{}
+	}).Once()
+
+	// Start timer Processor.
+	emptyResponse := &persistence.GetTimerIndexTasksResponse{Timers: []*persistence.TimerTaskInfo{}}
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything).Return(emptyResponse, nil).Run(func(arguments mock.Arguments) {
+		waitCh <- struct{}{}
+	}).Once()
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything).Return(emptyResponse, nil).Run(func(arguments mock.Arguments) {
+		waitCh <- struct{}{}
+	}).Once() // for lookAheadTask
+	s.timerQueueActiveProcessor.Start()
+	<-waitCh
+	<-waitCh
+
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything).Return(timerIndexResponse, nil).Once()
+	s.mockExecutionMgr.On("GetTimerIndexTasks", mock.Anything).Return(emptyResponse, nil) // for lookAheadTask
+	s.timerQueueActiveProcessor.notifyNewTimers(
+		[]persistence.Task{&persistence.DelayedSignalTimerTask{
+			VisibilityTimestamp: timerTask.VisibilityTimestamp,
+		}})
+
+	<-waitCh
+	s.timerQueueActiveProcessor.Stop()
+}
+
 func (s *timerQueueProcessor2Suite) TestWorkflowTimeout_Cron() {
 	we := workflow.WorkflowExecution{WorkflowId: common.StringPtr("workflow-timesout-test"),
 		RunId: common.StringPtr(validRunID)}
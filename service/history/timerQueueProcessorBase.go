@@ -272,6 +272,12 @@ func (t *timerQueueProcessorBase) notifyNewTimers(timerTasks []persistence.Task)
 			} else {
 				t.metricsClient.IncCounter(metrics.TimerStandbyTaskWorkflowBackoffTimerScope, metrics.NewTimerCounter)
 			}
+		case persistence.TaskTypeDelayedSignalTimer:
+			if isActive {
+				t.metricsClient.IncCounter(metrics.TimerActiveTaskDelayedSignalTimerScope, metrics.NewTimerCounter)
+			} else {
+				t.metricsClient.IncCounter(metrics.TimerStandbyTaskDelayedSignalTimerScope, metrics.NewTimerCounter)
+			}
 			// TODO add default
 		}
 	}
@@ -756,6 +762,8 @@ func (t *timerQueueProcessorBase) getTimerTaskType(taskType int) string {
 		return "ActivityRetryTimerTask"
 	case persistence.TaskTypeWorkflowBackoffTimer:
 		return "WorkflowBackoffTimerTask"
+	case persistence.TaskTypeDelayedSignalTimer:
+		return "DelayedSignalTimerTask"
 	}
 	return "UnKnown"
 }
@@ -192,6 +192,12 @@ func (t *timerQueueStandbyProcessorImpl) process(
 		}
 		return metrics.TimerStandbyTaskWorkflowBackoffTimerScope, err
 
+	case persistence.TaskTypeDelayedSignalTimer:
+		if shouldProcessTask {
+			err = t.processDelayedSignalTimer(timerTask, lastAttempt)
+		}
+		return metrics.TimerStandbyTaskDelayedSignalTimerScope, err
+
 	case persistence.TaskTypeDeleteHistoryEvent:
 		// guarantee the processing of workflow execution history deletion
 		return metrics.TimerStandbyTaskDeleteHistoryEventScope, t.timerQueueProcessorBase.processDeleteHistoryEvent(timerTask)
@@ -444,6 +450,48 @@ func (t *timerQueueStandbyProcessorImpl) processWorkflowBackoffTimer(
 	}, postProcessingFn)
 }
 
+func (t *timerQueueStandbyProcessorImpl) processDelayedSignalTimer(
+	timerTask *persistence.TimerTaskInfo,
+	lastAttempt bool,
+) error {
+
+	var nextEventID *int64
+	postProcessingFn := func() error {
+		return t.fetchHistoryAndVerifyOnce(timerTask, nextEventID, t.processDelayedSignalTimer)
+	}
+	if lastAttempt {
+		postProcessingFn = func() error {
+			return standbyTimerTaskPostActionTaskDiscarded(nextEventID, timerTask, t.logger)
+		}
+	}
+
+	return t.processTimer(timerTask, func(context workflowExecutionContext, msBuilder mutableState) error {
+
+		for _, ds := range msBuilder.GetPendingDelayedSignalInfos() {
+			if ds.FireTime.After(timerTask.VisibilityTimestamp) {
+				continue
+			}
+
+			// active cluster will add a signaled event for each due delayed signal
+			// standby cluster should just call ack manager to retry this task
+			// since we are stilling waiting for the signaled events to be replicated
+			//
+			// we do not need to notity new timer to base, since if there is no new event being replicated
+			// checking again if the timer can be completed is meaningless
+
+			if t.discardTask(timerTask) {
+				// returning nil and set next event ID
+				// the post action function below shall take over
+				nextEventID = common.Int64Ptr(msBuilder.GetNextEventID())
+				return nil
+			}
+			return ErrTaskRetry
+		}
+		// if there is no delayed signal due, then we are good
+		return nil
+	}, postProcessingFn)
+}
+
 func (t *timerQueueStandbyProcessorImpl) processWorkflowTimeout(
 	timerTask *persistence.TimerTaskInfo,
 	lastAttempt bool,
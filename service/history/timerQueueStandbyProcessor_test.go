@@ -619,6 +619,10 @@ func (s *timerQueueStandbyProcessorSuite) TestProcessActivityTimeout_Multiple_Ca
 				DeleteSignalInfo:          nil,
 				UpsertSignalRequestedIDs:  []string{},
 				DeleteSignalRequestedID:   "",
+				UpsertDelayedSignalInfos:  []*persistence.DelayedSignalInfo{},
+				DeleteDelayedSignalInfos:  []string{},
+				UpsertUpdateRequestedIDs:  map[string]int64{},
+				DeleteUpdateRequestedIDs:  []string{},
 				NewBufferedEvents:         nil,
 				ClearBufferedEvents:       false,
 			},
@@ -864,6 +868,118 @@ func (s *timerQueueStandbyProcessorSuite) TestProcessWorkflowBackoffTimer_Succes
 	s.Nil(err)
 }
 
+func (s *timerQueueStandbyProcessorSuite) TestProcessDelayedSignalTimer_Pending() {
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	workflowType := "some random workflow type"
+	taskListName := "some random task list"
+
+	version := int64(4096)
+	msBuilder := newMutableStateBuilderWithReplicationStateWithEventV2(s.mockShard, s.mockShard.GetEventsCache(), s.logger, version, execution.GetRunId())
+	_, err := msBuilder.AddWorkflowExecutionStartedEvent(
+		s.domainEntry,
+		execution,
+		&history.StartWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(s.domainID),
+			StartRequest: &workflow.StartWorkflowExecutionRequest{
+				WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(workflowType)},
+				TaskList:                            &workflow.TaskList{Name: common.StringPtr(taskListName)},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(2),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+			},
+		},
+	)
+	s.Nil(err)
+
+	addDecisionTaskScheduledEvent(msBuilder)
+
+	fireTime := time.Now()
+	requestID := uuid.New()
+	_, err = msBuilder.AddWorkflowExecutionDelayedSignalRequestedEvent(requestID, "some random signal name", nil, nil, "", fireTime)
+	s.Nil(err)
+
+	timerTask := &persistence.TimerTaskInfo{
+		Version:             version,
+		DomainID:            s.domainID,
+		WorkflowID:          execution.GetWorkflowId(),
+		RunID:               execution.GetRunId(),
+		TaskID:              int64(100),
+		TaskType:            persistence.TaskTypeDelayedSignalTimer,
+		VisibilityTimestamp: fireTime,
+	}
+
+	persistenceMutableState := createMutableState(msBuilder)
+	nextEventID := msBuilder.GetNextEventID()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil).Once()
+
+	_, err = s.timerQueueStandbyProcessor.process(timerTask, true)
+	s.Equal(ErrTaskRetry, err)
+
+	s.mockShard.SetCurrentTime(s.clusterName, time.Now().Add(5*s.mockShard.GetConfig().StandbyClusterDelay()))
+	s.mockHistoryRereplicator.On("SendMultiWorkflowHistory",
+		timerTask.DomainID, timerTask.WorkflowID,
+		timerTask.RunID, nextEventID,
+		timerTask.RunID, common.EndEventID,
+	).Return(nil).Once()
+	_, err = s.timerQueueStandbyProcessor.process(timerTask, true)
+	s.Equal(ErrTaskDiscarded, err)
+}
+
+func (s *timerQueueStandbyProcessorSuite) TestProcessDelayedSignalTimer_Success() {
+
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	workflowType := "some random workflow type"
+	taskListName := "some random task list"
+
+	version := int64(4096)
+	msBuilder := newMutableStateBuilderWithReplicationStateWithEventV2(s.mockShard, s.mockShard.GetEventsCache(), s.logger, version, execution.GetRunId())
+	_, err := msBuilder.AddWorkflowExecutionStartedEvent(
+		s.domainEntry,
+		execution,
+		&history.StartWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(s.domainID),
+			StartRequest: &workflow.StartWorkflowExecutionRequest{
+				WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(workflowType)},
+				TaskList:                            &workflow.TaskList{Name: common.StringPtr(taskListName)},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(2),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+			},
+		},
+	)
+	s.Nil(err)
+
+	addDecisionTaskScheduledEvent(msBuilder)
+
+	fireTime := time.Now()
+	requestID := uuid.New()
+	_, err = msBuilder.AddWorkflowExecutionDelayedSignalRequestedEvent(requestID, "some random signal name", nil, nil, "", fireTime)
+	s.Nil(err)
+	_, err = msBuilder.AddWorkflowExecutionSignaledForDelayedSignal(requestID)
+	s.Nil(err)
+
+	timerTask := &persistence.TimerTaskInfo{
+		Version:             version,
+		DomainID:            s.domainID,
+		WorkflowID:          execution.GetWorkflowId(),
+		RunID:               execution.GetRunId(),
+		TaskID:              int64(100),
+		TaskType:            persistence.TaskTypeDelayedSignalTimer,
+		VisibilityTimestamp: fireTime,
+	}
+
+	persistenceMutableState := createMutableState(msBuilder)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil).Once()
+
+	_, err = s.timerQueueStandbyProcessor.process(timerTask, true)
+	s.Nil(err)
+}
+
 func (s *timerQueueStandbyProcessorSuite) TestProcessWorkflowTimeout_Pending() {
 
 	execution := workflow.WorkflowExecution{
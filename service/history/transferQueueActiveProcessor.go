@@ -23,6 +23,7 @@ package history
 import (
 	ctx "context"
 	"fmt"
+	"sync"
 
 	"github.com/pborman/uuid"
 	h "github.com/uber/cadence/.gen/go/history"
@@ -37,7 +38,13 @@ import (
 	"github.com/uber/cadence/common/persistence"
 )
 
-const identityHistoryService = "history-service"
+const (
+	identityHistoryService  = "history-service"
+	reasonParentClosePolicy = "by parent close policy"
+
+	// parentClosePolicyConcurrency is the max number of children a close execution task applies the policy to in parallel
+	parentClosePolicyConcurrency = 16
+)
 
 type (
 	transferQueueActiveProcessorImpl struct {
@@ -55,6 +62,17 @@ type (
 		*queueProcessorBase
 		queueAckMgr
 	}
+
+	// childClosePolicyInfo is the snapshot of a child execution
+	// which is needed to apply the child policy after parent is closed,
+	// runID is empty if the parent has not recorded the child as started
+	childClosePolicyInfo struct {
+		domainName      string
+		workflowID      string
+		runID           string
+		createRequestID string
+		policy          workflow.ChildPolicy
+	}
 )
 
 func newTransferQueueActiveProcessor(
@@ -322,11 +340,17 @@ func (t *transferQueueActiveProcessorImpl) processActivityTask(
 		return nil
 	}
 
+	if msBuilder.IsWorkflowExecutionPaused() {
+		// the task is created again once the workflow execution is unpaused
+		return nil
+	}
+
 	timeout := common.MinInt32(ai.ScheduleToStartTimeout, common.MaxTaskTimeout)
+	priority := ai.TaskPriority
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushActivity(task, timeout)
+	return t.pushActivity(task, timeout, priority)
 }
 
 func (t *transferQueueActiveProcessorImpl) processDecisionTask(
@@ -369,9 +393,16 @@ func (t *transferQueueActiveProcessorImpl) processDecisionTask(
 		return nil
 	}
 
+	if msBuilder.IsWorkflowExecutionPaused() {
+		// the task is created again once the workflow execution is unpaused
+		return nil
+	}
+
 	executionInfo := msBuilder.GetExecutionInfo()
 	workflowTimeout := executionInfo.WorkflowTimeout
 	decisionTimeout := common.MinInt32(workflowTimeout, common.MaxTaskTimeout)
+	priority := executionInfo.TaskPriority
+	buildID := getDecisionTaskBuildID(executionInfo)
 
 	// NOTE: previously this section check whether mutable state has enabled
 	// sticky decision, if so convert the decision to a sticky decision.
@@ -388,7 +419,7 @@ func (t *transferQueueActiveProcessorImpl) processDecisionTask(
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
-	return t.pushDecision(task, tasklist, decisionTimeout)
+	return t.pushDecision(task, tasklist, decisionTimeout, priority, buildID)
 }
 
 func (t *transferQueueActiveProcessorImpl) processCloseExecution(
@@ -451,6 +482,20 @@ func (t *transferQueueActiveProcessorImpl) processCloseExecution(
 	visibilityMemo := getWorkflowMemo(executionInfo.Memo)
 	searchAttr := executionInfo.SearchAttributes
 
+	domainEntry, err := t.shard.GetDomainCache().GetDomainByID(domainID)
+	if err != nil {
+		return err
+	}
+	var children []*childClosePolicyInfo
+	// the child policy is only applied to domains which opted in, pending children
+	// are abandoned otherwise, which is the behavior existing workflows rely on
+	if t.shard.GetConfig().EnableParentClosePolicy(domainEntry.GetInfo().Name) {
+		children, err = getChildrenForParentClosePolicy(msBuilder)
+		if err != nil {
+			return err
+		}
+	}
+
 	// release the context lock since we no longer need mutable state builder and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
@@ -485,9 +530,178 @@ func (t *transferQueueActiveProcessorImpl) processCloseExecution(
 			err = nil
 		}
 	}
+	if err != nil {
+		return err
+	}
+
+	return t.applyParentClosePolicy(domainID, execution, children)
+}
+
+func (t *transferQueueActiveProcessorImpl) applyParentClosePolicy(
+	domainID string,
+	execution workflow.WorkflowExecution,
+	children []*childClosePolicyInfo,
+) error {
+
+	if len(children) == 0 {
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	var errLock sync.Mutex
+	var retErr error
+	childC := make(chan *childClosePolicyInfo, len(children))
+	for _, child := range children {
+		childC <- child
+	}
+	close(childC)
+
+	concurrency := common.MinInt(parentClosePolicyConcurrency, len(children))
+	wg.Add(concurrency)
+	for i := 0; i < concurrency; i++ {
+		go func() {
+			defer wg.Done()
+			for child := range childC {
+				if err := t.applyChildPolicy(domainID, execution, child); err != nil {
+					errLock.Lock()
+					retErr = err
+					errLock.Unlock()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	// policies already applied are idempotent, so the whole task is retried on any error
+	return retErr
+}
+
+func (t *transferQueueActiveProcessorImpl) applyChildPolicy(
+	domainID string,
+	execution workflow.WorkflowExecution,
+	child *childClosePolicyInfo,
+) error {
+
+	childDomainID := domainID
+	childDomainName := child.domainName
+	if childDomainName == "" {
+		domainEntry, err := t.shard.GetDomainCache().GetDomainByID(domainID)
+		if err != nil {
+			return err
+		}
+		childDomainName = domainEntry.GetInfo().Name
+	} else {
+		domainEntry, err := t.shard.GetDomainCache().GetDomain(childDomainName)
+		if err != nil {
+			if _, ok := err.(*workflow.EntityNotExistsError); ok {
+				// child domain is deleted, nothing left to do for this child
+				return nil
+			}
+			return err
+		}
+		childDomainID = domainEntry.GetInfo().ID
+	}
+
+	runID := child.runID
+	if runID == "" {
+		// the child may have been started right before the parent closed without the parent
+		// recording it, look the child up and only apply the policy if it points back to this parent
+		var err error
+		runID, err = t.findUnrecordedChild(childDomainID, childDomainName, execution, child)
+		if err != nil || runID == "" {
+			return err
+		}
+	}
+
+	childExecution := &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(child.workflowID),
+		RunId:      common.StringPtr(runID),
+	}
+
+	var err error
+	switch child.policy {
+	case workflow.ChildPolicyTerminate:
+		err = t.historyClient.TerminateWorkflowExecution(nil, &h.TerminateWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(childDomainID),
+			TerminateRequest: &workflow.TerminateWorkflowExecutionRequest{
+				Domain:            common.StringPtr(childDomainName),
+				WorkflowExecution: childExecution,
+				Reason:            common.StringPtr(reasonParentClosePolicy),
+				Identity:          common.StringPtr(identityHistoryService),
+			},
+		})
+	case workflow.ChildPolicyRequestCancel:
+		err = t.historyClient.RequestCancelWorkflowExecution(nil, &h.RequestCancelWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(childDomainID),
+			CancelRequest: &workflow.RequestCancelWorkflowExecutionRequest{
+				Domain:            common.StringPtr(childDomainName),
+				WorkflowExecution: childExecution,
+				Identity:          common.StringPtr(identityHistoryService),
+				// Use the child create request ID to dedupe RequestCancelWorkflowExecution calls
+				RequestId: common.StringPtr(child.createRequestID),
+			},
+			ExternalWorkflowExecution: &execution,
+			ChildWorkflowOnly:         common.BoolPtr(true),
+		})
+	default:
+		// ChildPolicyAbandon, nothing to do
+		return nil
+	}
+
+	// Check to see if the error is non-transient, in which case reset the error and continue with processing
+	switch err.(type) {
+	case *workflow.EntityNotExistsError, *workflow.CancellationAlreadyRequestedError:
+		// child is already closed, or it does not belong to this parent any more
+		err = nil
+	case *workflow.DomainNotActiveError:
+		// child domain is active in another cluster, the child is skipped instead of
+		// retrying the whole task, which would record the close and reply to the parent again
+		t.metricsClient.IncCounter(metrics.TransferActiveTaskCloseExecutionScope, metrics.ParentClosePolicySkippedCounter)
+		t.logger.Warn("Skipped applying parent close policy, child domain is not active.",
+			tag.WorkflowDomainID(childDomainID),
+			tag.WorkflowID(child.workflowID),
+			tag.WorkflowRunID(runID),
+		)
+		err = nil
+	}
 	return err
 }
 
+// findUnrecordedChild returns the run ID of a running child execution which was started by the parent
+// but not recorded in the parent's mutable state, an empty run ID means the child was never started
+func (t *transferQueueActiveProcessorImpl) findUnrecordedChild(
+	childDomainID string,
+	childDomainName string,
+	execution workflow.WorkflowExecution,
+	child *childClosePolicyInfo,
+) (string, error) {
+
+	resp, err := t.historyClient.DescribeWorkflowExecution(nil, &h.DescribeWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(childDomainID),
+		Request: &workflow.DescribeWorkflowExecutionRequest{
+			Domain: common.StringPtr(childDomainName),
+			Execution: &workflow.WorkflowExecution{
+				WorkflowId: common.StringPtr(child.workflowID),
+			},
+		},
+	})
+	if err != nil {
+		if _, ok := err.(*workflow.EntityNotExistsError); ok {
+			return "", nil
+		}
+		return "", err
+	}
+
+	info := resp.WorkflowExecutionInfo
+	if info == nil || info.CloseStatus != nil {
+		return "", nil
+	}
+	parent := info.ParentExecution
+	if parent == nil || parent.GetWorkflowId() != execution.GetWorkflowId() || parent.GetRunId() != execution.GetRunId() {
+		return "", nil
+	}
+	return info.Execution.GetRunId(), nil
+}
+
 func (t *transferQueueActiveProcessorImpl) processCancelExecution(
 	task *persistence.TransferTaskInfo,
 ) (retError error) {
@@ -828,6 +1042,8 @@ func (t *transferQueueActiveProcessorImpl) processStartChildExecution(
 				ChildPolicy:           attributes.ChildPolicy,
 				RetryPolicy:           attributes.RetryPolicy,
 				CronSchedule:          attributes.CronSchedule,
+				CronMaxJitterSeconds:  attributes.CronMaxJitterSeconds,
+				TaskPriority:          attributes.TaskPriority,
 				Memo:                  attributes.Memo,
 				SearchAttributes:      attributes.SearchAttributes,
 			},
@@ -840,7 +1056,8 @@ func (t *transferQueueActiveProcessorImpl) processStartChildExecution(
 				},
 				InitiatedId: common.Int64Ptr(initiatedEventID),
 			},
-			FirstDecisionTaskBackoffSeconds: common.Int32Ptr(backoff.GetBackoffForNextScheduleInSeconds(attributes.GetCronSchedule(), now, now)),
+			FirstDecisionTaskBackoffSeconds: common.Int32Ptr(backoff.GetBackoffForNextScheduleInSeconds(
+				attributes.GetCronSchedule(), now, now, attributes.GetCronMaxJitterSeconds())),
 		}
 
 		var startResponse *workflow.StartWorkflowExecutionResponse
@@ -1372,3 +1589,39 @@ func getWorkflowExecutionCloseStatus(
 		panic("Invalid value for enum WorkflowExecutionCloseStatus")
 	}
 }
+
+func getChildrenForParentClosePolicy(
+	msBuilder mutableState,
+) ([]*childClosePolicyInfo, error) {
+
+	var children []*childClosePolicyInfo
+	for initiatedID, ci := range msBuilder.GetPendingChildExecutionInfos() {
+		initiatedEvent, ok := msBuilder.GetChildExecutionInitiatedEvent(initiatedID)
+		if !ok {
+			return nil, &workflow.InternalServiceError{Message: "Unable to get child execution initiated event."}
+		}
+		attributes := initiatedEvent.StartChildWorkflowExecutionInitiatedEventAttributes
+		// child without explicit policy is abandoned
+		if attributes.ChildPolicy == nil || attributes.GetChildPolicy() == workflow.ChildPolicyAbandon {
+			continue
+		}
+		policy := attributes.GetChildPolicy()
+
+		workflowID := ci.StartedWorkflowID
+		runID := ci.StartedRunID
+		if ci.StartedID == common.EmptyEventID {
+			// child start is not recorded, the start child transfer task may still have
+			// started it, so it is looked up by workflow ID when the policy is applied
+			workflowID = attributes.GetWorkflowId()
+			runID = ""
+		}
+		children = append(children, &childClosePolicyInfo{
+			domainName:      ci.DomainName,
+			workflowID:      workflowID,
+			runID:           runID,
+			createRequestID: ci.CreateRequestID,
+			policy:          policy,
+		})
+	}
+	return children, nil
+}
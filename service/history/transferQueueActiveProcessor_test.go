@@ -46,6 +46,7 @@ import (
 	"github.com/uber/cadence/common/persistence"
 	p "github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
+	"github.com/uber/cadence/common/service/dynamicconfig"
 )
 
 type (
@@ -722,6 +723,187 @@ func (s *transferQueueActiveProcessorSuite) TestProcessCloseExecution_NoParent()
 	s.Nil(err)
 }
 
+func (s *transferQueueActiveProcessorSuite) TestProcessCloseExecution_ParentClosePolicy() {
+
+	domainName := "some random domain Name"
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random workflow ID"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	workflowType := "some random workflow type"
+	taskListName := "some random task list"
+
+	childDomainID := "some random child domain ID"
+	childDomainName := "some random child domain Name"
+	childWorkflowType := "some random child workflow type"
+	childTaskListName := "some random child task list"
+	terminateChildExecution := &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random terminate child workflow ID"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	cancelChildExecution := &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random cancel child workflow ID"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+
+	msBuilder := newMutableStateBuilderWithReplicationStateWithEventV2(s.mockShard, s.mockShard.GetEventsCache(), s.logger, s.version, execution.GetRunId())
+	_, err := msBuilder.AddWorkflowExecutionStartedEvent(
+		s.domainEntry,
+		execution,
+		&history.StartWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(s.domainID),
+			StartRequest: &workflow.StartWorkflowExecutionRequest{
+				WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(workflowType)},
+				TaskList:                            &workflow.TaskList{Name: common.StringPtr(taskListName)},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(2),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+			},
+		},
+	)
+	s.Nil(err)
+
+	di := addDecisionTaskScheduledEvent(msBuilder)
+	event := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, taskListName, uuid.New())
+	di.StartedID = event.GetEventId()
+	event = addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID, di.StartedID, nil, "some random identity")
+	decisionCompletedID := event.GetEventId()
+
+	// child with terminate policy
+	event, _ = addStartChildWorkflowExecutionInitiatedEvent(msBuilder, decisionCompletedID, uuid.New(),
+		childDomainName, terminateChildExecution.GetWorkflowId(), childWorkflowType, childTaskListName, nil, 1, 1)
+	addChildWorkflowExecutionStartedEvent(msBuilder, event.GetEventId(), childDomainName,
+		terminateChildExecution.GetWorkflowId(), terminateChildExecution.GetRunId(), childWorkflowType)
+
+	// child with request cancel policy
+	event, cancelChildInfo, err := msBuilder.AddStartChildWorkflowExecutionInitiatedEvent(decisionCompletedID, uuid.New(),
+		&workflow.StartChildWorkflowExecutionDecisionAttributes{
+			Domain:                              common.StringPtr(childDomainName),
+			WorkflowId:                          cancelChildExecution.WorkflowId,
+			WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(childWorkflowType)},
+			TaskList:                            &workflow.TaskList{Name: common.StringPtr(childTaskListName)},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+			ChildPolicy:                         common.ChildPolicyPtr(workflow.ChildPolicyRequestCancel),
+		})
+	s.Nil(err)
+	addChildWorkflowExecutionStartedEvent(msBuilder, event.GetEventId(), childDomainName,
+		cancelChildExecution.GetWorkflowId(), cancelChildExecution.GetRunId(), childWorkflowType)
+
+	// child with terminate policy which is started but not recorded in the parent
+	unrecordedChildExecution := &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("some random unrecorded child workflow ID"),
+		RunId:      common.StringPtr(uuid.New()),
+	}
+	addStartChildWorkflowExecutionInitiatedEvent(msBuilder, decisionCompletedID, uuid.New(),
+		childDomainName, unrecordedChildExecution.GetWorkflowId(), childWorkflowType, childTaskListName, nil, 1, 1)
+
+	// child with terminate policy which is never started
+	notStartedChildWorkflowID := "some random not started child workflow ID"
+	addStartChildWorkflowExecutionInitiatedEvent(msBuilder, decisionCompletedID, uuid.New(),
+		childDomainName, notStartedChildWorkflowID, childWorkflowType, childTaskListName, nil, 1, 1)
+
+	// child with abandon policy which is not started yet
+	_, _, err = msBuilder.AddStartChildWorkflowExecutionInitiatedEvent(decisionCompletedID, uuid.New(),
+		&workflow.StartChildWorkflowExecutionDecisionAttributes{
+			Domain:                              common.StringPtr(childDomainName),
+			WorkflowId:                          common.StringPtr("some random abandon child workflow ID"),
+			WorkflowType:                        &workflow.WorkflowType{Name: common.StringPtr(childWorkflowType)},
+			TaskList:                            &workflow.TaskList{Name: common.StringPtr(childTaskListName)},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(1),
+			ChildPolicy:                         common.ChildPolicyPtr(workflow.ChildPolicyAbandon),
+		})
+	s.Nil(err)
+
+	taskID := int64(59)
+	event = addCompleteWorkflowEvent(msBuilder, decisionCompletedID, nil)
+	s.mockClusterMetadata.On("ClusterNameForFailoverVersion", s.version).Return(s.mockClusterMetadata.GetCurrentClusterName())
+	msBuilder.UpdateReplicationStateLastEventID(s.version, event.GetEventId())
+
+	transferTask := &persistence.TransferTaskInfo{
+		Version:    s.version,
+		DomainID:   s.domainID,
+		WorkflowID: execution.GetWorkflowId(),
+		RunID:      execution.GetRunId(),
+		TaskID:     taskID,
+		TaskList:   taskListName,
+		TaskType:   persistence.TransferTaskTypeCloseExecution,
+		ScheduleID: event.GetEventId(),
+	}
+
+	persistenceMutableState := createMutableState(msBuilder)
+	s.mockMetadataMgr.ExpectedCalls = nil
+	s.mockMetadataMgr.On("GetDomain", &persistence.GetDomainRequest{ID: s.domainID}).Return(&persistence.GetDomainResponse{
+		Info:              &persistence.DomainInfo{ID: s.domainID, Name: domainName},
+		Config:            &persistence.DomainConfig{},
+		ReplicationConfig: &persistence.DomainReplicationConfig{},
+		FailoverVersion:   s.version,
+		TableVersion:      persistence.DomainTableVersionV1,
+	}, nil)
+	s.mockMetadataMgr.On("GetDomain", &persistence.GetDomainRequest{Name: childDomainName}).Return(&persistence.GetDomainResponse{
+		Info:              &persistence.DomainInfo{ID: childDomainID, Name: childDomainName},
+		Config:            &persistence.DomainConfig{},
+		ReplicationConfig: &persistence.DomainReplicationConfig{},
+		TableVersion:      persistence.DomainTableVersionV1,
+	}, nil)
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(&persistence.GetWorkflowExecutionResponse{State: persistenceMutableState}, nil)
+	s.mockVisibilityMgr.On("RecordWorkflowExecutionClosed", mock.Anything).Return(nil).Twice()
+	terminateRequest := func(childExecution *workflow.WorkflowExecution) *history.TerminateWorkflowExecutionRequest {
+		return &history.TerminateWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(childDomainID),
+			TerminateRequest: &workflow.TerminateWorkflowExecutionRequest{
+				Domain:            common.StringPtr(childDomainName),
+				WorkflowExecution: childExecution,
+				Reason:            common.StringPtr(reasonParentClosePolicy),
+				Identity:          common.StringPtr(identityHistoryService),
+			},
+		}
+	}
+	describeRequest := func(workflowID string) *history.DescribeWorkflowExecutionRequest {
+		return &history.DescribeWorkflowExecutionRequest{
+			DomainUUID: common.StringPtr(childDomainID),
+			Request: &workflow.DescribeWorkflowExecutionRequest{
+				Domain:    common.StringPtr(childDomainName),
+				Execution: &workflow.WorkflowExecution{WorkflowId: common.StringPtr(workflowID)},
+			},
+		}
+	}
+	// the child domain is not active, the child is skipped without failing the task
+	s.mockHistoryClient.On("TerminateWorkflowExecution", nil, terminateRequest(terminateChildExecution)).
+		Return(&workflow.DomainNotActiveError{}).Once()
+	s.mockHistoryClient.On("DescribeWorkflowExecution", nil, describeRequest(unrecordedChildExecution.GetWorkflowId())).
+		Return(&workflow.DescribeWorkflowExecutionResponse{
+			WorkflowExecutionInfo: &workflow.WorkflowExecutionInfo{
+				Execution:       unrecordedChildExecution,
+				ParentExecution: &execution,
+			},
+		}, nil).Once()
+	s.mockHistoryClient.On("TerminateWorkflowExecution", nil, terminateRequest(unrecordedChildExecution)).Return(nil).Once()
+	s.mockHistoryClient.On("DescribeWorkflowExecution", nil, describeRequest(notStartedChildWorkflowID)).
+		Return(nil, &workflow.EntityNotExistsError{}).Once()
+	s.mockHistoryClient.On("RequestCancelWorkflowExecution", nil, &history.RequestCancelWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(childDomainID),
+		CancelRequest: &workflow.RequestCancelWorkflowExecutionRequest{
+			Domain:            common.StringPtr(childDomainName),
+			WorkflowExecution: cancelChildExecution,
+			Identity:          common.StringPtr(identityHistoryService),
+			RequestId:         common.StringPtr(cancelChildInfo.CreateRequestID),
+		},
+		ExternalWorkflowExecution: &execution,
+		ChildWorkflowOnly:         common.BoolPtr(true),
+	}).Return(&workflow.CancellationAlreadyRequestedError{}).Once()
+
+	// children are abandoned unless the domain enables the parent close policy
+	_, err = s.transferQueueActiveProcessor.process(transferTask, true)
+	s.Nil(err)
+	s.mockHistoryClient.AssertNotCalled(s.T(), "TerminateWorkflowExecution", mock.Anything, mock.Anything)
+
+	s.mockShard.GetConfig().EnableParentClosePolicy = dynamicconfig.GetBoolPropertyFnFilteredByDomain(true)
+	_, err = s.transferQueueActiveProcessor.process(transferTask, true)
+	s.Nil(err)
+	s.mockHistoryClient.AssertExpectations(s.T())
+}
+
 func (s *transferQueueActiveProcessorSuite) TestProcessCancelExecution_Success() {
 
 	execution := workflow.WorkflowExecution{
@@ -1630,6 +1812,7 @@ func (s *transferQueueActiveProcessorSuite) createAddActivityTaskRequest(task *p
 		TaskList:                      taskList,
 		ScheduleId:                    &task.ScheduleID,
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(ai.ScheduleToStartTimeout),
+		Priority:                      common.Int32Ptr(ai.TaskPriority),
 	}
 }
 
@@ -1653,6 +1836,8 @@ func (s *transferQueueActiveProcessorSuite) createAddDecisionTaskRequest(task *p
 		TaskList:                      taskList,
 		ScheduleId:                    common.Int64Ptr(task.ScheduleID),
 		ScheduleToStartTimeoutSeconds: common.Int32Ptr(timeout),
+		Priority:                      common.Int32Ptr(executionInfo.TaskPriority),
+		BuildId:                       getDecisionTaskBuildID(executionInfo),
 	}
 }
 
@@ -1764,7 +1949,7 @@ func (s *transferQueueActiveProcessorSuite) createChildWorkflowExecutionRequest(
 			Execution:   &execution,
 			InitiatedId: common.Int64Ptr(task.ScheduleID),
 		},
-		FirstDecisionTaskBackoffSeconds: common.Int32Ptr(backoff.GetBackoffForNextScheduleInSeconds(attributes.GetCronSchedule(), now, now)),
+		FirstDecisionTaskBackoffSeconds: common.Int32Ptr(backoff.GetBackoffForNextScheduleInSeconds(attributes.GetCronSchedule(), now, now, 0)),
 	}
 }
 
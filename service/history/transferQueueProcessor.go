@@ -24,10 +24,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"sync/atomic"
 	"time"
 
 	h "github.com/uber/cadence/.gen/go/history"
+	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common/log"
@@ -206,6 +208,21 @@ func (t *transferQueueProcessorImpl) UnlockTaskPrrocessing() {
 	t.taskAllocator.unlock()
 }
 
+func (t *transferQueueProcessorImpl) describeQueues() []*workflow.ShardQueueProcessorInfo {
+	queues := []*workflow.ShardQueueProcessorInfo{
+		t.activeTaskProcessor.describeQueue(workflow.ShardQueueTypeTransfer),
+	}
+	clusterNames := make([]string, 0, len(t.standbyTaskProcessors))
+	for clusterName := range t.standbyTaskProcessors {
+		clusterNames = append(clusterNames, clusterName)
+	}
+	sort.Strings(clusterNames)
+	for _, clusterName := range clusterNames {
+		queues = append(queues, t.standbyTaskProcessors[clusterName].describeQueue(workflow.ShardQueueTypeTransfer))
+	}
+	return queues
+}
+
 func (t *transferQueueProcessorImpl) completeTransferLoop() {
 	timer := time.NewTimer(t.config.TransferProcessorCompleteTransferInterval())
 	defer timer.Stop()
@@ -199,6 +199,7 @@ func (t *transferQueueStandbyProcessorImpl) processActivityTask(
 ) error {
 
 	var activityScheduleToStartTimeout *int32
+	var activityPriority int32
 	processTaskIfClosed := false
 	return t.processTransfer(processTaskIfClosed, transferTask, func(msBuilder mutableState) error {
 		activityInfo, isPending := msBuilder.GetActivityInfo(transferTask.ScheduleID)
@@ -221,6 +222,7 @@ func (t *transferQueueStandbyProcessorImpl) processActivityTask(
 			}
 
 			activityScheduleToStartTimeout = common.Int32Ptr(common.MinInt32(activityInfo.ScheduleToStartTimeout, common.MaxTaskTimeout))
+			activityPriority = activityInfo.TaskPriority
 			return nil
 		}
 
@@ -231,7 +233,7 @@ func (t *transferQueueStandbyProcessorImpl) processActivityTask(
 		}
 
 		timeout := common.MinInt32(*activityScheduleToStartTimeout, common.MaxTaskTimeout)
-		err := t.pushActivity(transferTask, timeout)
+		err := t.pushActivity(transferTask, timeout, activityPriority)
 		return err
 	})
 }
@@ -241,6 +243,8 @@ func (t *transferQueueStandbyProcessorImpl) processDecisionTask(
 ) error {
 
 	var decisionScheduleToStartTimeout *int32
+	var decisionPriority int32
+	var decisionBuildID *string
 	var tasklist *workflow.TaskList
 	processTaskIfClosed := false
 
@@ -269,6 +273,8 @@ func (t *transferQueueStandbyProcessorImpl) processDecisionTask(
 			}
 
 			decisionScheduleToStartTimeout = common.Int32Ptr(decisionTimeout)
+			decisionPriority = executionInfo.TaskPriority
+			decisionBuildID = getDecisionTaskBuildID(executionInfo)
 			tasklist = &workflow.TaskList{Name: &transferTask.TaskList}
 			return nil
 		}
@@ -280,7 +286,7 @@ func (t *transferQueueStandbyProcessorImpl) processDecisionTask(
 		}
 
 		timeout := common.MinInt32(*decisionScheduleToStartTimeout, common.MaxTaskTimeout)
-		err := t.pushDecision(transferTask, tasklist, timeout)
+		err := t.pushDecision(transferTask, tasklist, timeout, decisionPriority, decisionBuildID)
 		return err
 	})
 }
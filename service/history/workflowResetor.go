@@ -23,6 +23,7 @@ package history
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/pborman/uuid"
@@ -105,6 +106,7 @@ func (w *workflowResetorImpl) ResetWorkflowExecution(
 	newMutableState, newHistorySize, newTransferTasks, newTimerTasks, retError := w.buildNewMutableStateForReset(
 		ctx, domainEntry, baseMutableState, currMutableState,
 		request.GetReason(), request.GetDecisionFinishEventId(), request.GetRequestId(), resetNewRunID,
+		request.GetReapplyPolicy(),
 	)
 	// complete the fork process at the end, it is OK even if this defer fails, because our timer task can still clean up correctly
 	defer func() {
@@ -246,6 +248,7 @@ func (w *workflowResetorImpl) buildNewMutableStateForReset(
 	resetReason string,
 	resetDecisionCompletedEventID int64,
 	requestedID, newRunID string,
+	reapplyPolicy workflow.ResetReapplyPolicy,
 ) (newMutableState mutableState, newHistorySize int64, newTransferTasks, newTimerTasks []persistence.Task, retError error) {
 
 	domainID := baseMutableState.GetExecutionInfo().DomainID
@@ -253,8 +256,8 @@ func (w *workflowResetorImpl) buildNewMutableStateForReset(
 	baseRunID := baseMutableState.GetExecutionInfo().RunID
 
 	// replay history to reset point(exclusive) to rebuild mutableState
-	forkEventVersion, wfTimeoutSecs, receivedSignals, continueRunID, newStateBuilder, historySize, retError := w.replayHistoryEvents(
-		domainEntry, resetDecisionCompletedEventID, requestedID, baseMutableState, newRunID,
+	forkEventVersion, wfTimeoutSecs, receivedEvents, continueRunID, newStateBuilder, historySize, retError := w.replayHistoryEvents(
+		domainEntry, resetDecisionCompletedEventID, requestedID, baseMutableState, newRunID, reapplyPolicy,
 	)
 	if retError != nil {
 		return
@@ -307,19 +310,31 @@ func (w *workflowResetorImpl) buildNewMutableStateForReset(
 	// we will need a timer for the scheduled activities
 	needActivityTimer := len(newTransferTasks) > 0
 
+	// replay received external events back to mutableState/history:
+	retError = w.replayReceivedEvents(ctx, reapplyPolicy, receivedEvents, continueRunID, newMutableState, currMutableState)
+	if retError != nil {
+		return
+	}
+	// the new run keeps the pause state of the current run, regardless of the state at the reset point
+	retError = w.keepPauseStateForReset(newMutableState, currMutableState, resetReason)
+	if retError != nil {
+		return
+	}
+	// the new run keeps the pending delayed signals of the current run, regardless of the state at the reset point
+	retError = w.keepDelayedSignalsForReset(newMutableState, currMutableState)
+	if retError != nil {
+		return
+	}
+
 	// generate new timer tasks: we need 4 timers:
 	// 1. WF timeout,
 	// 2. user timers for timers started but not fired by reset
 	// 3. activity timeout for scheduled but not started activities
+	// 4. delayed signals which are not delivered yet
 	newTimerTasks, retError = w.generateTimerTasksForReset(newMutableState, wfTimeoutSecs, needActivityTimer)
 	if retError != nil {
 		return
 	}
-	// replay received signals back to mutableState/history:
-	retError = w.replayReceivedSignals(ctx, receivedSignals, continueRunID, newMutableState, currMutableState)
-	if retError != nil {
-		return
-	}
 
 	// we always schedule a new decision after reset
 	di, err = newMutableState.AddDecisionTaskScheduledEvent()
@@ -351,6 +366,73 @@ func (w *workflowResetorImpl) buildNewMutableStateForReset(
 	return
 }
 
+func (w *workflowResetorImpl) keepPauseStateForReset(
+	newMutableState, currMutableState mutableState,
+	resetReason string,
+) error {
+
+	paused := currMutableState.IsWorkflowExecutionPaused()
+	if newMutableState.IsWorkflowExecutionPaused() == paused {
+		return nil
+	}
+	var err error
+	if paused {
+		_, err = newMutableState.AddWorkflowExecutionPausedEvent(resetReason, identityHistoryService)
+	} else {
+		_, err = newMutableState.AddWorkflowExecutionUnpausedEvent(resetReason, identityHistoryService)
+	}
+	if err != nil {
+		return &workflow.InternalServiceError{Message: "Failed to keep pause state of the current run."}
+	}
+	return nil
+}
+
+func (w *workflowResetorImpl) keepDelayedSignalsForReset(
+	newMutableState, currMutableState mutableState,
+) error {
+
+	newDelayedSignals := newMutableState.GetPendingDelayedSignalInfos()
+	currDelayedSignals := currMutableState.GetPendingDelayedSignalInfos()
+
+	// delayed signals pending at the reset point but already delivered or cancelled by the current run
+	var canceledRequestIDs []string
+	for requestID := range newDelayedSignals {
+		if _, ok := currDelayedSignals[requestID]; !ok {
+			canceledRequestIDs = append(canceledRequestIDs, requestID)
+		}
+	}
+	sort.Strings(canceledRequestIDs)
+	for _, requestID := range canceledRequestIDs {
+		if _, err := newMutableState.AddWorkflowExecutionDelayedSignalCanceledEvent(requestID, identityHistoryService); err != nil {
+			return &workflow.InternalServiceError{Message: "Failed to keep delayed signals of the current run."}
+		}
+	}
+
+	// delayed signals requested by the current run after the reset point
+	var requested []*persistence.DelayedSignalInfo
+	for requestID, ds := range currDelayedSignals {
+		if _, ok := newDelayedSignals[requestID]; !ok {
+			requested = append(requested, ds)
+		}
+	}
+	sort.Slice(requested, func(i, j int) bool {
+		return requested[i].RequestID < requested[j].RequestID
+	})
+	for _, ds := range requested {
+		if _, err := newMutableState.AddWorkflowExecutionDelayedSignalRequestedEvent(
+			ds.RequestID,
+			ds.SignalName,
+			ds.Input,
+			ds.Control,
+			ds.Identity,
+			ds.FireTime,
+		); err != nil {
+			return &workflow.InternalServiceError{Message: "Failed to keep delayed signals of the current run."}
+		}
+	}
+	return nil
+}
+
 func (w *workflowResetorImpl) terminateIfCurrIsRunning(
 	currMutableState mutableState,
 	reason string,
@@ -431,20 +513,22 @@ func (w *workflowResetorImpl) generateReplicationTasksForReset(
 	return currRepTasks, insertRepTasks
 }
 
-// replay signals in the base run, and also signals in all the runs along the chain of contineAsNew
-func (w *workflowResetorImpl) replayReceivedSignals(
+// replay external events(based on reapply policy) in the base run,
+// and also those events in all the runs along the chain of contineAsNew
+func (w *workflowResetorImpl) replayReceivedEvents(
 	ctx context.Context,
-	receivedSignals []*workflow.HistoryEvent,
+	reapplyPolicy workflow.ResetReapplyPolicy,
+	receivedEvents []*workflow.HistoryEvent,
 	continueRunID string,
 	newMutableState, currMutableState mutableState,
 ) error {
-	for _, se := range receivedSignals {
-		sigReq := &workflow.SignalWorkflowExecutionRequest{
-			SignalName: se.GetWorkflowExecutionSignaledEventAttributes().SignalName,
-			Identity:   se.GetWorkflowExecutionSignaledEventAttributes().Identity,
-			Input:      se.GetWorkflowExecutionSignaledEventAttributes().Input,
+	if reapplyPolicy == workflow.ResetReapplyPolicyNone {
+		return nil
+	}
+	for _, e := range receivedEvents {
+		if err := reapplyEventForReset(newMutableState, e); err != nil {
+			return err
 		}
-		newMutableState.AddWorkflowExecutionSignaled(sigReq.GetSignalName(), sigReq.GetInput(), sigReq.GetIdentity())
 	}
 	for {
 		if len(continueRunID) == 0 {
@@ -474,7 +558,7 @@ func (w *workflowResetorImpl) replayReceivedSignals(
 		readReq := &persistence.ReadHistoryBranchRequest{
 			BranchToken: continueMutableState.GetCurrentBranch(),
 			MinEventID:  common.FirstEventID,
-			// NOTE: read through history to the end so that we can collect all the received signals
+			// NOTE: read through history to the end so that we can collect all the received external events
 			MaxEventID:    continueMutableState.GetNextEventID(),
 			PageSize:      defaultHistoryPageSize,
 			NextPageToken: nextPageToken,
@@ -488,13 +572,10 @@ func (w *workflowResetorImpl) replayReceivedSignals(
 			}
 			for _, batch := range readResp.History {
 				for _, e := range batch.Events {
-					if e.GetEventType() == workflow.EventTypeWorkflowExecutionSignaled {
-						sigReq := &workflow.SignalWorkflowExecutionRequest{
-							SignalName: e.GetWorkflowExecutionSignaledEventAttributes().SignalName,
-							Identity:   e.GetWorkflowExecutionSignaledEventAttributes().Identity,
-							Input:      e.GetWorkflowExecutionSignaledEventAttributes().Input,
+					if isReapplyEventForReset(e, reapplyPolicy) {
+						if err := reapplyEventForReset(newMutableState, e); err != nil {
+							return err
 						}
-						newMutableState.AddWorkflowExecutionSignaled(sigReq.GetSignalName(), sigReq.GetInput(), sigReq.GetIdentity())
 					} else if e.GetEventType() == workflow.EventTypeWorkflowExecutionContinuedAsNew {
 						attr := e.GetWorkflowExecutionContinuedAsNewEventAttributes()
 						continueRunID = attr.GetNewExecutionRunId()
@@ -511,6 +592,58 @@ func (w *workflowResetorImpl) replayReceivedSignals(
 	return nil
 }
 
+// isReapplyEventForReset returns whether the event should be reapplied to the new run according to the reapply policy
+func isReapplyEventForReset(
+	event *workflow.HistoryEvent,
+	reapplyPolicy workflow.ResetReapplyPolicy,
+) bool {
+
+	switch reapplyPolicy {
+	case workflow.ResetReapplyPolicyNone:
+		return false
+	case workflow.ResetReapplyPolicyAllExternalEvents:
+		// search attributes upserts are always generated by decisions, which will be redone after reset
+		return event.GetEventType() == workflow.EventTypeWorkflowExecutionSignaled ||
+			event.GetEventType() == workflow.EventTypeWorkflowExecutionCancelRequested
+	default:
+		return event.GetEventType() == workflow.EventTypeWorkflowExecutionSignaled
+	}
+}
+
+func reapplyEventForReset(
+	msBuilder mutableState,
+	event *workflow.HistoryEvent,
+) error {
+
+	switch event.GetEventType() {
+	case workflow.EventTypeWorkflowExecutionSignaled:
+		attr := event.GetWorkflowExecutionSignaledEventAttributes()
+		if _, err := msBuilder.AddWorkflowExecutionSignaled(
+			attr.GetSignalName(),
+			attr.GetInput(),
+			attr.GetIdentity(),
+		); err != nil {
+			return &workflow.InternalServiceError{Message: "Failed to reapply signal event."}
+		}
+	case workflow.EventTypeWorkflowExecutionCancelRequested:
+		if requested, _ := msBuilder.IsCancelRequested(); requested {
+			// cancellation is already requested before the reset point
+			return nil
+		}
+		attr := event.GetWorkflowExecutionCancelRequestedEventAttributes()
+		if _, err := msBuilder.AddWorkflowExecutionCancelRequestedEvent(attr.GetCause(), &h.RequestCancelWorkflowExecutionRequest{
+			CancelRequest: &workflow.RequestCancelWorkflowExecutionRequest{
+				Identity: attr.Identity,
+			},
+			ExternalInitiatedEventId:  attr.ExternalInitiatedEventId,
+			ExternalWorkflowExecution: attr.ExternalWorkflowExecution,
+		}); err != nil {
+			return &workflow.InternalServiceError{Message: "Failed to reapply cancel requested event."}
+		}
+	}
+	return nil
+}
+
 func (w *workflowResetorImpl) generateTimerTasksForReset(
 	msBuilder mutableState,
 	wfTimeoutSecs int64,
@@ -544,6 +677,13 @@ func (w *workflowResetorImpl) generateTimerTasksForReset(
 		timerTasks = append(timerTasks, tt)
 	}
 
+	// delayed signal timer
+	for _, ds := range msBuilder.GetPendingDelayedSignalInfos() {
+		timerTasks = append(timerTasks, &persistence.DelayedSignalTimerTask{
+			VisibilityTimestamp: ds.FireTime,
+		})
+	}
+
 	return timerTasks, nil
 }
 
@@ -562,7 +702,8 @@ func (w *workflowResetorImpl) replayHistoryEvents(
 	requestID string,
 	prevMutableState mutableState,
 	newRunID string,
-) (forkEventVersion, wfTimeoutSecs int64, receivedSignalsAfterReset []*workflow.HistoryEvent, continueRunID string, sBuilder stateBuilder, newHistorySize int64, retError error) {
+	reapplyPolicy workflow.ResetReapplyPolicy,
+) (forkEventVersion, wfTimeoutSecs int64, receivedEventsAfterReset []*workflow.HistoryEvent, continueRunID string, sBuilder stateBuilder, newHistorySize int64, retError error) {
 
 	prevExecution := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr(prevMutableState.GetExecutionInfo().WorkflowID),
@@ -573,7 +714,7 @@ func (w *workflowResetorImpl) replayHistoryEvents(
 	readReq := &persistence.ReadHistoryBranchRequest{
 		BranchToken: prevMutableState.GetCurrentBranch(),
 		MinEventID:  common.FirstEventID,
-		// NOTE: read through history to the end so that we can keep the received signals
+		// NOTE: read through history to the end so that we can keep the received external events
 		MaxEventID:    prevMutableState.GetNextEventID(),
 		PageSize:      defaultHistoryPageSize,
 		NextPageToken: nextPageToken,
@@ -593,11 +734,11 @@ func (w *workflowResetorImpl) replayHistoryEvents(
 			firstEvent := history[0]
 			lastEvent := history[len(history)-1]
 
-			// for saving received signals only
+			// for saving received external events only
 			if firstEvent.GetEventId() >= decisionFinishEventID {
 				for _, e := range batch.Events {
-					if e.GetEventType() == workflow.EventTypeWorkflowExecutionSignaled {
-						receivedSignalsAfterReset = append(receivedSignalsAfterReset, e)
+					if isReapplyEventForReset(e, reapplyPolicy) {
+						receivedEventsAfterReset = append(receivedEventsAfterReset, e)
 					}
 					if e.GetEventType() == workflow.EventTypeWorkflowExecutionContinuedAsNew {
 						attr := e.GetWorkflowExecutionContinuedAsNewEventAttributes()
@@ -4193,6 +4193,64 @@ func (s *resetorSuite) TestApplyReset() {
 	s.Equal(0, len(resetReq.NewWorkflowSnapshot.RequestCancelInfos))
 }
 
+func (s *resetorSuite) TestKeepDelayedSignalsForReset() {
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr(uuid.New().String()),
+	}
+	now := time.Now()
+	deliveredRequestID := uuid.New().String()
+	keptRequestID := uuid.New().String()
+	newRequestID := uuid.New().String()
+
+	s.mockEventsCache.On("putEvent", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return()
+
+	// delayed signals pending at the reset point
+	newMsBuilder := newMutableStateBuilderWithEventV2(s.historyEngine.shard, s.mockEventsCache, s.logger, uuid.New().String())
+	addWorkflowExecutionStartedEvent(newMsBuilder, we, "wType", "testTaskList", nil, 100, 10, "identity")
+	_, err := newMsBuilder.AddWorkflowExecutionDelayedSignalRequestedEvent(deliveredRequestID, "delivered", nil, nil, "", now.Add(time.Minute))
+	s.NoError(err)
+	_, err = newMsBuilder.AddWorkflowExecutionDelayedSignalRequestedEvent(keptRequestID, "kept", nil, nil, "", now.Add(time.Hour))
+	s.NoError(err)
+
+	// delayed signals pending in the current run
+	currMsBuilder := newMutableStateBuilderWithEventV2(s.historyEngine.shard, s.mockEventsCache, s.logger, we.GetRunId())
+	addWorkflowExecutionStartedEvent(currMsBuilder, we, "wType", "testTaskList", nil, 100, 10, "identity")
+	_, err = currMsBuilder.AddWorkflowExecutionDelayedSignalRequestedEvent(keptRequestID, "kept", nil, nil, "", now.Add(time.Hour))
+	s.NoError(err)
+	_, err = currMsBuilder.AddWorkflowExecutionDelayedSignalRequestedEvent(newRequestID, "new", []byte("input"), nil, "", now.Add(2*time.Hour))
+	s.NoError(err)
+
+	resetor := s.resetor.(*workflowResetorImpl)
+	newMsBuilder.GetHistoryBuilder().history = nil
+	err = resetor.keepDelayedSignalsForReset(newMsBuilder, currMsBuilder)
+	s.NoError(err)
+
+	history := newMsBuilder.GetHistoryBuilder().history
+	s.Equal(2, len(history))
+	s.Equal(workflow.EventTypeWorkflowExecutionDelayedSignalCanceled, history[0].GetEventType())
+	s.Equal(deliveredRequestID, history[0].WorkflowExecutionDelayedSignalCanceledEventAttributes.GetRequestId())
+	s.Equal(workflow.EventTypeWorkflowExecutionDelayedSignalRequested, history[1].GetEventType())
+	s.Equal(newRequestID, history[1].WorkflowExecutionDelayedSignalRequestedEventAttributes.GetRequestId())
+
+	pending := newMsBuilder.GetPendingDelayedSignalInfos()
+	s.Equal(2, len(pending))
+	s.Contains(pending, keptRequestID)
+	s.Contains(pending, newRequestID)
+	s.True(now.Add(2 * time.Hour).Equal(pending[newRequestID].FireTime))
+	s.Equal([]byte("input"), pending[newRequestID].Input)
+
+	timerTasks, err := resetor.generateTimerTasksForReset(newMsBuilder, 100, false)
+	s.NoError(err)
+	var fireTimes []time.Time
+	for _, task := range timerTasks {
+		if delayedSignalTask, ok := task.(*p.DelayedSignalTimerTask); ok {
+			fireTimes = append(fireTimes, delayedSignalTask.VisibilityTimestamp)
+		}
+	}
+	s.Equal(2, len(fireTimes))
+}
+
 func TestFindAutoResetPoint(t *testing.T) {
 	timeSource := clock.NewRealTimeSource()
 
@@ -4310,3 +4368,21 @@ func TestFindAutoResetPoint(t *testing.T) {
 	})
 	assert.Equal(t, pt.String(), pt5.String())
 }
+
+func TestIsReapplyEventForReset(t *testing.T) {
+	signaled := &workflow.HistoryEvent{EventType: common.EventTypePtr(workflow.EventTypeWorkflowExecutionSignaled)}
+	cancelRequested := &workflow.HistoryEvent{EventType: common.EventTypePtr(workflow.EventTypeWorkflowExecutionCancelRequested)}
+	activityScheduled := &workflow.HistoryEvent{EventType: common.EventTypePtr(workflow.EventTypeActivityTaskScheduled)}
+
+	assert.True(t, isReapplyEventForReset(signaled, workflow.ResetReapplyPolicySignals))
+	assert.False(t, isReapplyEventForReset(cancelRequested, workflow.ResetReapplyPolicySignals))
+	assert.False(t, isReapplyEventForReset(activityScheduled, workflow.ResetReapplyPolicySignals))
+
+	assert.False(t, isReapplyEventForReset(signaled, workflow.ResetReapplyPolicyNone))
+	assert.False(t, isReapplyEventForReset(cancelRequested, workflow.ResetReapplyPolicyNone))
+	assert.False(t, isReapplyEventForReset(activityScheduled, workflow.ResetReapplyPolicyNone))
+
+	assert.True(t, isReapplyEventForReset(signaled, workflow.ResetReapplyPolicyAllExternalEvents))
+	assert.True(t, isReapplyEventForReset(cancelRequested, workflow.ResetReapplyPolicyAllExternalEvents))
+	assert.False(t, isReapplyEventForReset(activityScheduled, workflow.ResetReapplyPolicyAllExternalEvents))
+}
@@ -0,0 +1,389 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/clock"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	workflowTaskRefresher interface {
+		refreshTasks(msBuilder mutableState, tBuilder *timerBuilder) ([]persistence.Task, []persistence.Task, error)
+	}
+
+	workflowTaskRefresherImpl struct {
+		shard        ShardContext
+		domainCache  cache.DomainCache
+		historyMgr   persistence.HistoryManager
+		historyV2Mgr persistence.HistoryV2Manager
+		timeSource   clock.TimeSource
+	}
+)
+
+var _ workflowTaskRefresher = (*workflowTaskRefresherImpl)(nil)
+
+func newWorkflowTaskRefresher(historyEngine *historyEngineImpl) *workflowTaskRefresherImpl {
+	return &workflowTaskRefresherImpl{
+		shard:        historyEngine.shard,
+		domainCache:  historyEngine.shard.GetDomainCache(),
+		historyMgr:   historyEngine.historyMgr,
+		historyV2Mgr: historyEngine.historyV2Mgr,
+		timeSource:   historyEngine.shard.GetTimeSource(),
+	}
+}
+
+// refreshTasks regenerates all transfer and timer tasks implied by the mutable state, e.g. after tasks were lost.
+// Timer task status of activities and user timers are reset so the timer builder creates their timer tasks again.
+func (r *workflowTaskRefresherImpl) refreshTasks(
+	msBuilder mutableState,
+	tBuilder *timerBuilder,
+) ([]persistence.Task, []persistence.Task, error) {
+
+	executionInfo := msBuilder.GetExecutionInfo()
+	if !msBuilder.IsWorkflowExecutionRunning() {
+		// only the history deletion timer is pending for a closed workflow execution
+		_, deleteTask, err := getWorkflowHistoryCleanupTasksFromShard(
+			r.shard,
+			executionInfo.DomainID,
+			executionInfo.WorkflowID,
+			tBuilder,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+		return nil, []persistence.Task{deleteTask}, nil
+	}
+
+	timerTasks, err := r.refreshWorkflowTimerTasks(msBuilder)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transferTasks, decisionTimerTasks, err := r.refreshDecisionTasks(msBuilder, tBuilder)
+	if err != nil {
+		return nil, nil, err
+	}
+	timerTasks = append(timerTasks, decisionTimerTasks...)
+
+	activityTransferTasks, activityTimerTasks, err := r.refreshActivityTasks(msBuilder, tBuilder)
+	if err != nil {
+		return nil, nil, err
+	}
+	transferTasks = append(transferTasks, activityTransferTasks...)
+	timerTasks = append(timerTasks, activityTimerTasks...)
+
+	timerTasks = append(timerTasks, r.refreshUserTimerTasks(msBuilder, tBuilder)...)
+
+	for _, ds := range msBuilder.GetPendingDelayedSignalInfos() {
+		timerTasks = append(timerTasks, &persistence.DelayedSignalTimerTask{
+			VisibilityTimestamp: ds.FireTime,
+		})
+	}
+
+	externalTransferTasks, err := r.refreshExternalTransferTasks(msBuilder)
+	if err != nil {
+		return nil, nil, err
+	}
+	transferTasks = append(transferTasks, externalTransferTasks...)
+
+	return transferTasks, timerTasks, nil
+}
+
+func (r *workflowTaskRefresherImpl) refreshWorkflowTimerTasks(
+	msBuilder mutableState,
+) ([]persistence.Task, error) {
+
+	startEvent, ok := msBuilder.GetStartEvent()
+	if !ok {
+		return nil, &workflow.InternalServiceError{Message: "Unable to load workflow execution started event."}
+	}
+	startAttributes := startEvent.WorkflowExecutionStartedEventAttributes
+	startTime := time.Unix(0, startEvent.GetTimestamp())
+	backoffDuration := time.Duration(startAttributes.GetFirstDecisionTaskBackoffSeconds()) * time.Second
+	timeout := startTime.Add(backoffDuration).Add(time.Duration(msBuilder.GetExecutionInfo().WorkflowTimeout) * time.Second)
+
+	timerTasks := []persistence.Task{&persistence.WorkflowTimeoutTask{VisibilityTimestamp: timeout}}
+	if backoffDuration != backoff.NoBackoff && msBuilder.IsWorkflowPendingOnDecisionBackoff() {
+		timeoutType := persistence.WorkflowBackoffTimeoutTypeRetry
+		if startAttributes.GetInitiator().Equals(workflow.ContinueAsNewInitiatorCronSchedule) {
+			timeoutType = persistence.WorkflowBackoffTimeoutTypeCron
+		}
+		timerTasks = append(timerTasks, &persistence.WorkflowBackoffTimerTask{
+			VisibilityTimestamp: startTime.Add(backoffDuration),
+			TimeoutType:         timeoutType,
+		})
+	}
+	return timerTasks, nil
+}
+
+func (r *workflowTaskRefresherImpl) refreshDecisionTasks(
+	msBuilder mutableState,
+	tBuilder *timerBuilder,
+) ([]persistence.Task, []persistence.Task, error) {
+
+	if !msBuilder.HasPendingDecisionTask() {
+		return nil, nil, nil
+	}
+
+	executionInfo := msBuilder.GetExecutionInfo()
+	di, ok := msBuilder.GetPendingDecision(executionInfo.DecisionScheduleID)
+	if !ok {
+		return nil, nil, &workflow.InternalServiceError{Message: "Unable to load pending decision."}
+	}
+
+	// a started decision is only guarded by its start to close timeout
+	if di.StartedID != common.EmptyEventID {
+		return nil, []persistence.Task{
+			tBuilder.AddStartToCloseDecisionTimoutTask(di.ScheduleID, di.Attempt, di.DecisionTimeout),
+		}, nil
+	}
+
+	transferTasks := []persistence.Task{&persistence.DecisionTask{
+		DomainID:   executionInfo.DomainID,
+		TaskList:   di.TaskList,
+		ScheduleID: di.ScheduleID,
+	}}
+	var timerTasks []persistence.Task
+	if msBuilder.IsStickyTaskListEnabled() {
+		timerTasks = append(timerTasks, tBuilder.AddScheduleToStartDecisionTimoutTask(
+			di.ScheduleID, di.Attempt, executionInfo.StickyScheduleToStartTimeout))
+	}
+	return transferTasks, timerTasks, nil
+}
+
+func (r *workflowTaskRefresherImpl) refreshActivityTasks(
+	msBuilder mutableState,
+	tBuilder *timerBuilder,
+) ([]persistence.Task, []persistence.Task, error) {
+
+	now := r.timeSource.Now()
+	var transferTasks []persistence.Task
+	var timerTasks []persistence.Task
+	for _, ai := range msBuilder.GetPendingActivityInfos() {
+		if ai.StartedID == common.EmptyEventID {
+			if ai.ScheduledTime.After(now) {
+				// activity is waiting for its next retry
+				timerTasks = append(timerTasks, &persistence.ActivityRetryTimerTask{
+					Version:             ai.Version,
+					VisibilityTimestamp: ai.ScheduledTime,
+					EventID:             ai.ScheduleID,
+					Attempt:             ai.Attempt,
+				})
+			} else {
+				transferTasks = append(transferTasks, &persistence.ActivityTask{
+					DomainID:   ai.DomainID,
+					TaskList:   ai.TaskList,
+					ScheduleID: ai.ScheduleID,
+				})
+			}
+		}
+
+		ai.TimerTaskStatus = TimerTaskStatusNone
+		if err := msBuilder.UpdateActivity(ai); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if timerTask := tBuilder.GetActivityTimerTaskIfNeeded(msBuilder); timerTask != nil {
+		timerTasks = append(timerTasks, timerTask)
+	}
+	return transferTasks, timerTasks, nil
+}
+
+func (r *workflowTaskRefresherImpl) refreshUserTimerTasks(
+	msBuilder mutableState,
+	tBuilder *timerBuilder,
+) []persistence.Task {
+
+	for _, ti := range msBuilder.GetPendingTimerInfos() {
+		ti.TaskID = TimerTaskStatusNone
+		msBuilder.UpdateUserTimer(ti.TimerID, ti)
+	}
+
+	tBuilder.loadUserTimers(msBuilder)
+	if timerTask := tBuilder.GetUserTimerTaskIfNeeded(msBuilder); timerTask != nil {
+		return []persistence.Task{timerTask}
+	}
+	return nil
+}
+
+// refreshExternalTransferTasks regenerates the tasks of not yet started child workflows and of pending
+// cancellation and signal requests to external workflows
+func (r *workflowTaskRefresherImpl) refreshExternalTransferTasks(
+	msBuilder mutableState,
+) ([]persistence.Task, error) {
+
+	domainID := msBuilder.GetExecutionInfo().DomainID
+	var transferTasks []persistence.Task
+	for _, ci := range msBuilder.GetPendingChildExecutionInfos() {
+		if ci.StartedID != common.EmptyEventID {
+			continue
+		}
+		initiatedEvent, ok := msBuilder.GetChildExecutionInitiatedEvent(ci.InitiatedID)
+		if !ok {
+			return nil, &workflow.InternalServiceError{Message: "Unable to load child workflow initiated event."}
+		}
+		targetDomainID, err := r.getTargetDomainID(domainID, ci.DomainName)
+		if err != nil {
+			return nil, err
+		}
+		transferTasks = append(transferTasks, &persistence.StartChildExecutionTask{
+			TargetDomainID:   targetDomainID,
+			TargetWorkflowID: initiatedEvent.StartChildWorkflowExecutionInitiatedEventAttributes.GetWorkflowId(),
+			InitiatedID:      ci.InitiatedID,
+		})
+	}
+
+	// cancellation and signal requests only keep the initiated event ID in mutable state,
+	// their targets are read back from history
+	requestCancels := msBuilder.GetAllRequestCancels()
+	signals := msBuilder.GetAllSignalsToSend()
+	if len(requestCancels) == 0 && len(signals) == 0 {
+		return transferTasks, nil
+	}
+	initiatedEventIDs := make(map[int64]struct{})
+	for initiatedID := range requestCancels {
+		initiatedEventIDs[initiatedID] = struct{}{}
+	}
+	for initiatedID := range signals {
+		initiatedEventIDs[initiatedID] = struct{}{}
+	}
+	initiatedEvents, err := r.getHistoryEvents(msBuilder, initiatedEventIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for initiatedID := range requestCancels {
+		initiatedEvent, ok := initiatedEvents[initiatedID]
+		if !ok {
+			return nil, &workflow.InternalServiceError{Message: "Unable to load request cancel external workflow initiated event."}
+		}
+		attributes := initiatedEvent.RequestCancelExternalWorkflowExecutionInitiatedEventAttributes
+		targetDomainID, err := r.getTargetDomainID(domainID, attributes.GetDomain())
+		if err != nil {
+			return nil, err
+		}
+		transferTasks = append(transferTasks, &persistence.CancelExecutionTask{
+			TargetDomainID:          targetDomainID,
+			TargetWorkflowID:        attributes.WorkflowExecution.GetWorkflowId(),
+			TargetRunID:             attributes.WorkflowExecution.GetRunId(),
+			TargetChildWorkflowOnly: attributes.GetChildWorkflowOnly(),
+			InitiatedID:             initiatedID,
+		})
+	}
+
+	for initiatedID := range signals {
+		initiatedEvent, ok := initiatedEvents[initiatedID]
+		if !ok {
+			return nil, &workflow.InternalServiceError{Message: "Unable to load signal external workflow initiated event."}
+		}
+		attributes := initiatedEvent.SignalExternalWorkflowExecutionInitiatedEventAttributes
+		targetDomainID, err := r.getTargetDomainID(domainID, attributes.GetDomain())
+		if err != nil {
+			return nil, err
+		}
+		transferTasks = append(transferTasks, &persistence.SignalExecutionTask{
+			TargetDomainID:          targetDomainID,
+			TargetWorkflowID:        attributes.WorkflowExecution.GetWorkflowId(),
+			TargetRunID:             attributes.WorkflowExecution.GetRunId(),
+			TargetChildWorkflowOnly: attributes.GetChildWorkflowOnly(),
+			InitiatedID:             initiatedID,
+		})
+	}
+	return transferTasks, nil
+}
+
+func (r *workflowTaskRefresherImpl) getTargetDomainID(
+	domainID string,
+	targetDomainName string,
+) (string, error) {
+
+	if targetDomainName == "" {
+		return domainID, nil
+	}
+	targetDomainEntry, err := r.domainCache.GetDomain(targetDomainName)
+	if err != nil {
+		return "", err
+	}
+	return targetDomainEntry.GetInfo().ID, nil
+}
+
+// getHistoryEvents reads the history of the workflow execution and returns the events with the given IDs
+func (r *workflowTaskRefresherImpl) getHistoryEvents(
+	msBuilder mutableState,
+	eventIDs map[int64]struct{},
+) (map[int64]*workflow.HistoryEvent, error) {
+
+	executionInfo := msBuilder.GetExecutionInfo()
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(executionInfo.WorkflowID),
+		RunId:      common.StringPtr(executionInfo.RunID),
+	}
+
+	events := make(map[int64]*workflow.HistoryEvent, len(eventIDs))
+	var nextPageToken []byte
+	for {
+		var historyEvents []*workflow.HistoryEvent
+		if msBuilder.GetEventStoreVersion() == persistence.EventStoreVersionV2 {
+			response, err := r.historyV2Mgr.ReadHistoryBranch(&persistence.ReadHistoryBranchRequest{
+				BranchToken:   msBuilder.GetCurrentBranch(),
+				MinEventID:    common.FirstEventID,
+				MaxEventID:    msBuilder.GetNextEventID(),
+				PageSize:      defaultHistoryPageSize,
+				NextPageToken: nextPageToken,
+				ShardID:       common.IntPtr(r.shard.GetShardID()),
+			})
+			if err != nil {
+				return nil, err
+			}
+			historyEvents, nextPageToken = response.HistoryEvents, response.NextPageToken
+		} else {
+			response, err := r.historyMgr.GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
+				DomainID:      executionInfo.DomainID,
+				Execution:     execution,
+				FirstEventID:  common.FirstEventID,
+				NextEventID:   msBuilder.GetNextEventID(),
+				PageSize:      defaultHistoryPageSize,
+				NextPageToken: nextPageToken,
+			})
+			if err != nil {
+				return nil, err
+			}
+			historyEvents, nextPageToken = response.History.Events, response.NextPageToken
+		}
+
+		for _, event := range historyEvents {
+			if _, ok := eventIDs[event.GetEventId()]; ok {
+				events[event.GetEventId()] = event
+			}
+		}
+		if len(events) == len(eventIDs) || len(nextPageToken) == 0 {
+			return events, nil
+		}
+	}
+}
@@ -32,8 +32,10 @@ type (
 	// Config represents configuration for cadence-matching service
 	Config struct {
 		PersistenceMaxQPS dynamicconfig.IntPropertyFn
-		EnableSyncMatch   dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
-		RPS               dynamicconfig.IntPropertyFn
+		// PersistenceDomainMaxQPS is the max qps a single domain can issue to persistence
+		PersistenceDomainMaxQPS dynamicconfig.IntPropertyFnWithDomainFilter
+		EnableSyncMatch         dynamicconfig.BoolPropertyFnWithTaskListInfoFilters
+		RPS                     dynamicconfig.IntPropertyFn
 
 		// taskListManager configuration
 		RangeSize                    int64
@@ -52,6 +54,8 @@ type (
 		LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithTaskListInfoFilters
 		MinTaskThrottlingBurstSize dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 		MaxTaskDeleteBatchSize     dynamicconfig.IntPropertyFnWithTaskListInfoFilters
+		// Every n-th dispatch from the task buffer picks the oldest task instead of the highest priority one
+		PriorityStarvationInterval dynamicconfig.IntPropertyFnWithTaskListInfoFilters
 
 		// taskWriter configuration
 		OutstandingTaskAppendsThreshold dynamicconfig.IntPropertyFnWithTaskListInfoFilters
@@ -79,6 +83,7 @@ type (
 		MaxTasklistIdleTime        func() time.Duration
 		MinTaskThrottlingBurstSize func() int
 		MaxTaskDeleteBatchSize     func() int
+		PriorityStarvationInterval func() int
 		// taskWriter configuration
 		OutstandingTaskAppendsThreshold func() int
 		MaxTaskBatchSize                func() int
@@ -91,6 +96,7 @@ type (
 func NewConfig(dc *dynamicconfig.Collection) *Config {
 	return &Config{
 		PersistenceMaxQPS:               dc.GetIntProperty(dynamicconfig.MatchingPersistenceMaxQPS, 3000),
+		PersistenceDomainMaxQPS:         dc.GetIntPropertyFilteredByDomain(dynamicconfig.MatchingPersistenceDomainMaxQPS, 0),
 		EnableSyncMatch:                 dc.GetBoolPropertyFilteredByTaskListInfo(dynamicconfig.MatchingEnableSyncMatch, true),
 		RPS:                             dc.GetIntProperty(dynamicconfig.MatchingRPS, 1200),
 		RangeSize:                       100000,
@@ -110,6 +116,7 @@ func NewConfig(dc *dynamicconfig.Collection) *Config {
 		ForwarderMaxOutstandingTasks:    dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxOutstandingTasks, 1),
 		ForwarderMaxRatePerSecond:       dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxRatePerSecond, 10),
 		ForwarderMaxChildrenPerNode:     dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingForwarderMaxChildrenPerNode, 20),
+		PriorityStarvationInterval:      dc.GetIntPropertyFilteredByTaskListInfo(dynamicconfig.MatchingPriorityStarvationInterval, 10),
 	}
 }
 
@@ -148,6 +155,9 @@ func newTaskListConfig(id *taskListID, config *Config, domainCache cache.DomainC
 		MaxTaskDeleteBatchSize: func() int {
 			return config.MaxTaskDeleteBatchSize(domain, taskListName, taskType)
 		},
+		PriorityStarvationInterval: func() int {
+			return config.PriorityStarvationInterval(domain, taskListName, taskType)
+		},
 		OutstandingTaskAppendsThreshold: func() int {
 			return config.OutstandingTaskAppendsThreshold(domain, taskListName, taskType)
 		},
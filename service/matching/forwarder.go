@@ -148,6 +148,7 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 			ScheduleId:                    &task.event.ScheduleID,
 			ScheduleToStartTimeoutSeconds: &task.event.ScheduleToStartTimeout,
 			ForwardedFrom:                 &fwdr.taskListID.name,
+			Priority:                      &task.event.Priority,
 		})
 	case persistence.TaskListTypeActivity:
 		err = fwdr.client.AddActivityTask(ctx, &gen.AddActivityTaskRequest{
@@ -161,6 +162,7 @@ func (fwdr *Forwarder) ForwardTask(ctx context.Context, task *internalTask) erro
 			ScheduleId:                    &task.event.ScheduleID,
 			ScheduleToStartTimeoutSeconds: &task.event.ScheduleToStartTimeout,
 			ForwardedFrom:                 &fwdr.taskListID.name,
+			Priority:                      &task.event.Priority,
 		})
 	default:
 		return errInvalidTaskListType
@@ -210,6 +212,7 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*internalTask, error) {
 
 	pollerID, _ := ctx.Value(pollerIDKey).(string)
 	identity, _ := ctx.Value(identityKey).(string)
+	buildID, _ := ctx.Value(buildIDKey).(string)
 
 	switch fwdr.taskListID.taskType {
 	case persistence.TaskListTypeDecision:
@@ -222,6 +225,7 @@ func (fwdr *Forwarder) ForwardPoll(ctx context.Context) (*internalTask, error) {
 					Kind: &fwdr.taskListKind,
 				},
 				Identity: &identity,
+				BuildId:  &buildID,
 			},
 			ForwardedFrom: &fwdr.taskListID.name,
 		})
@@ -189,6 +189,7 @@ func (t *ForwarderTestSuite) TestForwardPollForDecision() {
 	pollerID := uuid.New()
 	ctx := context.WithValue(context.Background(), pollerIDKey, pollerID)
 	ctx = context.WithValue(ctx, identityKey, "id1")
+	ctx = context.WithValue(ctx, buildIDKey, "build1")
 	resp := &gen.PollForDecisionTaskResponse{}
 
 	var request *gen.PollForDecisionTaskRequest
@@ -203,6 +204,7 @@ func (t *ForwarderTestSuite) TestForwardPollForDecision() {
 	t.Equal(pollerID, request.GetPollerID())
 	t.Equal(t.taskList.domainID, request.GetDomainUUID())
 	t.Equal("id1", request.GetPollRequest().GetIdentity())
+	t.Equal("build1", request.GetPollRequest().GetBuildId())
 	t.Equal(t.taskList.Parent(20), request.GetPollRequest().GetTaskList().GetName())
 	t.Equal(t.fwdr.taskListKind, request.GetPollRequest().GetTaskList().GetKind())
 	t.True(resp == task.pollForDecisionResponse())
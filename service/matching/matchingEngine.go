@@ -68,6 +68,7 @@ type matchingEngineImpl struct {
 
 type pollerIDCtxKey string
 type identityCtxKey string
+type buildIDCtxKey string
 
 var (
 	// EmptyPollForDecisionTaskResponse is the response when there are no decision tasks to hand out
@@ -83,6 +84,7 @@ var (
 
 	pollerIDKey pollerIDCtxKey = "pollerID"
 	identityKey identityCtxKey = "identity"
+	buildIDKey  buildIDCtxKey  = "buildID"
 )
 
 const (
@@ -216,6 +218,10 @@ func (e *matchingEngineImpl) AddDecisionTask(ctx context.Context, addRequest *m.
 	if err != nil {
 		return false, err
 	}
+	taskList, err = e.getVersionedTaskListID(taskList, taskListKind, addRequest.BuildId, addRequest.GetForwardedFrom())
+	if err != nil {
+		return false, err
+	}
 
 	tlMgr, err := e.getTaskListManager(taskList, taskListKind)
 	if err != nil {
@@ -229,6 +235,7 @@ func (e *matchingEngineImpl) AddDecisionTask(ctx context.Context, addRequest *m.
 		ScheduleID:             addRequest.GetScheduleId(),
 		ScheduleToStartTimeout: addRequest.GetScheduleToStartTimeoutSeconds(),
 		CreatedTime:            time.Now(),
+		Priority:               addRequest.GetPriority(),
 	}
 	return tlMgr.AddTask(ctx, addTaskParams{
 		execution:     addRequest.Execution,
@@ -267,6 +274,7 @@ func (e *matchingEngineImpl) AddActivityTask(ctx context.Context, addRequest *m.
 		ScheduleID:             addRequest.GetScheduleId(),
 		ScheduleToStartTimeout: addRequest.GetScheduleToStartTimeoutSeconds(),
 		CreatedTime:            time.Now(),
+		Priority:               addRequest.GetPriority(),
 	}
 	return tlMgr.AddTask(ctx, addTaskParams{
 		execution:     addRequest.Execution,
@@ -295,11 +303,16 @@ pollLoop:
 		// long-poll when frontend calls CancelOutstandingPoll API
 		pollerCtx := context.WithValue(ctx, pollerIDKey, pollerID)
 		pollerCtx = context.WithValue(pollerCtx, identityKey, request.GetIdentity())
+		pollerCtx = context.WithValue(pollerCtx, buildIDKey, request.GetBuildId())
 		taskList, err := newTaskListID(domainID, taskListName, persistence.TaskListTypeDecision)
 		if err != nil {
 			return nil, err
 		}
 		taskListKind := common.TaskListKindPtr(request.TaskList.GetKind())
+		taskList, err = e.getVersionedTaskListID(taskList, taskListKind, common.StringPtr(request.GetBuildId()), req.GetForwardedFrom())
+		if err != nil {
+			return nil, err
+		}
 		task, err := e.getTask(pollerCtx, taskList, nil, taskListKind)
 		if err != nil {
 			// TODO: Is empty poll the best reply for errPumpClosed?
@@ -453,6 +466,10 @@ func (e *matchingEngineImpl) QueryWorkflow(ctx context.Context, queryRequest *m.
 	if err != nil {
 		return nil, err
 	}
+	taskList, err = e.getVersionedTaskListID(taskList, taskListKind, queryRequest.BuildId, queryRequest.GetForwardedFrom())
+	if err != nil {
+		return nil, err
+	}
 
 	var lastErr error
 query_loop:
@@ -598,7 +615,22 @@ func (e *matchingEngineImpl) DescribeTaskList(ctx context.Context, request *m.De
 		return nil, err
 	}
 
-	return tlMgr.DescribeTaskList(request.DescRequest.GetIncludeTaskListStatus()), nil
+	response := tlMgr.DescribeTaskList(request.DescRequest.GetIncludeTaskListStatus())
+	if taskListType == persistence.TaskListTypeDecision && *taskListKind != workflow.TaskListKindSticky {
+		// include the pollers of the task lists of the compatible build sets which are loaded on this host
+		versionedTaskLists, err := e.getVersionedTaskListIDs(taskList)
+		if err != nil {
+			return nil, err
+		}
+		e.taskListsLock.RLock()
+		for _, id := range versionedTaskLists {
+			if mgr, ok := e.taskLists[*id]; ok {
+				response.Pollers = append(response.Pollers, mgr.GetAllPollerInfo()...)
+			}
+		}
+		e.taskListsLock.RUnlock()
+	}
+	return response, nil
 }
 
 // Loads a task from persistence and wraps it in a task context
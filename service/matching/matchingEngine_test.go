@@ -414,6 +414,67 @@ func (s *matchingEngineSuite) AddTasksTest(taskType int) {
 	s.EqualValues(taskCount, s.taskManager.getTaskCount(newTestTaskListID(domainID, tl, taskType)))
 }
 
+func (s *matchingEngineSuite) TestAddDecisionTasksRoutedByBuildID() {
+	domainID := "domainId"
+	tl := "makeToast"
+	domainCache := &cache.DomainCacheMock{}
+	domainCache.On("GetDomainByID", mock.Anything).Return(cache.NewLocalDomainCacheEntryForTest(
+		&persistence.DomainInfo{ID: domainID, Name: "domainName"},
+		&persistence.DomainConfig{
+			WorkerVersioning: workflow.WorkerVersioning{
+				TaskLists: map[string]*workflow.BuildIdCompatibility{
+					tl: {
+						CompatibleBuildSets: []*workflow.CompatibleBuildSet{
+							{BuildIds: []string{"v1", "v2"}},
+							{BuildIds: []string{"v3"}},
+						},
+					},
+				},
+			},
+		},
+		"", nil), nil)
+	s.matchingEngine.domainCache = domainCache
+
+	testCases := []struct {
+		buildID  *string
+		taskList string
+	}{
+		{nil, buildIDTaskListPrefix + "v3/" + tl},
+		{common.StringPtr("v2"), buildIDTaskListPrefix + "v1/" + tl},
+		{common.StringPtr("v3"), buildIDTaskListPrefix + "v3/" + tl},
+		{common.StringPtr(""), tl},
+		{common.StringPtr("v0"), tl},
+	}
+
+	execution := workflow.WorkflowExecution{RunId: common.StringPtr("run1"), WorkflowId: common.StringPtr("workflow1")}
+	for i, tc := range testCases {
+		_, err := s.matchingEngine.AddDecisionTask(context.Background(), &matching.AddDecisionTaskRequest{
+			DomainUUID:                    common.StringPtr(domainID),
+			Execution:                     &execution,
+			ScheduleId:                    common.Int64Ptr(int64(i)),
+			TaskList:                      &workflow.TaskList{Name: common.StringPtr(tl)},
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
+			BuildId:                       tc.buildID,
+		})
+		s.NoError(err)
+	}
+	s.EqualValues(2, s.taskManager.getTaskCount(newTestTaskListID(domainID, tl, persistence.TaskListTypeDecision)))
+	s.EqualValues(1, s.taskManager.getTaskCount(newTestTaskListID(domainID, buildIDTaskListPrefix+"v1/"+tl, persistence.TaskListTypeDecision)))
+	s.EqualValues(2, s.taskManager.getTaskCount(newTestTaskListID(domainID, buildIDTaskListPrefix+"v3/"+tl, persistence.TaskListTypeDecision)))
+
+	// activity task lists are not versioned
+	_, err := s.matchingEngine.AddActivityTask(context.Background(), &matching.AddActivityTaskRequest{
+		SourceDomainUUID:              common.StringPtr(domainID),
+		DomainUUID:                    common.StringPtr(domainID),
+		Execution:                     &execution,
+		ScheduleId:                    common.Int64Ptr(10),
+		TaskList:                      &workflow.TaskList{Name: common.StringPtr(tl)},
+		ScheduleToStartTimeoutSeconds: common.Int32Ptr(1),
+	})
+	s.NoError(err)
+	s.EqualValues(1, s.taskManager.getTaskCount(newTestTaskListID(domainID, tl, persistence.TaskListTypeActivity)))
+}
+
 func (s *matchingEngineSuite) TestTaskWriterShutdown() {
 	s.matchingEngine.config.RangeSize = 300 // override to low number for the test
 
@@ -1591,7 +1652,10 @@ func (s *matchingEngineSuite) TestTaskExpiryAndCompletion() {
 
 		// wait until all tasks are loaded by into in-memory buffers by task list manager
 		// the buffer size should be one less than expected because dispatcher will dequeue the head
-		s.True(s.awaitCondition(func() bool { return len(tlMgr.taskReader.taskBuffer) >= (taskCount/2 - 1) }, time.Second))
+		s.True(s.awaitCondition(func() bool {
+			buffered := len(tlMgr.taskReader.taskBuffer) + int(atomic.LoadInt32(&tlMgr.taskReader.pendingTaskCount))
+			return buffered >= (taskCount/2 - 1)
+		}, time.Second))
 
 		maxTimeBetweenTaskDeletes = tc.maxTimeBtwnDeletes
 		s.matchingEngine.config.MaxTaskDeleteBatchSize = dynamicconfig.GetIntPropertyFilteredByTaskListInfo(tc.batchSize)
@@ -1884,8 +1948,10 @@ func (m *testTaskManager) CreateTasks(request *persistence.CreateTasksRequest) (
 			DomainID:   domainID,
 			RunID:      *task.Execution.RunId,
 			ScheduleID: scheduleID,
-			TaskID:     task.TaskID,
-			WorkflowID: *task.Execution.WorkflowId,
+			TaskID:      task.TaskID,
+			WorkflowID:  *task.Execution.WorkflowId,
+			Priority:    task.Data.Priority,
+			CreatedTime: time.Now(),
 		}
 		if task.Data.ScheduleToStartTimeout != 0 {
 			info.Expiry = time.Now().Add(time.Duration(task.Data.ScheduleToStartTimeout) * time.Second)
@@ -39,6 +39,7 @@ type (
 
 	pollerInfo struct {
 		ratePerSecond float64
+		buildID       string
 	}
 )
 
@@ -60,12 +61,12 @@ func newPollerHistory() *pollerHistory {
 	}
 }
 
-func (pollers *pollerHistory) updatePollerInfo(id pollerIdentity, ratePerSecond *float64) {
+func (pollers *pollerHistory) updatePollerInfo(id pollerIdentity, ratePerSecond *float64, buildID string) {
 	rps := _defaultTaskDispatchRPS
 	if ratePerSecond != nil {
 		rps = *ratePerSecond
 	}
-	pollers.history.Put(id, &pollerInfo{ratePerSecond: rps})
+	pollers.history.Put(id, &pollerInfo{ratePerSecond: rps, buildID: buildID})
 }
 
 func (pollers *pollerHistory) getAllPollerInfo() []*shared.PollerInfo {
@@ -79,11 +80,15 @@ func (pollers *pollerHistory) getAllPollerInfo() []*shared.PollerInfo {
 		value := entry.Value().(*pollerInfo)
 		// TODO add IP, T1396795
 		lastAccessTime := entry.CreateTime()
-		result = append(result, &shared.PollerInfo{
+		pollerInfo := &shared.PollerInfo{
 			Identity:       common.StringPtr(string(key)),
 			LastAccessTime: common.Int64Ptr(lastAccessTime.UnixNano()),
 			RatePerSecond:  common.Float64Ptr(value.ratePerSecond),
-		})
+		}
+		if value.buildID != "" {
+			pollerInfo.BuildId = common.StringPtr(value.buildID)
+		}
+		result = append(result, pollerInfo)
 	}
 
 	return result
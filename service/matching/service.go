@@ -22,6 +22,7 @@ package matching
 
 import (
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/healthcheck"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/tag"
 	persistencefactory "github.com/uber/cadence/common/persistence/persistence-factory"
@@ -60,7 +61,8 @@ func (s *Service) Start() {
 
 	pConfig := params.PersistenceConfig
 	pConfig.SetMaxQPS(pConfig.DefaultStore, s.config.PersistenceMaxQPS())
-	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), base.GetMetricsClient(), log)
+	pConfig.DomainMaxQPS = s.config.PersistenceDomainMaxQPS
+	pFactory := persistencefactory.New(&pConfig, params.ClusterMetadata.GetCurrentClusterName(), base.GetMetricsClient(), params.PayloadEncryptor, log)
 
 	taskPersistence, err := pFactory.NewTaskManager()
 	if err != nil {
@@ -81,6 +83,11 @@ func (s *Service) Start() {
 	if err != nil {
 		log.Fatal("Matching handler failed to start", tag.Error(err))
 	}
+	pFactory.SetDomainNameResolver(handler.domainCache.GetDomainName)
+
+	healthCheckServer := base.GetHealthCheckServer()
+	healthCheckServer.AddReadinessCheck("persistence", healthcheck.NewPersistenceCheck(metadata))
+	healthCheckServer.SetServing(true)
 
 	log.Info("started", tag.Service(common.MatchingServiceName))
 	<-s.stopC
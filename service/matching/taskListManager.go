@@ -24,6 +24,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -104,6 +105,14 @@ type (
 		outstandingPollsLock sync.Mutex
 		outstandingPollsMap  map[string]context.CancelFunc
 
+		// priorityBacklog persists the tasks with a positive priority so that they are read
+		// independently of the default backlog, it is loaded when the first one is added
+		priorityBacklogLock sync.Mutex
+		priorityBacklog     *taskListManagerImpl
+		// parent is the manager that owns this priority backlog, nil for the task lists
+		// that are polled
+		parent *taskListManagerImpl
+
 		shutdownCh chan struct{}  // Delivers stop to the pump that populates taskBuffer
 		startWG    sync.WaitGroup // ensures that background processes do not start until setup is ready
 		stopped    int32
@@ -164,6 +173,39 @@ func newTaskListManager(
 	return tlMgr, nil
 }
 
+// newPriorityBacklog creates the manager of the backlog that persists the tasks with a positive
+// priority. The backlog shares the matcher and the pollers of its parent, its tasks are dispatched
+// by the task reader of the parent together with the ones of the default backlog.
+func newPriorityBacklog(parent *taskListManagerImpl) *taskListManagerImpl {
+	e := parent.engine
+	taskList := parent.taskListID.priorityBacklogID()
+	db := newTaskListDB(e.taskManager, taskList.domainID, taskList.name, taskList.taskType, parent.taskListKind, e.logger)
+	backlog := &taskListManagerImpl{
+		domainCache:   e.domainCache,
+		metricsClient: e.metricsClient,
+		engine:        e,
+		shutdownCh:    make(chan struct{}),
+		taskListID:    taskList,
+		logger: e.logger.WithTags(tag.WorkflowTaskListName(taskList.name),
+			tag.WorkflowTaskListType(taskList.taskType)),
+		db:                  db,
+		taskAckManager:      newAckManager(e.logger),
+		taskGC:              newTaskGC(db, parent.config),
+		config:              parent.config,
+		matcher:             parent.matcher,
+		pollerHistory:       parent.pollerHistory,
+		outstandingPollsMap: make(map[string]context.CancelFunc),
+		taskListKind:        parent.taskListKind,
+		parent:              parent,
+	}
+	backlog.domainNameValue.Store(parent.domainNameValue.Load())
+	backlog.domainScopeValue.Store(parent.domainScopeValue.Load())
+	backlog.taskWriter = newTaskWriter(backlog)
+	backlog.taskReader = newTaskReader(backlog)
+	backlog.startWG.Add(1)
+	return backlog
+}
+
 // Starts reading pump for the given task list.
 // The pump fills up taskBuffer from persistence.
 func (c *taskListManagerImpl) Start() error {
@@ -180,6 +222,13 @@ func (c *taskListManagerImpl) Start() error {
 	c.taskWriter.Start(c.rangeIDToTaskIDBlock(state.rangeID))
 	c.taskReader.Start()
 
+	if c.isPriorityBacklogAllowed() {
+		if err := c.loadPriorityBacklogIfNotEmpty(); err != nil {
+			c.Stop()
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -191,11 +240,20 @@ func (c *taskListManagerImpl) Stop() {
 	close(c.shutdownCh)
 	c.taskWriter.Stop()
 	c.taskReader.Stop()
-	c.engine.removeTaskListManager(c.taskListID)
-	c.engine.removeTaskListManager(c.taskListID)
+	if c.parent == nil {
+		if backlog := c.getPriorityBacklog(); backlog != nil {
+			backlog.Stop()
+		}
+		c.engine.removeTaskListManager(c.taskListID)
+		c.engine.removeTaskListManager(c.taskListID)
+	}
 	c.logger.Info("", tag.LifeCycleStopped)
 }
 
+func (c *taskListManagerImpl) isStopped() bool {
+	return atomic.LoadInt32(&c.stopped) == 1
+}
+
 // AddTask adds a task to the task list. This method will first attempt a synchronous
 // match with a poller. When there are no pollers or if ratelimit is exceeded, task will
 // be written to database and later asynchronously matched with a poller
@@ -210,7 +268,7 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 		}
 
 		if domainEntry.GetDomainNotActiveErr() != nil {
-			r, err := c.taskWriter.appendTask(params.execution, params.taskInfo)
+			r, err := c.appendTask(params.execution, params.taskInfo)
 			syncMatch = false
 			return r, err
 		}
@@ -220,7 +278,7 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 			return &persistence.CreateTasksResponse{}, err
 		}
 
-		r, err := c.taskWriter.appendTask(params.execution, params.taskInfo)
+		r, err := c.appendTask(params.execution, params.taskInfo)
 		syncMatch = false
 		return r, err
 	})
@@ -230,6 +288,92 @@ func (c *taskListManagerImpl) AddTask(ctx context.Context, params addTaskParams)
 	return syncMatch, err
 }
 
+// appendTask persists the task in the priority backlog when it has a positive priority,
+// and in the default backlog otherwise
+func (c *taskListManagerImpl) appendTask(
+	execution *s.WorkflowExecution,
+	taskInfo *persistence.TaskInfo,
+) (*persistence.CreateTasksResponse, error) {
+	if taskInfo.Priority <= 0 || !c.isPriorityBacklogAllowed() {
+		return c.taskWriter.appendTask(execution, taskInfo)
+	}
+
+	backlog, err := c.loadPriorityBacklog()
+	if err != nil {
+		return nil, err
+	}
+	r, err := backlog.taskWriter.appendTask(execution, taskInfo)
+	if err == nil {
+		backlog.taskReader.Signal()
+	}
+	return r, err
+}
+
+// loadPriorityBacklog returns the running priority backlog of the task list, starting a new one
+// if it is not loaded or was unloaded after being idle
+func (c *taskListManagerImpl) loadPriorityBacklog() (*taskListManagerImpl, error) {
+	c.priorityBacklogLock.Lock()
+	defer c.priorityBacklogLock.Unlock()
+
+	if c.priorityBacklog != nil && !c.priorityBacklog.isStopped() {
+		return c.priorityBacklog, nil
+	}
+	if c.isStopped() {
+		return nil, errShutdown
+	}
+
+	backlog := newPriorityBacklog(c)
+	if err := backlog.Start(); err != nil {
+		return nil, err
+	}
+	c.priorityBacklog = backlog
+	c.taskReader.notifyPriorityBacklogLoaded()
+	return backlog, nil
+}
+
+// loadPriorityBacklogIfNotEmpty loads the priority backlog when it still has tasks written
+// by a previous owner of the task list
+func (c *taskListManagerImpl) loadPriorityBacklogIfNotEmpty() error {
+	taskList := c.taskListID.priorityBacklogID()
+	db := newTaskListDB(c.engine.taskManager, taskList.domainID, taskList.name, taskList.taskType, c.taskListKind, c.logger)
+	response, err := c.executeWithRetry(func() (interface{}, error) {
+		return db.GetTasks(0, math.MaxInt64, 1)
+	})
+	if err != nil {
+		return err
+	}
+	if len(response.(*persistence.GetTasksResponse).Tasks) == 0 {
+		return nil
+	}
+	_, err = c.loadPriorityBacklog()
+	return err
+}
+
+// getPriorityBacklog returns the priority backlog of the task list, or nil if it is not running
+func (c *taskListManagerImpl) getPriorityBacklog() *taskListManagerImpl {
+	c.priorityBacklogLock.Lock()
+	defer c.priorityBacklogLock.Unlock()
+	if c.priorityBacklog == nil || c.priorityBacklog.isStopped() {
+		return nil
+	}
+	return c.priorityBacklog
+}
+
+// isPriorityBacklogAllowed returns true if the tasks with a positive priority are persisted in
+// a separate backlog, sticky task lists are short lived and keep a single backlog
+func (c *taskListManagerImpl) isPriorityBacklogAllowed() bool {
+	return c.parent == nil && c.taskListKind != int(s.TaskListKindSticky)
+}
+
+// getBacklogCountHint returns the approximate number of tasks in the backlogs of the task list
+func (c *taskListManagerImpl) getBacklogCountHint() int64 {
+	hint := c.taskAckManager.getBacklogCountHint()
+	if backlog := c.getPriorityBacklog(); backlog != nil {
+		hint += backlog.taskAckManager.getBacklogCountHint()
+	}
+	return hint
+}
+
 // DispatchTask dispatches a task to a poller. When there are no pollers to pick
 // up the task or if rate limit is exceeded, this method will return error. Task
 // *will not* be persisted to db
@@ -274,7 +418,7 @@ func (c *taskListManagerImpl) GetTask(
 		return nil, err
 	}
 	task.domainName = c.domainName()
-	task.backlogCountHint = c.taskAckManager.getBacklogCountHint()
+	task.backlogCountHint = c.getBacklogCountHint()
 	return task, nil
 }
 
@@ -302,7 +446,8 @@ func (c *taskListManagerImpl) getTask(ctx context.Context, maxDispatchPerSecond
 
 	identity, ok := ctx.Value(identityKey).(string)
 	if ok && identity != "" {
-		c.pollerHistory.updatePollerInfo(pollerIdentity(identity), maxDispatchPerSecond)
+		buildID, _ := ctx.Value(buildIDKey).(string)
+		c.pollerHistory.updatePollerInfo(pollerIdentity(identity), maxDispatchPerSecond, buildID)
 	}
 
 	domainEntry, err := c.domainCache.GetDomainByID(c.taskListID.domainID)
@@ -341,7 +486,8 @@ func (c *taskListManagerImpl) CancelPoller(pollerID string) {
 
 // DescribeTaskList returns information about the target tasklist, right now this API returns the
 // pollers which polled this tasklist in last few minutes and status of tasklist's ackManager
-// (readLevel, ackLevel, backlogCountHint and taskIDBlock).
+// (readLevel, ackLevel, backlogCountHint and taskIDBlock). The backlogCountHint includes the
+// tasks of the priority backlog, the other levels are the ones of the default backlog.
 func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *s.DescribeTaskListResponse {
 	response := &s.DescribeTaskListResponse{Pollers: c.GetAllPollerInfo()}
 	if !includeTaskListStatus {
@@ -352,7 +498,7 @@ func (c *taskListManagerImpl) DescribeTaskList(includeTaskListStatus bool) *s.De
 	response.TaskListStatus = &s.TaskListStatus{
 		ReadLevel:        common.Int64Ptr(c.taskAckManager.getReadLevel()),
 		AckLevel:         common.Int64Ptr(c.taskAckManager.getAckLevel()),
-		BacklogCountHint: common.Int64Ptr(c.taskAckManager.getBacklogCountHint()),
+		BacklogCountHint: common.Int64Ptr(c.getBacklogCountHint()),
 		RatePerSecond:    common.Float64Ptr(c.matcher.Rate()),
 		TaskIDBlock: &s.TaskIDBlock{
 			StartID: common.Int64Ptr(taskIDBlock.start),
@@ -426,7 +572,12 @@ func (c *taskListManagerImpl) renewLeaseWithRetry() (taskListState, error) {
 	err := backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
 	if err != nil {
 		c.domainScope().IncCounter(metrics.LeaseFailureCounter)
-		c.engine.unloadTaskList(c.taskListID)
+		if c.parent != nil {
+			// the parent loads the priority backlog again when the next task is added to it
+			c.Stop()
+		} else {
+			c.engine.unloadTaskList(c.taskListID)
+		}
 		return newState, err
 	}
 	return newState, nil
@@ -489,6 +640,10 @@ func (c *taskListManagerImpl) executeWithRetry(
 }
 
 func (c *taskListManagerImpl) trySyncMatch(ctx context.Context, params addTaskParams) (bool, error) {
+	if c.taskReader.hasPendingTaskWithHigherPriority(params.taskInfo.Priority) {
+		// pollers get the loaded higher priority tasks first, this one goes to the backlog
+		return false, nil
+	}
 	childCtx, cancel := c.newChildContext(ctx, maxSyncMatchWaitTime, time.Second)
 	task := newInternalTask(params.taskInfo, c.completeTask, params.forwardedFrom, true)
 	matched, err := c.matcher.Offer(childCtx, task)
@@ -524,7 +679,9 @@ func (c *taskListManagerImpl) newChildContext(
 }
 
 func (c *taskListManagerImpl) isFowardingAllowed(taskList *taskListID, kind s.TaskListKind) bool {
-	return !taskList.IsRoot() && kind != s.TaskListKindSticky
+	// partitions of the task lists of compatible build sets live on the same host as the partition they
+	// are derived from, forwarding them by name would load the parent on a different host
+	return !taskList.IsRoot() && kind != s.TaskListKindSticky && !isVersionedTaskListName(taskList.baseName)
 }
 
 func createServiceBusyError(msg string) *s.ServiceBusyError {
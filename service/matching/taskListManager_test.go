@@ -105,6 +105,138 @@ func createTestTaskListManagerWithConfig(cfg *Config) *taskListManagerImpl {
 	return tlMgr.(*taskListManagerImpl)
 }
 
+func TestDeliverBufferTasks_Priority(t *testing.T) {
+	tlm := createTestTaskListManager()
+	for i, priority := range []int32{0, 1, 0, 2, 1} {
+		tlm.taskReader.taskBuffer <- &persistence.TaskInfo{TaskID: int64(i + 1), Priority: priority}
+	}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		tlm.taskReader.dispatchBufferedTasks()
+	}()
+
+	var order []int64
+	for i := 0; i < 5; i++ {
+		task, err := tlm.matcher.Poll(context.Background())
+		require.NoError(t, err)
+		order = append(order, task.event.TaskID)
+	}
+	require.Equal(t, []int64{4, 2, 5, 1, 3}, order)
+
+	tlm.taskReader.cancelFunc()
+	close(tlm.taskReader.dispatcherShutdownC)
+	wg.Wait()
+}
+
+func TestDeliverBufferTasks_PriorityBacklog(t *testing.T) {
+	tlm := createTestTaskListManager()
+	backlog := newPriorityBacklog(tlm)
+	tlm.priorityBacklog = backlog
+	// task IDs of the backlogs overlap, the tasks must be completed in the backlog they were loaded from
+	for i := int64(1); i <= 2; i++ {
+		tlm.taskAckManager.addTask(i)
+		tlm.taskReader.taskBuffer <- &persistence.TaskInfo{TaskID: i}
+		backlog.taskAckManager.addTask(i)
+		backlog.taskReader.taskBuffer <- &persistence.TaskInfo{TaskID: i, Priority: int32(i)}
+	}
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		tlm.taskReader.dispatchBufferedTasks()
+	}()
+
+	var priorities []int32
+	for i := 0; i < 4; i++ {
+		task, err := tlm.matcher.Poll(context.Background())
+		require.NoError(t, err)
+		priorities = append(priorities, task.event.Priority)
+		task.finish(nil)
+	}
+	require.Equal(t, []int32{2, 1, 0, 0}, priorities)
+	require.Equal(t, int64(2), tlm.taskAckManager.getAckLevel())
+	require.Equal(t, int64(2), backlog.taskAckManager.getAckLevel())
+	require.Zero(t, tlm.getBacklogCountHint())
+
+	tlm.taskReader.cancelFunc()
+	close(tlm.taskReader.dispatcherShutdownC)
+	wg.Wait()
+}
+
+func TestAddTask_PriorityBacklog(t *testing.T) {
+	tlm := createTestTaskListManager()
+	require.NoError(t, tlm.Start())
+	require.Nil(t, tlm.getPriorityBacklog())
+
+	tm := tlm.engine.taskManager.(*testTaskManager)
+	backlogID := newTestTaskListID(tlm.taskListID.domainID, priorityBacklogPrefix+tlm.taskListID.name, tlm.taskListID.taskType)
+	// no time is left for a sync match, the tasks are persisted
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	for _, priority := range []int32{0, 1, -1, 2} {
+		params := addTaskParams{
+			execution: &workflow.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid")},
+			taskInfo:  &persistence.TaskInfo{DomainID: tlm.taskListID.domainID, Priority: priority},
+		}
+		syncMatch, err := tlm.AddTask(ctx, params)
+		require.NoError(t, err)
+		require.False(t, syncMatch)
+	}
+	require.NotNil(t, tlm.getPriorityBacklog())
+	require.Equal(t, 2, tm.getTaskCount(tlm.taskListID))
+	require.Equal(t, 2, tm.getTaskCount(backlogID))
+	tlm.Stop()
+	require.Nil(t, tlm.getPriorityBacklog())
+
+	// the priority backlog is loaded with the task list when it is not empty
+	reloaded, err := newTaskListManager(tlm.engine, tlm.taskListID, common.TaskListKindPtr(workflow.TaskListKindNormal), tlm.engine.config)
+	require.NoError(t, err)
+	tlm = reloaded.(*taskListManagerImpl)
+	require.NoError(t, tlm.Start())
+	defer tlm.Stop()
+	require.NotNil(t, tlm.getPriorityBacklog())
+
+	var priorities []int32
+	for i := 0; i < 4; i++ {
+		task, err := tlm.matcher.Poll(context.Background())
+		require.NoError(t, err)
+		priorities = append(priorities, task.event.Priority)
+		task.finish(nil)
+	}
+	require.ElementsMatch(t, []int32{0, 1, -1, 2}, priorities)
+}
+
+func TestSyncMatch_DefersToPendingHigherPriorityTask(t *testing.T) {
+	tlm := createTestTaskListManager()
+	atomic.StoreInt64(&tlm.taskReader.maxPendingTaskPriority, 2)
+
+	polled := make(chan int32, 1)
+	go func() {
+		task, err := tlm.matcher.Poll(context.Background())
+		if err == nil {
+			polled <- task.event.Priority
+			task.finish(nil)
+		}
+	}()
+
+	for _, priority := range []int32{1, 3} {
+		params := addTaskParams{taskInfo: &persistence.TaskInfo{Priority: priority}}
+		matched := false
+		for i := 0; i < 100 && !matched; i++ {
+			var err error
+			matched, err = tlm.trySyncMatch(context.Background(), params)
+			require.NoError(t, err)
+			if !matched {
+				time.Sleep(time.Millisecond)
+			}
+		}
+		require.Equal(t, priority > 2, matched)
+	}
+	require.Equal(t, int32(3), <-polled)
+}
+
 func TestIsTaskAddedRecently(t *testing.T) {
 	tlm := createTestTaskListManager()
 	require.True(t, tlm.taskReader.isTaskAddedRecently(time.Now()))
@@ -146,7 +278,7 @@ func TestDescribeTaskList(t *testing.T) {
 	require.Equal(t, tlm.config.RangeSize, taskIDBlock.GetEndID())
 
 	// Add a poller and complete all tasks
-	tlm.pollerHistory.updatePollerInfo(pollerIdentity(PollerIdentity), nil)
+	tlm.pollerHistory.updatePollerInfo(pollerIdentity(PollerIdentity), nil, "")
 	for i := int64(0); i < taskCount; i++ {
 		tlm.taskAckManager.completeTask(startTaskID + i)
 	}
@@ -158,7 +290,7 @@ func TestDescribeTaskList(t *testing.T) {
 	require.True(t, descResp.Pollers[0].GetRatePerSecond() > (_defaultTaskDispatchRPS-1))
 
 	rps := 5.0
-	tlm.pollerHistory.updatePollerInfo(pollerIdentity(PollerIdentity), &rps)
+	tlm.pollerHistory.updatePollerInfo(pollerIdentity(PollerIdentity), &rps, "")
 	descResp = tlm.DescribeTaskList(includeTaskStatus)
 	require.Equal(t, 1, len(descResp.GetPollers()))
 	require.Equal(t, PollerIdentity, descResp.Pollers[0].GetIdentity())
@@ -189,7 +321,7 @@ func TestCheckIdleTaskList(t *testing.T) {
 
 	// Active poll-er
 	tlm = createTestTaskListManagerWithConfig(cfg)
-	tlm.pollerHistory.updatePollerInfo(pollerIdentity("test-poll"), nil)
+	tlm.pollerHistory.updatePollerInfo(pollerIdentity("test-poll"), nil, "")
 	require.Equal(t, 1, len(tlm.GetAllPollerInfo()))
 	tlMgrStartWithoutNotifyEvent(tlm)
 	time.Sleep(20 * time.Millisecond)
@@ -0,0 +1,129 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"container/heap"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// taskPriorityBuffer holds the tasks loaded from persistence that are waiting
+	// to be dispatched. Tasks with a higher priority are dispatched first, tasks
+	// with the same priority are dispatched in the order they were written. To keep
+	// low priority tasks from starving, every starvationInterval-th pop returns the
+	// oldest buffered task instead. The buffer is not safe for concurrent use.
+	taskPriorityBuffer struct {
+		tasks              taskHeap
+		popCount           int
+		starvationInterval func() int
+	}
+
+	// bufferedTask is a task loaded from one of the backlogs of a task list,
+	// completionFunc completes the task in the backlog it was loaded from
+	bufferedTask struct {
+		info           *persistence.TaskInfo
+		completionFunc func(*persistence.TaskInfo, error)
+	}
+
+	taskHeap []*bufferedTask
+)
+
+func newTaskPriorityBuffer(starvationInterval func() int) *taskPriorityBuffer {
+	return &taskPriorityBuffer{
+		starvationInterval: starvationInterval,
+	}
+}
+
+func (b *taskPriorityBuffer) Len() int {
+	return len(b.tasks)
+}
+
+func (b *taskPriorityBuffer) Push(task *bufferedTask) {
+	heap.Push(&b.tasks, task)
+}
+
+// MaxPriority returns the highest priority of the buffered tasks, the buffer must not be empty
+func (b *taskPriorityBuffer) MaxPriority() int32 {
+	return b.tasks[0].info.Priority
+}
+
+// Pop returns the next task to dispatch, or nil if the buffer is empty
+func (b *taskPriorityBuffer) Pop() *bufferedTask {
+	if len(b.tasks) == 0 {
+		return nil
+	}
+
+	b.popCount++
+	interval := b.starvationInterval()
+	if interval > 0 && b.popCount%interval == 0 {
+		return heap.Remove(&b.tasks, b.oldestIndex()).(*bufferedTask)
+	}
+	return heap.Pop(&b.tasks).(*bufferedTask)
+}
+
+func (b *taskPriorityBuffer) oldestIndex() int {
+	oldest := 0
+	for i, task := range b.tasks {
+		if isOlderTask(task.info, b.tasks[oldest].info) {
+			oldest = i
+		}
+	}
+	return oldest
+}
+
+// isOlderTask compares the tasks by creation time, task IDs are only
+// comparable among the tasks of the same backlog
+func isOlderTask(a *persistence.TaskInfo, b *persistence.TaskInfo) bool {
+	if !a.CreatedTime.Equal(b.CreatedTime) {
+		return a.CreatedTime.Before(b.CreatedTime)
+	}
+	return a.TaskID < b.TaskID
+}
+
+func (h taskHeap) Len() int {
+	return len(h)
+}
+
+func (h taskHeap) Less(i, j int) bool {
+	if h[i].info.Priority != h[j].info.Priority {
+		return h[i].info.Priority > h[j].info.Priority
+	}
+	return h[i].info.TaskID < h[j].info.TaskID
+}
+
+func (h taskHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+func (h *taskHeap) Push(x interface{}) {
+	*h = append(*h, x.(*bufferedTask))
+}
+
+func (h *taskHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	task := old[n-1]
+	old[n-1] = nil
+	*h = old[0 : n-1]
+	return task
+}
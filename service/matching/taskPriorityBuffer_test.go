@@ -0,0 +1,91 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestTaskPriorityBuffer_Order(t *testing.T) {
+	buffer := newTaskPriorityBuffer(func() int { return 0 })
+	require.Nil(t, buffer.Pop())
+
+	tasks := []struct {
+		taskID   int64
+		priority int32
+	}{
+		{1, 0}, {2, 5}, {3, -1}, {4, 5}, {5, 0}, {6, 10},
+	}
+	for _, task := range tasks {
+		buffer.Push(newTestBufferedTask(task.taskID, task.priority))
+	}
+	require.Equal(t, len(tasks), buffer.Len())
+
+	var order []int64
+	for buffer.Len() > 0 {
+		order = append(order, buffer.Pop().info.TaskID)
+	}
+	require.Equal(t, []int64{6, 2, 4, 1, 5, 3}, order)
+	require.Nil(t, buffer.Pop())
+}
+
+func TestTaskPriorityBuffer_StarvationInterval(t *testing.T) {
+	buffer := newTaskPriorityBuffer(func() int { return 3 })
+	buffer.Push(newTestBufferedTask(1, 0))
+	for i := int64(2); i <= 7; i++ {
+		buffer.Push(newTestBufferedTask(i, 1))
+	}
+
+	var order []int64
+	for buffer.Len() > 0 {
+		order = append(order, buffer.Pop().info.TaskID)
+	}
+	// every third pop returns the oldest task regardless of its priority
+	require.Equal(t, []int64{2, 3, 1, 4, 5, 6, 7}, order)
+}
+
+func TestTaskPriorityBuffer_StarvationIntervalAcrossBacklogs(t *testing.T) {
+	buffer := newTaskPriorityBuffer(func() int { return 2 })
+	now := time.Now()
+	// task IDs of different backlogs are not comparable, the oldest task is the one created first
+	oldest := newTestBufferedTask(100, 0)
+	oldest.info.CreatedTime = now
+	buffer.Push(oldest)
+	for i := int64(1); i <= 3; i++ {
+		task := newTestBufferedTask(i, 1)
+		task.info.CreatedTime = now.Add(time.Duration(i) * time.Second)
+		buffer.Push(task)
+	}
+
+	var order []int64
+	for buffer.Len() > 0 {
+		order = append(order, buffer.Pop().info.TaskID)
+	}
+	require.Equal(t, []int64{1, 100, 2, 3}, order)
+}
+
+func newTestBufferedTask(taskID int64, priority int32) *bufferedTask {
+	return &bufferedTask{info: &persistence.TaskInfo{TaskID: taskID, Priority: priority}}
+}
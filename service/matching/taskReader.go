@@ -22,7 +22,9 @@ package matching
 
 import (
 	"context"
+	"math"
 	"runtime"
+	"sync/atomic"
 	"time"
 
 	"github.com/uber/cadence/common/log"
@@ -48,17 +50,28 @@ type (
 		// separate shutdownC needed for dispatchTasks go routine to allow
 		// getTasksPump to be stopped without stopping dispatchTasks in unit tests
 		dispatcherShutdownC chan struct{}
+		// number of tasks moved out of taskBuffer that are waiting to be dispatched
+		pendingTaskCount int32
+		// highest priority among the task being dispatched and the ones waiting to be
+		// dispatched, noPendingTaskPriority if there is none
+		maxPendingTaskPriority int64
+		// notifies dispatchBufferedTasks that the priority backlog of the task list was loaded
+		priorityBacklogC chan struct{}
 	}
 )
 
+const noPendingTaskPriority = math.MinInt64
+
 func newTaskReader(tlMgr *taskListManagerImpl) *taskReader {
 	ctx, cancel := context.WithCancel(context.Background())
 	return &taskReader{
-		tlMgr:               tlMgr,
-		cancelCtx:           ctx,
-		cancelFunc:          cancel,
-		notifyC:             make(chan struct{}, 1),
-		dispatcherShutdownC: make(chan struct{}),
+		tlMgr:                  tlMgr,
+		cancelCtx:              ctx,
+		cancelFunc:             cancel,
+		notifyC:                make(chan struct{}, 1),
+		priorityBacklogC:       make(chan struct{}, 1),
+		dispatcherShutdownC:    make(chan struct{}),
+		maxPendingTaskPriority: noPendingTaskPriority,
 		// we always dequeue the head of the buffer and try to dispatch it to a poller
 		// so allocate one less than desired target buffer size
 		taskBuffer: make(chan *persistence.TaskInfo, tlMgr.config.GetTasksBatchSize()-1),
@@ -67,7 +80,10 @@ func newTaskReader(tlMgr *taskListManagerImpl) *taskReader {
 
 func (tr *taskReader) Start() {
 	tr.Signal()
-	go tr.dispatchBufferedTasks()
+	if tr.tlMgr.parent == nil {
+		// the tasks of a priority backlog are dispatched by the task reader of its parent
+		go tr.dispatchBufferedTasks()
+	}
 	go tr.getTasksPump()
 }
 
@@ -84,35 +100,112 @@ func (tr *taskReader) Signal() {
 	}
 }
 
+func (tr *taskReader) notifyPriorityBacklogLoaded() {
+	select {
+	case tr.priorityBacklogC <- struct{}{}:
+	default: // channel already has an event, don't block
+	}
+}
+
 func (tr *taskReader) dispatchBufferedTasks() {
+	// tasks are moved from taskBuffer and from the buffer of the priority backlog into
+	// a priority buffer so that the highest priority task among all the loaded ones is
+	// offered to pollers first
+	pending := newTaskPriorityBuffer(tr.tlMgr.config.PriorityStarvationInterval)
+	maxPending := cap(tr.taskBuffer) + 1
+	bufferClosed := false
+	var backlog *taskListManagerImpl
+	var backlogBuffer chan *persistence.TaskInfo // closed when the priority backlog is unloaded
 dispatchLoop:
 	for {
-		select {
-		case taskInfo, ok := <-tr.taskBuffer:
-			if !ok { // Task list getTasks pump is shutdown
+		if backlogBuffer == nil {
+			if backlog = tr.tlMgr.getPriorityBacklog(); backlog != nil {
+				backlogBuffer = backlog.taskReader.taskBuffer
+			}
+		}
+		if pending.Len() == 0 {
+			atomic.StoreInt64(&tr.maxPendingTaskPriority, noPendingTaskPriority)
+			if bufferClosed { // Task list getTasks pump is shutdown
 				break dispatchLoop
 			}
-			task := newInternalTask(taskInfo, tr.tlMgr.completeTask, "", false)
-			for {
-				err := tr.tlMgr.DispatchTask(tr.cancelCtx, task)
-				if err == nil {
-					break
-				}
-				if err == context.Canceled {
-					tr.tlMgr.logger.Info("Tasklist manager context is cancelled, shutting down")
+			select {
+			case taskInfo, ok := <-tr.taskBuffer:
+				if !ok {
 					break dispatchLoop
 				}
-				// this should never happen unless there is a bug - don't drop the task
-				tr.scope().IncCounter(metrics.BufferThrottleCounter)
-				tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
-				runtime.Gosched()
+				pending.Push(&bufferedTask{info: taskInfo, completionFunc: tr.tlMgr.completeTask})
+			case taskInfo, ok := <-backlogBuffer:
+				if !ok {
+					backlogBuffer = nil
+					continue dispatchLoop
+				}
+				pending.Push(&bufferedTask{info: taskInfo, completionFunc: backlog.completeTask})
+			case <-tr.priorityBacklogC:
+				continue dispatchLoop
+			case <-tr.dispatcherShutdownC:
+				break dispatchLoop
 			}
-		case <-tr.dispatcherShutdownC:
-			break dispatchLoop
+		}
+	drainLoop:
+		for pending.Len() < maxPending {
+			// the priority backlog is drained first so that its tasks do not wait behind
+			// the ones of the default backlog
+			select {
+			case taskInfo, ok := <-backlogBuffer:
+				if !ok {
+					backlogBuffer = nil
+				} else {
+					pending.Push(&bufferedTask{info: taskInfo, completionFunc: backlog.completeTask})
+				}
+				continue drainLoop
+			default:
+			}
+			if bufferClosed {
+				break drainLoop
+			}
+			select {
+			case taskInfo, ok := <-tr.taskBuffer:
+				if !ok {
+					bufferClosed = true
+					break drainLoop
+				}
+				pending.Push(&bufferedTask{info: taskInfo, completionFunc: tr.tlMgr.completeTask})
+			default:
+				break drainLoop
+			}
+		}
+
+		next := pending.Pop()
+		atomic.StoreInt32(&tr.pendingTaskCount, int32(pending.Len()))
+		maxPriority := next.info.Priority
+		if pending.Len() > 0 && pending.MaxPriority() > maxPriority {
+			maxPriority = pending.MaxPriority()
+		}
+		atomic.StoreInt64(&tr.maxPendingTaskPriority, int64(maxPriority))
+		task := newInternalTask(next.info, next.completionFunc, "", false)
+		for {
+			err := tr.tlMgr.DispatchTask(tr.cancelCtx, task)
+			if err == nil {
+				break
+			}
+			if err == context.Canceled {
+				tr.tlMgr.logger.Info("Tasklist manager context is cancelled, shutting down")
+				break dispatchLoop
+			}
+			// this should never happen unless there is a bug - don't drop the task
+			tr.scope().IncCounter(metrics.BufferThrottleCounter)
+			tr.logger().Error("taskReader: unexpected error dispatching task", tag.Error(err))
+			runtime.Gosched()
 		}
 	}
 }
 
+// hasPendingTaskWithHigherPriority returns true if a task loaded from persistence and not
+// dispatched yet has a higher priority than the given one
+func (tr *taskReader) hasPendingTaskWithHigherPriority(priority int32) bool {
+	return atomic.LoadInt64(&tr.maxPendingTaskPriority) > int64(priority)
+}
+
 func (tr *taskReader) getTasksPump() {
 	tr.tlMgr.startWG.Wait()
 	defer close(tr.taskBuffer)
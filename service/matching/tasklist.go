@@ -47,6 +47,9 @@ type (
 const (
 	// taskListPartitionPrefix is the required naming prefix for any task list partition other than partition 0
 	taskListPartitionPrefix = "/__cadence_sys/"
+	// priorityBacklogPrefix is the naming prefix of the task list that persists the tasks
+	// with a positive priority of the task list it is prepended to
+	priorityBacklogPrefix = "/__cadence_priority/"
 )
 
 // newTaskListName returns a fully qualified task list name.
@@ -134,6 +137,14 @@ func newTaskListID(domainID, taskListName string, taskType int) (*taskListID, er
 	}, nil
 }
 
+// priorityBacklogID returns the id of the task list that persists the tasks with a positive
+// priority, the backlog is owned by the manager of this task list and is never polled directly
+func (tid *taskListID) priorityBacklogID() *taskListID {
+	id := *tid
+	id.name = priorityBacklogPrefix + tid.name
+	return &id
+}
+
 func (tid *taskListID) String() string {
 	var b bytes.Buffer
 	b.WriteString("[")
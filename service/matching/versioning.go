@@ -0,0 +1,133 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"strings"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/persistence"
+)
+
+const (
+	// buildIDTaskListPrefix is the naming prefix of the task lists holding the decision tasks
+	// of a compatible build set
+	buildIDTaskListPrefix = "/__cadence_build/"
+)
+
+// compatibleBuildSets returns the compatible build sets configured for the given task list. The
+// sets configured for the task list take precedence over the domain wide default.
+func compatibleBuildSets(config *persistence.DomainConfig, taskListName string) []*workflow.CompatibleBuildSet {
+	if config == nil {
+		return nil
+	}
+	if compatibility, ok := config.WorkerVersioning.TaskLists[taskListName]; ok {
+		return compatibility.GetCompatibleBuildSets()
+	}
+	return config.WorkerVersioning.DefaultCompatibility.GetCompatibleBuildSets()
+}
+
+// findCompatibleBuildSet returns the set which the given build ID belongs to, or nil if there is none.
+// A nil build ID selects the last set, which is the default for new workflows.
+func findCompatibleBuildSet(sets []*workflow.CompatibleBuildSet, buildID *string) *workflow.CompatibleBuildSet {
+	if len(sets) == 0 {
+		return nil
+	}
+	if buildID == nil {
+		return sets[len(sets)-1]
+	}
+	for _, set := range sets {
+		for _, id := range set.GetBuildIds() {
+			if id == *buildID {
+				return set
+			}
+		}
+	}
+	return nil
+}
+
+// isVersionedTaskListName returns true if the given task list base name belongs to a compatible build set
+func isVersionedTaskListName(baseName string) bool {
+	return strings.HasPrefix(baseName, buildIDTaskListPrefix)
+}
+
+// newVersionedTaskListID returns the id of the task list partition holding the tasks of the given
+// compatible build set. Frontend only allows build IDs to be appended to a set, so the first build
+// ID identifies it. The versioned task lists are partitioned the same way as the task list they are
+// derived from:
+//
+//     /__cadence_build/[first-build-id]/[original-name]
+//     /__cadence_sys//__cadence_build/[first-build-id]/[original-name]/[partitionID]
+func newVersionedTaskListID(taskList *taskListID, set *workflow.CompatibleBuildSet) (*taskListID, error) {
+	name := qualifiedTaskListName{
+		baseName: buildIDTaskListPrefix + set.BuildIds[0] + "/" + taskList.baseName,
+	}
+	return newTaskListID(taskList.domainID, name.mkName(taskList.partition), taskList.taskType)
+}
+
+// getVersionedTaskListID returns the task list which decision tasks and polls of the given worker build
+// are routed to. Workflows started by a build which is not part of any compatible build set, as well as
+// workers of such a build, use the task list itself.
+func (e *matchingEngineImpl) getVersionedTaskListID(
+	taskList *taskListID,
+	taskListKind *workflow.TaskListKind,
+	buildID *string,
+	forwardedFrom string,
+) (*taskListID, error) {
+
+	if taskList.taskType != persistence.TaskListTypeDecision ||
+		(taskListKind != nil && *taskListKind == workflow.TaskListKindSticky) ||
+		forwardedFrom != "" ||
+		isVersionedTaskListName(taskList.baseName) {
+		return taskList, nil
+	}
+
+	domainEntry, err := e.domainCache.GetDomainByID(taskList.domainID)
+	if err != nil {
+		return nil, err
+	}
+	set := findCompatibleBuildSet(compatibleBuildSets(domainEntry.GetConfig(), taskList.baseName), buildID)
+	if len(set.GetBuildIds()) == 0 {
+		return taskList, nil
+	}
+	return newVersionedTaskListID(taskList, set)
+}
+
+// getVersionedTaskListIDs returns the ids of all task lists derived from the given one for its
+// compatible build sets
+func (e *matchingEngineImpl) getVersionedTaskListIDs(taskList *taskListID) ([]*taskListID, error) {
+	domainEntry, err := e.domainCache.GetDomainByID(taskList.domainID)
+	if err != nil {
+		return nil, err
+	}
+	var result []*taskListID
+	for _, set := range compatibleBuildSets(domainEntry.GetConfig(), taskList.baseName) {
+		if len(set.GetBuildIds()) == 0 {
+			continue
+		}
+		id, err := newVersionedTaskListID(taskList, set)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, id)
+	}
+	return result, nil
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+func TestFindCompatibleBuildSet(t *testing.T) {
+	sets := []*workflow.CompatibleBuildSet{
+		{BuildIds: []string{"v1", "v2"}},
+		{BuildIds: []string{"v3"}},
+	}
+	require.Nil(t, findCompatibleBuildSet(nil, nil))
+	require.Nil(t, findCompatibleBuildSet(nil, common.StringPtr("v1")))
+	require.Equal(t, sets[1], findCompatibleBuildSet(sets, nil))
+	require.Equal(t, sets[0], findCompatibleBuildSet(sets, common.StringPtr("v1")))
+	require.Equal(t, sets[0], findCompatibleBuildSet(sets, common.StringPtr("v2")))
+	require.Equal(t, sets[1], findCompatibleBuildSet(sets, common.StringPtr("v3")))
+	require.Nil(t, findCompatibleBuildSet(sets, common.StringPtr("v4")))
+	require.Nil(t, findCompatibleBuildSet(sets, common.StringPtr("")))
+}
+
+func TestCompatibleBuildSets(t *testing.T) {
+	defaultSets := []*workflow.CompatibleBuildSet{{BuildIds: []string{"v1"}}}
+	taskListSets := []*workflow.CompatibleBuildSet{{BuildIds: []string{"v2"}}}
+	config := &persistence.DomainConfig{
+		WorkerVersioning: workflow.WorkerVersioning{
+			DefaultCompatibility: &workflow.BuildIdCompatibility{CompatibleBuildSets: defaultSets},
+			TaskLists: map[string]*workflow.BuildIdCompatibility{
+				"tl1": {CompatibleBuildSets: taskListSets},
+				"tl2": {},
+			},
+		},
+	}
+	require.Nil(t, compatibleBuildSets(nil, "tl0"))
+	require.Nil(t, compatibleBuildSets(&persistence.DomainConfig{}, "tl0"))
+	require.Equal(t, defaultSets, compatibleBuildSets(config, "tl0"))
+	require.Equal(t, taskListSets, compatibleBuildSets(config, "tl1"))
+	require.Nil(t, compatibleBuildSets(config, "tl2"))
+}
+
+func TestVersionedTaskListID(t *testing.T) {
+	set := &workflow.CompatibleBuildSet{BuildIds: []string{"v1", "v2"}}
+	testCases := []struct {
+		input  string
+		output string
+	}{
+		{"list0", "/__cadence_build/v1/list0"},
+		{"/__cadence_sys/list0/3", "/__cadence_sys//__cadence_build/v1/list0/3"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			taskList, err := newTaskListID("domain", tc.input, persistence.TaskListTypeDecision)
+			require.NoError(t, err)
+			versioned, err := newVersionedTaskListID(taskList, set)
+			require.NoError(t, err)
+			require.Equal(t, tc.output, versioned.name)
+			require.Equal(t, taskList.partition, versioned.partition)
+			require.Equal(t, taskList.domainID, versioned.domainID)
+			require.Equal(t, taskList.taskType, versioned.taskType)
+			require.True(t, isVersionedTaskListName(versioned.baseName))
+			require.False(t, isVersionedTaskListName(taskList.baseName))
+		})
+	}
+}
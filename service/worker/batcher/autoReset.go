@@ -0,0 +1,229 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/systemworkflow"
+	"go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+	"golang.org/x/time/rate"
+)
+
+const (
+	autoResetActivityName = "cadence-sys-auto-reset-activity"
+)
+
+type (
+	// AutoResetProgress is the progress of auto reset, it is also the heartbeat details of auto reset activity
+	AutoResetProgress struct {
+		PageToken []byte
+		// Open workflows started after this time are not scanned
+		LatestStartTime int64
+		// Number of open workflows scanned
+		ScannedCount int
+		// Number of workflows reset successfully
+		ResetCount int
+		// Number of workflows that failed to reset
+		ErrorCount int
+	}
+)
+
+func init() {
+	workflow.RegisterWithOptions(AutoResetWorkflow, workflow.RegisterOptions{Name: systemworkflow.AutoResetWFTypeName})
+	activity.RegisterWithOptions(AutoResetActivity, activity.RegisterOptions{Name: autoResetActivityName})
+}
+
+// AutoResetWorkflow is the workflow that resets the open workflows affected by a bad binary
+// to the first decision completed by the bad binary
+func AutoResetWorkflow(ctx workflow.Context, params systemworkflow.AutoResetParams) (AutoResetProgress, error) {
+	params = setDefaultAutoResetParams(params)
+	if err := validateAutoResetParams(params); err != nil {
+		return AutoResetProgress{}, err
+	}
+	activityOptions := batchActivityOptions
+	activityOptions.HeartbeatTimeout = params.ActivityHeartBeatTimeout
+	opt := workflow.WithActivityOptions(ctx, activityOptions)
+	var result AutoResetProgress
+	err := workflow.ExecuteActivity(opt, autoResetActivityName, params).Get(ctx, &result)
+	return result, err
+}
+
+func validateAutoResetParams(params systemworkflow.AutoResetParams) error {
+	if params.DomainName == "" ||
+		params.BinaryChecksum == "" ||
+		params.Reason == "" {
+		return fmt.Errorf("must provide required parameters: DomainName/BinaryChecksum/Reason")
+	}
+	return nil
+}
+
+func setDefaultAutoResetParams(params systemworkflow.AutoResetParams) systemworkflow.AutoResetParams {
+	if params.RPS <= 0 {
+		params.RPS = DefaultRPS
+	}
+	if params.ActivityHeartBeatTimeout <= 0 {
+		params.ActivityHeartBeatTimeout = DefaultActivityHeartBeatTimeout
+	}
+	return params
+}
+
+// AutoResetActivity is activity for resetting the open workflows affected by a bad binary
+func AutoResetActivity(ctx context.Context, params systemworkflow.AutoResetParams) (AutoResetProgress, error) {
+	batcher := ctx.Value(batcherContextKey).(*Batcher)
+	logger := getActivityLogger(ctx).WithTags(tag.WorkflowBinaryChecksum(params.BinaryChecksum))
+
+	progress := AutoResetProgress{}
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &progress); err != nil {
+			batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorFailures)
+			logger.Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+			progress = AutoResetProgress{}
+		}
+	}
+
+	if progress.LatestStartTime == 0 {
+		// the workflow is started after the bad binary is persisted in domain, so decisions of
+		// workflows started from now on are already rejected and only earlier ones are scanned
+		progress.LatestStartTime = time.Now().UnixNano()
+	}
+
+	limiter := rate.NewLimiter(rate.Limit(params.RPS), params.RPS)
+	for {
+		resp, err := batcher.svcClient.ListOpenWorkflowExecutions(ctx, &shared.ListOpenWorkflowExecutionsRequest{
+			Domain:          common.StringPtr(params.DomainName),
+			MaximumPageSize: common.Int32Ptr(int32(pageSize)),
+			NextPageToken:   progress.PageToken,
+			StartTimeFilter: &shared.StartTimeFilter{
+				EarliestTime: common.Int64Ptr(0),
+				LatestTime:   common.Int64Ptr(progress.LatestStartTime),
+			},
+		})
+		if err != nil {
+			return progress, err
+		}
+
+		for _, info := range resp.Executions {
+			if err := limiter.Wait(ctx); err != nil {
+				return progress, err
+			}
+			progress.ScannedCount++
+			reset, err := resetAffectedWorkflow(ctx, params, info.Execution)
+			if err != nil {
+				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorFailures)
+				logger.Error("Failed to reset workflow affected by bad binary",
+					tag.WorkflowID(info.Execution.GetWorkflowId()),
+					tag.WorkflowRunID(info.Execution.GetRunId()),
+					tag.Error(err))
+				progress.ErrorCount++
+			} else if reset {
+				batcher.metricsClient.IncCounter(metrics.BatcherScope, metrics.BatcherProcessorSuccess)
+				progress.ResetCount++
+			}
+			activity.RecordHeartbeat(ctx, progress)
+		}
+
+		progress.PageToken = resp.NextPageToken
+		activity.RecordHeartbeat(ctx, progress)
+		if len(progress.PageToken) == 0 {
+			break
+		}
+	}
+
+	logger.Info(fmt.Sprintf("Auto reset finished, scanned: %v, reset: %v, failed: %v",
+		progress.ScannedCount, progress.ResetCount, progress.ErrorCount))
+	return progress, nil
+}
+
+// resetAffectedWorkflow resets the workflow if it is still running and the bad binary is found in its
+// auto reset points, it returns whether the workflow is reset. A run that is already reset is closed,
+// so a retried activity does not reset it again.
+func resetAffectedWorkflow(ctx context.Context, params systemworkflow.AutoResetParams, execution *shared.WorkflowExecution) (bool, error) {
+	batcher := ctx.Value(batcherContextKey).(*Batcher)
+	resp, err := batcher.svcClient.DescribeWorkflowExecution(ctx, &shared.DescribeWorkflowExecutionRequest{
+		Domain:    common.StringPtr(params.DomainName),
+		Execution: execution,
+	})
+	if err != nil {
+		// EntityNotExistsError means wf is deleted
+		if _, ok := err.(*shared.EntityNotExistsError); ok {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if resp.WorkflowExecutionInfo.CloseStatus != nil {
+		return false, nil
+	}
+	resetPoint := findAutoResetPoint(resp.WorkflowExecutionInfo.AutoResetPoints, params.BinaryChecksum, time.Now().UnixNano())
+	if resetPoint == nil {
+		return false, nil
+	}
+
+	_, err = batcher.svcClient.ResetWorkflowExecution(ctx, &shared.ResetWorkflowExecutionRequest{
+		Domain: common.StringPtr(params.DomainName),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: execution.WorkflowId,
+			RunId:      resetPoint.RunId,
+		},
+		Reason:                common.StringPtr(fmt.Sprintf("auto-reset reason:%v, binaryChecksum:%v", params.Reason, params.BinaryChecksum)),
+		DecisionFinishEventId: resetPoint.FirstDecisionCompletedId,
+		RequestId:             common.StringPtr(getAutoResetRequestID(execution.GetRunId(), params.BinaryChecksum)),
+	})
+	if err != nil {
+		// EntityNotExistsError means wf is not running or deleted
+		if _, ok := err.(*shared.EntityNotExistsError); ok {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// getAutoResetRequestID returns the request ID of the reset of the run affected by the bad binary
+func getAutoResetRequestID(runID, binaryChecksum string) string {
+	return uuid.NewSHA1(uuid.NameSpace_OID, []byte(runID+"/"+binaryChecksum)).String()
+}
+
+// findAutoResetPoint returns the first resettable point created by the bad binary
+func findAutoResetPoint(resetPoints *shared.ResetPoints, binaryChecksum string, nowNano int64) *shared.ResetPointInfo {
+	if resetPoints == nil {
+		return nil
+	}
+	for _, p := range resetPoints.Points {
+		if p.GetBinaryChecksum() != binaryChecksum || !p.GetResettable() {
+			continue
+		}
+		if p.GetExpiringTimeNano() > 0 && p.GetExpiringTimeNano() < nowNano {
+			continue
+		}
+		return p
+	}
+	return nil
+}
@@ -0,0 +1,201 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package batcher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log/loggerimpl"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/systemworkflow"
+	"go.uber.org/cadence/.gen/go/cadence/workflowservicetest"
+	"go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/testsuite"
+	"go.uber.org/cadence/worker"
+	"go.uber.org/zap"
+)
+
+type autoResetSuite struct {
+	*require.Assertions
+	suite.Suite
+	testsuite.WorkflowTestSuite
+
+	mockCtrl      *gomock.Controller
+	mockSvcClient *workflowservicetest.MockClient
+}
+
+func TestAutoResetSuite(t *testing.T) {
+	suite.Run(t, new(autoResetSuite))
+}
+
+func (s *autoResetSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.mockCtrl = gomock.NewController(s.T())
+	s.mockSvcClient = workflowservicetest.NewMockClient(s.mockCtrl)
+}
+
+func (s *autoResetSuite) TearDownTest() {
+	s.mockCtrl.Finish()
+}
+
+func (s *autoResetSuite) TestFindAutoResetPoint() {
+	now := time.Now().UnixNano()
+	s.Nil(findAutoResetPoint(nil, "bad", now))
+
+	notResettable := &shared.ResetPointInfo{
+		BinaryChecksum: common.StringPtr("bad"),
+		RunId:          common.StringPtr("run1"),
+		Resettable:     common.BoolPtr(false),
+	}
+	expired := &shared.ResetPointInfo{
+		BinaryChecksum:   common.StringPtr("bad"),
+		RunId:            common.StringPtr("run2"),
+		Resettable:       common.BoolPtr(true),
+		ExpiringTimeNano: common.Int64Ptr(now - 1),
+	}
+	otherBinary := &shared.ResetPointInfo{
+		BinaryChecksum: common.StringPtr("good"),
+		RunId:          common.StringPtr("run3"),
+		Resettable:     common.BoolPtr(true),
+	}
+	first := &shared.ResetPointInfo{
+		BinaryChecksum:   common.StringPtr("bad"),
+		RunId:            common.StringPtr("run4"),
+		Resettable:       common.BoolPtr(true),
+		ExpiringTimeNano: common.Int64Ptr(now + int64(time.Hour)),
+	}
+	second := &shared.ResetPointInfo{
+		BinaryChecksum: common.StringPtr("bad"),
+		RunId:          common.StringPtr("run5"),
+		Resettable:     common.BoolPtr(true),
+	}
+	points := &shared.ResetPoints{Points: []*shared.ResetPointInfo{notResettable, expired, otherBinary, first, second}}
+
+	s.Equal(first, findAutoResetPoint(points, "bad", now))
+	s.Equal(otherBinary, findAutoResetPoint(points, "good", now))
+	s.Nil(findAutoResetPoint(points, "unknown", now))
+	s.Equal(second, findAutoResetPoint(points, "bad", now+int64(2*time.Hour)))
+}
+
+func (s *autoResetSuite) TestAutoResetActivity() {
+	params := systemworkflow.AutoResetParams{
+		DomainName:     "test-domain",
+		BinaryChecksum: "bad",
+		Reason:         "test reason",
+		RPS:            1000,
+	}
+	affected := &shared.WorkflowExecution{WorkflowId: common.StringPtr("wid1"), RunId: common.StringPtr("rid1")}
+	notAffected := &shared.WorkflowExecution{WorkflowId: common.StringPtr("wid2"), RunId: common.StringPtr("rid2")}
+	deleted := &shared.WorkflowExecution{WorkflowId: common.StringPtr("wid3"), RunId: common.StringPtr("rid3")}
+	alreadyReset := &shared.WorkflowExecution{WorkflowId: common.StringPtr("wid4"), RunId: common.StringPtr("rid4")}
+
+	s.mockSvcClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *shared.ListOpenWorkflowExecutionsRequest, _ ...interface{}) (*shared.ListOpenWorkflowExecutionsResponse, error) {
+			s.Equal("test-domain", req.GetDomain())
+			s.True(req.StartTimeFilter.GetLatestTime() > 0)
+			return &shared.ListOpenWorkflowExecutionsResponse{
+				Executions: []*shared.WorkflowExecutionInfo{
+					{Execution: affected},
+					{Execution: notAffected},
+				},
+				NextPageToken: []byte("next"),
+			}, nil
+		})
+	s.mockSvcClient.EXPECT().ListOpenWorkflowExecutions(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *shared.ListOpenWorkflowExecutionsRequest, _ ...interface{}) (*shared.ListOpenWorkflowExecutionsResponse, error) {
+			s.Equal([]byte("next"), req.NextPageToken)
+			return &shared.ListOpenWorkflowExecutionsResponse{
+				Executions: []*shared.WorkflowExecutionInfo{{Execution: deleted}, {Execution: alreadyReset}},
+			}, nil
+		})
+
+	resetPoint := &shared.ResetPointInfo{
+		BinaryChecksum:           common.StringPtr("bad"),
+		RunId:                    common.StringPtr("rid0"),
+		FirstDecisionCompletedId: common.Int64Ptr(4),
+		Resettable:               common.BoolPtr(true),
+	}
+	s.mockSvcClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), &shared.DescribeWorkflowExecutionRequest{
+		Domain:    common.StringPtr("test-domain"),
+		Execution: affected,
+	}, gomock.Any()).Return(&shared.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &shared.WorkflowExecutionInfo{
+			AutoResetPoints: &shared.ResetPoints{Points: []*shared.ResetPointInfo{resetPoint}},
+		},
+	}, nil)
+	s.mockSvcClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), &shared.DescribeWorkflowExecutionRequest{
+		Domain:    common.StringPtr("test-domain"),
+		Execution: notAffected,
+	}, gomock.Any()).Return(&shared.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &shared.WorkflowExecutionInfo{},
+	}, nil)
+	s.mockSvcClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), &shared.DescribeWorkflowExecutionRequest{
+		Domain:    common.StringPtr("test-domain"),
+		Execution: deleted,
+	}, gomock.Any()).Return(nil, &shared.EntityNotExistsError{})
+	// the run closed after it was listed, it is not reset again
+	s.mockSvcClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), &shared.DescribeWorkflowExecutionRequest{
+		Domain:    common.StringPtr("test-domain"),
+		Execution: alreadyReset,
+	}, gomock.Any()).Return(&shared.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &shared.WorkflowExecutionInfo{
+			CloseStatus:     shared.WorkflowExecutionCloseStatusTerminated.Ptr(),
+			AutoResetPoints: &shared.ResetPoints{Points: []*shared.ResetPointInfo{resetPoint}},
+		},
+	}, nil)
+	s.mockSvcClient.EXPECT().ResetWorkflowExecution(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *shared.ResetWorkflowExecutionRequest, _ ...interface{}) (*shared.ResetWorkflowExecutionResponse, error) {
+			s.Equal("test-domain", req.GetDomain())
+			s.Equal("wid1", req.WorkflowExecution.GetWorkflowId())
+			s.Equal("rid0", req.WorkflowExecution.GetRunId())
+			s.Equal(int64(4), req.GetDecisionFinishEventId())
+			s.Contains(req.GetReason(), "bad")
+			s.Equal(getAutoResetRequestID("rid1", "bad"), req.GetRequestId())
+			return &shared.ResetWorkflowExecutionResponse{RunId: common.StringPtr("new-rid")}, nil
+		})
+
+	batcher := &Batcher{
+		svcClient:     s.mockSvcClient,
+		metricsClient: metrics.NewClient(tally.NoopScope, metrics.Worker),
+		logger:        loggerimpl.NewLogger(zap.NewNop()),
+	}
+	env := s.NewTestActivityEnvironment()
+	env.SetTestTimeout(time.Second * 5)
+	env.SetWorkerOptions(worker.Options{
+		BackgroundActivityContext: context.WithValue(context.Background(), batcherContextKey, batcher),
+	})
+	result, err := env.ExecuteActivity(autoResetActivityName, params)
+	s.NoError(err)
+
+	var progress AutoResetProgress
+	s.NoError(result.Get(&progress))
+	s.Equal(4, progress.ScannedCount)
+	s.Equal(1, progress.ResetCount)
+	s.Equal(0, progress.ErrorCount)
+	s.Empty(progress.PageToken)
+}
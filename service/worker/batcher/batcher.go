@@ -34,6 +34,7 @@ import (
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/service/dynamicconfig"
+	"github.com/uber/cadence/common/systemworkflow"
 	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
 	"go.uber.org/cadence/.gen/go/shared"
 	"go.uber.org/cadence/worker"
@@ -113,7 +114,7 @@ func (s *Batcher) Start() error {
 		BackgroundActivityContext: context.WithValue(context.Background(), batcherContextKey, s),
 		Tracer:                    opentracing.GlobalTracer(),
 	}
-	worker := worker.New(s.svcClient, common.SystemGlobalDomainName, BatcherTaskListName, workerOpts)
+	worker := worker.New(s.svcClient, common.SystemGlobalDomainName, systemworkflow.BatcherTaskListName, workerOpts)
 	return worker.Start()
 }
 
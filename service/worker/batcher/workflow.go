@@ -39,8 +39,6 @@ import (
 
 const (
 	batcherContextKey = "batcherContext"
-	// BatcherTaskListName is the tasklist name
-	BatcherTaskListName = "cadence-sys-batcher-tasklist"
 	// BatchWFTypeName is the workflow type
 	BatchWFTypeName   = "cadence-sys-batch-workflow"
 	batchActivityName = "cadence-sys-batch-activity"
@@ -74,16 +72,16 @@ type (
 	// TerminateParams is the parameters for terminating workflow
 	TerminateParams struct {
 		// this indicates whether to terminate children workflow. Default to true.
-		// TODO https://github.com/uber/cadence/issues/2159
-		// Ideally default should be childPolicy of the workflow. But it's currently totally broken.
+		// Children are also handled by history according to their childPolicy once parent is closed if the
+		// domain enables history.enableParentClosePolicy, this option makes it explicit regardless of the childPolicy.
 		TerminateChildren *bool
 	}
 
 	// CancelParams is the parameters for canceling workflow
 	CancelParams struct {
 		// this indicates whether to cancel children workflow. Default to true.
-		// TODO https://github.com/uber/cadence/issues/2159
-		// Ideally default should be childPolicy of the workflow. But it's currently totally broken.
+		// Children are also handled by history according to their childPolicy once parent is closed if the
+		// domain enables history.enableParentClosePolicy, this option makes it explicit regardless of the childPolicy.
 		CancelChildren *bool
 	}
 
@@ -416,8 +414,7 @@ func processTask(
 			continue
 		}
 
-		// TODO https://github.com/uber/cadence/issues/2159
-		// By default should use ChildPolicy, but it is totally broken in Cadence, we need to fix it before using
+		// apply on children explicitly, instead of waiting for history to apply the childPolicy after parent is closed
 		if applyOnChild != nil && *applyOnChild && len(resp.PendingChildren) > 0 {
 			getActivityLogger(ctx).Info("Found more child workflows to process", tag.Number(int64(len(resp.PendingChildren))))
 			for _, ch := range resp.PendingChildren {
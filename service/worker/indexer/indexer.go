@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"github.com/uber/cadence/common"
 	es "github.com/uber/cadence/common/elasticsearch"
+	"github.com/uber/cadence/common/elasticsearch/bulk"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
@@ -34,7 +35,7 @@ import (
 type (
 	// Indexer used to consumer data from kafka then send to ElasticSearch
 	Indexer struct {
-		config              *Config
+		config              *bulk.Config
 		kafkaClient         messaging.Client
 		esClient            es.Client
 		logger              log.Logger
@@ -43,16 +44,6 @@ type (
 		visibilityProcessor *indexProcessor
 		visibilityIndexName string
 	}
-
-	// Config contains all configs for indexer
-	Config struct {
-		IndexerConcurrency       dynamicconfig.IntPropertyFn
-		ESProcessorNumOfWorkers  dynamicconfig.IntPropertyFn
-		ESProcessorBulkActions   dynamicconfig.IntPropertyFn // max number of requests in bulk
-		ESProcessorBulkSize      dynamicconfig.IntPropertyFn // max total size of bytes in bulk
-		ESProcessorFlushInterval dynamicconfig.DurationPropertyFn
-		ValidSearchAttributes    dynamicconfig.MapPropertyFn
-	}
 )
 
 const (
@@ -60,7 +51,7 @@ const (
 )
 
 // NewIndexer create a new Indexer
-func NewIndexer(config *Config, client messaging.Client, esClient es.Client, esConfig *es.Config,
+func NewIndexer(config *bulk.Config, client messaging.Client, esClient es.Client, esConfig *es.Config,
 	logger log.Logger, metricsClient metrics.Client) *Indexer {
 	logger = logger.WithTags(tag.ComponentIndexer)
 
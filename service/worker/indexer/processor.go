@@ -21,15 +21,11 @@
 package indexer
 
 import (
-	"encoding/json"
-	"fmt"
-	"github.com/olivere/elastic"
 	"github.com/uber/cadence/.gen/go/indexer"
-	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/codec"
-	"github.com/uber/cadence/common/definition"
 	es "github.com/uber/cadence/common/elasticsearch"
+	"github.com/uber/cadence/common/elasticsearch/bulk"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/tag"
 	"github.com/uber/cadence/common/messaging"
@@ -45,10 +41,11 @@ type indexProcessor struct {
 	kafkaClient     messaging.Client
 	consumer        messaging.Consumer
 	esClient        es.Client
-	esProcessor     ESProcessor
+	esProcessor     bulk.ESProcessor
+	esWriter        *bulk.VisibilityWriter
 	esProcessorName string
 	esIndexName     string
-	config          *Config
+	config          *bulk.Config
 	logger          log.Logger
 	metricsClient   metrics.Client
 	isStarted       int32
@@ -58,19 +55,8 @@ type indexProcessor struct {
 	msgEncoder      codec.BinaryEncoder
 }
 
-const (
-	esDocIDDelimiter = "~"
-	esDocType        = "_doc"
-
-	versionTypeExternal = "external"
-)
-
-var (
-	errUnknownMessageType = &shared.BadRequestError{Message: "unknown message type"}
-)
-
 func newIndexProcessor(appName, consumerName string, kafkaClient messaging.Client, esClient es.Client,
-	esProcessorName, esIndexName string, config *Config, logger log.Logger, metricsClient metrics.Client) *indexProcessor {
+	esProcessorName, esIndexName string, config *bulk.Config, logger log.Logger, metricsClient metrics.Client) *indexProcessor {
 	return &indexProcessor{
 		appName:         appName,
 		consumerName:    consumerName,
@@ -103,7 +89,7 @@ func (p *indexProcessor) Start() error {
 		return err
 	}
 
-	esProcessor, err := NewESProcessorAndStart(p.config, p.esClient, p.esProcessorName, p.logger, p.metricsClient)
+	esProcessor, err := bulk.NewESProcessorAndStart(p.config, p.esClient, p.esProcessorName, p.logger, p.metricsClient)
 	if err != nil {
 		p.logger.Info("", tag.LifeCycleStartFailed, tag.Error(err))
 		return err
@@ -111,6 +97,7 @@ func (p *indexProcessor) Start() error {
 
 	p.consumer = consumer
 	p.esProcessor = esProcessor
+	p.esWriter = bulk.NewVisibilityWriter(esProcessor, p.esIndexName, p.config, p.logger, p.metricsClient)
 	p.shutdownWG.Add(1)
 	go p.processorPump()
 
@@ -187,7 +174,7 @@ func (p *indexProcessor) process(kafkaMsg messaging.Message) error {
 		return err
 	}
 
-	return p.addMessageToES(indexMsg, kafkaMsg, logger)
+	return p.esWriter.Add(indexMsg, kafkaMsg, logger)
 }
 
 func (p *indexProcessor) deserialize(payload []byte) (*indexer.Message, error) {
@@ -197,102 +184,3 @@ func (p *indexProcessor) deserialize(payload []byte) (*indexer.Message, error) {
 	}
 	return &msg, nil
 }
-
-func (p *indexProcessor) addMessageToES(indexMsg *indexer.Message, kafkaMsg messaging.Message, logger log.Logger) error {
-	docID := indexMsg.GetWorkflowID() + esDocIDDelimiter + indexMsg.GetRunID()
-
-	var keyToKafkaMsg string
-	var req elastic.BulkableRequest
-	switch indexMsg.GetMessageType() {
-	case indexer.MessageTypeIndex:
-		keyToKafkaMsg = fmt.Sprintf("%v-%v", kafkaMsg.Partition(), kafkaMsg.Offset())
-		doc := p.generateESDoc(indexMsg, keyToKafkaMsg)
-		req = elastic.NewBulkIndexRequest().
-			Index(p.esIndexName).
-			Type(esDocType).
-			Id(docID).
-			VersionType(versionTypeExternal).
-			Version(indexMsg.GetVersion()).
-			Doc(doc)
-	case indexer.MessageTypeDelete:
-		keyToKafkaMsg = docID
-		req = elastic.NewBulkDeleteRequest().
-			Index(p.esIndexName).
-			Type(esDocType).
-			Id(docID).
-			VersionType(versionTypeExternal).
-			Version(indexMsg.GetVersion())
-	default:
-		logger.Error("Unknown message type")
-		p.metricsClient.IncCounter(metrics.IndexProcessorScope, metrics.IndexProcessorCorruptedData)
-		return errUnknownMessageType
-	}
-
-	p.esProcessor.Add(req, keyToKafkaMsg, kafkaMsg)
-	return nil
-}
-
-func (p *indexProcessor) generateESDoc(msg *indexer.Message, keyToKafkaMsg string) map[string]interface{} {
-	doc := p.dumpFieldsToMap(msg.Fields)
-	fulfillDoc(doc, msg, keyToKafkaMsg)
-	return doc
-}
-
-func (p *indexProcessor) decodeSearchAttrBinary(bytes []byte, key string) interface{} {
-	var val interface{}
-	err := json.Unmarshal(bytes, &val)
-	if err != nil {
-		p.logger.Error("Error when decode search attributes values.", tag.Error(err), tag.ESField(key))
-		p.metricsClient.IncCounter(metrics.IndexProcessorScope, metrics.IndexProcessorCorruptedData)
-	}
-	return val
-}
-
-func (p *indexProcessor) dumpFieldsToMap(fields map[string]*indexer.Field) map[string]interface{} {
-	doc := make(map[string]interface{})
-	attr := make(map[string]interface{})
-	for k, v := range fields {
-		if !p.isValidFieldToES(k) {
-			p.logger.Error("Unregistered field.", tag.ESField(k))
-			p.metricsClient.IncCounter(metrics.IndexProcessorScope, metrics.IndexProcessorCorruptedData)
-			continue
-		}
-
-		switch v.GetType() {
-		case indexer.FieldTypeString:
-			doc[k] = v.GetStringData()
-		case indexer.FieldTypeInt:
-			doc[k] = v.GetIntData()
-		case indexer.FieldTypeBool:
-			doc[k] = v.GetBoolData()
-		case indexer.FieldTypeBinary:
-			if k == definition.Memo {
-				doc[k] = v.GetBinaryData()
-			} else { // custom search attributes
-				attr[k] = p.decodeSearchAttrBinary(v.GetBinaryData(), k)
-			}
-		default:
-			// must be bug in code and bad deployment, check data sent from producer
-			p.logger.Fatal("Unknown field type")
-		}
-	}
-	doc[definition.Attr] = attr
-	return doc
-}
-
-func (p *indexProcessor) isValidFieldToES(field string) bool {
-	if _, ok := p.config.ValidSearchAttributes()[field]; ok {
-		return true
-	}
-	if field == definition.Memo || field == definition.KafkaKey || field == definition.Encoding {
-		return true
-	}
-	return false
-}
-
-func fulfillDoc(doc map[string]interface{}, msg *indexer.Message, keyToKafkaMsg string) {
-	doc[definition.DomainID] = msg.GetDomainID()
-	doc[definition.WorkflowID] = msg.GetWorkflowID()
-	doc[definition.RunID] = msg.GetRunID()
-	doc[definition.KafkaKey] = keyToKafkaMsg
-}
@@ -232,6 +232,9 @@ func (domainReplicator *domainReplicatorImpl) handleDomainUpdateReplicationTask(
 		if task.Config.GetBadBinaries() != nil {
 			request.Config.BadBinaries = *task.Config.GetBadBinaries()
 		}
+		if task.Config.GetWorkerVersioning() != nil {
+			request.Config.WorkerVersioning = *task.Config.GetWorkerVersioning()
+		}
 		request.ReplicationConfig.Clusters = domainReplicator.convertClusterReplicationConfigFromThrift(task.ReplicationConfig.Clusters)
 		request.ConfigVersion = task.GetConfigVersion()
 	}
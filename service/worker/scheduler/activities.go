@@ -0,0 +1,192 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pborman/uuid"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/activity"
+)
+
+const (
+	longPollTimeout = time.Minute
+)
+
+// startWorkflowActivity starts the workflow of a schedule action, the ID of the started workflow is derived
+// from the nominal time of the action so that retries never start the same action twice
+func startWorkflowActivity(ctx context.Context, params startWorkflowParams) (*gen.WorkflowExecution, error) {
+	scheduler := ctx.Value(schedulerContextKey).(*Scheduler)
+	scope := scheduler.metricsClient.Scope(metrics.SchedulerScope, metrics.DomainTag(params.DomainName))
+
+	request, err := getStartWorkflowRequest(params)
+	if err != nil {
+		return nil, err
+	}
+	execution := &gen.WorkflowExecution{
+		WorkflowId: request.WorkflowId,
+	}
+	resp, err := scheduler.svcClient.StartWorkflowExecution(ctx, request)
+	switch err := err.(type) {
+	case nil:
+		execution.RunId = resp.RunId
+	case *shared.WorkflowExecutionAlreadyStartedError:
+		execution.RunId = err.RunId
+	default:
+		scope.IncCounter(metrics.SchedulerActionFailures)
+		getActivityLogger(ctx).Error("Failed to start scheduled workflow", tag.Error(err))
+		return nil, err
+	}
+	scope.IncCounter(metrics.SchedulerActionsStarted)
+	return execution, nil
+}
+
+// isWorkflowRunningActivity returns whether the given workflow is still open
+func isWorkflowRunningActivity(ctx context.Context, domainName string, execution gen.WorkflowExecution) (bool, error) {
+	scheduler := ctx.Value(schedulerContextKey).(*Scheduler)
+	resp, err := scheduler.svcClient.DescribeWorkflowExecution(ctx, &shared.DescribeWorkflowExecutionRequest{
+		Domain: common.StringPtr(domainName),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: execution.WorkflowId,
+			RunId:      execution.RunId,
+		},
+	})
+	switch err.(type) {
+	case nil:
+		return resp.WorkflowExecutionInfo.CloseStatus == nil, nil
+	case *shared.EntityNotExistsError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// cancelWorkflowActivity requests cancellation of the given workflow
+func cancelWorkflowActivity(ctx context.Context, domainName string, execution gen.WorkflowExecution) error {
+	scheduler := ctx.Value(schedulerContextKey).(*Scheduler)
+	err := scheduler.svcClient.RequestCancelWorkflowExecution(ctx, &shared.RequestCancelWorkflowExecutionRequest{
+		Domain: common.StringPtr(domainName),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: execution.WorkflowId,
+			RunId:      execution.RunId,
+		},
+		Identity:  common.StringPtr(schedulerIdentity),
+		RequestId: common.StringPtr(uuid.New()),
+	})
+	switch err.(type) {
+	case nil, *shared.EntityNotExistsError, *shared.CancellationAlreadyRequestedError:
+		return nil
+	default:
+		return err
+	}
+}
+
+// waitForWorkflowCloseActivity long polls the history of the given workflow until its close event shows up
+func waitForWorkflowCloseActivity(ctx context.Context, domainName string, execution gen.WorkflowExecution) error {
+	scheduler := ctx.Value(schedulerContextKey).(*Scheduler)
+	var nextPageToken []byte
+	for {
+		pollCtx, cancel := context.WithTimeout(ctx, longPollTimeout)
+		resp, err := scheduler.svcClient.GetWorkflowExecutionHistory(pollCtx, &shared.GetWorkflowExecutionHistoryRequest{
+			Domain: common.StringPtr(domainName),
+			Execution: &shared.WorkflowExecution{
+				WorkflowId: execution.WorkflowId,
+				RunId:      execution.RunId,
+			},
+			WaitForNewEvent:        common.BoolPtr(true),
+			HistoryEventFilterType: shared.HistoryEventFilterTypeCloseEvent.Ptr(),
+			NextPageToken:          nextPageToken,
+		})
+		cancel()
+		switch err.(type) {
+		case nil:
+		case *shared.EntityNotExistsError:
+			return nil
+		default:
+			return err
+		}
+		if resp.History != nil && len(resp.History.Events) > 0 {
+			return nil
+		}
+		nextPageToken = resp.NextPageToken
+
+		activity.RecordHeartbeat(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+}
+
+func getStartWorkflowRequest(params startWorkflowParams) (*shared.StartWorkflowExecutionRequest, error) {
+	action := params.Action
+	if action == nil {
+		return nil, fmt.Errorf("schedule %v has no start workflow action", params.ScheduleID)
+	}
+	workflowIDPrefix := action.GetWorkflowIdPrefix()
+	if workflowIDPrefix == "" {
+		workflowIDPrefix = params.ScheduleID
+	}
+	request := &gen.StartWorkflowExecutionRequest{
+		Domain:                              common.StringPtr(params.DomainName),
+		WorkflowId:                          common.StringPtr(fmt.Sprintf("%v-%v", workflowIDPrefix, params.ScheduledTime.UTC().Format(time.RFC3339))),
+		WorkflowType:                        action.WorkflowType,
+		TaskList:                            action.TaskList,
+		Input:                               action.Input,
+		ExecutionStartToCloseTimeoutSeconds: action.ExecutionStartToCloseTimeoutSeconds,
+		TaskStartToCloseTimeoutSeconds:      action.TaskStartToCloseTimeoutSeconds,
+		Identity:                            common.StringPtr(schedulerIdentity),
+		RequestId:                           common.StringPtr(uuid.New()),
+		WorkflowIdReusePolicy:               gen.WorkflowIdReusePolicyRejectDuplicate.Ptr(),
+		RetryPolicy:                         action.RetryPolicy,
+		Memo:                                action.Memo,
+		SearchAttributes:                    action.SearchAttributes,
+		Header:                              action.Header,
+	}
+
+	// the public client only speaks the client side types, convert through the wire representation
+	wireValue, err := request.ToWire()
+	if err != nil {
+		return nil, err
+	}
+	clientRequest := &shared.StartWorkflowExecutionRequest{}
+	if err := clientRequest.FromWire(wireValue); err != nil {
+		return nil, err
+	}
+	return clientRequest, nil
+}
+
+func getActivityLogger(ctx context.Context) log.Logger {
+	scheduler := ctx.Value(schedulerContextKey).(*Scheduler)
+	wfInfo := activity.GetInfo(ctx)
+	return scheduler.logger.WithTags(
+		tag.WorkflowID(wfInfo.WorkflowExecution.ID),
+		tag.WorkflowRunID(wfInfo.WorkflowExecution.RunID),
+		tag.WorkflowDomainName(wfInfo.WorkflowDomain),
+	)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/uber-go/tally"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/log"
+	"github.com/uber/cadence/common/log/tag"
+	"github.com/uber/cadence/common/metrics"
+	"github.com/uber/cadence/common/systemworkflow"
+	"go.uber.org/cadence/.gen/go/cadence/workflowserviceclient"
+	"go.uber.org/cadence/.gen/go/shared"
+	"go.uber.org/cadence/worker"
+)
+
+const (
+	// maximum time waiting for the global system domain before giving up
+	maxStartupTime         = time.Minute * 3
+	backOffInitialInterval = time.Second
+	backOffMaxInterval     = time.Minute
+	rpcTimeout             = time.Second
+)
+
+type (
+	// BootstrapParams contains the set of params needed to bootstrap
+	// the scheduler sub-system
+	BootstrapParams struct {
+		// ServiceClient is an instance of cadence service client
+		ServiceClient workflowserviceclient.Interface
+		// MetricsClient is an instance of metrics object for emitting stats
+		MetricsClient metrics.Client
+		Logger        log.Logger
+		// TallyScope is an instance of tally metrics scope
+		TallyScope tally.Scope
+	}
+
+	// Scheduler is the background sub-system that runs the workflows backing schedules
+	// It is also the context object that get's passed around within the schedule workflows / activities
+	Scheduler struct {
+		svcClient     workflowserviceclient.Interface
+		metricsClient metrics.Client
+		tallyScope    tally.Scope
+		logger        log.Logger
+	}
+)
+
+// New returns a new instance of scheduler daemon Scheduler
+func New(params *BootstrapParams) *Scheduler {
+	return &Scheduler{
+		svcClient:     params.ServiceClient,
+		metricsClient: params.MetricsClient,
+		tallyScope:    params.TallyScope,
+		logger:        params.Logger.WithTags(tag.ComponentScheduler),
+	}
+}
+
+// Start starts the scheduler
+func (s *Scheduler) Start() error {
+	// schedule workflows live in the global system domain, which is registered by the batcher
+	err := s.waitForGlobalSystemDomainWithRetry()
+	if err != nil {
+		return err
+	}
+
+	// start worker for schedule workflows
+	workerOpts := worker.Options{
+		MetricsScope:              s.tallyScope,
+		BackgroundActivityContext: context.WithValue(context.Background(), schedulerContextKey, s),
+		Tracer:                    opentracing.GlobalTracer(),
+	}
+	worker := worker.New(s.svcClient, common.SystemGlobalDomainName, systemworkflow.SchedulerTaskListName, workerOpts)
+	return worker.Start()
+}
+
+func (s *Scheduler) waitForGlobalSystemDomainWithRetry() error {
+	policy := backoff.NewExponentialRetryPolicy(backOffInitialInterval)
+	policy.SetMaximumInterval(backOffMaxInterval)
+	policy.SetExpirationInterval(maxStartupTime)
+	return backoff.Retry(func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+		defer cancel()
+		_, err := s.svcClient.DescribeDomain(ctx, &shared.DescribeDomainRequest{
+			Name: common.StringPtr(common.SystemGlobalDomainName),
+		})
+		return err
+	}, policy, func(err error) bool {
+		return true
+	})
+}
@@ -0,0 +1,458 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"math/rand"
+	"time"
+
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/systemworkflow"
+	"go.uber.org/cadence"
+	"go.uber.org/cadence/activity"
+	"go.uber.org/cadence/workflow"
+	"go.uber.org/zap"
+)
+
+const (
+	schedulerContextKey                 = "schedulerContext"
+	startWorkflowActivityName           = "cadence-sys-schedule-start-workflow-activity"
+	isWorkflowRunningActivityName       = "cadence-sys-schedule-is-workflow-running-activity"
+	cancelWorkflowActivityName          = "cadence-sys-schedule-cancel-workflow-activity"
+	waitForWorkflowCloseActivityName    = "cadence-sys-schedule-wait-for-workflow-close-activity"
+	schedulerIdentity                   = "cadence-sys-scheduler"
+	maxRecentActions                    = 10
+	maxNextActionTimes                  = 5
+	backfillActionsPerIteration         = 10
+	maxIterationsBeforeContinueAsNew    = 100
+	waitForWorkflowCloseHeartbeatTimout = time.Minute
+)
+
+type (
+	startWorkflowParams struct {
+		DomainName    string
+		ScheduleID    string
+		Action        *gen.StartWorkflowAction
+		ScheduledTime time.Time
+	}
+
+	scheduleWorkflow struct {
+		state         systemworkflow.ScheduleState
+		deleted       bool
+		lastRunClosed workflow.Future
+		logger        *zap.Logger
+	}
+)
+
+var (
+	activityRetryPolicy = cadence.RetryPolicy{
+		InitialInterval:    time.Second,
+		BackoffCoefficient: 2,
+		MaximumInterval:    time.Minute,
+		ExpirationInterval: time.Minute * 5,
+	}
+
+	activityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Minute,
+		RetryPolicy:            &activityRetryPolicy,
+	}
+
+	waitForWorkflowCloseActivityOptions = workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    systemworkflow.InfiniteDuration,
+		HeartbeatTimeout:       waitForWorkflowCloseHeartbeatTimout,
+		RetryPolicy: &cadence.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2,
+			MaximumInterval:    time.Minute,
+			ExpirationInterval: systemworkflow.InfiniteDuration,
+		},
+	}
+)
+
+func init() {
+	workflow.RegisterWithOptions(ScheduleWorkflow, workflow.RegisterOptions{Name: systemworkflow.ScheduleWFTypeName})
+	activity.RegisterWithOptions(startWorkflowActivity, activity.RegisterOptions{Name: startWorkflowActivityName})
+	activity.RegisterWithOptions(isWorkflowRunningActivity, activity.RegisterOptions{Name: isWorkflowRunningActivityName})
+	activity.RegisterWithOptions(cancelWorkflowActivity, activity.RegisterOptions{Name: cancelWorkflowActivityName})
+	activity.RegisterWithOptions(waitForWorkflowCloseActivity, activity.RegisterOptions{Name: waitForWorkflowCloseActivityName})
+}
+
+// GetNextActionTimes returns up to count nominal times after the given time at which the schedule takes its action
+func GetNextActionTimes(cronSchedule string, after time.Time, count int) []time.Time {
+	var result []time.Time
+	for len(result) < count {
+		after = backoff.GetNextScheduleTime(cronSchedule, after)
+		if after.IsZero() {
+			break
+		}
+		result = append(result, after)
+	}
+	return result
+}
+
+// ScheduleWorkflow is the workflow that takes the actions of a schedule
+func ScheduleWorkflow(ctx workflow.Context, state systemworkflow.ScheduleState) error {
+	s := &scheduleWorkflow{
+		state:  state,
+		logger: workflow.GetLogger(ctx),
+	}
+	return s.run(ctx)
+}
+
+func (s *scheduleWorkflow) run(ctx workflow.Context) error {
+	if s.state.CreateTime.IsZero() {
+		s.state.CreateTime = workflow.Now(ctx)
+		s.state.UpdateTime = s.state.CreateTime
+	}
+	if s.state.LastFireTime.IsZero() {
+		s.state.LastFireTime = workflow.Now(ctx)
+	}
+	if err := workflow.SetQueryHandler(ctx, systemworkflow.QueryTypeDescribe, func() (*gen.DescribeScheduleResponse, error) {
+		return s.describe(workflow.Now(ctx)), nil
+	}); err != nil {
+		return err
+	}
+
+	ctx = workflow.WithActivityOptions(ctx, activityOptions)
+	updateCh := workflow.GetSignalChannel(ctx, systemworkflow.SignalNameUpdate)
+	pauseCh := workflow.GetSignalChannel(ctx, systemworkflow.SignalNamePause)
+	backfillCh := workflow.GetSignalChannel(ctx, systemworkflow.SignalNameBackfill)
+	deleteCh := workflow.GetSignalChannel(ctx, systemworkflow.SignalNameDelete)
+
+	// the wait on the last run does not survive continue as new
+	if s.state.BufferedTime != nil {
+		s.waitForLastRun(ctx)
+	}
+
+	for i := 0; i < maxIterationsBeforeContinueAsNew && !s.deleted; i++ {
+		var fireTime time.Time
+		runBackfill := false
+		lastRunClosed := false
+
+		selector := workflow.NewSelector(ctx)
+		timerCtx, cancelTimer := workflow.WithCancel(ctx)
+		if !s.state.Paused {
+			now := workflow.Now(ctx)
+			next := s.skipMissedFireTimes(now)
+			if !next.IsZero() {
+				selector.AddFuture(workflow.NewTimer(timerCtx, next.Sub(now)+s.getJitter(ctx, next)), func(f workflow.Future) {
+					if f.Get(ctx, nil) == nil {
+						fireTime = next
+					}
+				})
+			}
+		}
+		selector.AddReceive(updateCh, func(c workflow.Channel, more bool) {
+			var request gen.UpdateScheduleRequest
+			c.Receive(ctx, &request)
+			s.update(ctx, &request)
+		})
+		selector.AddReceive(pauseCh, func(c workflow.Channel, more bool) {
+			var request gen.PauseScheduleRequest
+			c.Receive(ctx, &request)
+			s.pause(ctx, &request)
+		})
+		selector.AddReceive(backfillCh, func(c workflow.Channel, more bool) {
+			var request gen.BackfillScheduleRequest
+			c.Receive(ctx, &request)
+			s.addBackfill(&request)
+		})
+		selector.AddReceive(deleteCh, func(c workflow.Channel, more bool) {
+			c.Receive(ctx, nil)
+			s.deleted = true
+		})
+		if s.lastRunClosed != nil {
+			selector.AddFuture(s.lastRunClosed, func(f workflow.Future) {
+				if err := f.Get(ctx, nil); err != nil {
+					s.logger.Error("failed to wait for last run to close", zap.Error(err))
+				}
+				s.lastRunClosed = nil
+				lastRunClosed = true
+			})
+		}
+		if len(s.state.PendingBackfills) > 0 {
+			// backfill is taken in pages, so that signals and the schedule itself are not blocked by it
+			selector.AddDefault(func() {
+				runBackfill = true
+			})
+		}
+		selector.Select(ctx)
+		cancelTimer()
+
+		if s.deleted {
+			break
+		}
+		if !fireTime.IsZero() {
+			s.state.LastFireTime = fireTime
+			s.takeAction(ctx, fireTime, s.state.Policies.GetOverlapPolicy())
+		}
+		if runBackfill {
+			s.backfill(ctx)
+		}
+		if lastRunClosed && s.state.BufferedTime != nil {
+			bufferedTime := *s.state.BufferedTime
+			s.state.BufferedTime = nil
+			s.takeAction(ctx, bufferedTime, gen.ScheduleOverlapPolicyBufferOne)
+		}
+	}
+
+	if !s.deleted {
+		s.drainSignals(ctx, updateCh, pauseCh, backfillCh, deleteCh)
+	}
+	if s.deleted {
+		s.logger.Info("Schedule deleted", zap.String("domain", s.state.DomainName), zap.String("schedule-id", s.state.ScheduleID))
+		return nil
+	}
+	return workflow.NewContinueAsNewError(ctx, systemworkflow.ScheduleWFTypeName, s.state)
+}
+
+// drainSignals handles the signals received since the last iteration so that none of them is lost on continue as new
+func (s *scheduleWorkflow) drainSignals(ctx workflow.Context, updateCh, pauseCh, backfillCh, deleteCh workflow.Channel) {
+	for {
+		var update gen.UpdateScheduleRequest
+		var pause gen.PauseScheduleRequest
+		var backfill gen.BackfillScheduleRequest
+		switch {
+		case deleteCh.ReceiveAsync(nil):
+			s.deleted = true
+			return
+		case updateCh.ReceiveAsync(&update):
+			s.update(ctx, &update)
+		case pauseCh.ReceiveAsync(&pause):
+			s.pause(ctx, &pause)
+		case backfillCh.ReceiveAsync(&backfill):
+			s.addBackfill(&backfill)
+		default:
+			return
+		}
+	}
+}
+
+func (s *scheduleWorkflow) update(ctx workflow.Context, request *gen.UpdateScheduleRequest) {
+	if request.Spec != nil {
+		s.state.Spec = request.Spec
+		// the new spec only applies from now on
+		s.state.LastFireTime = workflow.Now(ctx)
+	}
+	if request.Action != nil {
+		s.state.Action = request.Action
+	}
+	if request.Policies != nil {
+		s.state.Policies = request.Policies
+	}
+	s.state.UpdateTime = workflow.Now(ctx)
+}
+
+func (s *scheduleWorkflow) pause(ctx workflow.Context, request *gen.PauseScheduleRequest) {
+	if s.state.Paused && !request.GetPause() {
+		// fire times while paused are not missed
+		s.state.LastFireTime = workflow.Now(ctx)
+	}
+	s.state.Paused = request.GetPause()
+	s.state.PauseReason = request.GetReason()
+	s.state.UpdateTime = workflow.Now(ctx)
+}
+
+// skipMissedFireTimes records the fire times between the last fire time and now as skipped, they were missed
+// while the schedule was busy taking an action, and returns the next fire time
+func (s *scheduleWorkflow) skipMissedFireTimes(now time.Time) time.Time {
+	cronExpression := s.state.Spec.GetCronExpression()
+	next := backoff.GetNextScheduleTime(cronExpression, s.state.LastFireTime)
+	for !next.IsZero() && next.Before(now) {
+		s.skipAction(next)
+		s.state.LastFireTime = next
+		next = backoff.GetNextScheduleTime(cronExpression, next)
+	}
+	return next
+}
+
+// getJitter returns the random delay of the action at the given fire time, the delay never
+// reaches the following fire time
+func (s *scheduleWorkflow) getJitter(ctx workflow.Context, fireTime time.Time) time.Duration {
+	maxJitter := time.Duration(s.state.Spec.GetMaxJitterSeconds()) * time.Second
+	if maxJitter <= 0 {
+		return 0
+	}
+	following := backoff.GetNextScheduleTime(s.state.Spec.GetCronExpression(), fireTime)
+	if interval := following.Sub(fireTime); !following.IsZero() && interval < maxJitter {
+		maxJitter = interval
+	}
+	var jitter time.Duration
+	workflow.SideEffect(ctx, func(ctx workflow.Context) interface{} {
+		return time.Duration(rand.Int63n(int64(maxJitter)))
+	}).Get(&jitter)
+	return jitter
+}
+
+func (s *scheduleWorkflow) addBackfill(request *gen.BackfillScheduleRequest) {
+	if request.GetStartTime() >= request.GetEndTime() {
+		return
+	}
+	s.state.PendingBackfills = append(s.state.PendingBackfills, request)
+}
+
+// backfill takes the next page of actions the schedule would have taken in (startTime, endTime]
+// of the pending backfills
+func (s *scheduleWorkflow) backfill(ctx workflow.Context) {
+	for numActions := 0; numActions < backfillActionsPerIteration && len(s.state.PendingBackfills) > 0; {
+		request := s.state.PendingBackfills[0]
+		policy := s.state.Policies.GetOverlapPolicy()
+		if request.OverlapPolicy != nil {
+			policy = request.GetOverlapPolicy()
+		}
+		endTime := time.Unix(0, request.GetEndTime())
+		fireTime := backoff.GetNextScheduleTime(s.state.Spec.GetCronExpression(), time.Unix(0, request.GetStartTime()))
+		if fireTime.IsZero() || fireTime.After(endTime) {
+			s.state.PendingBackfills = s.state.PendingBackfills[1:]
+			continue
+		}
+		request.StartTime = common.Int64Ptr(fireTime.UnixNano())
+		s.takeAction(ctx, fireTime, policy)
+		numActions++
+	}
+}
+
+// skipAction records that the action at the given nominal time was not taken
+func (s *scheduleWorkflow) skipAction(scheduledTime time.Time) {
+	s.state.NumSkippedActions++
+	s.state.RecentSkippedTimes = append(s.state.RecentSkippedTimes, scheduledTime)
+	if len(s.state.RecentSkippedTimes) > maxRecentActions {
+		s.state.RecentSkippedTimes = s.state.RecentSkippedTimes[len(s.state.RecentSkippedTimes)-maxRecentActions:]
+	}
+}
+
+// takeAction starts the workflow of the schedule for the given nominal time, applying the overlap policy
+func (s *scheduleWorkflow) takeAction(ctx workflow.Context, scheduledTime time.Time, policy gen.ScheduleOverlapPolicy) {
+	if s.state.LastRun != nil && policy != gen.ScheduleOverlapPolicyAllowAll {
+		var running bool
+		if err := workflow.ExecuteActivity(ctx, isWorkflowRunningActivityName, s.state.DomainName, *s.state.LastRun).Get(ctx, &running); err != nil {
+			s.logger.Error("failed to check whether last run is running, skipping action", zap.Error(err))
+			s.skipAction(scheduledTime)
+			return
+		}
+		if running {
+			switch policy {
+			case gen.ScheduleOverlapPolicyBufferOne:
+				if s.state.BufferedTime != nil {
+					s.skipAction(*s.state.BufferedTime)
+				}
+				s.state.BufferedTime = &scheduledTime
+				s.waitForLastRun(ctx)
+				return
+			case gen.ScheduleOverlapPolicyCancelOther:
+				if !s.cancelLastRun(ctx) {
+					s.skipAction(scheduledTime)
+					return
+				}
+			default:
+				s.skipAction(scheduledTime)
+				return
+			}
+		}
+	}
+
+	params := startWorkflowParams{
+		DomainName:    s.state.DomainName,
+		ScheduleID:    s.state.ScheduleID,
+		Action:        s.state.Action.GetStartWorkflow(),
+		ScheduledTime: scheduledTime,
+	}
+	var execution gen.WorkflowExecution
+	if err := workflow.ExecuteActivity(ctx, startWorkflowActivityName, params).Get(ctx, &execution); err != nil {
+		s.logger.Error("failed to start workflow, skipping action", zap.Error(err))
+		s.skipAction(scheduledTime)
+		return
+	}
+
+	s.state.LastRun = &execution
+	s.state.NumActions++
+	s.state.RecentActions = append(s.state.RecentActions, &gen.ScheduleActionResult{
+		ScheduledTime:   common.Int64Ptr(scheduledTime.UnixNano()),
+		ActualTime:      common.Int64Ptr(workflow.Now(ctx).UnixNano()),
+		StartedWorkflow: &execution,
+	})
+	if len(s.state.RecentActions) > maxRecentActions {
+		s.state.RecentActions = s.state.RecentActions[len(s.state.RecentActions)-maxRecentActions:]
+	}
+}
+
+// cancelLastRun requests cancellation of the last run and waits until it is closed, so that the
+// next run never overlaps with it
+func (s *scheduleWorkflow) cancelLastRun(ctx workflow.Context) bool {
+	if err := workflow.ExecuteActivity(ctx, cancelWorkflowActivityName, s.state.DomainName, *s.state.LastRun).Get(ctx, nil); err != nil {
+		s.logger.Error("failed to cancel last run, skipping action", zap.Error(err))
+		return false
+	}
+	s.waitForLastRun(ctx)
+	err := s.lastRunClosed.Get(ctx, nil)
+	s.lastRunClosed = nil
+	if err != nil {
+		s.logger.Error("failed to wait for last run to close, skipping action", zap.Error(err))
+		return false
+	}
+	// the action buffered until the last run closes is superseded by this one
+	if s.state.BufferedTime != nil {
+		s.skipAction(*s.state.BufferedTime)
+		s.state.BufferedTime = nil
+	}
+	return true
+}
+
+func (s *scheduleWorkflow) waitForLastRun(ctx workflow.Context) {
+	if s.lastRunClosed != nil || s.state.LastRun == nil {
+		return
+	}
+	waitCtx := workflow.WithActivityOptions(ctx, waitForWorkflowCloseActivityOptions)
+	s.lastRunClosed = workflow.ExecuteActivity(waitCtx, waitForWorkflowCloseActivityName, s.state.DomainName, *s.state.LastRun)
+}
+
+func (s *scheduleWorkflow) describe(now time.Time) *gen.DescribeScheduleResponse {
+	info := &gen.ScheduleInfo{
+		NumActions:        common.Int64Ptr(s.state.NumActions),
+		NumSkippedActions: common.Int64Ptr(s.state.NumSkippedActions),
+		RecentActions:     s.state.RecentActions,
+		CreateTime:        common.Int64Ptr(s.state.CreateTime.UnixNano()),
+		UpdateTime:        common.Int64Ptr(s.state.UpdateTime.UnixNano()),
+		BufferedAction:    common.BoolPtr(s.state.BufferedTime != nil),
+	}
+	for _, t := range s.state.RecentSkippedTimes {
+		info.RecentSkippedTimes = append(info.RecentSkippedTimes, t.UnixNano())
+	}
+	if !s.state.Paused {
+		for _, t := range GetNextActionTimes(s.state.Spec.GetCronExpression(), now, maxNextActionTimes) {
+			info.NextActionTimes = append(info.NextActionTimes, t.UnixNano())
+		}
+	}
+	return &gen.DescribeScheduleResponse{
+		Spec:     s.state.Spec,
+		Action:   s.state.Action,
+		Policies: s.state.Policies,
+		State: &gen.ScheduleState{
+			Paused:      common.BoolPtr(s.state.Paused),
+			PauseReason: common.StringPtr(s.state.PauseReason),
+		},
+		Info: info,
+	}
+}
@@ -0,0 +1,326 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/systemworkflow"
+	"go.uber.org/cadence/testsuite"
+)
+
+type scheduleWorkflowTestSuite struct {
+	suite.Suite
+	testsuite.WorkflowTestSuite
+}
+
+func TestScheduleWorkflowTestSuite(t *testing.T) {
+	suite.Run(t, new(scheduleWorkflowTestSuite))
+}
+
+func (s *scheduleWorkflowTestSuite) newTestWorkflowEnvironment() *testsuite.TestWorkflowEnvironment {
+	env := s.NewTestWorkflowEnvironment()
+	// keep the timers firing at the same offsets from the start of the workflow
+	env.SetStartTime(time.Date(2019, 7, 1, 10, 0, 10, 0, time.UTC))
+	return env
+}
+
+func (s *scheduleWorkflowTestSuite) newState(policy gen.ScheduleOverlapPolicy) systemworkflow.ScheduleState {
+	return systemworkflow.ScheduleState{
+		DomainName: "test-domain",
+		ScheduleID: "test-schedule",
+		Spec:       &gen.ScheduleSpec{CronExpression: common.StringPtr("* * * * *")},
+		Action: &gen.ScheduleAction{
+			StartWorkflow: &gen.StartWorkflowAction{
+				WorkflowType:                        &gen.WorkflowType{Name: common.StringPtr("test-workflow-type")},
+				TaskList:                            &gen.TaskList{Name: common.StringPtr("test-task-list")},
+				ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(60),
+				TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+			},
+		},
+		Policies: &gen.SchedulePolicies{OverlapPolicy: policy.Ptr()},
+	}
+}
+
+func (s *scheduleWorkflowTestSuite) describe(env *testsuite.TestWorkflowEnvironment) *gen.DescribeScheduleResponse {
+	value, err := env.QueryWorkflow(systemworkflow.QueryTypeDescribe)
+	s.NoError(err)
+	var resp gen.DescribeScheduleResponse
+	s.NoError(value.Get(&resp))
+	return &resp
+}
+
+func (s *scheduleWorkflowTestSuite) TestTakesActionsUntilDeleted() {
+	env := s.newTestWorkflowEnvironment()
+	env.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		&gen.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid")}, nil).Times(3)
+	env.OnActivity(isWorkflowRunningActivityName, mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Times(2)
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(systemworkflow.SignalNameDelete, &gen.DeleteScheduleRequest{})
+	}, time.Minute*3+time.Second*30)
+
+	env.ExecuteWorkflow(systemworkflow.ScheduleWFTypeName, s.newState(gen.ScheduleOverlapPolicySkipNew))
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	env.AssertExpectations(s.T())
+}
+
+func (s *scheduleWorkflowTestSuite) TestSkipNewWhenLastRunIsRunning() {
+	env := s.newTestWorkflowEnvironment()
+	env.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		&gen.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid")}, nil).Once()
+	env.OnActivity(isWorkflowRunningActivityName, mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(systemworkflow.SignalNameDelete, &gen.DeleteScheduleRequest{})
+	}, time.Minute*3+time.Second*30)
+
+	env.ExecuteWorkflow(systemworkflow.ScheduleWFTypeName, s.newState(gen.ScheduleOverlapPolicySkipNew))
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	resp := s.describe(env)
+	s.Equal(int64(1), resp.Info.GetNumActions())
+	s.Equal(int64(2), resp.Info.GetNumSkippedActions())
+	s.Len(resp.Info.RecentActions, 1)
+	s.Equal([]int64{
+		time.Date(2019, 7, 1, 10, 2, 0, 0, time.UTC).UnixNano(),
+		time.Date(2019, 7, 1, 10, 3, 0, 0, time.UTC).UnixNano(),
+	}, resp.Info.RecentSkippedTimes)
+	env.AssertExpectations(s.T())
+}
+
+func (s *scheduleWorkflowTestSuite) TestMissedFireTimesAreSkipped() {
+	env := s.newTestWorkflowEnvironment()
+	// each action takes 90s, so the 10:02 and 10:04 fire times are missed
+	env.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).After(time.Second*90).Return(
+		&gen.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid")}, nil).Times(2)
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(systemworkflow.SignalNameDelete, &gen.DeleteScheduleRequest{})
+	}, time.Minute*3+time.Second*30)
+
+	env.ExecuteWorkflow(systemworkflow.ScheduleWFTypeName, s.newState(gen.ScheduleOverlapPolicyAllowAll))
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	resp := s.describe(env)
+	s.Equal(int64(2), resp.Info.GetNumActions())
+	s.Equal(int64(2), resp.Info.GetNumSkippedActions())
+	s.Equal([]int64{
+		time.Date(2019, 7, 1, 10, 2, 0, 0, time.UTC).UnixNano(),
+		time.Date(2019, 7, 1, 10, 4, 0, 0, time.UTC).UnixNano(),
+	}, resp.Info.RecentSkippedTimes)
+	s.Equal(time.Date(2019, 7, 1, 10, 3, 0, 0, time.UTC).UnixNano(), resp.Info.RecentActions[1].GetScheduledTime())
+	env.AssertExpectations(s.T())
+}
+
+func (s *scheduleWorkflowTestSuite) TestCancelOtherWaitsForLastRunToClose() {
+	env := s.newTestWorkflowEnvironment()
+	env.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		&gen.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid")}, nil).Times(2)
+	env.OnActivity(isWorkflowRunningActivityName, mock.Anything, mock.Anything, mock.Anything).Return(true, nil).Once()
+	env.OnActivity(cancelWorkflowActivityName, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	env.OnActivity(waitForWorkflowCloseActivityName, mock.Anything, mock.Anything, mock.Anything).After(time.Second * 20).Return(nil).Once()
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(systemworkflow.SignalNameDelete, &gen.DeleteScheduleRequest{})
+	}, time.Minute*2+time.Second*30)
+
+	env.ExecuteWorkflow(systemworkflow.ScheduleWFTypeName, s.newState(gen.ScheduleOverlapPolicyCancelOther))
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	resp := s.describe(env)
+	s.Equal(int64(2), resp.Info.GetNumActions())
+	s.Equal(int64(0), resp.Info.GetNumSkippedActions())
+	// the second run is started only after the cancelled run closed
+	s.Equal(time.Date(2019, 7, 1, 10, 2, 20, 0, time.UTC).UnixNano(), resp.Info.RecentActions[1].GetActualTime())
+	env.AssertExpectations(s.T())
+}
+
+func (s *scheduleWorkflowTestSuite) TestJitterAndTimeZone() {
+	env := s.newTestWorkflowEnvironment()
+	state := s.newState(gen.ScheduleOverlapPolicySkipNew)
+	state.Spec = &gen.ScheduleSpec{
+		CronExpression:   common.StringPtr("CRON_TZ=America/New_York 0 7 * * *"),
+		MaxJitterSeconds: common.Int32Ptr(60),
+	}
+	env.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		&gen.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid")}, nil).Once()
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(systemworkflow.SignalNameDelete, &gen.DeleteScheduleRequest{})
+	}, time.Hour+time.Minute*30)
+
+	env.ExecuteWorkflow(systemworkflow.ScheduleWFTypeName, state)
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	resp := s.describe(env)
+	s.Len(resp.Info.RecentActions, 1)
+	// 7:00 in New York is 11:00 UTC in July
+	scheduledTime := time.Date(2019, 7, 1, 11, 0, 0, 0, time.UTC)
+	s.Equal(scheduledTime.UnixNano(), resp.Info.RecentActions[0].GetScheduledTime())
+	actualTime := time.Unix(0, resp.Info.RecentActions[0].GetActualTime())
+	s.False(actualTime.Before(scheduledTime))
+	s.True(actualTime.Before(scheduledTime.Add(time.Minute)))
+	env.AssertExpectations(s.T())
+}
+
+func (s *scheduleWorkflowTestSuite) TestBufferOneStartsWhenLastRunCloses() {
+	env := s.newTestWorkflowEnvironment()
+	env.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		&gen.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid")}, nil).Times(3)
+	env.OnActivity(isWorkflowRunningActivityName, mock.Anything, mock.Anything, mock.Anything).Return(true, nil).Once()
+	env.OnActivity(isWorkflowRunningActivityName, mock.Anything, mock.Anything, mock.Anything).Return(false, nil)
+	env.OnActivity(waitForWorkflowCloseActivityName, mock.Anything, mock.Anything, mock.Anything).After(time.Second * 20).Return(nil).Once()
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(systemworkflow.SignalNameDelete, &gen.DeleteScheduleRequest{})
+	}, time.Minute*3+time.Second*30)
+
+	env.ExecuteWorkflow(systemworkflow.ScheduleWFTypeName, s.newState(gen.ScheduleOverlapPolicyBufferOne))
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	resp := s.describe(env)
+	s.Equal(int64(3), resp.Info.GetNumActions())
+	s.Equal(int64(0), resp.Info.GetNumSkippedActions())
+	s.False(resp.Info.GetBufferedAction())
+	env.AssertExpectations(s.T())
+}
+
+func (s *scheduleWorkflowTestSuite) TestPausedScheduleTakesNoAction() {
+	env := s.newTestWorkflowEnvironment()
+	state := s.newState(gen.ScheduleOverlapPolicySkipNew)
+	state.Paused = true
+	state.PauseReason = "test-reason"
+	env.RegisterDelayedCallback(func() {
+		resp := s.describe(env)
+		s.True(resp.State.GetPaused())
+		s.Equal("test-reason", resp.State.GetPauseReason())
+		s.Empty(resp.Info.NextActionTimes)
+		env.SignalWorkflow(systemworkflow.SignalNameDelete, &gen.DeleteScheduleRequest{})
+	}, time.Minute*3)
+
+	env.ExecuteWorkflow(systemworkflow.ScheduleWFTypeName, state)
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	s.Equal(int64(0), s.describe(env).Info.GetNumActions())
+}
+
+func (s *scheduleWorkflowTestSuite) TestUnpauseAndUpdate() {
+	env := s.newTestWorkflowEnvironment()
+	state := s.newState(gen.ScheduleOverlapPolicySkipNew)
+	state.Paused = true
+	env.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		&gen.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid")}, nil).Once()
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(systemworkflow.SignalNameUpdate, &gen.UpdateScheduleRequest{
+			Spec: &gen.ScheduleSpec{CronExpression: common.StringPtr("0 * * * *")},
+		})
+		env.SignalWorkflow(systemworkflow.SignalNamePause, &gen.PauseScheduleRequest{Pause: common.BoolPtr(false)})
+	}, time.Minute)
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(systemworkflow.SignalNameDelete, &gen.DeleteScheduleRequest{})
+	}, time.Hour+time.Minute*2)
+
+	env.ExecuteWorkflow(systemworkflow.ScheduleWFTypeName, state)
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	resp := s.describe(env)
+	s.Equal("0 * * * *", resp.Spec.GetCronExpression())
+	s.False(resp.State.GetPaused())
+	s.Equal(int64(1), resp.Info.GetNumActions())
+	env.AssertExpectations(s.T())
+}
+
+func (s *scheduleWorkflowTestSuite) TestBackfill() {
+	env := s.newTestWorkflowEnvironment()
+	state := s.newState(gen.ScheduleOverlapPolicySkipNew)
+	state.Paused = true
+	env.OnActivity(startWorkflowActivityName, mock.Anything, mock.Anything).Return(
+		&gen.WorkflowExecution{WorkflowId: common.StringPtr("wid"), RunId: common.StringPtr("rid")}, nil).Times(25)
+	env.RegisterDelayedCallback(func() {
+		// more actions than taken in one iteration, so the backfill is paged
+		endTime := time.Date(2019, 7, 1, 10, 25, 0, 0, time.UTC)
+		env.SignalWorkflow(systemworkflow.SignalNameBackfill, &gen.BackfillScheduleRequest{
+			StartTime:     common.Int64Ptr(endTime.Add(-time.Minute * 25).UnixNano()),
+			EndTime:       common.Int64Ptr(endTime.UnixNano()),
+			OverlapPolicy: gen.ScheduleOverlapPolicyAllowAll.Ptr(),
+		})
+	}, time.Minute)
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(systemworkflow.SignalNameDelete, &gen.DeleteScheduleRequest{})
+	}, time.Minute*2)
+
+	env.ExecuteWorkflow(systemworkflow.ScheduleWFTypeName, state)
+
+	s.True(env.IsWorkflowCompleted())
+	s.NoError(env.GetWorkflowError())
+	resp := s.describe(env)
+	s.Equal(int64(25), resp.Info.GetNumActions())
+	s.Len(resp.Info.RecentActions, maxRecentActions)
+	s.Equal(time.Date(2019, 7, 1, 10, 25, 0, 0, time.UTC).UnixNano(), resp.Info.RecentActions[maxRecentActions-1].GetScheduledTime())
+	env.AssertExpectations(s.T())
+}
+
+func (s *scheduleWorkflowTestSuite) TestGetStartWorkflowRequest() {
+	scheduledTime := time.Date(2019, 7, 1, 10, 5, 0, 0, time.UTC)
+	action := s.newState(gen.ScheduleOverlapPolicySkipNew).Action.StartWorkflow
+	request, err := getStartWorkflowRequest(startWorkflowParams{
+		DomainName:    "test-domain",
+		ScheduleID:    "test-schedule",
+		Action:        action,
+		ScheduledTime: scheduledTime,
+	})
+	s.NoError(err)
+	s.Equal("test-domain", request.GetDomain())
+	s.Equal("test-schedule-2019-07-01T10:05:00Z", request.GetWorkflowId())
+	s.Equal("test-workflow-type", request.WorkflowType.GetName())
+	s.Equal("test-task-list", request.TaskList.GetName())
+	s.Equal(int32(60), request.GetExecutionStartToCloseTimeoutSeconds())
+
+	action.WorkflowIdPrefix = common.StringPtr("prefix")
+	request, err = getStartWorkflowRequest(startWorkflowParams{
+		ScheduleID:    "test-schedule",
+		Action:        action,
+		ScheduledTime: scheduledTime,
+	})
+	s.NoError(err)
+	s.Equal("prefix-2019-07-01T10:05:00Z", request.GetWorkflowId())
+}
+
+func (s *scheduleWorkflowTestSuite) TestGetNextActionTimes() {
+	after := time.Date(2019, 7, 1, 10, 5, 30, 0, time.UTC)
+	times := GetNextActionTimes("*/10 * * * *", after, 3)
+	s.Equal([]time.Time{
+		time.Date(2019, 7, 1, 10, 10, 0, 0, time.UTC),
+		time.Date(2019, 7, 1, 10, 20, 0, 0, time.UTC),
+		time.Date(2019, 7, 1, 10, 30, 0, 0, time.UTC),
+	}, times)
+	s.Empty(GetNextActionTimes("invalid", after, 3))
+	s.Equal("cadence-sys-schedule-domain/schedule", systemworkflow.GetScheduleWorkflowID("domain", "schedule"))
+}
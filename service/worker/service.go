@@ -29,6 +29,8 @@ import (
 	carchiver "github.com/uber/cadence/common/archiver"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/definition"
+	"github.com/uber/cadence/common/elasticsearch/bulk"
+	"github.com/uber/cadence/common/healthcheck"
 	"github.com/uber/cadence/common/log"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/log/tag"
@@ -43,6 +45,7 @@ import (
 	"github.com/uber/cadence/service/worker/indexer"
 	"github.com/uber/cadence/service/worker/replicator"
 	"github.com/uber/cadence/service/worker/scanner"
+	"github.com/uber/cadence/service/worker/scheduler"
 )
 
 type (
@@ -63,11 +66,12 @@ type (
 	Config struct {
 		ReplicationCfg  *replicator.Config
 		ArchiverConfig  *archiver.Config
-		IndexerCfg      *indexer.Config
+		IndexerCfg      *bulk.Config
 		ScannerCfg      *scanner.Config
 		BatcherCfg      *batcher.Config
 		ThrottledLogRPS dynamicconfig.IntPropertyFn
 		EnableBatcher   dynamicconfig.BoolPropertyFn
+		EnableScheduler dynamicconfig.BoolPropertyFn
 	}
 )
 
@@ -104,7 +108,7 @@ func NewConfig(params *service.BootstrapParams) *Config {
 			ArchivalsPerIteration:         dc.GetIntProperty(dynamicconfig.WorkerArchivalsPerIteration, 1000),
 			TimeLimitPerArchivalIteration: dc.GetDurationProperty(dynamicconfig.WorkerTimeLimitPerArchivalIteration, archiver.MaxArchivalIterationTimeout()),
 		},
-		IndexerCfg: &indexer.Config{
+		IndexerCfg: &bulk.Config{
 			IndexerConcurrency:       dc.GetIntProperty(dynamicconfig.WorkerIndexerConcurrency, 1000),
 			ESProcessorNumOfWorkers:  dc.GetIntProperty(dynamicconfig.WorkerESProcessorNumOfWorkers, 1),
 			ESProcessorBulkActions:   dc.GetIntProperty(dynamicconfig.WorkerESProcessorBulkActions, 1000),
@@ -122,6 +126,7 @@ func NewConfig(params *service.BootstrapParams) *Config {
 			ClusterMetadata:     params.ClusterMetadata,
 		},
 		EnableBatcher:   dc.GetBoolProperty(dynamicconfig.EnableBatcher, false),
+		EnableScheduler: dc.GetBoolProperty(dynamicconfig.EnableScheduler, false),
 		ThrottledLogRPS: dc.GetIntProperty(dynamicconfig.WorkerThrottledLogRPS, 20),
 	}
 }
@@ -134,7 +139,8 @@ func (s *Service) Start() {
 	s.metricsClient = base.GetMetricsClient()
 	s.logger.Info("service starting", tag.ComponentWorker)
 
-	if s.params.ESConfig.Enable {
+	// indexer is not needed when history writes visibility records into ElasticSearch directly
+	if s.params.ESConfig.IsKafkaRequired() {
 		s.startIndexer(base)
 	}
 
@@ -142,11 +148,13 @@ func (s *Service) Start() {
 	archiverEnabled := base.GetArchivalMetadata().GetHistoryConfig().ClusterConfiguredForArchival()
 	scannerEnabled := s.config.ScannerCfg.Persistence.DefaultStoreType() == config.StoreTypeSQL
 	batcherEnabled := s.config.EnableBatcher()
+	schedulerEnabled := s.config.EnableScheduler()
 
-	if replicatorEnabled || archiverEnabled || scannerEnabled || batcherEnabled {
+	if replicatorEnabled || archiverEnabled || scannerEnabled || batcherEnabled || schedulerEnabled {
 		pConfig := s.params.PersistenceConfig
 		pConfig.SetMaxQPS(pConfig.DefaultStore, s.config.ReplicationCfg.PersistenceMaxQPS())
-		pFactory := persistencefactory.New(&pConfig, s.params.ClusterMetadata.GetCurrentClusterName(), s.metricsClient, s.logger)
+		pFactory := persistencefactory.New(&pConfig, s.params.ClusterMetadata.GetCurrentClusterName(), s.metricsClient, s.params.PayloadEncryptor, s.logger)
+		s.addPersistenceCheck(base, pFactory)
 
 		if archiverEnabled || scannerEnabled {
 			s.ensureSystemDomainExists(pFactory, base.GetClusterMetadata().GetCurrentClusterName())
@@ -163,8 +171,12 @@ func (s *Service) Start() {
 		if batcherEnabled {
 			s.startBatcher(base)
 		}
+		if schedulerEnabled {
+			s.startScheduler(base)
+		}
 	}
 
+	base.GetHealthCheckServer().SetServing(true)
 	s.logger.Info("service started", tag.ComponentWorker)
 	<-s.stopC
 	base.Stop()
@@ -193,6 +205,19 @@ func (s *Service) startBatcher(base service.Service) {
 	}
 }
 
+func (s *Service) startScheduler(base service.Service) {
+	params := &scheduler.BootstrapParams{
+		ServiceClient: s.params.PublicClient,
+		MetricsClient: s.metricsClient,
+		Logger:        s.logger,
+		TallyScope:    s.params.MetricScope,
+	}
+	scheduler := scheduler.New(params)
+	if err := scheduler.Start(); err != nil {
+		s.logger.Fatal("error starting scheduler", tag.Error(err))
+	}
+}
+
 func (s *Service) startScanner(base service.Service) {
 	params := &scanner.BootstrapParams{
 		Config:        *s.config.ScannerCfg,
@@ -292,6 +317,14 @@ func (s *Service) startArchiver(base service.Service, pFactory persistencefactor
 	}
 }
 
+func (s *Service) addPersistenceCheck(base service.Service, pFactory persistencefactory.Factory) {
+	metadataMgr, err := pFactory.NewMetadataManager(persistencefactory.MetadataV1V2)
+	if err != nil {
+		s.logger.Fatal("error creating metadata manager for health check", tag.Error(err))
+	}
+	base.GetHealthCheckServer().AddReadinessCheck("persistence", healthcheck.NewPersistenceCheck(metadataMgr))
+}
+
 func (s *Service) ensureSystemDomainExists(pFactory persistencefactory.Factory, clusterName string) {
 	metadataProxy, err := pFactory.NewMetadataManager(persistencefactory.MetadataV1V2)
 	if err != nil {
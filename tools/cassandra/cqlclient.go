@@ -64,6 +64,8 @@ const (
 	readSchemaVersionCQL        = `SELECT curr_version from schema_version where keyspace_name=?`
 	listTablesCQL               = `SELECT table_name from system_schema.tables where keyspace_name=?`
 	listTypesCQL                = `SELECT type_name from system_schema.types where keyspace_name=?`
+	listColumnsCQL              = `SELECT column_name from system_schema.columns where keyspace_name=? and table_name=?`
+	listTypeFieldsCQL           = `SELECT field_names from system_schema.types where keyspace_name=? and type_name=?`
 	writeSchemaVersionCQL       = `INSERT into schema_version(keyspace_name, creation_time, curr_version, min_compatible_version) VALUES (?,?,?,?)`
 	writeSchemaUpdateHistoryCQL = `INSERT into schema_update_history(year, month, update_time, old_version, new_version, manifest_md5, description) VALUES(?,?,?,?,?,?,?)`
 
@@ -215,8 +217,23 @@ func (client *cqlClient) ListTables() ([]string, error) {
 	return names, nil
 }
 
-// listTypes lists the User defined types in a Keyspace
-func (client *cqlClient) listTypes() ([]string, error) {
+// ListColumns lists the column names of a table in a Keyspace
+func (client *cqlClient) ListColumns(table string) ([]string, error) {
+	query := client.session.Query(listColumnsCQL, client.clusterConfig.Keyspace, table)
+	iter := query.Iter()
+	var names []string
+	var name string
+	for iter.Scan(&name) {
+		names = append(names, name)
+	}
+	if err := iter.Close(); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
+// ListTypes lists the User defined types in a Keyspace
+func (client *cqlClient) ListTypes() ([]string, error) {
 	qry := client.session.Query(listTypesCQL, client.clusterConfig.Keyspace)
 	iter := qry.Iter()
 	var names []string
@@ -230,6 +247,16 @@ func (client *cqlClient) listTypes() ([]string, error) {
 	return names, nil
 }
 
+// ListTypeFields lists the field names of a User defined type in a Keyspace
+func (client *cqlClient) ListTypeFields(name string) ([]string, error) {
+	query := client.session.Query(listTypeFieldsCQL, client.clusterConfig.Keyspace, name)
+	var names []string
+	if err := query.Scan(&names); err != nil {
+		return nil, err
+	}
+	return names, nil
+}
+
 // dropTable drops a given table from the Keyspace
 func (client *cqlClient) dropTable(name string) error {
 	return client.Exec(fmt.Sprintf("DROP TABLE %v", name))
@@ -254,7 +281,7 @@ func (client *cqlClient) dropAllTablesTypes() error {
 			log.Printf("Error dropping table %v, err=%v\n", table, err1)
 		}
 	}
-	types, err := client.listTypes()
+	types, err := client.ListTypes()
 	if err != nil {
 		return err
 	}
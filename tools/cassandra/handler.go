@@ -103,13 +103,6 @@ func updateSchema(cli *cli.Context) error {
 	if err != nil {
 		return handleErr(schema.NewConfigError(err.Error()))
 	}
-	if config.Keyspace == schema.DryrunDBName {
-		cfg := *config
-		if err := doCreateKeyspace(cfg, cfg.Keyspace); err != nil {
-			return handleErr(fmt.Errorf("error creating dryrun Keyspace: %v", err))
-		}
-		defer doDropKeyspace(cfg, cfg.Keyspace)
-	}
 	client, err := newCQLClient(config)
 	if err != nil {
 		return handleErr(err)
@@ -121,6 +114,24 @@ func updateSchema(cli *cli.Context) error {
 	return nil
 }
 
+// verifySchema executes the verifySchemaTask
+// using the given command line args as input
+func verifySchema(cli *cli.Context) error {
+	config, err := newCQLClientConfig(cli)
+	if err != nil {
+		return handleErr(schema.NewConfigError(err.Error()))
+	}
+	client, err := newCQLClient(config)
+	if err != nil {
+		return handleErr(err)
+	}
+	defer client.Close()
+	if err := schema.Verify(cli, client); err != nil {
+		return handleErr(err)
+	}
+	return nil
+}
+
 // createKeyspace creates a cassandra Keyspace
 func createKeyspace(cli *cli.Context) error {
 	config, err := newCQLClientConfig(cli)
@@ -148,16 +159,6 @@ func doCreateKeyspace(cfg CQLClientConfig, name string) error {
 	return client.createKeyspace(name)
 }
 
-func doDropKeyspace(cfg CQLClientConfig, name string) {
-	cfg.Keyspace = systemKeyspace
-	client, err := newCQLClient(&cfg)
-	if err != nil {
-		return
-	}
-	client.dropKeyspace(name)
-	client.Close()
-}
-
 func newCQLClientConfig(cli *cli.Context) (*CQLClientConfig, error) {
 	config := new(CQLClientConfig)
 	config.Hosts = cli.GlobalString(schema.CLIOptEndpoint)
@@ -167,22 +168,18 @@ func newCQLClientConfig(cli *cli.Context) (*CQLClientConfig, error) {
 	config.Timeout = cli.GlobalInt(schema.CLIOptTimeout)
 	config.Keyspace = cli.GlobalString(schema.CLIOptKeyspace)
 	config.numReplicas = cli.Int(schema.CLIOptReplicationFactor)
-	isDryRun := cli.Bool(schema.CLIOptDryrun)
-	if err := validateCQLClientConfig(config, isDryRun); err != nil {
+	if err := validateCQLClientConfig(config); err != nil {
 		return nil, err
 	}
 	return config, nil
 }
 
-func validateCQLClientConfig(config *CQLClientConfig, isDryRun bool) error {
+func validateCQLClientConfig(config *CQLClientConfig) error {
 	if len(config.Hosts) == 0 {
 		return schema.NewConfigError("missing cassandra endpoint argument " + flag(schema.CLIOptEndpoint))
 	}
 	if config.Keyspace == "" {
-		if !isDryRun {
-			return schema.NewConfigError("missing " + flag(schema.CLIOptKeyspace) + " argument ")
-		}
-		config.Keyspace = schema.DryrunDBName
+		return schema.NewConfigError("missing " + flag(schema.CLIOptKeyspace) + " argument ")
 	}
 	if config.Port == 0 {
 		config.Port = defaultCassandraPort
@@ -35,7 +35,7 @@ func RunTool(args []string) error {
 
 // SetupSchema setups the cassandra schema
 func SetupSchema(config *SetupSchemaConfig) error {
-	if err := validateCQLClientConfig(&config.CQLClientConfig, false); err != nil {
+	if err := validateCQLClientConfig(&config.CQLClientConfig); err != nil {
 		return err
 	}
 	db, err := newCQLClient(&config.CQLClientConfig)
@@ -146,13 +146,27 @@ func buildCLIOptions() *cli.App {
 				},
 				cli.BoolFlag{
 					Name:  schema.CLIFlagDryrun,
-					Usage: "do a dryrun",
+					Usage: "print the statements of the update without executing them",
 				},
 			},
 			Action: func(c *cli.Context) {
 				cliHandler(c, updateSchema)
 			},
 		},
+		{
+			Name:    "verify-schema",
+			Aliases: []string{"verify"},
+			Usage:   "verify that the cassandra schema has the tables and columns of the versioned schema of its current version, column types are not compared",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  schema.CLIFlagSchemaDir,
+					Usage: "path to directory containing versioned schema",
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, verifySchema)
+			},
+		},
 		{
 			Name:    "create-Keyspace",
 			Aliases: []string{"create"},
@@ -77,6 +77,10 @@ func newAdminWorkflowCommands() []cli.Command {
 					Name:  FlagKeyspace,
 					Usage: "cassandra keyspace",
 				},
+				cli.StringFlag{
+					Name:  FlagEncryptionKeyFile,
+					Usage: "key file of the history encryption at rest, required if history events are encrypted",
+				},
 
 				// support mysql query
 				cli.IntFlag{
@@ -107,9 +111,9 @@ func newAdminWorkflowCommands() []cli.Command {
 			},
 		},
 		{
-			Name:    "delete",
-			Aliases: []string{"del"},
-			Usage:   "Delete current workflow execution and the mutableState record",
+			Name:    "refresh-tasks",
+			Aliases: []string{"rt"},
+			Usage:   "Regenerate transfer and timer tasks of workflow execution from its mutable state",
 			Flags: []cli.Flag{
 				cli.StringFlag{
 					Name:  FlagWorkflowIDWithAlias,
@@ -119,32 +123,23 @@ func newAdminWorkflowCommands() []cli.Command {
 					Name:  FlagRunIDWithAlias,
 					Usage: "RunID",
 				},
-				cli.BoolFlag{
-					Name:  FlagSkipErrorModeWithAlias,
-					Usage: "skip errors when deleting history",
-				},
-
-				// for cassandra connection
-				cli.StringFlag{
-					Name:  FlagAddress,
-					Usage: "cassandra host address",
-				},
-				cli.IntFlag{
-					Name:  FlagPort,
-					Value: 9042,
-					Usage: "cassandra port for the host",
-				},
-				cli.StringFlag{
-					Name:  FlagUsername,
-					Usage: "cassandra username",
-				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRefreshWorkflowTasks(c)
+			},
+		},
+		{
+			Name:    "delete",
+			Aliases: []string{"del"},
+			Usage:   "Delete closed workflow execution, including its mutableState, history and visibility records",
+			Flags: []cli.Flag{
 				cli.StringFlag{
-					Name:  FlagPassword,
-					Usage: "cassandra password",
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
 				},
 				cli.StringFlag{
-					Name:  FlagKeyspace,
-					Usage: "cassandra keyspace",
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID, default to the current run",
 				},
 			},
 			Action: func(c *cli.Context) {
@@ -441,6 +436,10 @@ clusters:
 					Name:  FlagKeyspace,
 					Usage: "cassandra keyspace",
 				},
+				cli.StringFlag{
+					Name:  FlagEncryptionKeyFile,
+					Usage: "key file of the history encryption at rest, required if history events are encrypted",
+				},
 
 				// kafka
 				cli.StringFlag{
@@ -568,5 +567,111 @@ func newAdminClusterCommands() []cli.Command {
 				AdminAddSearchAttribute(c)
 			},
 		},
+		{
+			Name:    "describe",
+			Aliases: []string{"d"},
+			Usage:   "Describe the members of each service ring, and the host and status of each history shard",
+			Action: func(c *cli.Context) {
+				AdminDescribeCluster(c)
+			},
+		},
+	}
+}
+
+func newAdminShardCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "describe-queues",
+			Aliases: []string{"dq"},
+			Usage:   "Describe ack and read levels of the queue processors of a shard",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminDescribeShardQueues(c)
+			},
+		},
+		{
+			Name:    "list-tasks",
+			Aliases: []string{"lt"},
+			Usage:   "List persisted tasks of a shard queue",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+				cli.StringFlag{
+					Name:  FlagQueueTypeWithAlias,
+					Usage: "Queue type: transfer, timer or replication",
+				},
+				cli.Int64Flag{
+					Name:  FlagMinLevel,
+					Usage: "Exclusive min level, task ID for transfer and replication queue, UnixNano for timer queue",
+				},
+				cli.Int64Flag{
+					Name:  FlagMaxLevel,
+					Usage: "Max level, task ID for transfer and replication queue, UnixNano for timer queue (default to unbounded)",
+				},
+				cli.IntFlag{
+					Name:  FlagPageSizeWithAlias,
+					Value: 100,
+					Usage: "Result page size",
+				},
+				cli.BoolFlag{
+					Name:  FlagMoreWithAlias,
+					Usage: "List more pages, default is to list one page of default page size 100",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminListShardTasks(c)
+			},
+		},
+		{
+			Name:    "remove-task",
+			Aliases: []string{"rmtk"},
+			Usage:   "Remove a task from a shard queue and reload the shard",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+				cli.StringFlag{
+					Name:  FlagQueueTypeWithAlias,
+					Usage: "Queue type: transfer, timer or replication",
+				},
+				cli.Int64Flag{
+					Name:  FlagTaskID,
+					Usage: "TaskID",
+				},
+				cli.Int64Flag{
+					Name:  FlagTaskVisibilityTimestamp,
+					Usage: "Task visibility timestamp in UnixNano, required for timer queue",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminRemoveShardTask(c)
+			},
+		},
+		{
+			Name:    "close",
+			Aliases: []string{"cl"},
+			Usage:   "Close a shard on its current host, so that it is acquired by the host the membership ring assigns it to",
+			Flags: []cli.Flag{
+				cli.IntFlag{
+					Name:  FlagShardIDWithAlias,
+					Usage: "ShardID",
+				},
+				cli.StringFlag{
+					Name:  FlagHistoryAddressWithAlias,
+					Usage: "Address of the history host to close the shard on, defaults to the owner in the membership ring",
+				},
+			},
+			Action: func(c *cli.Context) {
+				AdminCloseShard(c)
+			},
+		},
 	}
 }
@@ -24,10 +24,12 @@ import (
 	"bufio"
 	"fmt"
 	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
 	"github.com/uber/cadence/.gen/go/admin"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/urfave/cli"
 	"os"
+	"strconv"
 	"strings"
 )
 
@@ -65,6 +67,41 @@ func AdminAddSearchAttribute(c *cli.Context) {
 	fmt.Println("Success")
 }
 
+// AdminDescribeCluster describes the service rings and the history shards of the cluster
+func AdminDescribeCluster(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	response, err := adminClient.DescribeCluster(ctx)
+	if err != nil {
+		ErrorAndExit("Operation DescribeCluster failed.", err)
+	}
+
+	for _, ring := range response.Rings {
+		fmt.Printf("%v: %v\n", ring.GetRole(), strings.Join(ring.Members, ", "))
+	}
+	fmt.Println()
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Shard", "Ring Owner", "Host", "Status"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, shard := range response.HistoryShards {
+		host := shard.GetHostAddress()
+		if host != "" && host != shard.GetRingOwner() {
+			host = color.YellowString(host)
+		}
+		table.Append([]string{strconv.Itoa(int(shard.GetShardID())),
+			shard.GetRingOwner(),
+			host,
+			shard.GetStatus()})
+	}
+	table.Render()
+}
+
 func intValTypeToString(valType int) string {
 	switch valType {
 	case 0:
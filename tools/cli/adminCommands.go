@@ -24,7 +24,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
-	"strconv"
 	"time"
 
 	"github.com/gocql/gocql"
@@ -32,9 +31,11 @@ import (
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/codec"
+	"github.com/uber/cadence/common/encryption"
 	"github.com/uber/cadence/common/log/loggerimpl"
 	"github.com/uber/cadence/common/persistence"
 	cassp "github.com/uber/cadence/common/persistence/cassandra"
+	"github.com/uber/cadence/common/service/config"
 	"github.com/uber/cadence/tools/cassandra"
 	"github.com/urfave/cli"
 )
@@ -52,7 +53,7 @@ func AdminShowWorkflow(c *cli.Context) {
 	outputFileName := c.String(FlagOutputFilename)
 
 	session := connectToCassandra(c)
-	serializer := persistence.NewPayloadSerializer()
+	serializer := newPayloadSerializer(c)
 	var history []*persistence.DataBlob
 	if len(wid) != 0 {
 		histV1 := cassp.NewHistoryPersistenceFromSession(session, loggerimpl.NewNopLogger())
@@ -182,97 +183,52 @@ func describeMutableState(c *cli.Context) *admin.DescribeWorkflowExecutionRespon
 	return resp
 }
 
-// AdminDeleteWorkflow describe a new workflow execution for admin
-func AdminDeleteWorkflow(c *cli.Context) {
+// AdminRefreshWorkflowTasks regenerates the transfer and timer tasks of a workflow execution
+func AdminRefreshWorkflowTasks(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
 	wid := getRequiredOption(c, FlagWorkflowID)
 	rid := c.String(FlagRunID)
 
-	resp := describeMutableState(c)
-	msStr := resp.GetMutableStateInDatabase()
-	ms := persistence.WorkflowMutableState{}
-	err := json.Unmarshal([]byte(msStr), &ms)
-	if err != nil {
-		ErrorAndExit("json.Unmarshal err", err)
-	}
-	domainID := ms.ExecutionInfo.DomainID
-	skipError := c.Bool(FlagSkipErrorMode)
-	session := connectToCassandra(c)
-	shardID := resp.GetShardId()
-	shardIDInt, err := strconv.Atoi(shardID)
+	ctx, cancel := newContext(c)
+	defer cancel()
+
+	err := adminClient.RefreshWorkflowTasks(ctx, &admin.RefreshWorkflowTasksRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      common.StringPtr(rid),
+		},
+	})
 	if err != nil {
-		ErrorAndExit("strconv.Atoi(shardID) err", err)
-	}
-	if ms.ExecutionInfo.EventStoreVersion == persistence.EventStoreVersionV2 {
-		branchInfo := shared.HistoryBranch{}
-		thriftrwEncoder := codec.NewThriftRWEncoder()
-		err := thriftrwEncoder.Decode(ms.ExecutionInfo.BranchToken, &branchInfo)
-		if err != nil {
-			ErrorAndExit("thriftrwEncoder.Decode err", err)
-		}
-		fmt.Println("deleting history events for ...")
-		prettyPrintJSONObject(branchInfo)
-		histV2 := cassp.NewHistoryV2PersistenceFromSession(session, loggerimpl.NewNopLogger())
-		err = histV2.DeleteHistoryBranch(&persistence.InternalDeleteHistoryBranchRequest{
-			BranchInfo: branchInfo,
-			ShardID:    shardIDInt,
-		})
-		if err != nil {
-			if skipError {
-				fmt.Println("failed to delete history, ", err)
-			} else {
-				ErrorAndExit("DeleteHistoryBranch err", err)
-			}
-		}
-	} else {
-		histV1 := cassp.NewHistoryPersistenceFromSession(session, loggerimpl.NewNopLogger())
-		err = histV1.DeleteWorkflowExecutionHistory(&persistence.DeleteWorkflowExecutionHistoryRequest{
-			DomainID: domainID,
-			Execution: shared.WorkflowExecution{
-				WorkflowId: common.StringPtr(wid),
-				RunId:      common.StringPtr(rid),
-			},
-		})
-		if err != nil {
-			if skipError {
-				fmt.Println("failed to delete history, ", err)
-			} else {
-				ErrorAndExit("DeleteWorkflowExecutionHistory err", err)
-			}
-		}
+		ErrorAndExit("Refresh workflow tasks failed", err)
 	}
+	fmt.Println("Refresh workflow tasks succeeded.")
+}
 
-	exeStore, _ := cassp.NewWorkflowExecutionPersistence(shardIDInt, session, loggerimpl.NewNopLogger())
-	req := &persistence.DeleteWorkflowExecutionRequest{
-		DomainID:   domainID,
-		WorkflowID: wid,
-		RunID:      rid,
-	}
+// AdminDeleteWorkflow deletes a closed workflow execution, including its history and visibility records
+func AdminDeleteWorkflow(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
 
-	err = exeStore.DeleteWorkflowExecution(req)
-	if err != nil {
-		if skipError {
-			fmt.Println("delete mutableState row failed, ", err)
-		} else {
-			ErrorAndExit("delete mutableState row failed", err)
-		}
-	}
-	fmt.Println("delete mutableState row successfully")
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
 
-	deleteCurrentReq := &persistence.DeleteCurrentWorkflowExecutionRequest{
-		DomainID:   domainID,
-		WorkflowID: wid,
-		RunID:      rid,
-	}
+	ctx, cancel := newContext(c)
+	defer cancel()
 
-	err = exeStore.DeleteCurrentWorkflowExecution(deleteCurrentReq)
+	err := adminClient.DeleteWorkflowExecution(ctx, &admin.DeleteWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		Execution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      common.StringPtr(rid),
+		},
+	})
 	if err != nil {
-		if skipError {
-			fmt.Println("delete current row failed, ", err)
-		} else {
-			ErrorAndExit("delete current row failed", err)
-		}
+		ErrorAndExit("Delete workflow failed", err)
 	}
-	fmt.Println("delete current row successfully")
+	fmt.Println("Delete workflow succeeded.")
 }
 
 func readOneRow(query *gocql.Query) (map[string]interface{}, error) {
@@ -304,6 +260,22 @@ func connectToCassandra(c *cli.Context) *gocql.Session {
 	return session
 }
 
+// newPayloadSerializer returns a serializer which decrypts history events
+// with the keys of the encryption key file, if one is given
+func newPayloadSerializer(c *cli.Context) persistence.PayloadSerializer {
+	keyFile := c.String(FlagEncryptionKeyFile)
+	if keyFile == "" {
+		return persistence.NewPayloadSerializer()
+	}
+	keyProvider, err := encryption.NewKeyProvider(&config.Encryption{
+		FileKeyProvider: &config.FileKeyProvider{KeyFile: keyFile},
+	})
+	if err != nil {
+		ErrorAndExit("Error creating encryption key provider", err)
+	}
+	return persistence.NewPayloadSerializerWithEncryptor(encryption.NewAESEncryptor(keyProvider))
+}
+
 // AdminGetDomainIDOrName map domain
 func AdminGetDomainIDOrName(c *cli.Context) {
 	domainID := c.String(FlagDomainID)
@@ -397,6 +369,7 @@ func AdminDescribeHistoryHost(c *cli.Context) {
 
 	if !printFully {
 		resp.ShardIDs = nil
+		resp.ShardStatuses = nil
 	}
 	prettyPrintJSONObject(resp)
 }
@@ -462,7 +462,8 @@ type ClustersConfig struct {
 	TLS      messaging.TLS
 }
 
-func doRereplicate(shardID int, domainID, wid, rid string, minID, maxID int64, targets []string, producer messaging.Producer, session *gocql.Session) {
+func doRereplicate(shardID int, domainID, wid, rid string, minID, maxID int64, targets []string, producer messaging.Producer, session *gocql.Session,
+	serializer persistence.PayloadSerializer) {
 	if minID <= 0 {
 		minID = 1
 	}
@@ -471,13 +472,13 @@ func doRereplicate(shardID int, domainID, wid, rid string, minID, maxID int64, t
 	}
 
 	histV1 := cassandra.NewHistoryPersistenceFromSession(session, loggerimpl.NewNopLogger())
-	historyMgr := persistence.NewHistoryManagerImpl(histV1, loggerimpl.NewNopLogger(), dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit))
+	historyMgr := persistence.NewHistoryManagerImpl(histV1, serializer, loggerimpl.NewNopLogger(), dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit))
 
 	histV2 := cassandra.NewHistoryV2PersistenceFromSession(session, loggerimpl.NewNopLogger())
-	historyV2Mgr := persistence.NewHistoryV2ManagerImpl(histV2, loggerimpl.NewNopLogger(), dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit))
+	historyV2Mgr := persistence.NewHistoryV2ManagerImpl(histV2, serializer, loggerimpl.NewNopLogger(), dynamicconfig.GetIntPropertyFn(common.DefaultTransactionSizeLimit))
 
 	exeM, _ := cassandra.NewWorkflowExecutionPersistence(shardID, session, loggerimpl.NewNopLogger())
-	exeMgr := persistence.NewExecutionManagerImpl(exeM, loggerimpl.NewNopLogger())
+	exeMgr := persistence.NewExecutionManagerImpl(exeM, serializer, loggerimpl.NewNopLogger())
 
 	for {
 		fmt.Printf("Start rereplicate for wid: %v, rid:%v \n", wid, rid)
@@ -579,6 +580,7 @@ func AdminRereplicate(c *cli.Context) {
 
 	producer := newKafkaProducer(c)
 	session := connectToCassandra(c)
+	serializer := newPayloadSerializer(c)
 
 	if c.IsSet(FlagInputFile) {
 		inFile := c.String(FlagInputFile)
@@ -623,7 +625,7 @@ func AdminRereplicate(c *cli.Context) {
 			}
 
 			shardID := common.WorkflowIDToHistoryShard(wid, numberOfShards)
-			doRereplicate(shardID, domainID, wid, rid, minID, maxID, targets, producer, session)
+			doRereplicate(shardID, domainID, wid, rid, minID, maxID, targets, producer, session, serializer)
 			fmt.Printf("Done processing line %v ...\n", idx)
 		}
 		if err := scanner.Err(); err != nil {
@@ -637,7 +639,7 @@ func AdminRereplicate(c *cli.Context) {
 		maxID := c.Int64(FlagMaxEventID)
 
 		shardID := common.WorkflowIDToHistoryShard(wid, numberOfShards)
-		doRereplicate(shardID, domainID, wid, rid, minID, maxID, targets, producer, session)
+		doRereplicate(shardID, domainID, wid, rid, minID, maxID, targets, producer, session, serializer)
 	}
 }
 
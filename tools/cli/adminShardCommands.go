@@ -0,0 +1,203 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	s "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+)
+
+// AdminDescribeShardQueues describes the queue processors of a shard
+func AdminDescribeShardQueues(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	shardID := getRequiredIntOption(c, FlagShardID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	response, err := adminClient.DescribeShardQueues(ctx, &s.DescribeShardQueuesRequest{
+		ShardID: common.Int32Ptr(int32(shardID)),
+	})
+	if err != nil {
+		ErrorAndExit("Operation DescribeShardQueues failed.", err)
+	}
+
+	fmt.Printf("Shard %v is owned by %v\n", response.GetShardID(), response.GetHostAddress())
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Queue", "Cluster", "Ack Level", "Read Level", "Outstanding Tasks"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+	for _, info := range response.QueueProcessors {
+		table.Append([]string{info.GetQueueType().String(),
+			info.GetClusterName(),
+			formatQueueLevel(info.GetQueueType(), info.GetAckLevel()),
+			formatQueueLevel(info.GetQueueType(), info.GetReadLevel()),
+			strconv.Itoa(int(info.GetOutstandingTaskCount()))})
+	}
+	table.Render()
+}
+
+// AdminListShardTasks lists the persisted tasks of a shard queue
+func AdminListShardTasks(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	shardID := getRequiredIntOption(c, FlagShardID)
+	queueType := strToShardQueueType(getRequiredOption(c, FlagQueueType))
+	more := c.Bool(FlagMore)
+
+	request := &s.ListShardTasksRequest{
+		ShardID:   common.Int32Ptr(int32(shardID)),
+		QueueType: &queueType,
+		MinLevel:  common.Int64Ptr(c.Int64(FlagMinLevel)),
+		PageSize:  common.Int32Ptr(int32(c.Int(FlagPageSize))),
+	}
+	if c.IsSet(FlagMaxLevel) {
+		request.MaxLevel = common.Int64Ptr(c.Int64(FlagMaxLevel))
+	}
+
+	table := tablewriter.NewWriter(os.Stdout)
+	table.SetBorder(false)
+	table.SetColumnSeparator("|")
+	table.SetHeader([]string{"Task ID", "Task Type", "Domain ID", "Workflow ID", "Run ID", "Visibility Timestamp", "Version"})
+	table.SetHeaderLine(false)
+	table.SetHeaderColor(tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue)
+
+	for {
+		ctx, cancel := newContext(c)
+		response, err := adminClient.ListShardTasks(ctx, request)
+		cancel()
+		if err != nil {
+			ErrorAndExit("Operation ListShardTasks failed.", err)
+		}
+		for _, task := range response.Tasks {
+			table.Append([]string{strconv.FormatInt(task.GetTaskId(), 10),
+				strconv.Itoa(int(task.GetTaskType())),
+				task.GetDomainId(),
+				task.GetWorkflowId(),
+				task.GetRunId(),
+				convertTime(task.GetVisibilityTimestamp(), false),
+				strconv.FormatInt(task.GetVersion(), 10)})
+		}
+		table.Render()
+		table.ClearRows()
+
+		if !more || len(response.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+
+		fmt.Printf("Press %s to show next page, press %s to quit: ",
+			color.GreenString("Enter"), color.RedString("any other key then Enter"))
+		var input string
+		fmt.Scanln(&input)
+		if strings.Trim(input, " ") != "" {
+			break
+		}
+	}
+}
+
+// AdminRemoveShardTask removes a task from a shard queue
+func AdminRemoveShardTask(c *cli.Context) {
+	shardID := getRequiredIntOption(c, FlagShardID)
+	queueType := strToShardQueueType(getRequiredOption(c, FlagQueueType))
+	taskID := getRequiredInt64Option(c, FlagTaskID)
+
+	request := &s.RemoveShardTaskRequest{
+		ShardID:   common.Int32Ptr(int32(shardID)),
+		QueueType: &queueType,
+		TaskId:    common.Int64Ptr(taskID),
+	}
+	if queueType == s.ShardQueueTypeTimer {
+		request.VisibilityTimestamp = common.Int64Ptr(getRequiredInt64Option(c, FlagTaskVisibilityTimestamp))
+	}
+
+	// ask user for confirmation
+	reader := bufio.NewReader(os.Stdin)
+	fmt.Printf("Are you trying to remove %s task [%s] from shard [%s]? Y/N\n",
+		color.YellowString(queueType.String()), color.YellowString(strconv.FormatInt(taskID, 10)),
+		color.YellowString(strconv.Itoa(shardID)))
+	text, _ := reader.ReadString('\n')
+	textLower := strings.ToLower(strings.TrimRight(text, "\n"))
+	if textLower != "y" && textLower != "yes" {
+		return
+	}
+
+	adminClient := cFactory.ServerAdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := adminClient.RemoveShardTask(ctx, request)
+	if err != nil {
+		ErrorAndExit("Remove shard task failed.", err)
+	}
+	fmt.Println("Success")
+}
+
+// AdminCloseShard closes a shard on its current host
+func AdminCloseShard(c *cli.Context) {
+	adminClient := cFactory.ServerAdminClient(c)
+	shardID := getRequiredIntOption(c, FlagShardID)
+
+	request := &s.CloseShardRequest{
+		ShardID: common.Int32Ptr(int32(shardID)),
+	}
+	if c.IsSet(FlagHistoryAddress) {
+		request.HostAddress = common.StringPtr(c.String(FlagHistoryAddress))
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := adminClient.CloseShard(ctx, request)
+	if err != nil {
+		ErrorAndExit("Close shard failed.", err)
+	}
+	fmt.Println("Success")
+}
+
+func strToShardQueueType(str string) s.ShardQueueType {
+	switch strings.ToLower(str) {
+	case "transfer":
+		return s.ShardQueueTypeTransfer
+	case "timer":
+		return s.ShardQueueTypeTimer
+	case "replication":
+		return s.ShardQueueTypeReplication
+	default:
+		ErrorAndExit(fmt.Sprintf("Unknown queue type '%s', use transfer, timer or replication.", str), nil)
+	}
+	return s.ShardQueueTypeTransfer
+}
+
+// timer queue levels are timestamps, transfer and replication queue levels are task IDs
+func formatQueueLevel(queueType s.ShardQueueType, level int64) string {
+	if queueType == s.ShardQueueTypeTimer {
+		return convertTime(level, false)
+	}
+	return strconv.FormatInt(level, 10)
+}
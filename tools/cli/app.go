@@ -79,6 +79,12 @@ func NewCliApp() *cli.App {
 			Usage:       "Operate cadence tasklist",
 			Subcommands: newTaskListCommands(),
 		},
+		{
+			Name:        "schedule",
+			Aliases:     []string{"sch"},
+			Usage:       "Operate cadence schedule",
+			Subcommands: newScheduleCommands(),
+		},
 		{
 			Name:    "admin",
 			Aliases: []string{"adm"},
@@ -126,6 +132,12 @@ func NewCliApp() *cli.App {
 					Usage:       "Run admin operation on cluster",
 					Subcommands: newAdminClusterCommands(),
 				},
+				{
+					Name:        "shard",
+					Aliases:     []string{"shar"},
+					Usage:       "Run admin operation on specific shard",
+					Subcommands: newAdminShardCommands(),
+				},
 			},
 		},
 		{
@@ -285,6 +285,30 @@ func (s *cliAppSuite) TestStartWorkflow() {
 	s.Nil(err)
 }
 
+func (s *cliAppSuite) TestStartWorkflow_DelayStart() {
+	resp := &serverShared.StartWorkflowExecutionResponse{RunId: common.StringPtr(uuid.New())}
+	s.serverFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+		Do(func(_ interface{}, request *serverShared.StartWorkflowExecutionRequest, _ ...interface{}) {
+			s.Equal(int32(60), request.GetDelayStartSeconds())
+			s.Equal("testWorkflowType", request.WorkflowType.GetName())
+		}).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "start", "-tl", "testTaskList", "-wt", "testWorkflowType", "-et", "60", "-w", "wid", "--delay_start", "60"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestStartWorkflow_CronMaxJitter() {
+	resp := &serverShared.StartWorkflowExecutionResponse{RunId: common.StringPtr(uuid.New())}
+	s.serverFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).
+		Do(func(_ interface{}, request *serverShared.StartWorkflowExecutionRequest, _ ...interface{}) {
+			s.Equal("CRON_TZ=America/New_York 0 9 * * *", request.GetCronSchedule())
+			s.Equal(int32(30), request.GetCronMaxJitterSeconds())
+			s.Equal(int32(0), request.GetDelayStartSeconds())
+		}).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "start", "-tl", "testTaskList", "-wt", "testWorkflowType", "-et", "60", "-w", "wid",
+		"--cron", "CRON_TZ=America/New_York 0 9 * * *", "--cron_max_jitter", "30"})
+	s.Nil(err)
+}
+
 func (s *cliAppSuite) TestStartWorkflow_Failed() {
 	resp := &shared.StartWorkflowExecutionResponse{RunId: common.StringPtr(uuid.New())}
 	s.clientFrontendClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, &shared.BadRequestError{"faked error"})
@@ -328,6 +352,30 @@ func (s *cliAppSuite) TestTerminateWorkflow_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestPauseWorkflow() {
+	s.serverFrontendClient.EXPECT().PauseWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "pause", "-w", "wid", "--reason", "test"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestPauseWorkflow_Failed() {
+	s.serverFrontendClient.EXPECT().PauseWorkflowExecution(gomock.Any(), gomock.Any()).Return(&serverShared.BadRequestError{"faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "pause", "-w", "wid"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestUnpauseWorkflow() {
+	s.serverFrontendClient.EXPECT().UnpauseWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "unpause", "-w", "wid", "--reason", "test"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestUnpauseWorkflow_Failed() {
+	s.serverFrontendClient.EXPECT().UnpauseWorkflowExecution(gomock.Any(), gomock.Any()).Return(&serverShared.BadRequestError{"faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "unpause", "-w", "wid"})
+	s.Equal(1, errorCode)
+}
+
 func (s *cliAppSuite) TestCancelWorkflow() {
 	s.clientFrontendClient.EXPECT().RequestCancelWorkflowExecution(gomock.Any(), gomock.Any(), callOptions...).Return(nil)
 	err := s.app.Run([]string{"", "--do", domainName, "workflow", "cancel", "-w", "wid"})
@@ -352,6 +400,118 @@ func (s *cliAppSuite) TestSignalWorkflow_Failed() {
 	s.Equal(1, errorCode)
 }
 
+func (s *cliAppSuite) TestSignalWorkflow_Delayed() {
+	s.serverFrontendClient.EXPECT().SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+		Do(func(_ interface{}, request *serverShared.SignalWorkflowExecutionRequest, _ ...interface{}) {
+			s.Equal(int32(30), request.GetDelaySeconds())
+			s.NotEmpty(request.GetRequestId())
+		}).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "signal", "-w", "wid", "-n", "signal-name", "--delay", "30"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestCancelDelayedSignal() {
+	s.serverFrontendClient.EXPECT().CancelDelayedSignal(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "cancel-delayed-signal", "-w", "wid", "--request_id", "request-id"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestCancelDelayedSignal_Failed() {
+	s.serverFrontendClient.EXPECT().CancelDelayedSignal(gomock.Any(), gomock.Any()).Return(&serverShared.EntityNotExistsError{Message: "faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "cancel-delayed-signal", "-w", "wid", "--request_id", "request-id"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestCreateSchedule() {
+	s.serverFrontendClient.EXPECT().CreateSchedule(gomock.Any(), gomock.Any()).
+		Do(func(_ interface{}, request *serverShared.CreateScheduleRequest, _ ...interface{}) {
+			s.Equal("sid", request.GetScheduleId())
+			s.Equal("*/5 * * * *", request.Spec.GetCronExpression())
+			s.Equal("wt", request.Action.StartWorkflow.WorkflowType.GetName())
+			s.Equal(serverShared.ScheduleOverlapPolicyBufferOne, request.Policies.GetOverlapPolicy())
+			s.True(request.GetPaused())
+		}).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "schedule", "create", "--sid", "sid", "--cron", "*/5 * * * *",
+		"-tl", "tl", "-wt", "wt", "-et", "60", "--overlap_policy", "buffer_one", "--paused"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestCreateSchedule_Failed() {
+	s.serverFrontendClient.EXPECT().CreateSchedule(gomock.Any(), gomock.Any()).Return(&serverShared.BadRequestError{Message: "faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "schedule", "create", "--sid", "sid", "--cron", "*/5 * * * *",
+		"-tl", "tl", "-wt", "wt", "-et", "60"})
+	s.Equal(1, errorCode)
+}
+
+func (s *cliAppSuite) TestDescribeSchedule() {
+	resp := &serverShared.DescribeScheduleResponse{
+		Spec: &serverShared.ScheduleSpec{CronExpression: common.StringPtr("*/5 * * * *")},
+	}
+	s.serverFrontendClient.EXPECT().DescribeSchedule(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "schedule", "describe", "--sid", "sid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestUpdateSchedule() {
+	s.serverFrontendClient.EXPECT().UpdateSchedule(gomock.Any(), gomock.Any()).
+		Do(func(_ interface{}, request *serverShared.UpdateScheduleRequest, _ ...interface{}) {
+			s.Nil(request.Spec)
+			s.Nil(request.Action)
+			s.Equal(serverShared.ScheduleOverlapPolicyAllowAll, request.Policies.GetOverlapPolicy())
+		}).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "schedule", "update", "--sid", "sid", "--overlap_policy", "allow_all"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestPauseSchedule() {
+	s.serverFrontendClient.EXPECT().PauseSchedule(gomock.Any(), gomock.Any()).
+		Do(func(_ interface{}, request *serverShared.PauseScheduleRequest, _ ...interface{}) {
+			s.True(request.GetPause())
+			s.Equal("maintenance", request.GetReason())
+		}).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "schedule", "pause", "--sid", "sid", "--reason", "maintenance"})
+	s.Nil(err)
+
+	s.serverFrontendClient.EXPECT().PauseSchedule(gomock.Any(), gomock.Any()).
+		Do(func(_ interface{}, request *serverShared.PauseScheduleRequest, _ ...interface{}) {
+			s.False(request.GetPause())
+		}).Return(nil)
+	err = s.app.Run([]string{"", "--do", domainName, "schedule", "unpause", "--sid", "sid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestBackfillSchedule() {
+	s.serverFrontendClient.EXPECT().BackfillSchedule(gomock.Any(), gomock.Any()).
+		Do(func(_ interface{}, request *serverShared.BackfillScheduleRequest, _ ...interface{}) {
+			s.Equal(int64(100), request.GetStartTime())
+			s.Equal(int64(200), request.GetEndTime())
+			s.Nil(request.OverlapPolicy)
+		}).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "schedule", "backfill", "--sid", "sid", "--earliest_time", "100", "--latest_time", "200"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestDeleteSchedule() {
+	s.serverFrontendClient.EXPECT().DeleteSchedule(gomock.Any(), gomock.Any()).Return(nil)
+	err := s.app.Run([]string{"", "--do", domainName, "schedule", "delete", "--sid", "sid"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestUpdateWorkflow() {
+	resp := &serverShared.UpdateWorkflowExecutionResponse{
+		Result: []byte("update-result"),
+	}
+	s.serverFrontendClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).Return(resp, nil)
+	err := s.app.Run([]string{"", "--do", domainName, "workflow", "update", "-w", "wid", "-n", "update-name"})
+	s.Nil(err)
+}
+
+func (s *cliAppSuite) TestUpdateWorkflow_Failed() {
+	s.serverFrontendClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, &serverShared.BadRequestError{"faked error"})
+	errorCode := s.RunErrorExitCode([]string{"", "--do", domainName, "workflow", "update", "-w", "wid", "-n", "update-name"})
+	s.Equal(1, errorCode)
+}
+
 func (s *cliAppSuite) TestQueryWorkflow() {
 	resp := &shared.QueryWorkflowResponse{
 		QueryResult: []byte("query-result"),
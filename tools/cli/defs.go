@@ -26,6 +26,7 @@ import (
 
 	"github.com/fatih/color"
 	"github.com/olekukonko/tablewriter"
+	"github.com/uber/cadence/.gen/go/shared"
 	s "go.uber.org/cadence/.gen/go/shared"
 )
 
@@ -67,6 +68,12 @@ var resetTypesMap = map[string]string{
 	"BadBinary":              FlagResetBadBinaryChecksum,
 }
 
+var resetReapplyPolicies = []string{
+	shared.ResetReapplyPolicySignals.String(),
+	shared.ResetReapplyPolicyNone.String(),
+	shared.ResetReapplyPolicyAllExternalEvents.String(),
+}
+
 type jsonType int
 
 const (
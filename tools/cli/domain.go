@@ -189,6 +189,19 @@ func newDomainCommands() []cli.Command {
 					Name:  FlagRemoveBadBinary,
 					Usage: "Binary checksum to remove for resetting workflow",
 				},
+				cli.StringFlag{
+					Name:  FlagAutoResetBadBinaries,
+					Usage: "Flag to automatically reset open workflows affected by newly added bad binaries, valid values are \"true\" and \"false\"",
+				},
+				cli.StringFlag{
+					Name: FlagCompatibleBuildSets,
+					Usage: "Compatible worker build sets, separated by ';', each a list of build IDs separated by ','; " +
+						"the last set is the default for new workflows, e.g. \"v1,v2;v3\". Empty value removes the sets",
+				},
+				cli.StringFlag{
+					Name:  FlagTaskListWithAlias,
+					Usage: "TaskList the compatible build sets apply to, the domain default is updated if not provided",
+				},
 				cli.StringFlag{
 					Name:  FlagReason,
 					Usage: "Reason for the operation",
@@ -25,11 +25,13 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/olekukonko/tablewriter"
 	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
 	"github.com/urfave/cli"
 	s "go.uber.org/cadence/.gen/go/shared"
 )
@@ -180,6 +182,13 @@ func UpdateDomain(c *cli.Context) {
 				ErrorAndExit("Domain data format is invalid.", err)
 			}
 		}
+		if c.IsSet(FlagAutoResetBadBinaries) {
+			autoReset, err := strconv.ParseBool(c.String(FlagAutoResetBadBinaries))
+			if err != nil {
+				ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagAutoResetBadBinaries), err)
+			}
+			domainData[cache.AutoResetBadBinariesKey] = strconv.FormatBool(autoReset)
+		}
 		if c.IsSet(FlagRetentionDays) {
 			retentionDays = int32(c.Int(FlagRetentionDays))
 		}
@@ -224,6 +233,27 @@ func UpdateDomain(c *cli.Context) {
 			badBinaryToDelete = common.StringPtr(c.String(FlagRemoveBadBinary))
 		}
 
+		var workerVersioning *shared.WorkerVersioning
+		if c.IsSet(FlagCompatibleBuildSets) {
+			workerVersioning = resp.Configuration.WorkerVersioning
+			if workerVersioning == nil {
+				workerVersioning = &shared.WorkerVersioning{}
+			}
+			compatibility := parseCompatibleBuildSets(c.String(FlagCompatibleBuildSets))
+			if taskList := c.String(FlagTaskList); taskList != "" {
+				if workerVersioning.TaskLists == nil {
+					workerVersioning.TaskLists = map[string]*shared.BuildIdCompatibility{}
+				}
+				if compatibility == nil {
+					delete(workerVersioning.TaskLists, taskList)
+				} else {
+					workerVersioning.TaskLists[taskList] = compatibility
+				}
+			} else {
+				workerVersioning.DefaultCompatibility = compatibility
+			}
+		}
+
 		updateInfo := &shared.UpdateDomainInfo{
 			Description: common.StringPtr(description),
 			OwnerEmail:  common.StringPtr(ownerEmail),
@@ -237,6 +267,7 @@ func UpdateDomain(c *cli.Context) {
 			VisibilityArchivalStatus:               archivalStatus(c, FlagVisibilityArchivalStatus),
 			VisibilityArchivalURI:                  common.StringPtr(c.String(FlagVisibilityArchivalURI)),
 			BadBinaries:                            binBinaries,
+			WorkerVersioning:                       workerVersioning,
 		}
 		replicationConfig := &shared.DomainReplicationConfiguration{
 			Clusters: clusters,
@@ -318,8 +349,8 @@ func DescribeDomain(c *cli.Context) {
 		table := tablewriter.NewWriter(os.Stdout)
 		table.SetBorder(true)
 		table.SetColumnSeparator("|")
-		header := []string{"Binary Checksum", "Operator", "Start Time", "Reason"}
-		headerColor := []tablewriter.Colors{tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue}
+		header := []string{"Binary Checksum", "Operator", "Start Time", "Reason", "Auto Reset Workflow"}
+		headerColor := []tablewriter.Colors{tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue, tableHeaderBlue}
 		table.SetHeader(header)
 		table.SetHeaderColor(headerColor...)
 		for cs, bin := range resp.Configuration.BadBinaries.Binaries {
@@ -327,10 +358,61 @@ func DescribeDomain(c *cli.Context) {
 			row = append(row, bin.GetOperator())
 			row = append(row, time.Unix(0, bin.GetCreatedTimeNano()).String())
 			row = append(row, bin.GetReason())
+			autoReset := ""
+			if bin.AutoResetExecution != nil {
+				autoReset = fmt.Sprintf("%v/%v", bin.AutoResetExecution.GetWorkflowId(), bin.AutoResetExecution.GetRunId())
+			}
+			row = append(row, autoReset)
 			table.Append(row)
 		}
 		table.Render()
 	}
+	if workerVersioning := resp.Configuration.WorkerVersioning; workerVersioning != nil &&
+		(workerVersioning.DefaultCompatibility != nil || len(workerVersioning.TaskLists) != 0) {
+		fmt.Println("Compatible build sets:")
+		table := tablewriter.NewWriter(os.Stdout)
+		table.SetBorder(true)
+		table.SetColumnSeparator("|")
+		header := []string{"TaskList", "Compatible Build Sets"}
+		headerColor := []tablewriter.Colors{tableHeaderBlue, tableHeaderBlue}
+		table.SetHeader(header)
+		table.SetHeaderColor(headerColor...)
+		if workerVersioning.DefaultCompatibility != nil {
+			table.Append([]string{"*", compatibleBuildSetsToString(workerVersioning.DefaultCompatibility)})
+		}
+		for taskList, compatibility := range workerVersioning.TaskLists {
+			table.Append([]string{taskList, compatibleBuildSetsToString(compatibility)})
+		}
+		table.Render()
+	}
+}
+
+// parseCompatibleBuildSets parses compatible build sets of the form "v1,v2;v3", an empty string yields nil
+func parseCompatibleBuildSets(str string) *shared.BuildIdCompatibility {
+	var sets []*shared.CompatibleBuildSet
+	for _, setStr := range strings.Split(str, ";") {
+		var buildIDs []string
+		for _, buildID := range strings.Split(setStr, ",") {
+			if buildID = strings.TrimSpace(buildID); buildID != "" {
+				buildIDs = append(buildIDs, buildID)
+			}
+		}
+		if len(buildIDs) != 0 {
+			sets = append(sets, &shared.CompatibleBuildSet{BuildIds: buildIDs})
+		}
+	}
+	if len(sets) == 0 {
+		return nil
+	}
+	return &shared.BuildIdCompatibility{CompatibleBuildSets: sets}
+}
+
+func compatibleBuildSetsToString(compatibility *shared.BuildIdCompatibility) string {
+	var sets []string
+	for _, set := range compatibility.GetCompatibleBuildSets() {
+		sets = append(sets, strings.Join(set.GetBuildIds(), ","))
+	}
+	return strings.Join(sets, ";")
 }
 
 func archivalStatus(c *cli.Context, statusFlagName string) *shared.ArchivalStatus {
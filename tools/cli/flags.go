@@ -28,6 +28,7 @@ const (
 	FlagUsername                          = "username"
 	FlagPassword                          = "password"
 	FlagKeyspace                          = "keyspace"
+	FlagEncryptionKeyFile                 = "encryption_key_file"
 	FlagAddress                           = "address"
 	FlagAddressWithAlias                  = FlagAddress + ", ad"
 	FlagHistoryAddress                    = "history_address"
@@ -54,6 +55,18 @@ const (
 	FlagWorkflowIDReusePolicy             = "workflowidreusepolicy"
 	FlagWorkflowIDReusePolicyAlias        = FlagWorkflowIDReusePolicy + ", wrp"
 	FlagCronSchedule                      = "cron"
+	FlagCronMaxJitter                     = "cron_max_jitter"
+	FlagDelayStart                        = "delay_start"
+	FlagDelay                             = "delay"
+	FlagRequestID                         = "request_id"
+	FlagRequestIDWithAlias                = FlagRequestID + ", rqid"
+	FlagScheduleID                        = "schedule_id"
+	FlagScheduleIDWithAlias               = FlagScheduleID + ", sid"
+	FlagWorkflowIDPrefix                  = "workflow_id_prefix"
+	FlagWorkflowIDPrefixWithAlias         = FlagWorkflowIDPrefix + ", wip"
+	FlagOverlapPolicy                     = "overlap_policy"
+	FlagOverlapPolicyWithAlias            = FlagOverlapPolicy + ", op"
+	FlagPaused                            = "paused"
 	FlagWorkflowType                      = "workflow_type"
 	FlagWorkflowTypeWithAlias             = FlagWorkflowType + ", wt"
 	FlagWorkflowStatus                    = "status"
@@ -171,9 +184,12 @@ const (
 	FlagSearchAttributesType              = "search_attr_type"
 	FlagAddBadBinary                      = "add_bad_binary"
 	FlagRemoveBadBinary                   = "remove_bad_binary"
+	FlagAutoResetBadBinaries              = "auto_reset_bad_binaries"
+	FlagCompatibleBuildSets               = "compatible_build_sets"
 	FlagResetType                         = "reset_type"
 	FlagResetPointsOnly                   = "reset_points_only"
 	FlagResetBadBinaryChecksum            = "reset_bad_binary_checksum"
+	FlagResetReapplyPolicy                = "reapply_policy"
 	FlagListQuery                         = "query"
 	FlagListQueryWithAlias                = FlagListQuery + ", q"
 	FlagBatchType                         = "batch_type"
@@ -184,6 +200,12 @@ const (
 	FlagJobID                             = "job_id"
 	FlagJobIDWithAlias                    = FlagJobID + ", jid"
 	FlagYes                               = "yes"
+	FlagQueueType                         = "queue_type"
+	FlagQueueTypeWithAlias                = FlagQueueType + ", qt"
+	FlagMinLevel                          = "min_level"
+	FlagMaxLevel                          = "max_level"
+	FlagTaskID                            = "task_id"
+	FlagTaskVisibilityTimestamp           = "task_visibility_timestamp"
 )
 
 var flagsForExecution = []cli.Flag{
@@ -271,12 +293,21 @@ func getFlagsForStart() []cli.Flag {
 				"\t│ │ │ ┌───────────── month (1 - 12) \n" +
 				"\t│ │ │ │ ┌───────────── day of the week (0 - 6) (Sunday to Saturday) \n" +
 				"\t│ │ │ │ │ \n" +
-				"\t* * * * *",
+				"\t* * * * *\n" +
+				"Prefix the spec with CRON_TZ=<time zone> to evaluate it in that time zone instead of UTC, e.g. 'CRON_TZ=America/New_York 0 9 * * *'",
+		},
+		cli.IntFlag{
+			Name:  FlagCronMaxJitter,
+			Usage: "Optional max random delay in seconds added to each run of the cron schedule",
+		},
+		cli.IntFlag{
+			Name:  FlagDelayStart,
+			Usage: "Optional delay in seconds before the first decision of the workflow is scheduled",
 		},
 		cli.IntFlag{
 			Name: FlagWorkflowIDReusePolicyAlias,
 			Usage: "Optional input to configure if the same workflow ID is allow to use for new workflow execution. " +
-				"Available options: 0: AllowDuplicateFailedOnly, 1: AllowDuplicate, 2: RejectDuplicate",
+				"Available options: 0: AllowDuplicateFailedOnly, 1: AllowDuplicate, 2: RejectDuplicate, 3: TerminateIfRunning",
 		},
 		cli.StringFlag{
 			Name:  FlagInputWithAlias,
@@ -0,0 +1,190 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import "github.com/urfave/cli"
+
+func newScheduleCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:    "create",
+			Aliases: []string{"c"},
+			Usage:   "Create a schedule which periodically starts a workflow",
+			Flags:   getFlagsForScheduleDefinition(),
+			Action: func(c *cli.Context) {
+				CreateSchedule(c)
+			},
+		},
+		{
+			Name:    "describe",
+			Aliases: []string{"desc"},
+			Usage:   "Describe a schedule",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				DescribeSchedule(c)
+			},
+		},
+		{
+			Name:    "update",
+			Aliases: []string{"u"},
+			Usage:   "Update the cron, workflow or overlap policy of a schedule, unset options are left unchanged",
+			Flags:   getFlagsForScheduleDefinition(),
+			Action: func(c *cli.Context) {
+				UpdateSchedule(c)
+			},
+		},
+		{
+			Name:  "pause",
+			Usage: "Pause a schedule",
+			Flags: getFlagsForSchedulePause(),
+			Action: func(c *cli.Context) {
+				PauseSchedule(c, true)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "Unpause a schedule",
+			Flags: getFlagsForSchedulePause(),
+			Action: func(c *cli.Context) {
+				PauseSchedule(c, false)
+			},
+		},
+		{
+			Name:  "backfill",
+			Usage: "Take the actions a schedule would have taken between earliest_time (exclusive) and latest_time (inclusive)",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+				cli.StringFlag{
+					Name:  FlagEarliestTime,
+					Usage: "Start of the backfill range, use UTC format '2006-01-02T15:04:05Z' or raw UnixNano",
+				},
+				cli.StringFlag{
+					Name:  FlagLatestTime,
+					Usage: "End of the backfill range, use UTC format '2006-01-02T15:04:05Z' or raw UnixNano",
+				},
+				cli.StringFlag{
+					Name:  FlagOverlapPolicyWithAlias,
+					Usage: "Optional overlap policy of the backfill, defaults to the policy of the schedule [skip_new|buffer_one|cancel_other|allow_all]",
+				},
+			},
+			Action: func(c *cli.Context) {
+				BackfillSchedule(c)
+			},
+		},
+		{
+			Name:  "delete",
+			Usage: "Delete a schedule, workflows already started by the schedule are not affected",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagScheduleIDWithAlias,
+					Usage: "ScheduleID",
+				},
+			},
+			Action: func(c *cli.Context) {
+				DeleteSchedule(c)
+			},
+		},
+	}
+}
+
+func getFlagsForScheduleDefinition() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagScheduleIDWithAlias,
+			Usage: "ScheduleID",
+		},
+		cli.StringFlag{
+			Name: FlagCronSchedule,
+			Usage: "Cron schedule of the schedule. Cron spec is as following: \n" +
+				"\t┌───────────── minute (0 - 59) \n" +
+				"\t│ ┌───────────── hour (0 - 23) \n" +
+				"\t│ │ ┌───────────── day of the month (1 - 31) \n" +
+				"\t│ │ │ ┌───────────── month (1 - 12) \n" +
+				"\t│ │ │ │ ┌───────────── day of the week (0 - 6) (Sunday to Saturday) \n" +
+				"\t│ │ │ │ │ \n" +
+				"\t* * * * *\n" +
+				"Prefix the spec with CRON_TZ=<time zone> to evaluate it in that time zone instead of UTC, e.g. 'CRON_TZ=America/New_York 0 9 * * *'",
+		},
+		cli.IntFlag{
+			Name:  FlagCronMaxJitter,
+			Usage: "Optional max random delay in seconds added to each action of the schedule",
+		},
+		cli.StringFlag{
+			Name:  FlagTaskListWithAlias,
+			Usage: "TaskList of the started workflows",
+		},
+		cli.StringFlag{
+			Name:  FlagWorkflowTypeWithAlias,
+			Usage: "WorkflowTypeName of the started workflows",
+		},
+		cli.StringFlag{
+			Name:  FlagWorkflowIDPrefixWithAlias,
+			Usage: "Optional prefix of the workflowID of the started workflows, defaults to the scheduleID",
+		},
+		cli.IntFlag{
+			Name:  FlagExecutionTimeoutWithAlias,
+			Usage: "Execution start to close timeout in seconds of the started workflows",
+		},
+		cli.IntFlag{
+			Name:  FlagDecisionTimeoutWithAlias,
+			Value: defaultDecisionTimeoutInSeconds,
+			Usage: "Decision task start to close timeout in seconds of the started workflows",
+		},
+		cli.StringFlag{
+			Name:  FlagInputWithAlias,
+			Usage: "Optional input for the started workflows, in JSON format. If there are multiple parameters, concatenate them and separate by space.",
+		},
+		cli.StringFlag{
+			Name: FlagInputFileWithAlias,
+			Usage: "Optional input for the started workflows from JSON file. If there are multiple JSON, concatenate them and separate by space or newline. " +
+				"Input from file will be overwrite by input from command line",
+		},
+		cli.StringFlag{
+			Name:  FlagOverlapPolicyWithAlias,
+			Usage: "Optional policy applied when the previous workflow is still running [skip_new|buffer_one|cancel_other|allow_all], defaults to skip_new",
+		},
+		cli.BoolFlag{
+			Name:  FlagPaused,
+			Usage: "Create the schedule in paused state, only used by create",
+		},
+	}
+}
+
+func getFlagsForSchedulePause() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  FlagScheduleIDWithAlias,
+			Usage: "ScheduleID",
+		},
+		cli.StringFlag{
+			Name:  FlagReasonWithAlias,
+			Usage: "Optional reason of the operation",
+		},
+	}
+}
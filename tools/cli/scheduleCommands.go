@@ -0,0 +1,234 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pborman/uuid"
+	"github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/urfave/cli"
+)
+
+// CreateSchedule creates a schedule
+func CreateSchedule(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	cronSchedule := getRequiredOption(c, FlagCronSchedule)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.CreateSchedule(ctx, &shared.CreateScheduleRequest{
+		Domain:     common.StringPtr(domain),
+		ScheduleId: common.StringPtr(scheduleID),
+		Spec:       getScheduleSpec(c, cronSchedule),
+		Action:     getScheduleAction(c),
+		Policies:   &shared.SchedulePolicies{OverlapPolicy: getOverlapPolicy(c)},
+		Paused:     common.BoolPtr(c.Bool(FlagPaused)),
+		Identity:   common.StringPtr(getCliIdentity()),
+		RequestId:  common.StringPtr(uuid.New()),
+	})
+
+	if err != nil {
+		ErrorAndExit("Create schedule failed.", err)
+	} else {
+		fmt.Println("Create schedule succeeded.")
+	}
+}
+
+// DescribeSchedule prints the definition, state and recent actions of a schedule
+func DescribeSchedule(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := frontendClient.DescribeSchedule(ctx, &shared.DescribeScheduleRequest{
+		Domain:     common.StringPtr(domain),
+		ScheduleId: common.StringPtr(scheduleID),
+	})
+	if err != nil {
+		ErrorAndExit("Describe schedule failed.", err)
+	}
+	prettyPrintJSONObject(resp)
+}
+
+// UpdateSchedule updates the cron, action and / or overlap policy of a schedule
+func UpdateSchedule(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	request := &shared.UpdateScheduleRequest{
+		Domain:     common.StringPtr(domain),
+		ScheduleId: common.StringPtr(scheduleID),
+		Identity:   common.StringPtr(getCliIdentity()),
+	}
+	if c.IsSet(FlagCronSchedule) || c.IsSet(FlagCronMaxJitter) {
+		// the spec is replaced as a whole
+		request.Spec = getScheduleSpec(c, getRequiredOption(c, FlagCronSchedule))
+	}
+	if c.IsSet(FlagWorkflowType) || c.IsSet(FlagTaskList) {
+		request.Action = getScheduleAction(c)
+	}
+	if c.IsSet(FlagOverlapPolicy) {
+		request.Policies = &shared.SchedulePolicies{OverlapPolicy: getOverlapPolicy(c)}
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.UpdateSchedule(ctx, request)
+
+	if err != nil {
+		ErrorAndExit("Update schedule failed.", err)
+	} else {
+		fmt.Println("Update schedule succeeded.")
+	}
+}
+
+// PauseSchedule pauses or unpauses a schedule
+func PauseSchedule(c *cli.Context, pause bool) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.PauseSchedule(ctx, &shared.PauseScheduleRequest{
+		Domain:     common.StringPtr(domain),
+		ScheduleId: common.StringPtr(scheduleID),
+		Pause:      common.BoolPtr(pause),
+		Reason:     common.StringPtr(c.String(FlagReason)),
+		Identity:   common.StringPtr(getCliIdentity()),
+	})
+
+	operation := "Pause"
+	if !pause {
+		operation = "Unpause"
+	}
+	if err != nil {
+		ErrorAndExit(operation+" schedule failed.", err)
+	} else {
+		fmt.Println(operation + " schedule succeeded.")
+	}
+}
+
+// BackfillSchedule takes the actions a schedule would have taken in the given time range
+func BackfillSchedule(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+	startTime := parseTime(getRequiredOption(c, FlagEarliestTime), 0)
+	endTime := parseTime(getRequiredOption(c, FlagLatestTime), 0)
+
+	request := &shared.BackfillScheduleRequest{
+		Domain:     common.StringPtr(domain),
+		ScheduleId: common.StringPtr(scheduleID),
+		StartTime:  common.Int64Ptr(startTime),
+		EndTime:    common.Int64Ptr(endTime),
+		Identity:   common.StringPtr(getCliIdentity()),
+	}
+	if c.IsSet(FlagOverlapPolicy) {
+		request.OverlapPolicy = getOverlapPolicy(c)
+	}
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.BackfillSchedule(ctx, request)
+
+	if err != nil {
+		ErrorAndExit("Backfill schedule failed.", err)
+	} else {
+		fmt.Println("Backfill schedule succeeded.")
+	}
+}
+
+// DeleteSchedule deletes a schedule
+func DeleteSchedule(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	scheduleID := getRequiredOption(c, FlagScheduleID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.DeleteSchedule(ctx, &shared.DeleteScheduleRequest{
+		Domain:     common.StringPtr(domain),
+		ScheduleId: common.StringPtr(scheduleID),
+		Identity:   common.StringPtr(getCliIdentity()),
+	})
+
+	if err != nil {
+		ErrorAndExit("Delete schedule failed.", err)
+	} else {
+		fmt.Println("Delete schedule succeeded.")
+	}
+}
+
+func getScheduleSpec(c *cli.Context, cronSchedule string) *shared.ScheduleSpec {
+	spec := &shared.ScheduleSpec{CronExpression: common.StringPtr(cronSchedule)}
+	if c.IsSet(FlagCronMaxJitter) {
+		spec.MaxJitterSeconds = common.Int32Ptr(int32(c.Int(FlagCronMaxJitter)))
+	}
+	return spec
+}
+
+func getScheduleAction(c *cli.Context) *shared.ScheduleAction {
+	taskList := getRequiredOption(c, FlagTaskList)
+	workflowType := getRequiredOption(c, FlagWorkflowType)
+	et := c.Int(FlagExecutionTimeout)
+	if et == 0 {
+		ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagExecutionTimeout), nil)
+	}
+	dt := c.Int(FlagDecisionTimeout)
+	input := processJSONInput(c)
+
+	return &shared.ScheduleAction{
+		StartWorkflow: &shared.StartWorkflowAction{
+			WorkflowIdPrefix:                    getPtrOrNilIfEmpty(c.String(FlagWorkflowIDPrefix)),
+			WorkflowType:                        &shared.WorkflowType{Name: common.StringPtr(workflowType)},
+			TaskList:                            &shared.TaskList{Name: common.StringPtr(taskList)},
+			Input:                               []byte(input),
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(int32(et)),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(int32(dt)),
+		},
+	}
+}
+
+func getOverlapPolicy(c *cli.Context) *shared.ScheduleOverlapPolicy {
+	if !c.IsSet(FlagOverlapPolicy) {
+		return shared.ScheduleOverlapPolicySkipNew.Ptr()
+	}
+	var policy shared.ScheduleOverlapPolicy
+	if err := policy.UnmarshalText([]byte(strings.ToUpper(c.String(FlagOverlapPolicy)))); err != nil {
+		ErrorAndExit(fmt.Sprintf("Option %s format is invalid.", FlagOverlapPolicy), err)
+	}
+	return &policy
+}
@@ -541,6 +541,13 @@ func getRequiredIntOption(c *cli.Context, optionName string) int {
 	return c.Int(optionName)
 }
 
+func getRequiredInt64Option(c *cli.Context, optionName string) int64 {
+	if !c.IsSet(optionName) {
+		ErrorAndExit(fmt.Sprintf("Option %s is required", optionName), nil)
+	}
+	return c.Int64(optionName)
+}
+
 func getRequiredGlobalOption(c *cli.Context, optionName string) string {
 	value := c.GlobalString(optionName)
 	if len(value) == 0 {
@@ -23,6 +23,7 @@ package cli
 import (
 	"strings"
 
+	"github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/service/worker/batcher"
 	"github.com/urfave/cli"
 )
@@ -102,11 +103,65 @@ func newWorkflowCommands() []cli.Command {
 					Name:  FlagInputFileWithAlias,
 					Usage: "Input for the signal from JSON file.",
 				},
+				cli.IntFlag{
+					Name:  FlagDelay,
+					Usage: "Optional delay in seconds before the signal is delivered to the workflow",
+				},
 			},
 			Action: func(c *cli.Context) {
 				SignalWorkflow(c)
 			},
 		},
+		{
+			Name:  "cancel-delayed-signal",
+			Usage: "cancel a delayed signal which has not been delivered yet",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagRequestIDWithAlias,
+					Usage: "RequestID of the delayed signal, as printed by the signal command",
+				},
+			},
+			Action: func(c *cli.Context) {
+				CancelDelayedSignal(c)
+			},
+		},
+		{
+			Name:  "update",
+			Usage: "send an update request to a workflow execution and wait for its result",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagNameWithAlias,
+					Usage: "UpdateName",
+				},
+				cli.StringFlag{
+					Name:  FlagInputWithAlias,
+					Usage: "Input for the update, in JSON format.",
+				},
+				cli.StringFlag{
+					Name:  FlagInputFileWithAlias,
+					Usage: "Input for the update from JSON file.",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UpdateWorkflow(c)
+			},
+		},
 		{
 			Name:    "terminate",
 			Aliases: []string{"term"},
@@ -129,6 +184,48 @@ func newWorkflowCommands() []cli.Command {
 				TerminateWorkflow(c)
 			},
 		},
+		{
+			Name:  "pause",
+			Usage: "pause a workflow execution, no decision or activity tasks are dispatched until it is unpaused",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "The reason you want to pause the workflow",
+				},
+			},
+			Action: func(c *cli.Context) {
+				PauseWorkflow(c)
+			},
+		},
+		{
+			Name:  "unpause",
+			Usage: "unpause a paused workflow execution",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  FlagWorkflowIDWithAlias,
+					Usage: "WorkflowID",
+				},
+				cli.StringFlag{
+					Name:  FlagRunIDWithAlias,
+					Usage: "RunID",
+				},
+				cli.StringFlag{
+					Name:  FlagReasonWithAlias,
+					Usage: "The reason you want to unpause the workflow",
+				},
+			},
+			Action: func(c *cli.Context) {
+				UnpauseWorkflow(c)
+			},
+		},
 		{
 			Name:        "list",
 			Aliases:     []string{"l"},
@@ -239,6 +336,10 @@ func newWorkflowCommands() []cli.Command {
 					Name:  FlagResetBadBinaryChecksum,
 					Usage: "Binary checksum for resetType of BadBinary",
 				},
+				cli.StringFlag{
+					Name:  FlagResetReapplyPolicy,
+					Usage: "events after reset point to reapply. Support one of these: " + strings.Join(resetReapplyPolicies, ",") + ". Default to " + shared.ResetReapplyPolicySignals.String(),
+				},
 			},
 			Action: func(c *cli.Context) {
 				ResetWorkflow(c)
@@ -283,6 +384,10 @@ func newWorkflowCommands() []cli.Command {
 					Name:  FlagResetBadBinaryChecksum,
 					Usage: "Binary checksum for resetType of BadBinary",
 				},
+				cli.StringFlag{
+					Name:  FlagResetReapplyPolicy,
+					Usage: "events after reset point to reapply. Support one of these: " + strings.Join(resetReapplyPolicies, ",") + ". Default to " + shared.ResetReapplyPolicySignals.String(),
+				},
 			},
 			Action: func(c *cli.Context) {
 				ResetInBatch(c)
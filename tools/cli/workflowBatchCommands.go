@@ -28,6 +28,7 @@ import (
 	"strings"
 
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/systemworkflow"
 	"github.com/uber/cadence/service/worker/batcher"
 	"github.com/urfave/cli"
 	"go.uber.org/cadence/.gen/go/shared"
@@ -173,7 +174,7 @@ func StartBatchJob(c *cli.Context) {
 	tcCtx, cancel = newContext(c)
 	defer cancel()
 	options := cclient.StartWorkflowOptions{
-		TaskList:                     batcher.BatcherTaskListName,
+		TaskList:                     systemworkflow.BatcherTaskListName,
 		ExecutionStartToCloseTimeout: batcher.InfiniteDuration,
 		Memo: map[string]interface{}{
 			"Reason": reason,
@@ -217,22 +217,42 @@ func startWorkflowHelper(c *cli.Context, shouldPrintProgress bool) {
 		startRequest.SearchAttributes = &s.SearchAttributes{IndexedFields: searchAttrFields}
 	}
 
+	startWorkflow := func(ctx context.Context) (string, error) {
+		if !c.IsSet(FlagDelayStart) && !c.IsSet(FlagCronMaxJitter) {
+			resp, err := serviceClient.StartWorkflowExecution(ctx, startRequest)
+			return resp.GetRunId(), err
+		}
+		// delayed start and cron jitter are only known by the server request type
+		serverRequest, err := convertToServerStartWorkflowRequest(startRequest)
+		if err != nil {
+			return "", err
+		}
+		if c.IsSet(FlagDelayStart) {
+			serverRequest.DelayStartSeconds = common.Int32Ptr(int32(c.Int(FlagDelayStart)))
+		}
+		if c.IsSet(FlagCronMaxJitter) {
+			serverRequest.CronMaxJitterSeconds = common.Int32Ptr(int32(c.Int(FlagCronMaxJitter)))
+		}
+		resp, err := cFactory.ServerFrontendClient(c).StartWorkflowExecution(ctx, serverRequest)
+		return resp.GetRunId(), err
+	}
+
 	startFn := func() {
 		tcCtx, cancel := newContext(c)
 		defer cancel()
-		resp, err := serviceClient.StartWorkflowExecution(tcCtx, startRequest)
+		runID, err := startWorkflow(tcCtx)
 
 		if err != nil {
 			ErrorAndExit("Failed to create workflow.", err)
 		} else {
-			fmt.Printf("Started Workflow Id: %s, run Id: %s\n", wid, resp.GetRunId())
+			fmt.Printf("Started Workflow Id: %s, run Id: %s\n", wid, runID)
 		}
 	}
 
 	runFn := func() {
 		tcCtx, cancel := newContextForLongPoll(c)
 		defer cancel()
-		resp, err := serviceClient.StartWorkflowExecution(tcCtx, startRequest)
+		runID, err := startWorkflow(tcCtx)
 
 		if err != nil {
 			ErrorAndExit("Failed to run workflow.", err)
@@ -243,7 +263,7 @@ func startWorkflowHelper(c *cli.Context, shouldPrintProgress bool) {
 		table := tablewriter.NewWriter(os.Stdout)
 		executionData := [][]string{
 			{"Workflow Id", wid},
-			{"Run Id", resp.GetRunId()},
+			{"Run Id", runID},
 			{"Type", workflowType},
 			{"Domain", domain},
 			{"Task List", taskList},
@@ -254,7 +274,7 @@ func startWorkflowHelper(c *cli.Context, shouldPrintProgress bool) {
 		table.AppendBulk(executionData) // Add Bulk Data
 		table.Render()
 
-		printWorkflowProgress(c, wid, resp.GetRunId())
+		printWorkflowProgress(c, wid, runID)
 	}
 
 	if shouldPrintProgress {
@@ -264,6 +284,19 @@ func startWorkflowHelper(c *cli.Context, shouldPrintProgress bool) {
 	}
 }
 
+// convertToServerStartWorkflowRequest converts the client start request to the server one through their common wire format
+func convertToServerStartWorkflowRequest(request *s.StartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionRequest, error) {
+	wireValue, err := request.ToWire()
+	if err != nil {
+		return nil, err
+	}
+	serverRequest := &shared.StartWorkflowExecutionRequest{}
+	if err := serverRequest.FromWire(wireValue); err != nil {
+		return nil, err
+	}
+	return serverRequest, nil
+}
+
 func processSearchAttr(c *cli.Context) map[string][]byte {
 	rawSearchAttrKey := c.String(FlagSearchAttributesKey)
 	var searchAttrKeys []string
@@ -422,6 +455,62 @@ func TerminateWorkflow(c *cli.Context) {
 	}
 }
 
+// PauseWorkflow pauses a workflow execution
+func PauseWorkflow(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	reason := c.String(FlagReason)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.PauseWorkflowExecution(ctx, &shared.PauseWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      getPtrOrNilIfEmpty(rid),
+		},
+		Reason:   common.StringPtr(reason),
+		Identity: common.StringPtr(getCliIdentity()),
+	})
+
+	if err != nil {
+		ErrorAndExit("Pause workflow failed.", err)
+	} else {
+		fmt.Println("Pause workflow succeeded.")
+	}
+}
+
+// UnpauseWorkflow unpauses a workflow execution
+func UnpauseWorkflow(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	reason := c.String(FlagReason)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.UnpauseWorkflowExecution(ctx, &shared.UnpauseWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      getPtrOrNilIfEmpty(rid),
+		},
+		Reason:   common.StringPtr(reason),
+		Identity: common.StringPtr(getCliIdentity()),
+	})
+
+	if err != nil {
+		ErrorAndExit("Unpause workflow failed.", err)
+	} else {
+		fmt.Println("Unpause workflow succeeded.")
+	}
+}
+
 // CancelWorkflow cancels a workflow execution
 func CancelWorkflow(c *cli.Context) {
 	wfClient := getWorkflowClient(c)
@@ -442,6 +531,11 @@ func CancelWorkflow(c *cli.Context) {
 
 // SignalWorkflow signals a workflow execution
 func SignalWorkflow(c *cli.Context) {
+	if c.Int(FlagDelay) > 0 {
+		signalWorkflowWithDelay(c)
+		return
+	}
+
 	serviceClient := cFactory.ClientFrontendClient(c)
 
 	domain := getRequiredGlobalOption(c, FlagDomain)
@@ -470,6 +564,99 @@ func SignalWorkflow(c *cli.Context) {
 	}
 }
 
+func signalWorkflowWithDelay(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	name := getRequiredOption(c, FlagName)
+	input := processJSONInput(c)
+	// delayed signal is identified by its request id, which is needed to cancel it
+	requestID := uuid.New()
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.SignalWorkflowExecution(ctx, &shared.SignalWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      getPtrOrNilIfEmpty(rid),
+		},
+		SignalName:   common.StringPtr(name),
+		Input:        []byte(input),
+		Identity:     common.StringPtr(getCliIdentity()),
+		RequestId:    common.StringPtr(requestID),
+		DelaySeconds: common.Int32Ptr(int32(c.Int(FlagDelay))),
+	})
+
+	if err != nil {
+		ErrorAndExit("Signal workflow failed.", err)
+	} else {
+		fmt.Printf("Signal workflow succeeded, delayed signal request Id: %s\n", requestID)
+	}
+}
+
+// CancelDelayedSignal cancels a delayed signal which has not been delivered yet
+func CancelDelayedSignal(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	requestID := getRequiredOption(c, FlagRequestID)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	err := frontendClient.CancelDelayedSignal(ctx, &shared.CancelDelayedSignalRequest{
+		Domain: common.StringPtr(domain),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      getPtrOrNilIfEmpty(rid),
+		},
+		RequestId: common.StringPtr(requestID),
+		Identity:  common.StringPtr(getCliIdentity()),
+	})
+
+	if err != nil {
+		ErrorAndExit("Cancel delayed signal failed.", err)
+	} else {
+		fmt.Println("Cancel delayed signal succeeded.")
+	}
+}
+
+// UpdateWorkflow sends an update request to a workflow execution and waits for its result
+func UpdateWorkflow(c *cli.Context) {
+	frontendClient := cFactory.ServerFrontendClient(c)
+
+	domain := getRequiredGlobalOption(c, FlagDomain)
+	wid := getRequiredOption(c, FlagWorkflowID)
+	rid := c.String(FlagRunID)
+	name := getRequiredOption(c, FlagName)
+	input := processJSONInput(c)
+
+	ctx, cancel := newContextForLongPoll(c)
+	defer cancel()
+	resp, err := frontendClient.UpdateWorkflowExecution(ctx, &shared.UpdateWorkflowExecutionRequest{
+		Domain: common.StringPtr(domain),
+		WorkflowExecution: &shared.WorkflowExecution{
+			WorkflowId: common.StringPtr(wid),
+			RunId:      getPtrOrNilIfEmpty(rid),
+		},
+		UpdateName: common.StringPtr(name),
+		Input:      []byte(input),
+		Identity:   common.StringPtr(getCliIdentity()),
+		RequestId:  common.StringPtr(uuid.New()),
+	})
+
+	if err != nil {
+		ErrorAndExit("Update workflow failed.", err)
+	} else {
+		fmt.Println("Update workflow succeeded, result:")
+		fmt.Println(string(resp.Result))
+	}
+}
+
 // QueryWorkflow query workflow execution
 func QueryWorkflow(c *cli.Context) {
 	getRequiredGlobalOption(c, FlagDomain) // for pre-check and alert if not provided
@@ -706,6 +893,7 @@ type describeWorkflowExecutionResponse struct {
 	WorkflowExecutionInfo  workflowExecutionInfo
 	PendingActivities      []*pendingActivityInfo
 	PendingChildren        []*shared.PendingChildExecutionInfo
+	PendingDelayedSignals  []*pendingDelayedSignalInfo
 }
 
 // workflowExecutionInfo has same fields as shared.WorkflowExecutionInfo, but has datetime instead of raw time
@@ -720,6 +908,7 @@ type workflowExecutionInfo struct {
 	ParentExecution  *shared.WorkflowExecution
 	SearchAttributes map[string]interface{}
 	AutoResetPoints  *shared.ResetPoints
+	Paused           *bool
 }
 
 // pendingActivityInfo has same fields as shared.PendingActivityInfo, but different field type for better display
@@ -738,6 +927,14 @@ type pendingActivityInfo struct {
 	LastWorkerIdentity     *string `json:",omitempty"`
 }
 
+// pendingDelayedSignalInfo has same fields as shared.PendingDelayedSignalInfo, but has datetime instead of raw time
+type pendingDelayedSignalInfo struct {
+	RequestID     *string
+	SignalName    *string
+	Identity      *string `json:",omitempty"`
+	FireTimestamp *string // change from *int64
+}
+
 func convertDescribeWorkflowExecutionResponse(resp *shared.DescribeWorkflowExecutionResponse,
 	wfClient workflowserviceclient.Interface, c *cli.Context) *describeWorkflowExecutionResponse {
 
@@ -753,6 +950,7 @@ func convertDescribeWorkflowExecutionResponse(resp *shared.DescribeWorkflowExecu
 		ParentExecution:  info.ParentExecution,
 		SearchAttributes: convertSearchAttributesToMapOfInterface(info.SearchAttributes, wfClient, c),
 		AutoResetPoints:  info.AutoResetPoints,
+		Paused:           info.Paused,
 	}
 
 	var pendingActs []*pendingActivityInfo
@@ -777,11 +975,22 @@ func convertDescribeWorkflowExecutionResponse(resp *shared.DescribeWorkflowExecu
 		pendingActs = append(pendingActs, tmpAct)
 	}
 
+	var pendingSignals []*pendingDelayedSignalInfo
+	for _, ps := range resp.PendingDelayedSignals {
+		pendingSignals = append(pendingSignals, &pendingDelayedSignalInfo{
+			RequestID:     ps.RequestId,
+			SignalName:    ps.SignalName,
+			Identity:      ps.Identity,
+			FireTimestamp: timestampPtrToStringPtr(ps.FireTimestamp, false),
+		})
+	}
+
 	return &describeWorkflowExecutionResponse{
 		ExecutionConfiguration: resp.ExecutionConfiguration,
 		WorkflowExecutionInfo:  executionInfo,
 		PendingActivities:      pendingActs,
 		PendingChildren:        resp.PendingChildren,
+		PendingDelayedSignals:  pendingSignals,
 	}
 }
 
@@ -1138,6 +1347,10 @@ func ResetWorkflow(c *cli.Context) {
 	if ok && len(extraForResetType) > 0 {
 		getRequiredOption(c, extraForResetType)
 	}
+	reapplyPolicy, err := getResetReapplyPolicy(c)
+	if err != nil {
+		ErrorAndExit("Not supported reapply policy", err)
+	}
 
 	ctx, cancel := newContext(c)
 	defer cancel()
@@ -1146,7 +1359,6 @@ func ResetWorkflow(c *cli.Context) {
 
 	resetBaseRunID := rid
 	decisionFinishID := eventID
-	var err error
 	if resetType != "" {
 		resetBaseRunID, decisionFinishID, err = getResetEventIDByType(ctx, c, resetType, domain, wid, rid, frontendClient)
 		if err != nil {
@@ -1162,6 +1374,7 @@ func ResetWorkflow(c *cli.Context) {
 		Reason:                common.StringPtr(fmt.Sprintf("%v:%v", getCurrentUserFromEnv(), reason)),
 		DecisionFinishEventId: common.Int64Ptr(decisionFinishID),
 		RequestId:             common.StringPtr(uuid.New()),
+		ReapplyPolicy:         common.ResetReapplyPolicyPtr(reapplyPolicy),
 	})
 	if err != nil {
 		ErrorAndExit("reset failed", err)
@@ -1169,7 +1382,7 @@ func ResetWorkflow(c *cli.Context) {
 	prettyPrintJSONObject(resp)
 }
 
-func processResets(c *cli.Context, domain string, wes chan shared.WorkflowExecution, done chan bool, wg *sync.WaitGroup, reason, resetType string, skipOpen bool) {
+func processResets(c *cli.Context, domain string, wes chan shared.WorkflowExecution, done chan bool, wg *sync.WaitGroup, reason, resetType string, reapplyPolicy shared.ResetReapplyPolicy, skipOpen bool) {
 	for {
 		select {
 		case we := <-wes:
@@ -1178,7 +1391,7 @@ func processResets(c *cli.Context, domain string, wes chan shared.WorkflowExecut
 			rid := we.GetRunId()
 			var err error
 			for i := 0; i < 3; i++ {
-				err = doReset(c, domain, wid, rid, reason, resetType, skipOpen)
+				err = doReset(c, domain, wid, rid, reason, resetType, reapplyPolicy, skipOpen)
 				if err == nil {
 					break
 				}
@@ -1210,6 +1423,10 @@ func ResetInBatch(c *cli.Context) {
 	} else {
 		getRequiredOption(c, extraForResetType)
 	}
+	reapplyPolicy, err := getResetReapplyPolicy(c)
+	if err != nil {
+		ErrorAndExit("Not supported reapply policy", err)
+	}
 
 	if !c.IsSet(FlagSkipCurrent) {
 		ErrorAndExit("need to specify whether skip on current is open", nil)
@@ -1223,7 +1440,7 @@ func ResetInBatch(c *cli.Context) {
 	done := make(chan bool)
 	for i := 0; i < parallel; i++ {
 		wg.Add(1)
-		go processResets(c, domain, wes, done, wg, reason, resetType, skipOpen)
+		go processResets(c, domain, wes, done, wg, reason, resetType, reapplyPolicy, skipOpen)
 	}
 
 	// read exclude
@@ -1314,7 +1531,7 @@ func printErrorAndReturn(msg string, err error) error {
 	return err
 }
 
-func doReset(c *cli.Context, domain, wid, rid string, reason, resetType string, skipOpen bool) error {
+func doReset(c *cli.Context, domain, wid, rid string, reason, resetType string, reapplyPolicy shared.ResetReapplyPolicy, skipOpen bool) error {
 	ctx, cancel := newContext(c)
 	defer cancel()
 
@@ -1361,6 +1578,7 @@ func doReset(c *cli.Context, domain, wid, rid string, reason, resetType string,
 		DecisionFinishEventId: common.Int64Ptr(decisionFinishID),
 		RequestId:             common.StringPtr(uuid.New()),
 		Reason:                common.StringPtr(fmt.Sprintf("%v:%v", getCurrentUserFromEnv(), reason)),
+		ReapplyPolicy:         common.ResetReapplyPolicyPtr(reapplyPolicy),
 	})
 
 	if err != nil {
@@ -1370,6 +1588,16 @@ func doReset(c *cli.Context, domain, wid, rid string, reason, resetType string,
 	return nil
 }
 
+func getResetReapplyPolicy(c *cli.Context) (shared.ResetReapplyPolicy, error) {
+	reapplyPolicy := shared.ResetReapplyPolicySignals
+	if c.IsSet(FlagResetReapplyPolicy) {
+		if err := reapplyPolicy.UnmarshalText([]byte(c.String(FlagResetReapplyPolicy))); err != nil {
+			return reapplyPolicy, err
+		}
+	}
+	return reapplyPolicy, nil
+}
+
 func getResetEventIDByType(ctx context.Context, c *cli.Context, resetType, domain, wid, rid string, frontendClient workflowserviceclient.Interface) (resetBaseRunID string, decisionFinishID int64, err error) {
 	fmt.Println("switch", resetType)
 	switch resetType {
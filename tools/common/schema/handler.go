@@ -75,6 +75,15 @@ func Update(cli *cli.Context, db DB) error {
 	return newUpdateSchemaTask(db, cfg).Run()
 }
 
+// Verify compares the tables and columns of the specified database with the versioned schema
+func Verify(cli *cli.Context, db DB) error {
+	cfg, err := newVerifyConfig(cli)
+	if err != nil {
+		return err
+	}
+	return newVerifySchemaTask(db, cfg).Run()
+}
+
 func newUpdateConfig(cli *cli.Context) (*UpdateConfig, error) {
 	config := new(UpdateConfig)
 	config.SchemaDir = cli.String(CLIOptSchemaDir)
@@ -87,6 +96,16 @@ func newUpdateConfig(cli *cli.Context) (*UpdateConfig, error) {
 	return config, nil
 }
 
+func newVerifyConfig(cli *cli.Context) (*VerifyConfig, error) {
+	config := new(VerifyConfig)
+	config.SchemaDir = cli.String(CLIOptSchemaDir)
+
+	if err := validateVerifyConfig(config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
 func newSetupConfig(cli *cli.Context) (*SetupConfig, error) {
 	config := new(SetupConfig)
 	config.SchemaFilePath = cli.String(CLIOptSchemaFile)
@@ -133,6 +152,13 @@ func validateUpdateConfig(config *UpdateConfig) error {
 	return nil
 }
 
+func validateVerifyConfig(config *VerifyConfig) error {
+	if len(config.SchemaDir) == 0 {
+		return NewConfigError("missing " + flag(CLIOptSchemaDir) + " argument ")
+	}
+	return nil
+}
+
 func flag(opt string) string {
 	return "(-" + opt + ")"
 }
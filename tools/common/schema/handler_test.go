@@ -101,6 +101,17 @@ func (s *HandlerTestSuite) TestValidateUpdateConfig() {
 	s.Equal("1.2", config.TargetVersion)
 }
 
+func (s *HandlerTestSuite) TestValidateVerifyConfig() {
+	config := new(VerifyConfig)
+	err := validateVerifyConfig(config)
+	s.NotNil(err)
+	_, ok := err.(*ConfigError)
+	s.True(ok)
+
+	config.SchemaDir = "/tmp"
+	s.Nil(validateVerifyConfig(config))
+}
+
 func (s *HandlerTestSuite) assertValidateSetupSucceeds(input *SetupConfig) {
 	err := validateSetupConfig(input)
 	s.Nil(err)
@@ -41,7 +41,6 @@ type (
 		schema.DB
 		CreateDatabase(name string) error
 		DropDatabase(name string) error
-		ListTables() ([]string, error)
 	}
 	// DBTestBase is the base for all test suites that test
 	// the functionality of a DB implementation
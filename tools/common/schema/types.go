@@ -39,6 +39,11 @@ type (
 		SchemaDir     string
 		IsDryRun      bool
 	}
+	// VerifyConfig holds the config
+	// params for executing a VerifyTask
+	VerifyConfig struct {
+		SchemaDir string
+	}
 	// SetupConfig holds the config
 	// params need by the SetupTask
 	SetupConfig struct {
@@ -52,7 +57,14 @@ type (
 	DB interface {
 		// Exec executes a cql statement
 		Exec(stmt string) error
-		//ListTables() ([]string, error)
+		// ListTables returns the names of the tables in the keyspace
+		ListTables() ([]string, error)
+		// ListColumns returns the names of the columns of the given table
+		ListColumns(table string) ([]string, error)
+		// ListTypes returns the names of the user defined types in the keyspace
+		ListTypes() ([]string, error)
+		// ListTypeFields returns the names of the fields of the given user defined type
+		ListTypeFields(name string) ([]string, error)
 		// DropAllTables drops all tables
 		DropAllTables() error
 		// CreateSchemaVersionTables sets up the schema version tables
@@ -140,9 +152,6 @@ const (
 	CLIFlagQuiet = CLIOptQuiet + ", q"
 )
 
-// DryrunDBName is the db name used for dryrun
-const DryrunDBName = "_cadence_dryrun_"
-
 var rmspaceRegex = regexp.MustCompile("\\s+")
 
 // NewConfigError creates and returns an instance of ConfigError
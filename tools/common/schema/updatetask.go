@@ -86,22 +86,27 @@ func (task *UpdateTask) Run() error {
 
 	log.Printf("UpdateSchemeTask started, config=%+v\n", config)
 
-	if config.IsDryRun {
-		if err := task.setupDryrunDatabase(); err != nil {
-			return fmt.Errorf("error creating dryrun database:%v", err.Error())
-		}
-	}
-
 	currVer, err := task.db.ReadSchemaVersion()
 	if err != nil {
-		return fmt.Errorf("error reading current schema version:%v", err.Error())
+		if !config.IsDryRun || task.hasVersionTable() {
+			return fmt.Errorf("error reading current schema version:%v", err.Error())
+		}
+		// a keyspace without versioning tables gets the full schema
+		log.Printf("schema version table not found, planning updates from version 0.0\n")
+		currVer = "0.0"
 	}
 
-	updates, err := task.buildChangeSet(currVer)
+	updates, err := readChangeSets(config.SchemaDir, currVer, config.TargetVersion)
 	if err != nil {
 		return err
 	}
 
+	if config.IsDryRun {
+		printUpdates(currVer, updates)
+		log.Printf("UpdateSchemeTask dryrun done, no statements executed\n")
+		return nil
+	}
+
 	err = task.executeUpdates(currVer, updates)
 	if err != nil {
 		return err
@@ -112,6 +117,21 @@ func (task *UpdateTask) Run() error {
 	return nil
 }
 
+// hasVersionTable returns false only when the keyspace/database is known to
+// have no schema version table, i.e. it was never set up by the schema tool
+func (task *UpdateTask) hasVersionTable() bool {
+	tables, err := task.db.ListTables()
+	if err != nil {
+		return true
+	}
+	for _, table := range tables {
+		if normalizeName(table) == "schema_version" {
+			return true
+		}
+	}
+	return false
+}
+
 func (task *UpdateTask) executeUpdates(currVer string, updates []changeSet) error {
 
 	if len(updates) == 0 {
@@ -137,6 +157,21 @@ func (task *UpdateTask) executeUpdates(currVer string, updates []changeSet) erro
 	return nil
 }
 
+// printUpdates prints the statements that an update from currVer executes, the output
+// can be applied as is to the keyspace/database
+func printUpdates(currVer string, updates []changeSet) {
+	if len(updates) == 0 {
+		log.Printf("found zero updates from current version %v", currVer)
+		return
+	}
+	for _, cs := range updates {
+		fmt.Printf("-- updates for version %v: %v\n", cs.version, cs.manifest.Description)
+		for _, stmt := range cs.cqlStmts {
+			fmt.Println(rmspaceRegex.ReplaceAllString(stmt, " "))
+		}
+	}
+}
+
 func (task *UpdateTask) execCQLStmts(ver string, stmts []string) error {
 	log.Printf("---- Executing updates for version %v ----\n", ver)
 	for _, stmt := range stmts {
@@ -165,11 +200,11 @@ func (task *UpdateTask) updateSchemaVersion(oldVer string, cs *changeSet) error
 	return nil
 }
 
-func (task *UpdateTask) buildChangeSet(currVer string) ([]changeSet, error) {
-
-	config := task.config
+// readChangeSets returns the changes of every version after currVer up to and
+// including targetVer, or up to the latest version if targetVer is empty
+func readChangeSets(schemaDir string, currVer string, targetVer string) ([]changeSet, error) {
 
-	verDirs, err := readSchemaDir(config.SchemaDir, currVer, config.TargetVersion)
+	verDirs, err := readSchemaDir(schemaDir, currVer, targetVer)
 	if err != nil {
 		return nil, fmt.Errorf("error listing schema dir:%v", err.Error())
 	}
@@ -178,7 +213,7 @@ func (task *UpdateTask) buildChangeSet(currVer string) ([]changeSet, error) {
 
 	for _, vd := range verDirs {
 
-		dirPath := config.SchemaDir + "/" + vd
+		dirPath := schemaDir + "/" + vd
 
 		m, e := readManifest(dirPath)
 		if e != nil {
@@ -190,7 +225,7 @@ func (task *UpdateTask) buildChangeSet(currVer string) ([]changeSet, error) {
 				vd, m.CurrVersion)
 		}
 
-		stmts, e := task.parseSQLStmts(dirPath, m)
+		stmts, e := parseSQLStmts(dirPath, m)
 		if e != nil {
 			return nil, e
 		}
@@ -210,7 +245,7 @@ func (task *UpdateTask) buildChangeSet(currVer string) ([]changeSet, error) {
 	return result, nil
 }
 
-func (task *UpdateTask) parseSQLStmts(dir string, manifest *manifest) ([]string, error) {
+func parseSQLStmts(dir string, manifest *manifest) ([]string, error) {
 
 	result := make([]string, 0, 4)
 
@@ -363,17 +398,6 @@ func readSchemaDir(dir string, startVer string, endVer string) ([]string, error)
 	return result, nil
 }
 
-// sets up a temporary dryrun database for
-// executing the cassandra schema update
-func (task *UpdateTask) setupDryrunDatabase() error {
-	setupConfig := &SetupConfig{
-		Overwrite:      true,
-		InitialVersion: "0.0",
-	}
-	setupTask := newSetupSchemaTask(task.db, setupConfig)
-	return setupTask.Run()
-}
-
 func dirToVersion(dir string) string {
 	return dir[1:]
 }
@@ -21,6 +21,7 @@
 package schema
 
 import (
+	"errors"
 	"io/ioutil"
 	"os"
 	"testing"
@@ -85,6 +86,31 @@ func (s *UpdateTaskTestSuite) TestReadSchemaDir() {
 	s.Equal(0, len(ans))
 }
 
+func (s *UpdateTaskTestSuite) TestRun_Dryrun() {
+	tmpDir, err := ioutil.TempDir("", "update_schema_test_dryrun")
+	s.NoError(err)
+	defer os.RemoveAll(tmpDir)
+
+	s.NoError(os.Mkdir(tmpDir+"/v1.0", os.FileMode(0700)))
+	manifest := `{"CurrVersion": "1.0", "MinCompatibleVersion": "1.0", "Description": "base", "SchemaUpdateCqlFiles": ["base.cql"]}`
+	s.NoError(ioutil.WriteFile(tmpDir+"/v1.0/manifest.json", []byte(manifest), os.FileMode(0600)))
+	s.NoError(ioutil.WriteFile(tmpDir+"/v1.0/base.cql", []byte("CREATE TABLE domains (id uuid PRIMARY KEY);"), os.FileMode(0600)))
+
+	// the fake db panics on any statement execution or version update
+	db := &fakeDB{version: "0.0"}
+	task := newUpdateSchemaTask(db, &UpdateConfig{SchemaDir: tmpDir, IsDryRun: true})
+	s.NoError(task.Run())
+
+	db.version = "1.0"
+	s.NoError(task.Run())
+
+	// only a missing version table means the schema was never set up
+	db.versionErr = errors.New("unconfigured table schema_version")
+	s.NoError(task.Run())
+	db.tables = map[string][]string{"schema_version": {"keyspace_name", "curr_version"}}
+	s.Error(task.Run())
+}
+
 func (s *UpdateTaskTestSuite) TestReadManifest() {
 
 	tmpDir, err := ioutil.TempDir("", "update_schema_test")
@@ -0,0 +1,356 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+type (
+	// VerifyTask represents a task that compares the tables,
+	// columns and user defined types of a keyspace/database
+	// with the ones defined by the versioned schema of its
+	// current version. Only the names are compared, the types
+	// of the columns and fields, the keys, the indexes and the
+	// table options are not verified
+	VerifyTask struct {
+		db     DB
+		config *VerifyConfig
+	}
+
+	// objectMembers is the set of columns of each table,
+	// or the set of fields of each user defined type
+	objectMembers map[string]map[string]struct{}
+
+	// schemaObjects is the set of tables and
+	// user defined types of a keyspace/database
+	schemaObjects struct {
+		tables objectMembers
+		types  objectMembers
+	}
+)
+
+var (
+	createTableRegex = regexp.MustCompile(`(?is)^CREATE\s+TABLE\s+(?:IF\s+NOT\s+EXISTS\s+)?([^\s(]+)\s*\(`)
+	alterTableRegex  = regexp.MustCompile(`(?is)^ALTER\s+TABLE\s+([^\s]+)\s+(.*?);?$`)
+	createTypeRegex  = regexp.MustCompile(`(?is)^CREATE\s+TYPE\s+(?:IF\s+NOT\s+EXISTS\s+)?([^\s(]+)\s*\(`)
+	alterTypeRegex   = regexp.MustCompile(`(?is)^ALTER\s+TYPE\s+([^\s]+)\s+(.*?);?$`)
+	addColumnRegex   = regexp.MustCompile(`(?is)^ADD\s+(?:COLUMN\s+)?([^\s(]+)`)
+	addColumnsRegex  = regexp.MustCompile(`(?is)^ADD\s*(?:COLUMN\s*)?\((.*)\)$`)
+	dropColumnRegex  = regexp.MustCompile(`(?is)^DROP\s+(?:COLUMN\s+)?([^\s(]+)`)
+	renameRegex      = regexp.MustCompile(`(?is)^RENAME\s+(?:COLUMN\s+)?(.*)$`)
+	renameToRegex    = regexp.MustCompile(`(?is)^([^\s]+)\s+TO\s+([^\s]+)$`)
+	renameAndRegex   = regexp.MustCompile(`(?is)\s+AND\s+`)
+
+	// the versioning tables are created by the schema tool, not by the versioned schema
+	versioningTables = map[string]struct{}{
+		"schema_version":        {},
+		"schema_update_history": {},
+	}
+
+	// keywords that start a table definition item that is not a column
+	nonColumnKeywords = map[string]struct{}{
+		"primary":    {},
+		"key":        {},
+		"index":      {},
+		"unique":     {},
+		"constraint": {},
+		"foreign":    {},
+		"fulltext":   {},
+		"check":      {},
+	}
+)
+
+// newVerifySchemaTask returns a new instance of VerifyTask
+func newVerifySchemaTask(db DB, config *VerifyConfig) *VerifyTask {
+	return &VerifyTask{
+		db:     db,
+		config: config,
+	}
+}
+
+// Run executes the task
+func (task *VerifyTask) Run() error {
+	config := task.config
+
+	log.Printf("VerifySchemaTask started, config=%+v\n", config)
+
+	currVer, err := task.db.ReadSchemaVersion()
+	if err != nil {
+		return fmt.Errorf("error reading current schema version:%v", err.Error())
+	}
+
+	expected, err := readExpectedSchema(config.SchemaDir, currVer)
+	if err != nil {
+		return err
+	}
+
+	actual, err := task.readSchema()
+	if err != nil {
+		return err
+	}
+
+	drift := append(diffObjects("table", "column", expected.tables, actual.tables),
+		diffObjects("type", "field", expected.types, actual.types)...)
+	if len(drift) > 0 {
+		for _, d := range drift {
+			log.Println(d)
+		}
+		return fmt.Errorf("schema does not match version %v, found %v differences", currVer, len(drift))
+	}
+
+	if latestVer, err := getExpectedVersion(config.SchemaDir); err == nil && cmpVersion(currVer, latestVer) < 0 {
+		log.Printf("schema is at version %v, latest available version is %v\n", currVer, latestVer)
+	}
+
+	log.Printf("VerifySchemaTask done, schema matches version %v\n", currVer)
+
+	return nil
+}
+
+// newSchemaObjects returns an empty set of tables and types
+func newSchemaObjects() schemaObjects {
+	return schemaObjects{
+		tables: make(objectMembers),
+		types:  make(objectMembers),
+	}
+}
+
+// readSchema returns the tables, columns and user defined types of the keyspace/database
+func (task *VerifyTask) readSchema() (schemaObjects, error) {
+	result := newSchemaObjects()
+	tables, err := task.db.ListTables()
+	if err != nil {
+		return result, fmt.Errorf("error listing tables:%v", err.Error())
+	}
+	for _, table := range tables {
+		name := normalizeName(table)
+		if _, ok := versioningTables[name]; ok {
+			continue
+		}
+		columns, err := task.db.ListColumns(table)
+		if err != nil {
+			return result, fmt.Errorf("error listing columns of table %v:%v", table, err.Error())
+		}
+		result.tables[name] = toMemberSet(columns)
+	}
+	types, err := task.db.ListTypes()
+	if err != nil {
+		return result, fmt.Errorf("error listing types:%v", err.Error())
+	}
+	for _, t := range types {
+		fields, err := task.db.ListTypeFields(t)
+		if err != nil {
+			return result, fmt.Errorf("error listing fields of type %v:%v", t, err.Error())
+		}
+		result.types[normalizeName(t)] = toMemberSet(fields)
+	}
+	return result, nil
+}
+
+// readExpectedSchema replays the versioned schema up to and including version
+// ver and returns the tables, columns and user defined types it defines
+func readExpectedSchema(schemaDir string, ver string) (schemaObjects, error) {
+	result := newSchemaObjects()
+	if cmpVersion(ver, "0.0") <= 0 {
+		return result, nil
+	}
+	changeSets, err := readChangeSets(schemaDir, "0.0", ver)
+	if err != nil {
+		return result, err
+	}
+	for _, cs := range changeSets {
+		for _, stmt := range cs.cqlStmts {
+			applySchemaStmt(result, stmt)
+		}
+	}
+	return result, nil
+}
+
+// applySchemaStmt applies the table, column and type changes of the given
+// statement, statements that do not change tables or types are ignored
+func applySchemaStmt(schema schemaObjects, stmt string) {
+	stmt = strings.TrimSpace(stmt)
+	if match := createTableRegex.FindStringSubmatch(stmt); match != nil {
+		schema.tables[normalizeName(match[1])] = definedMembers(stmt[len(match[0])-1:])
+	} else if match := createTypeRegex.FindStringSubmatch(stmt); match != nil {
+		schema.types[normalizeName(match[1])] = definedMembers(stmt[len(match[0])-1:])
+	} else if match := alterTableRegex.FindStringSubmatch(stmt); match != nil {
+		if columns, ok := schema.tables[normalizeName(match[1])]; ok {
+			alterMembers(columns, match[2])
+		}
+	} else if match := alterTypeRegex.FindStringSubmatch(stmt); match != nil {
+		if fields, ok := schema.types[normalizeName(match[1])]; ok {
+			alterMembers(fields, match[2])
+		}
+	}
+}
+
+// definedMembers returns the columns or fields defined
+// by the parenthesis that the given string starts with
+func definedMembers(s string) map[string]struct{} {
+	members := make(map[string]struct{})
+	for _, item := range splitTopLevel(tableBody(s)) {
+		if name, ok := columnName(item); ok {
+			members[name] = struct{}{}
+		}
+	}
+	return members
+}
+
+// alterMembers applies the ADD, DROP and RENAME clauses of an
+// ALTER TABLE or ALTER TYPE statement to the given columns or fields
+func alterMembers(members map[string]struct{}, clauses string) {
+	for _, clause := range splitTopLevel(clauses) {
+		if add := addColumnsRegex.FindStringSubmatch(clause); add != nil {
+			// e.g. ADD (a int, b int)
+			for _, item := range splitTopLevel(add[1]) {
+				if name, ok := columnName(item); ok {
+					members[name] = struct{}{}
+				}
+			}
+		} else if add := addColumnRegex.FindStringSubmatch(clause); add != nil {
+			if name, ok := columnName(add[1]); ok {
+				members[name] = struct{}{}
+			}
+		} else if drop := dropColumnRegex.FindStringSubmatch(clause); drop != nil {
+			if name, ok := columnName(drop[1]); ok {
+				delete(members, name)
+			}
+		} else if rename := renameRegex.FindStringSubmatch(clause); rename != nil {
+			// e.g. RENAME a TO b AND c TO d
+			for _, pair := range renameAndRegex.Split(rename[1], -1) {
+				if names := renameToRegex.FindStringSubmatch(strings.TrimSpace(pair)); names != nil {
+					if _, ok := members[normalizeName(names[1])]; ok {
+						delete(members, normalizeName(names[1]))
+						members[normalizeName(names[2])] = struct{}{}
+					}
+				}
+			}
+		}
+	}
+}
+
+// diffObjects returns a description of every difference between the expected
+// and the actual tables or types, sorted by name. kind and memberKind name
+// the objects and their members in the descriptions, e.g. table and column
+func diffObjects(kind string, memberKind string, expected objectMembers, actual objectMembers) []string {
+	var result []string
+	for object, members := range expected {
+		actualMembers, ok := actual[object]
+		if !ok {
+			result = append(result, fmt.Sprintf("missing %v %v", kind, object))
+			continue
+		}
+		for member := range members {
+			if _, ok := actualMembers[member]; !ok {
+				result = append(result, fmt.Sprintf("%v %v: missing %v %v", kind, object, memberKind, member))
+			}
+		}
+		for member := range actualMembers {
+			if _, ok := members[member]; !ok {
+				result = append(result, fmt.Sprintf("%v %v: unexpected %v %v", kind, object, memberKind, member))
+			}
+		}
+	}
+	for object := range actual {
+		if _, ok := expected[object]; !ok {
+			result = append(result, fmt.Sprintf("unexpected %v %v", kind, object))
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// toMemberSet returns the normalized names of the given columns or fields
+func toMemberSet(names []string) map[string]struct{} {
+	result := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		result[normalizeName(name)] = struct{}{}
+	}
+	return result
+}
+
+// tableBody returns the content of the parenthesis that the given string starts with
+func tableBody(s string) string {
+	depth := 0
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return s[1:i]
+			}
+		}
+	}
+	return strings.TrimPrefix(s, "(")
+}
+
+// splitTopLevel splits the given string at the commas that are not
+// nested in parenthesis or type parameters, e.g. map<text, int>
+func splitTopLevel(s string) []string {
+	var result []string
+	depth := 0
+	start := 0
+	for i, c := range s {
+		switch c {
+		case '(', '<', '{':
+			depth++
+		case ')', '>', '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				result = append(result, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if last := strings.TrimSpace(s[start:]); last != "" {
+		result = append(result, last)
+	}
+	return result
+}
+
+// columnName returns the name of the column or field defined by the given definition item
+func columnName(item string) (string, bool) {
+	fields := strings.Fields(item)
+	if len(fields) == 0 {
+		return "", false
+	}
+	if _, ok := nonColumnKeywords[strings.ToLower(fields[0])]; ok {
+		return "", false
+	}
+	return normalizeName(fields[0]), true
+}
+
+// normalizeName strips the keyspace and the quotes of the given name
+func normalizeName(name string) string {
+	if i := strings.LastIndex(name, "."); i >= 0 {
+		name = name[i+1:]
+	}
+	return strings.ToLower(strings.Trim(name, "`\""))
+}
@@ -0,0 +1,212 @@
+// Copyright (c) 2019 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package schema
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	VerifyTaskTestSuite struct {
+		*require.Assertions // override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test, not merely log an error
+		suite.Suite
+	}
+
+	fakeDB struct {
+		DB
+		version    string
+		versionErr error
+		tables     map[string][]string
+		types      map[string][]string
+	}
+)
+
+func TestVerifyTaskTestSuite(t *testing.T) {
+	suite.Run(t, new(VerifyTaskTestSuite))
+}
+
+func (s *VerifyTaskTestSuite) SetupSuite() {
+	s.Assertions = require.New(s.T())
+}
+
+func (s *VerifyTaskTestSuite) TestApplySchemaStmt() {
+	schema := newSchemaObjects()
+	applySchemaStmt(schema, "CREATE TABLE executions (shard_id int, type int, domain_id uuid, "+
+		"signal_map map<bigint, frozen<signal_info>>, PRIMARY KEY (shard_id, type, domain_id)) "+
+		"WITH COMPACTION = {'class': 'org.apache.cassandra.db.compaction.LeveledCompactionStrategy'};")
+	applySchemaStmt(schema, "CREATE TABLE `shards`(shard_id INT NOT NULL, range_id BIGINT NOT NULL, "+
+		"data BLOB NOT NULL, PRIMARY KEY (shard_id), UNIQUE KEY (range_id));")
+	applySchemaStmt(schema, "CREATE TYPE signal_info (version bigint, request_id uuid);")
+	applySchemaStmt(schema, "ALTER TABLE executions ADD workflow_state int;")
+	applySchemaStmt(schema, "ALTER TABLE shards ADD COLUMN owner VARCHAR(255), DROP COLUMN data;")
+	applySchemaStmt(schema, "ALTER TABLE executions ADD (next_event_id bigint, memo map<text, blob>);")
+	applySchemaStmt(schema, "ALTER TABLE shards ADD INDEX (owner), ADD(updated_at DATETIME(6));")
+	applySchemaStmt(schema, "ALTER TABLE executions WITH gc_grace_seconds=60;")
+	applySchemaStmt(schema, "INSERT INTO shards (shard_id) VALUES (1);")
+	applySchemaStmt(schema, "CREATE TYPE IF NOT EXISTS domain_config (\n  retention int,\n  emit_metric boolean,\n);")
+	applySchemaStmt(schema, "ALTER TYPE signal_info ADD signal_name text;")
+	applySchemaStmt(schema, "ALTER TYPE domain_config ADD bad_binaries map<text, frozen<bad_binary_info>>;")
+	applySchemaStmt(schema, "ALTER TYPE signal_info RENAME version TO signal_version AND request_id TO id;")
+	applySchemaStmt(schema, "ALTER TYPE unknown_type ADD data blob;")
+
+	s.Equal(objectMembers{
+		"executions": {"shard_id": {}, "type": {}, "domain_id": {}, "signal_map": {}, "workflow_state": {},
+			"next_event_id": {}, "memo": {}},
+		"shards": {"shard_id": {}, "range_id": {}, "owner": {}, "updated_at": {}},
+	}, schema.tables)
+	s.Equal(objectMembers{
+		"signal_info":   {"signal_version": {}, "id": {}, "signal_name": {}},
+		"domain_config": {"retention": {}, "emit_metric": {}, "bad_binaries": {}},
+	}, schema.types)
+}
+
+func (s *VerifyTaskTestSuite) TestReadExpectedSchema_VersionedSchemas() {
+	for _, dir := range []string{
+		"../../../schema/cassandra/cadence/versioned",
+		"../../../schema/cassandra/visibility/versioned",
+		"../../../schema/mysql/v57/cadence/versioned",
+		"../../../schema/mysql/v57/visibility/versioned",
+	} {
+		ver, err := getExpectedVersion(dir)
+		s.NoError(err)
+		schema, err := readExpectedSchema(dir, ver)
+		s.NoError(err)
+		s.NotEmpty(schema.tables, dir)
+		for table, columns := range schema.tables {
+			s.NotEmpty(columns, "%v: table %v", dir, table)
+		}
+		for t, fields := range schema.types {
+			s.NotEmpty(fields, "%v: type %v", dir, t)
+		}
+	}
+
+	// the task priority added in v0.24 is a field of the task type
+	schema, err := readExpectedSchema("../../../schema/cassandra/cadence/versioned", "0.24")
+	s.NoError(err)
+	s.Contains(schema.types["task"], "priority")
+}
+
+func (s *VerifyTaskTestSuite) TestRun() {
+	tmpDir := s.makeSchemaDir()
+	defer os.RemoveAll(tmpDir)
+
+	db := &fakeDB{
+		version: "1.0",
+		tables: map[string][]string{
+			"schema_version": {"keyspace_name", "curr_version"},
+			"domains":        {"id", "name"},
+		},
+		types: map[string][]string{
+			"domain_config": {"retention"},
+		},
+	}
+	task := newVerifySchemaTask(db, &VerifyConfig{SchemaDir: tmpDir})
+	s.NoError(task.Run())
+
+	db.version = "2.0"
+	s.Error(task.Run())
+	db.tables["domains"] = append(db.tables["domains"], "data")
+	s.NoError(task.Run())
+
+	db.types["domain_config"] = append(db.types["domain_config"], "emit_metric")
+	s.Error(task.Run())
+	db.types["domain_config"] = db.types["domain_config"][:1]
+	s.NoError(task.Run())
+
+	db.tables["tasks"] = []string{"id"}
+	s.Error(task.Run())
+}
+
+func (s *VerifyTaskTestSuite) TestDiffObjects() {
+	expected := objectMembers{
+		"domains": {"id": {}, "name": {}},
+		"shards":  {"shard_id": {}},
+	}
+	actual := objectMembers{
+		"domains": {"id": {}, "data": {}},
+		"tasks":   {"id": {}},
+	}
+	s.Empty(diffObjects("table", "column", expected, expected))
+	s.Equal([]string{
+		"missing table shards",
+		"table domains: missing column name",
+		"table domains: unexpected column data",
+		"unexpected table tasks",
+	}, diffObjects("table", "column", expected, actual))
+	s.Equal([]string{
+		"missing type shards",
+		"type domains: missing field name",
+		"type domains: unexpected field data",
+		"unexpected type tasks",
+	}, diffObjects("type", "field", expected, actual))
+}
+
+func (s *VerifyTaskTestSuite) makeSchemaDir() string {
+	tmpDir, err := ioutil.TempDir("", "verify_schema_test")
+	s.NoError(err)
+
+	versions := map[string]string{
+		"1.0": "CREATE TABLE domains (\n  id uuid,\n  name text, -- name of the domain\n  PRIMARY KEY (id)\n);\n" +
+			"CREATE TYPE domain_config (retention int);",
+		"2.0": "ALTER TABLE domains ADD data blob;",
+	}
+	for ver, stmts := range versions {
+		dir := tmpDir + "/v" + ver
+		s.NoError(os.Mkdir(dir, os.FileMode(0700)))
+		manifest := `{"CurrVersion": "` + ver + `", "MinCompatibleVersion": "1.0", "Description": "test", "SchemaUpdateCqlFiles": ["schema.cql"]}`
+		s.NoError(ioutil.WriteFile(dir+"/manifest.json", []byte(manifest), os.FileMode(0600)))
+		s.NoError(ioutil.WriteFile(dir+"/schema.cql", []byte(stmts), os.FileMode(0600)))
+	}
+	return tmpDir
+}
+
+func (db *fakeDB) ReadSchemaVersion() (string, error) {
+	return db.version, db.versionErr
+}
+
+func (db *fakeDB) ListTables() ([]string, error) {
+	var tables []string
+	for table := range db.tables {
+		tables = append(tables, table)
+	}
+	return tables, nil
+}
+
+func (db *fakeDB) ListColumns(table string) ([]string, error) {
+	return db.tables[table], nil
+}
+
+func (db *fakeDB) ListTypes() ([]string, error) {
+	var types []string
+	for t := range db.types {
+		types = append(types, t)
+	}
+	return types, nil
+}
+
+func (db *fakeDB) ListTypeFields(name string) ([]string, error) {
+	return db.types[name], nil
+}
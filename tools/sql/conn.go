@@ -49,6 +49,7 @@ const (
 	readSchemaVersionSQL        = `SELECT curr_version from schema_version where db_name=?`
 	writeSchemaVersionSQL       = `REPLACE into schema_version(db_name, creation_time, curr_version, min_compatible_version) VALUES (?,?,?,?)`
 	writeSchemaUpdateHistorySQL = `INSERT into schema_update_history(year, month, update_time, old_version, new_version, manifest_md5, description) VALUES(?,?,?,?,?,?,?)`
+	listColumnsSQL              = `SELECT column_name FROM information_schema.columns WHERE table_schema=? AND table_name=?`
 
 	createSchemaVersionTableSQL = `CREATE TABLE schema_version(db_name VARCHAR(255) not null PRIMARY KEY, ` +
 		`creation_time DATETIME(6), ` +
@@ -120,6 +121,23 @@ func (c *sqlConn) ListTables() ([]string, error) {
 	return tables, err
 }
 
+// ListColumns returns a list of the columns of a table in this database
+func (c *sqlConn) ListColumns(table string) ([]string, error) {
+	var columns []string
+	err := c.db.Select(&columns, listColumnsSQL, c.database, table)
+	return columns, err
+}
+
+// ListTypes returns nil, sql databases have no user defined types
+func (c *sqlConn) ListTypes() ([]string, error) {
+	return nil, nil
+}
+
+// ListTypeFields returns nil, sql databases have no user defined types
+func (c *sqlConn) ListTypeFields(name string) ([]string, error) {
+	return nil, nil
+}
+
 // DropTable drops a given table from the database
 func (c *sqlConn) DropTable(name string) error {
 	return c.Exec(fmt.Sprintf("DROP TABLE %v", name))
@@ -23,6 +23,7 @@ package sql
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/uber/cadence/tools/common/schema"
 	"github.com/urfave/cli"
@@ -36,15 +37,17 @@ func setupSchema(cli *cli.Context) error {
 	if err != nil {
 		return handleErr(schema.NewConfigError(err.Error()))
 	}
-	conn, err := newConn(params)
-	if err != nil {
-		return handleErr(err)
-	}
-	defer conn.Close()
-	if err := schema.Setup(cli, conn); err != nil {
-		return handleErr(err)
-	}
-	return nil
+	return forEachDatabase(params, func(p *sqlConnectParams) error {
+		conn, err := newConn(p)
+		if err != nil {
+			return handleErr(err)
+		}
+		defer conn.Close()
+		if err := schema.Setup(cli, conn); err != nil {
+			return handleErr(err)
+		}
+		return nil
+	})
 }
 
 // updateSchema executes the updateSchemaTask
@@ -54,22 +57,37 @@ func updateSchema(cli *cli.Context) error {
 	if err != nil {
 		return handleErr(schema.NewConfigError(err.Error()))
 	}
-	if params.database == schema.DryrunDBName {
-		p := *params
-		if err := doCreateDatabase(p, p.database); err != nil {
-			return handleErr(fmt.Errorf("error creating dryrun database: %v", err))
+	return forEachDatabase(params, func(p *sqlConnectParams) error {
+		conn, err := newConn(p)
+		if err != nil {
+			return handleErr(err)
 		}
-		defer doDropDatabase(p, p.database)
-	}
-	conn, err := newConn(params)
+		defer conn.Close()
+		if err := schema.Update(cli, conn); err != nil {
+			return handleErr(err)
+		}
+		return nil
+	})
+}
+
+// verifySchema executes the verifySchemaTask
+// using the given command line args as input
+func verifySchema(cli *cli.Context) error {
+	params, err := parseConnectParams(cli)
 	if err != nil {
-		return handleErr(err)
-	}
-	defer conn.Close()
-	if err := schema.Update(cli, conn); err != nil {
-		return handleErr(err)
+		return handleErr(schema.NewConfigError(err.Error()))
 	}
-	return nil
+	return forEachDatabase(params, func(p *sqlConnectParams) error {
+		conn, err := newConn(p)
+		if err != nil {
+			return handleErr(err)
+		}
+		defer conn.Close()
+		if err := schema.Verify(cli, conn); err != nil {
+			return handleErr(err)
+		}
+		return nil
+	})
 }
 
 // createDatabase creates a sql database
@@ -82,13 +100,41 @@ func createDatabase(cli *cli.Context) error {
 	if database == "" {
 		return handleErr(schema.NewConfigError("missing " + flag(schema.CLIOptKeyspace) + " argument "))
 	}
-	err = doCreateDatabase(*params, database)
-	if err != nil {
-		return handleErr(fmt.Errorf("error creating database:%v", err))
+	for _, name := range splitDatabases(database) {
+		if err := doCreateDatabase(*params, name); err != nil {
+			return handleErr(fmt.Errorf("error creating database %v:%v", name, err))
+		}
+	}
+	return nil
+}
+
+// forEachDatabase runs the given operation against every database of the
+// comma separated database argument, it stops at the first failure
+func forEachDatabase(params *sqlConnectParams, op func(p *sqlConnectParams) error) error {
+	names := splitDatabases(params.database)
+	for _, name := range names {
+		p := *params
+		p.database = name
+		if len(names) > 1 {
+			log.Printf("running against database %v\n", name)
+		}
+		if err := op(&p); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+func splitDatabases(database string) []string {
+	var result []string
+	for _, name := range strings.Split(database, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
 func doCreateDatabase(p sqlConnectParams, name string) error {
 	p.database = ""
 	conn, err := newConn(&p)
@@ -99,17 +145,6 @@ func doCreateDatabase(p sqlConnectParams, name string) error {
 	return conn.CreateDatabase(name)
 }
 
-func doDropDatabase(p sqlConnectParams, name string) {
-	p.database = ""
-	conn, err := newConn(&p)
-	if err != nil {
-		handleErr(err)
-		return
-	}
-	conn.DropDatabase(name)
-	conn.Close()
-}
-
 func parseConnectParams(cli *cli.Context) (*sqlConnectParams, error) {
 	params := new(sqlConnectParams)
 	params.host = cli.GlobalString(schema.CLIOptEndpoint)
@@ -118,22 +153,18 @@ func parseConnectParams(cli *cli.Context) (*sqlConnectParams, error) {
 	params.password = cli.GlobalString(schema.CLIOptPassword)
 	params.database = cli.GlobalString(schema.CLIOptDatabase)
 	params.driverName = cli.GlobalString(schema.CLIOptDriverName)
-	isDryRun := cli.Bool(schema.CLIOptDryrun)
-	if err := validateConnectParams(params, isDryRun); err != nil {
+	if err := validateConnectParams(params); err != nil {
 		return nil, err
 	}
 	return params, nil
 }
 
-func validateConnectParams(params *sqlConnectParams, isDryRun bool) error {
+func validateConnectParams(params *sqlConnectParams) error {
 	if len(params.host) == 0 {
 		return schema.NewConfigError("missing sql endpoint argument " + flag(schema.CLIOptEndpoint))
 	}
 	if params.database == "" {
-		if !isDryRun {
-			return schema.NewConfigError("missing " + flag(schema.CLIOptDatabase) + " argument ")
-		}
-		params.database = schema.DryrunDBName
+		return schema.NewConfigError("missing " + flag(schema.CLIOptDatabase) + " argument ")
 	}
 	return nil
 }
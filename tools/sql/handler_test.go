@@ -26,7 +26,6 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"github.com/uber/cadence/environment"
-	"github.com/uber/cadence/tools/common/schema"
 )
 
 type (
@@ -46,15 +45,29 @@ func (s *HandlerTestSuite) SetupTest() {
 
 func (s *HandlerTestSuite) TestValidateConnectParams() {
 	p := new(sqlConnectParams)
-	s.NotNil(validateConnectParams(p, false))
-	s.NotNil(validateConnectParams(p, true))
+	s.NotNil(validateConnectParams(p))
 
 	p.host = environment.GetMySQLAddress()
-	s.NotNil(validateConnectParams(p, false))
-	s.Nil(validateConnectParams(p, true))
-	s.Equal(schema.DryrunDBName, p.database)
+	s.NotNil(validateConnectParams(p))
 
 	p.database = "foobar"
-	s.Nil(validateConnectParams(p, false))
-	s.Nil(validateConnectParams(p, true))
+	s.Nil(validateConnectParams(p))
+}
+
+func (s *HandlerTestSuite) TestSplitDatabases() {
+	s.Equal([]string{"cadence"}, splitDatabases("cadence"))
+	s.Equal([]string{"cadence", "cadence1", "cadence2"}, splitDatabases("cadence, cadence1,,cadence2"))
+	s.Empty(splitDatabases(""))
+}
+
+func (s *HandlerTestSuite) TestForEachDatabase() {
+	p := &sqlConnectParams{host: environment.GetMySQLAddress(), database: "cadence,cadence1"}
+	var databases []string
+	err := forEachDatabase(p, func(p *sqlConnectParams) error {
+		databases = append(databases, p.database)
+		return nil
+	})
+	s.NoError(err)
+	s.Equal([]string{"cadence", "cadence1"}, databases)
+	s.Equal("cadence,cadence1", p.database)
 }
@@ -78,7 +78,7 @@ func buildCLIOptions() *cli.App {
 		cli.StringFlag{
 			Name:   schema.CLIFlagDatabase,
 			Value:  "cadence",
-			Usage:  "name of the sql database",
+			Usage:  "name of the sql database, or a comma separated list of databases to run the command against each of them",
 			EnvVar: "SQL_DATABASE",
 		},
 		cli.StringFlag{
@@ -135,13 +135,27 @@ func buildCLIOptions() *cli.App {
 				},
 				cli.BoolFlag{
 					Name:  schema.CLIFlagDryrun,
-					Usage: "do a dryrun",
+					Usage: "print the statements of the update without executing them",
 				},
 			},
 			Action: func(c *cli.Context) {
 				cliHandler(c, updateSchema)
 			},
 		},
+		{
+			Name:    "verify-schema",
+			Aliases: []string{"verify"},
+			Usage:   "verify that the sql schema has the tables and columns of the versioned schema of its current version, column types are not compared",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  schema.CLIFlagSchemaDir,
+					Usage: "path to directory containing versioned schema",
+				},
+			},
+			Action: func(c *cli.Context) {
+				cliHandler(c, verifySchema)
+			},
+		},
 		{
 			Name:    "create-database",
 			Aliases: []string{"create"},
@@ -149,7 +163,7 @@ func buildCLIOptions() *cli.App {
 			Flags: []cli.Flag{
 				cli.StringFlag{
 					Name:  schema.CLIFlagDatabase,
-					Usage: "name of the database",
+					Usage: "name of the database, or a comma separated list of databases to create",
 				},
 			},
 			Action: func(c *cli.Context) {